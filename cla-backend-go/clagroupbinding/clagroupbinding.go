@@ -0,0 +1,224 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package clagroupbinding
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+)
+
+// SignURLParameter is the sign URL query parameter selecting the CLA group to sign
+const SignURLParameter = "cla_group_id"
+
+// ErrInvalidBinding is returned when a binding has no CLA group, neither a path prefix nor a branch glob, or an empty
+// path prefix or branch glob
+var ErrInvalidBinding = errors.New("invalid CLA group binding")
+
+// Binding selects the CLA group of the changed files of a pull/merge request by path prefix and/or base branch - an
+// empty paths list matches any file and an empty branches list matches any base branch
+type Binding struct {
+	CLAGroupID string   `dynamodbav:"cla_group_id" json:"cla_group_id"`
+	Paths      []string `dynamodbav:"paths" json:"paths,omitempty"`
+	Branches   []string `dynamodbav:"branches" json:"branches,omitempty"`
+}
+
+// CLAGroup is a CLA group required by a pull/merge request
+type CLAGroup struct {
+	ID   string
+	Name string
+}
+
+// DisplayName returns the CLA group name, or the ID when the name is not known
+func (c CLAGroup) DisplayName() string {
+	if c.Name == "" {
+		return c.ID
+	}
+	return c.Name
+}
+
+// FromModels converts the API binding models
+func FromModels(bindings []*models.ClaGroupBinding) []Binding {
+	var response []Binding
+	for _, binding := range bindings {
+		if binding == nil {
+			continue
+		}
+		response = append(response, Binding{
+			CLAGroupID: utils.StringValue(binding.ClaGroupID),
+			Paths:      binding.Paths,
+			Branches:   binding.Branches,
+		})
+	}
+	return response
+}
+
+// ToModels converts the bindings to the API binding models
+func ToModels(bindings []Binding) []*models.ClaGroupBinding {
+	var response []*models.ClaGroupBinding
+	for _, binding := range bindings {
+		claGroupID := binding.CLAGroupID
+		response = append(response, &models.ClaGroupBinding{
+			ClaGroupID: &claGroupID,
+			Paths:      binding.Paths,
+			Branches:   binding.Branches,
+		})
+	}
+	return response
+}
+
+// ValidateBinding checks the binding has a CLA group, at least one constraint and no empty path prefix or branch glob
+func ValidateBinding(binding Binding) error {
+	if strings.TrimSpace(binding.CLAGroupID) == "" {
+		return fmt.Errorf("%w: the CLA group ID is required", ErrInvalidBinding)
+	}
+	if len(binding.Paths) == 0 && len(binding.Branches) == 0 {
+		return fmt.Errorf("%w: at least one path or branch is required", ErrInvalidBinding)
+	}
+	for _, prefix := range binding.Paths {
+		if strings.Trim(prefix, " /") == "" {
+			return fmt.Errorf("%w: empty path", ErrInvalidBinding)
+		}
+	}
+	for _, pattern := range binding.Branches {
+		if strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("%w: empty branch", ErrInvalidBinding)
+		}
+	}
+	return nil
+}
+
+// Select returns the IDs of the CLA groups which must cover a change of the files into the base branch, in the order
+// of the bindings followed by the default CLA group - each file, and the previous path of the renamed files, is covered
+// by the first binding matching the base branch and the path, and by the default CLA group when no binding matches
+func Select(bindings []Binding, defaultCLAGroupID, baseBranch string, files []trivialchange.File) []string {
+	var paths []string
+	for _, file := range files {
+		paths = append(paths, file.Path)
+		if file.PreviousPath != "" {
+			paths = append(paths, file.PreviousPath)
+		}
+	}
+	// a change without files is covered by the bindings of the base branch which match any path
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	selected := make([]bool, len(bindings))
+	coveredByDefault := false
+	for _, path := range paths {
+		index := firstMatch(bindings, baseBranch, path)
+		if index < 0 {
+			coveredByDefault = true
+			continue
+		}
+		selected[index] = true
+	}
+
+	var claGroupIDs []string
+	for i, binding := range bindings {
+		if selected[i] {
+			claGroupIDs = appendUnique(claGroupIDs, binding.CLAGroupID)
+		}
+	}
+	if coveredByDefault {
+		claGroupIDs = appendUnique(claGroupIDs, defaultCLAGroupID)
+	}
+	return claGroupIDs
+}
+
+// SelectForBranch returns the IDs of the CLA groups of every binding matching the base branch followed by the default
+// CLA group, used when the changed files of a pull/merge request cannot be loaded
+func SelectForBranch(bindings []Binding, defaultCLAGroupID, baseBranch string) []string {
+	var claGroupIDs []string
+	for _, binding := range bindings {
+		if ValidateBinding(binding) == nil && matchBranch(binding.Branches, baseBranch) {
+			claGroupIDs = appendUnique(claGroupIDs, binding.CLAGroupID)
+		}
+	}
+	return appendUnique(claGroupIDs, defaultCLAGroupID)
+}
+
+// IsDefault reports whether the selected CLA groups are only the default CLA group of the repository
+func IsDefault(claGroupIDs []string, defaultCLAGroupID string) bool {
+	return len(claGroupIDs) == 1 && claGroupIDs[0] == defaultCLAGroupID
+}
+
+// MatchPrefix reports whether the path is the prefix or is below the prefix directory
+func MatchPrefix(prefix, path string) bool {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	path = strings.TrimPrefix(path, "/")
+	if prefix == "" {
+		return false
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// SignURL returns the sign URL selecting the CLA group, the query parameter is added before the URL fragment
+func SignURL(signURL, claGroupID string) string {
+	if claGroupID == "" {
+		return signURL
+	}
+	fragment := ""
+	if index := strings.Index(signURL, "#"); index >= 0 {
+		signURL, fragment = signURL[:index], signURL[index:]
+	}
+	separator := "?"
+	if strings.Contains(signURL, "?") {
+		separator = "&"
+	}
+	return signURL + separator + SignURLParameter + "=" + url.QueryEscape(claGroupID) + fragment
+}
+
+// SignLinks returns the HTML links to sign the agreement of each CLA group
+func SignLinks(signURL string, claGroups []CLAGroup) string {
+	links := make([]string, 0, len(claGroups))
+	for _, claGroup := range claGroups {
+		links = append(links, fmt.Sprintf("<a href='%s' target='_blank'>%s</a>", SignURL(signURL, claGroup.ID), claGroup.DisplayName()))
+	}
+	return strings.Join(links, ", ")
+}
+
+func firstMatch(bindings []Binding, baseBranch, path string) int {
+	for i, binding := range bindings {
+		if ValidateBinding(binding) != nil || !matchBranch(binding.Branches, baseBranch) {
+			continue
+		}
+		if len(binding.Paths) == 0 {
+			return i
+		}
+		for _, prefix := range binding.Paths {
+			if MatchPrefix(prefix, path) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func matchBranch(patterns []string, branch string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if utils.MatchGlob(strings.TrimSpace(pattern), branch) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
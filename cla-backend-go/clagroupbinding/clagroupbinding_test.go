@@ -0,0 +1,112 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package clagroupbinding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelect(t *testing.T) {
+	bindings := []Binding{
+		{CLAGroupID: "foo-special", Paths: []string{"projects/foo/special"}},
+		{CLAGroupID: "foo", Paths: []string{"projects/foo/"}},
+		{CLAGroupID: "bar", Paths: []string{"projects/bar"}, Branches: []string{"main", "release/*"}},
+		{CLAGroupID: "legacy", Branches: []string{"legacy/**"}},
+	}
+
+	testCases := []struct {
+		name     string
+		branch   string
+		files    []trivialchange.File
+		expected []string
+	}{
+		{
+			name:     "files outside the bindings",
+			branch:   "main",
+			files:    []trivialchange.File{{Path: "README.md"}, {Path: "projects/foobar/main.go"}},
+			expected: []string{"default"},
+		},
+		{
+			name:     "first matching binding wins",
+			branch:   "main",
+			files:    []trivialchange.File{{Path: "projects/foo/special/a.go"}},
+			expected: []string{"foo-special"},
+		},
+		{
+			name:     "several sub-projects in the order of the bindings",
+			branch:   "release/1.0",
+			files:    []trivialchange.File{{Path: "projects/bar/b.go"}, {Path: "projects/foo/a.go"}, {Path: "Makefile"}},
+			expected: []string{"foo", "bar", "default"},
+		},
+		{
+			name:     "branch not bound",
+			branch:   "develop",
+			files:    []trivialchange.File{{Path: "projects/bar/b.go"}},
+			expected: []string{"default"},
+		},
+		{
+			name:     "renamed from another sub-project",
+			branch:   "main",
+			files:    []trivialchange.File{{Path: "projects/foo/b.go", PreviousPath: "projects/bar/b.go"}},
+			expected: []string{"foo", "bar"},
+		},
+		{
+			name:     "branch binding without paths",
+			branch:   "legacy/v1",
+			files:    []trivialchange.File{{Path: "projects/bar/b.go"}, {Path: "README.md"}},
+			expected: []string{"legacy"},
+		},
+		{
+			name:     "no files",
+			branch:   "legacy/v1",
+			expected: []string{"legacy"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Select(bindings, "default", tc.branch, tc.files))
+		})
+	}
+}
+
+func TestSelectForBranch(t *testing.T) {
+	bindings := []Binding{
+		{CLAGroupID: "foo", Paths: []string{"projects/foo"}},
+		{CLAGroupID: "bar", Paths: []string{"projects/bar"}, Branches: []string{"release/*"}},
+		{CLAGroupID: "default", Paths: []string{"docs"}},
+	}
+	assert.Equal(t, []string{"foo", "default"}, SelectForBranch(bindings, "default", "main"))
+	assert.Equal(t, []string{"foo", "bar", "default"}, SelectForBranch(bindings, "default", "release/2.0"))
+}
+
+func TestMatchPrefix(t *testing.T) {
+	assert.True(t, MatchPrefix("projects/foo", "projects/foo/main.go"))
+	assert.True(t, MatchPrefix("/projects/foo/", "projects/foo/a/b.go"))
+	assert.True(t, MatchPrefix("projects/foo/LICENSE", "projects/foo/LICENSE"))
+	assert.False(t, MatchPrefix("projects/foo", "projects/foobar/main.go"))
+	assert.False(t, MatchPrefix("/", "main.go"))
+}
+
+func TestValidateBinding(t *testing.T) {
+	assert.NoError(t, ValidateBinding(Binding{CLAGroupID: "foo", Paths: []string{"projects/foo"}}))
+	assert.NoError(t, ValidateBinding(Binding{CLAGroupID: "foo", Branches: []string{"release/*"}}))
+	assert.True(t, errors.Is(ValidateBinding(Binding{Paths: []string{"projects/foo"}}), ErrInvalidBinding))
+	assert.True(t, errors.Is(ValidateBinding(Binding{CLAGroupID: "foo"}), ErrInvalidBinding))
+	assert.True(t, errors.Is(ValidateBinding(Binding{CLAGroupID: "foo", Paths: []string{"/"}}), ErrInvalidBinding))
+	assert.True(t, errors.Is(ValidateBinding(Binding{CLAGroupID: "foo", Branches: []string{" "}}), ErrInvalidBinding))
+}
+
+func TestSignURL(t *testing.T) {
+	assert.Equal(t, "https://api.example.org/v2/repository-provider/github/sign/1/2/3/?cla_group_id=foo#/?version=2",
+		SignURL("https://api.example.org/v2/repository-provider/github/sign/1/2/3/#/?version=2", "foo"))
+	assert.Equal(t, "https://api.example.org/sign?a=b&cla_group_id=foo", SignURL("https://api.example.org/sign?a=b", "foo"))
+	assert.Equal(t, "https://api.example.org/sign", SignURL("https://api.example.org/sign", ""))
+	assert.Equal(t, "<a href='https://x/?cla_group_id=foo#/' target='_blank'>Foo</a>, <a href='https://x/?cla_group_id=bar#/' target='_blank'>bar</a>",
+		SignLinks("https://x/#/", []CLAGroup{{ID: "foo", Name: "Foo"}, {ID: "bar"}}))
+}
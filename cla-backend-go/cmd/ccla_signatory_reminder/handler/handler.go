@@ -0,0 +1,91 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/communitybridge/easycla/cla-backend-go/cmd/signservice"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	ini "github.com/communitybridge/easycla/cla-backend-go/init"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	awsSession *session.Session
+	stage      string
+	configFile config.Config
+)
+
+// Init initializes the handler
+func Init() {
+	f := logrus.Fields{
+		"functionName": "cmd.ccla_signatory_reminder.handler.Init",
+	}
+	ctx := utils.NewContext()
+	f[utils.XREQUESTID] = ctx.Value(utils.XREQUESTID)
+	log.WithFields(f).Debug("initializing...")
+
+	// General initialization
+	ini.Init()
+
+	var awsErr error
+	awsSession, awsErr = ini.GetAWSSession()
+	if awsErr != nil {
+		log.WithFields(f).WithError(awsErr).Panic("unable to load AWS session")
+	}
+
+	// Need to initialize the system to load the configuration which contains a number of SSM parameters
+	stage = os.Getenv("STAGE")
+	if stage == "" {
+		log.WithFields(f).Panic("unable to determine STAGE - please set in the environment variable: 'STAGE' - expected one of [DEV, STAGING, PROD]")
+	}
+
+	dynamodbRegion := os.Getenv("DYNAMODB_AWS_REGION")
+	if dynamodbRegion == "" {
+		log.WithFields(f).Panic("unable to determine DYNAMODB_AWS_REGION - please set in the environment variable: 'DYNAMODB_AWS_REGION'")
+	}
+
+	var configErr error
+	configFile, configErr = config.LoadConfig("", awsSession, stage)
+	if configErr != nil {
+		log.WithFields(f).WithError(configErr).Panicf("Unable to load config - Error: %v", configErr)
+	}
+
+	if configFile.ClaAPIV4Base == "" {
+		log.WithFields(f).Panic("unable to determine configFile.ClaAPIV4Base value - please set the configuration")
+	}
+}
+
+// Handler is invoked each time the lambda is triggered - https://docs.aws.amazon.com/lambda/latest/dg/golang-handler.html
+func Handler(ctx context.Context) error {
+	f := logrus.Fields{
+		"functionName": "cmd.ccla_signatory_reminder.handler.Handler",
+	}
+
+	// Add the x-request-id to the context
+	ctx = utils.NewContextFromParent(ctx)
+	f[utils.XREQUESTID] = ctx.Value(utils.XREQUESTID)
+
+	signService, err := signservice.NewSignService(awsSession, stage, configFile)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to initialize the sign service")
+		return err
+	}
+
+	log.WithFields(f).Debug("start - processing pending CCLA signatory requests")
+	summary, err := signService.ProcessPendingCorporateSignatures(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem processing pending CCLA signatory requests")
+		return err
+	}
+
+	log.WithFields(f).Debugf("done - checked %d requests, reminded %d, expired %d, failed %d",
+		summary.Checked, summary.Reminded, summary.Expired, summary.Failed)
+	return nil
+}
@@ -38,6 +38,7 @@ import (
 
 	"github.com/communitybridge/easycla/cla-backend-go/v2/approvals"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/dynamo_events"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/resign_campaigns"
 
 	"github.com/communitybridge/easycla/cla-backend-go/token"
 
@@ -100,6 +101,7 @@ func init() {
 	claManagerRequestsRepo := cla_manager.NewRepository(awsSession, stage)
 	approvalListRequestsRepo := approval_list.NewRepository(awsSession, stage)
 	githubOrganizationsRepo := github_organizations.NewRepository(awsSession, stage)
+	github.InitEnterpriseApps(github_organizations.NewEnterpriseAppLoader(githubOrganizationsRepo))
 	gitlabOrganizationRepo := gitlab_organizations.NewRepository(awsSession, stage)
 	storeRepo := store.NewRepository(awsSession, stage)
 	approvalsTableName := fmt.Sprintf("cla-%s-approvals", stage)
@@ -142,6 +144,14 @@ func init() {
 	v2CompanyService := v2Company.NewService(companyService, signaturesRepo, projectRepo, usersRepo, companyRepo, projectClaGroupRepo, eventsService)
 	organization_service.InitClient(configFile.APIGatewayURL, eventsService)
 	acs_service.InitClient(configFile.APIGatewayURL, configFile.AcsAPIKey)
+	lfGroup := &gerrits.LFGroup{
+		LfBaseURL:     configFile.LFGroup.ClientURL,
+		ClientID:      configFile.LFGroup.ClientID,
+		ClientSecret:  configFile.LFGroup.ClientSecret,
+		RefreshToken:  configFile.LFGroup.RefreshToken,
+		EventsService: eventsService,
+	}
+	resignCampaignService := resign_campaigns.NewService(projectService, signaturesRepo, usersService, storeRepo, eventsService, gerritService, lfGroup, configFile.CLALandingPage)
 	dynamoEventsService = dynamo_events.NewService(
 		stage,
 		signaturesRepo,
@@ -160,6 +170,7 @@ func init() {
 		approvalListRequestsRepo,
 		gitlabApp,
 		gitlabOrgService,
+		resignCampaignService,
 	)
 }
 
@@ -0,0 +1,121 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/communitybridge/easycla/cla-backend-go/cmd/signservice"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	ini "github.com/communitybridge/easycla/cla-backend-go/init"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultMinAge is the default minimum age of an unsigned signature before we check the envelope - gives DocuSign
+	// Connect a chance to deliver the callback first
+	defaultMinAge = 1 * time.Hour
+	// defaultMaxAge is the default maximum age of an unsigned signature that we check
+	defaultMaxAge = 30 * 24 * time.Hour
+)
+
+var (
+	awsSession *session.Session
+	stage      string
+	configFile config.Config
+	minAge     = defaultMinAge
+	maxAge     = defaultMaxAge
+)
+
+// Init initializes the handler
+func Init() {
+	f := logrus.Fields{
+		"functionName": "cmd.envelope_reconcile.handler.Init",
+	}
+	ctx := utils.NewContext()
+	f[utils.XREQUESTID] = ctx.Value(utils.XREQUESTID)
+	log.WithFields(f).Debug("initializing...")
+
+	// General initialization
+	ini.Init()
+
+	var awsErr error
+	awsSession, awsErr = ini.GetAWSSession()
+	if awsErr != nil {
+		log.WithFields(f).WithError(awsErr).Panic("unable to load AWS session")
+	}
+
+	// Need to initialize the system to load the configuration which contains a number of SSM parameters
+	stage = os.Getenv("STAGE")
+	if stage == "" {
+		log.WithFields(f).Panic("unable to determine STAGE - please set in the environment variable: 'STAGE' - expected one of [DEV, STAGING, PROD]")
+	}
+
+	dynamodbRegion := os.Getenv("DYNAMODB_AWS_REGION")
+	if dynamodbRegion == "" {
+		log.WithFields(f).Panic("unable to determine DYNAMODB_AWS_REGION - please set in the environment variable: 'DYNAMODB_AWS_REGION'")
+	}
+
+	minAge = durationFromEnv(f, "RECONCILE_MIN_AGE", defaultMinAge)
+	maxAge = durationFromEnv(f, "RECONCILE_MAX_AGE", defaultMaxAge)
+
+	var configErr error
+	configFile, configErr = config.LoadConfig("", awsSession, stage)
+	if configErr != nil {
+		log.WithFields(f).WithError(configErr).Panicf("Unable to load config - Error: %v", configErr)
+	}
+
+	if configFile.ClaAPIV4Base == "" {
+		log.WithFields(f).Panic("unable to determine configFile.ClaAPIV4Base value - please set the configuration")
+	}
+}
+
+// Handler is invoked each time the lambda is triggered - https://docs.aws.amazon.com/lambda/latest/dg/golang-handler.html
+func Handler(ctx context.Context) error {
+	f := logrus.Fields{
+		"functionName": "cmd.envelope_reconcile.handler.Handler",
+	}
+
+	// Add the x-request-id to the context
+	ctx = utils.NewContextFromParent(ctx)
+	f[utils.XREQUESTID] = ctx.Value(utils.XREQUESTID)
+
+	signService, err := signservice.NewSignService(awsSession, stage, configFile)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to initialize the sign service")
+		return err
+	}
+
+	log.WithFields(f).Debugf("start - reconciling unsigned signature envelopes modified between %s and %s ago", minAge, maxAge)
+	summary, err := signService.ReconcileEnvelopes(ctx, minAge, maxAge)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem reconciling unsigned signature envelopes")
+		return err
+	}
+
+	log.WithFields(f).Debugf("done - checked %d envelopes, reconciled %d, still pending %d, failed %d",
+		summary.Checked, summary.Reconciled, summary.Pending, summary.Failed)
+	return nil
+}
+
+// durationFromEnv returns the duration value of the environment variable, or the default value if not set or invalid
+func durationFromEnv(f logrus.Fields, key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to parse %s value: %s - using default value: %s", key, value, defaultValue)
+		return defaultValue
+	}
+
+	return duration
+}
@@ -0,0 +1,255 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/github/branch_protection"
+	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// auditUser is the user the drift events are logged for
+const auditUser = "easycla system"
+
+// protectionRepository is the part of the branch_protection.BranchProtectionRepository used by the audit
+type protectionRepository interface {
+	GetProtectedBranch(ctx context.Context, owner, repoName, protectedBranchName string) (*branch_protection.BranchProtectionRule, error)
+	GetDefaultBranchForRepo(ctx context.Context, owner, repoName string) (string, error)
+	GetBranchRulesets(ctx context.Context, owner, repoName, branchName string) ([]*branch_protection.BranchRuleset, error)
+	EnableBranchProtection(ctx context.Context, owner, repoName, branchName string, enforceAdmin bool, enableStatusChecks, disableStatusChecks []string) error
+}
+
+// protectionRepositoryFactory creates the protectionRepository for the app installation of a github organization, on
+// the GitHub host of its base URL
+type protectionRepositoryFactory func(installationID int64, baseURL string) (protectionRepository, error)
+
+func newProtectionRepository(installationID int64, baseURL string) (protectionRepository, error) {
+	repo, err := branch_protection.NewBranchProtectionRepository(installationID, branch_protection.EnableBlockingLimiter(),
+		branch_protection.WithGitHubBaseURL(baseURL))
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// AuditSummary is the outcome of an audit run
+type AuditSummary struct {
+	Organizations int
+	Repositories  int
+	Drifted       int
+	Remediated    int
+	Failed        int
+}
+
+// auditor checks the branch protection of the enabled repositories of the github organizations configured to setup
+// the branch protection
+type auditor struct {
+	githubOrgRepo        github_organizations.RepositoryInterface
+	repositoryRepo       repositories.RepositoryInterface
+	eventsService        events.Service
+	emailService         emails.Service
+	newProtectionRepo    protectionRepositoryFactory
+	requiredStatusChecks []string
+}
+
+func newAuditor(githubOrgRepo github_organizations.RepositoryInterface, repositoryRepo repositories.RepositoryInterface, eventsService events.Service, emailService emails.Service) *auditor {
+	return &auditor{
+		githubOrgRepo:        githubOrgRepo,
+		repositoryRepo:       repositoryRepo,
+		eventsService:        eventsService,
+		emailService:         emailService,
+		newProtectionRepo:    newProtectionRepository,
+		requiredStatusChecks: []string{utils.GitHubBotName},
+	}
+}
+
+// Run audits the github organizations and sends a digest of the drifted repositories to the project managers of each
+// CLA group
+func (a *auditor) Run(ctx context.Context) (*AuditSummary, error) {
+	f := logrus.Fields{
+		"functionName":   "cmd.github_branch_protection_audit.handler.auditor.Run",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	githubOrgs, err := a.githubOrgRepo.GetGitHubBranchProtectedOrganizations(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem loading the github organizations with branch protection enabled")
+		return nil, err
+	}
+
+	summary := &AuditSummary{}
+	digests := map[string][]emails.GithubBranchProtectionDriftRepository{}
+	for _, githubOrg := range githubOrgs {
+		if githubOrg.OrganizationInstallationID == 0 {
+			log.WithFields(f).Debugf("github organization: %s has no app installation - skipping", githubOrg.OrganizationName)
+			continue
+		}
+		summary.Organizations++
+		a.auditOrganization(ctx, githubOrg, summary, digests)
+	}
+
+	a.sendDigests(ctx, digests)
+	return summary, nil
+}
+
+// auditOrganization audits the enabled repositories of the github organization, the drifted repositories are added to
+// the digest of their CLA group
+func (a *auditor) auditOrganization(ctx context.Context, githubOrg *github_organizations.GithubOrganization, summary *AuditSummary, digests map[string][]emails.GithubBranchProtectionDriftRepository) {
+	f := logrus.Fields{
+		"functionName":     "cmd.github_branch_protection_audit.handler.auditor.auditOrganization",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": githubOrg.OrganizationName,
+		"installationID":   githubOrg.OrganizationInstallationID,
+		"autoRemediation":  githubOrg.BranchProtectionAutoRemediation,
+	}
+
+	repos, err := a.repositoryRepo.GitHubGetRepositoriesByOrganizationName(ctx, githubOrg.OrganizationName)
+	if err != nil {
+		if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+			log.WithFields(f).Debug("github organization has no repositories")
+			return
+		}
+		log.WithFields(f).WithError(err).Warn("problem loading the github organization repositories")
+		return
+	}
+
+	protectionRepo, err := a.newProtectionRepo(githubOrg.OrganizationInstallationID, githubOrg.OrganizationBaseURL)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("initializing branch protection repository failed")
+		return
+	}
+
+	for _, repo := range repos {
+		if !repo.Enabled || repo.RepositoryType != utils.GitHubType {
+			continue
+		}
+		summary.Repositories++
+
+		drift, err := a.auditRepository(ctx, protectionRepo, githubOrg.OrganizationName, repo.RepositoryName)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("problem auditing the branch protection of the repository: %s", repo.RepositoryName)
+			summary.Failed++
+			continue
+		}
+		if len(drift) == 0 {
+			continue
+		}
+		summary.Drifted++
+		log.WithFields(f).Infof("the branch protection of the repository: %s drifted: %v", repo.RepositoryName, drift)
+
+		driftRepo := emails.GithubBranchProtectionDriftRepository{
+			RepositoryName: repo.RepositoryName,
+			BranchName:     utils.GithubBranchProtectionPatternAll,
+			Drift:          drift,
+		}
+		if githubOrg.BranchProtectionAutoRemediation {
+			err = protectionRepo.EnableBranchProtection(ctx, githubOrg.OrganizationName, repo.RepositoryName,
+				utils.GithubBranchProtectionPatternAll, true, a.requiredStatusChecks, []string{})
+			if err != nil {
+				log.WithFields(f).WithError(err).Warnf("problem re-applying the branch protection of the repository: %s", repo.RepositoryName)
+				driftRepo.RemediationFailed = true
+				summary.Failed++
+			} else {
+				driftRepo.Remediated = true
+				summary.Remediated++
+			}
+		}
+
+		a.eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+			EventType:   events.RepositoryBranchProtectionDrift,
+			ProjectSFID: repo.RepositoryProjectSfid,
+			CLAGroupID:  repo.RepositoryClaGroupID,
+			LfUsername:  auditUser,
+			UserID:      auditUser,
+			EventData: &events.RepositoryBranchProtectionDriftEventData{
+				RepositoryName: repo.RepositoryName,
+				BranchName:     utils.GithubBranchProtectionPatternAll,
+				Drift:          drift,
+				Remediated:     driftRepo.Remediated,
+			},
+		})
+
+		digests[repo.RepositoryClaGroupID] = append(digests[repo.RepositoryClaGroupID], driftRepo)
+	}
+}
+
+// auditRepository returns how the branch protection of the repository drifted from the protection EasyCLA sets up,
+// the required status checks may also be enforced by the rulesets of the default branch
+func (a *auditor) auditRepository(ctx context.Context, protectionRepo protectionRepository, owner, repoName string) ([]string, error) {
+	f := logrus.Fields{
+		"functionName":   "cmd.github_branch_protection_audit.handler.auditor.auditRepository",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"owner":          owner,
+		"repoName":       repoName,
+	}
+
+	protection, err := protectionRepo.GetProtectedBranch(ctx, owner, repoName, utils.GithubBranchProtectionPatternAll)
+	if err != nil && !errors.Is(err, branch_protection.ErrBranchNotProtected) {
+		return nil, err
+	}
+
+	drift := branch_protection.ProtectionDrift(protection, true, a.requiredStatusChecks)
+	if len(drift) == 0 {
+		return nil, nil
+	}
+
+	defaultBranch, err := protectionRepo.GetDefaultBranchForRepo(ctx, owner, repoName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem loading the default branch, the rulesets are not checked")
+		return drift, nil
+	}
+	// the rulesets may be unavailable for the plan of the organization
+	rulesets, err := protectionRepo.GetBranchRulesets(ctx, owner, repoName, defaultBranch)
+	if err != nil {
+		log.WithFields(f).WithError(err).Debug("problem loading the rulesets of the default branch")
+		return drift, nil
+	}
+	for _, check := range a.requiredStatusChecks {
+		if !branch_protection.RequiresStatusCheck(rulesets, check) {
+			return drift, nil
+		}
+	}
+
+	log.WithFields(f).Debugf("the rulesets of the default branch: %s require the status checks", defaultBranch)
+	return nil, nil
+}
+
+// sendDigests notifies the project managers of each CLA group of the drifted repositories
+func (a *auditor) sendDigests(ctx context.Context, digests map[string][]emails.GithubBranchProtectionDriftRepository) {
+	f := logrus.Fields{
+		"functionName":   "cmd.github_branch_protection_audit.handler.auditor.sendDigests",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	for claGroupID, driftRepos := range digests {
+		if claGroupID == "" {
+			log.WithFields(f).Warnf("%d drifted repositories have no CLA group - no digest sent", len(driftRepos))
+			continue
+		}
+
+		subject := "EasyCLA: Github Branch Protection Changed"
+		body, err := emails.RenderGithubBranchProtectionDriftTemplate(a.emailService, claGroupID, emails.GithubBranchProtectionDriftTemplateParams{
+			CommonEmailParams: emails.CommonEmailParams{
+				RecipientName: "Project Manager",
+			},
+			Repositories: driftRepos,
+		})
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("rendering email template for the CLA group: %s failed", claGroupID)
+			continue
+		}
+
+		if err := a.emailService.NotifyProjectManagersForClaGroupID(ctx, claGroupID, subject, body); err != nil {
+			log.WithFields(f).WithError(err).Warnf("notifying project managers of the CLA group: %s via email failed", claGroupID)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	mock_events "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github/branch_protection"
+	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
+	mock_github_organizations "github.com/communitybridge/easycla/cla-backend-go/github_organizations/mock"
+	mock_repositories "github.com/communitybridge/easycla/cla-backend-go/repositories/mock"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProtectionRepo serves the branch protection of the repositories by name, the repositories without a rule are not
+// protected
+type fakeProtectionRepo struct {
+	protections map[string]*branch_protection.BranchProtectionRule
+	rulesets    map[string][]*branch_protection.BranchRuleset
+	lookupErrs  map[string]error
+	enableErr   error
+	enabled     []string
+}
+
+func (r *fakeProtectionRepo) GetProtectedBranch(ctx context.Context, owner, repoName, protectedBranchName string) (*branch_protection.BranchProtectionRule, error) {
+	if err := r.lookupErrs[repoName]; err != nil {
+		return nil, err
+	}
+	protection, ok := r.protections[repoName]
+	if !ok {
+		return nil, branch_protection.ErrBranchNotProtected
+	}
+	return protection, nil
+}
+
+func (r *fakeProtectionRepo) GetDefaultBranchForRepo(ctx context.Context, owner, repoName string) (string, error) {
+	return "main", nil
+}
+
+func (r *fakeProtectionRepo) GetBranchRulesets(ctx context.Context, owner, repoName, branchName string) ([]*branch_protection.BranchRuleset, error) {
+	return r.rulesets[repoName], nil
+}
+
+func (r *fakeProtectionRepo) EnableBranchProtection(ctx context.Context, owner, repoName, branchName string, enforceAdmin bool, enableStatusChecks, disableStatusChecks []string) error {
+	if r.enableErr != nil {
+		return r.enableErr
+	}
+	r.enabled = append(r.enabled, owner+"/"+repoName)
+	return nil
+}
+
+// fakeEmailService records the digests sent per CLA group
+type fakeEmailService struct {
+	emails.EmailTemplateService
+	projectManagerDigests map[string]string
+	claManagerDigests     map[string]string
+}
+
+func (s *fakeEmailService) GetCLAGroupTemplateParamsFromCLAGroup(claGroupID string) (emails.CLAGroupTemplateParams, error) {
+	return emails.CLAGroupTemplateParams{CLAGroupName: "CLA Group " + claGroupID, Version: utils.V2}, nil
+}
+
+func (s *fakeEmailService) NotifyClaManagersForClaGroupID(ctx context.Context, claGroupID, subject, body string) error {
+	s.claManagerDigests[claGroupID] = body
+	return nil
+}
+
+func (s *fakeEmailService) NotifyProjectManagersForClaGroupID(ctx context.Context, claGroupID, subject, body string) error {
+	s.projectManagerDigests[claGroupID] = body
+	return nil
+}
+
+func protectedRule() *branch_protection.BranchProtectionRule {
+	return &branch_protection.BranchProtectionRule{
+		Pattern:                     utils.GithubBranchProtectionPatternAll,
+		RequiresStatusChecks:        true,
+		RequiredStatusCheckContexts: []string{utils.GitHubBotName},
+		IsAdminEnforced:             true,
+	}
+}
+
+func githubRepo(name, claGroupID string, enabled bool) *models.GithubRepository {
+	return &models.GithubRepository{
+		RepositoryName:       name,
+		RepositoryType:       utils.GitHubType,
+		RepositoryClaGroupID: claGroupID,
+		Enabled:              enabled,
+	}
+}
+
+func TestAuditorRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	adminNotEnforced := protectedRule()
+	adminNotEnforced.IsAdminEnforced = false
+	protectionRepos := map[int64]*fakeProtectionRepo{
+		1: {
+			protections: map[string]*branch_protection.BranchProtectionRule{"protected": protectedRule()},
+			rulesets: map[string][]*branch_protection.BranchRuleset{
+				"ruleset": {{Name: "default branch", RequiredStatusChecks: []string{utils.GitHubBotName}}},
+			},
+		},
+		2: {
+			protections: map[string]*branch_protection.BranchProtectionRule{"drifted": adminNotEnforced},
+			lookupErrs:  map[string]error{"failing": errors.New("github unavailable")},
+		},
+	}
+
+	githubOrgRepo := mock_github_organizations.NewMockRepositoryInterface(ctrl)
+	githubOrgRepo.EXPECT().GetGitHubBranchProtectedOrganizations(gomock.Any()).Return([]*github_organizations.GithubOrganization{
+		{OrganizationName: "org-a", OrganizationInstallationID: 1, BranchProtectionAutoRemediation: true, OrganizationBaseURL: "https://ghe.example.org/api/v3/"},
+		{OrganizationName: "org-b", OrganizationInstallationID: 2},
+		{OrganizationName: "org-c"},
+	}, nil)
+
+	repositoryRepo := mock_repositories.NewMockRepositoryInterface(ctrl)
+	repositoryRepo.EXPECT().GitHubGetRepositoriesByOrganizationName(gomock.Any(), "org-a").Return([]*models.GithubRepository{
+		githubRepo("protected", "cla-group-1", true),
+		githubRepo("unprotected", "cla-group-1", true),
+		githubRepo("ruleset", "cla-group-1", true),
+		githubRepo("disabled", "cla-group-1", false),
+	}, nil)
+	repositoryRepo.EXPECT().GitHubGetRepositoriesByOrganizationName(gomock.Any(), "org-b").Return([]*models.GithubRepository{
+		githubRepo("drifted", "cla-group-2", true),
+		githubRepo("failing", "cla-group-2", true),
+	}, nil)
+
+	var driftEvents []*events.RepositoryBranchProtectionDriftEventData
+	eventsService := mock_events.NewMockService(ctrl)
+	eventsService.EXPECT().LogEventWithContext(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, args *events.LogEventArgs) {
+		assert.Equal(t, events.RepositoryBranchProtectionDrift, args.EventType)
+		driftEvents = append(driftEvents, args.EventData.(*events.RepositoryBranchProtectionDriftEventData))
+	}).Times(2)
+
+	emailService := &fakeEmailService{projectManagerDigests: map[string]string{}, claManagerDigests: map[string]string{}}
+	a := newAuditor(githubOrgRepo, repositoryRepo, eventsService, emailService)
+	var baseURLs []string
+	a.newProtectionRepo = func(installationID int64, baseURL string) (protectionRepository, error) {
+		baseURLs = append(baseURLs, baseURL)
+		return protectionRepos[installationID], nil
+	}
+
+	summary, err := a.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, AuditSummary{Organizations: 2, Repositories: 5, Drifted: 2, Remediated: 1, Failed: 1}, *summary)
+	// the protection client is created on the GitHub host of each organization
+	assert.Equal(t, []string{"https://ghe.example.org/api/v3/", ""}, baseURLs)
+
+	// only the organization with the auto-remediation re-applies the protection
+	assert.Equal(t, []string{"org-a/unprotected"}, protectionRepos[1].enabled)
+	assert.Empty(t, protectionRepos[2].enabled)
+
+	assert.Len(t, driftEvents, 2)
+	assert.Equal(t, "unprotected", driftEvents[0].RepositoryName)
+	assert.Equal(t, []string{branch_protection.DriftProtectionRemoved}, driftEvents[0].Drift)
+	assert.True(t, driftEvents[0].Remediated)
+	assert.Equal(t, "drifted", driftEvents[1].RepositoryName)
+	assert.Equal(t, []string{branch_protection.DriftAdminEnforcementDisabled}, driftEvents[1].Drift)
+	assert.False(t, driftEvents[1].Remediated)
+
+	// the digests go to the project managers of each CLA group
+	assert.Empty(t, emailService.claManagerDigests)
+	assert.Len(t, emailService.projectManagerDigests, 2)
+	assert.True(t, strings.Contains(emailService.projectManagerDigests["cla-group-1"], "Hello Project Manager"))
+	assert.True(t, strings.Contains(emailService.projectManagerDigests["cla-group-1"], "<li>unprotected ("))
+	assert.True(t, strings.Contains(emailService.projectManagerDigests["cla-group-1"], "the branch protection was re-applied"))
+	assert.False(t, strings.Contains(emailService.projectManagerDigests["cla-group-1"], "<li>ruleset ("))
+	assert.True(t, strings.Contains(emailService.projectManagerDigests["cla-group-2"], "<li>drifted ("))
+	assert.True(t, strings.Contains(emailService.projectManagerDigests["cla-group-2"], "please review the branch protection on Github"))
+}
+
+func TestAuditorRun_RemediationFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	protectionRepo := &fakeProtectionRepo{enableErr: errors.New("resource not accessible by integration")}
+
+	githubOrgRepo := mock_github_organizations.NewMockRepositoryInterface(ctrl)
+	githubOrgRepo.EXPECT().GetGitHubBranchProtectedOrganizations(gomock.Any()).Return([]*github_organizations.GithubOrganization{
+		{OrganizationName: "org-a", OrganizationInstallationID: 1, BranchProtectionAutoRemediation: true},
+	}, nil)
+	repositoryRepo := mock_repositories.NewMockRepositoryInterface(ctrl)
+	repositoryRepo.EXPECT().GitHubGetRepositoriesByOrganizationName(gomock.Any(), "org-a").Return([]*models.GithubRepository{
+		githubRepo("unprotected", "cla-group-1", true),
+	}, nil)
+
+	var driftEvent *events.RepositoryBranchProtectionDriftEventData
+	eventsService := mock_events.NewMockService(ctrl)
+	eventsService.EXPECT().LogEventWithContext(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, args *events.LogEventArgs) {
+		driftEvent = args.EventData.(*events.RepositoryBranchProtectionDriftEventData)
+	})
+
+	emailService := &fakeEmailService{projectManagerDigests: map[string]string{}, claManagerDigests: map[string]string{}}
+	a := newAuditor(githubOrgRepo, repositoryRepo, eventsService, emailService)
+	a.newProtectionRepo = func(installationID int64, baseURL string) (protectionRepository, error) {
+		return protectionRepo, nil
+	}
+
+	summary, err := a.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, AuditSummary{Organizations: 1, Repositories: 1, Drifted: 1, Failed: 1}, *summary)
+	assert.False(t, driftEvent.Remediated)
+	assert.True(t, strings.Contains(emailService.projectManagerDigests["cla-group-1"], "re-applying the branch protection failed"))
+}
@@ -0,0 +1,132 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	v1Company "github.com/communitybridge/easycla/cla-backend-go/company"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gerrits"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
+	ini "github.com/communitybridge/easycla/cla-backend-go/init"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
+	"github.com/communitybridge/easycla/cla-backend-go/project/service"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	v1Repositories "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	awsSession *session.Session
+	stage      string
+	configFile config.Config
+)
+
+// Init initializes the handler
+func Init() {
+	f := logrus.Fields{
+		"functionName": "cmd.github_branch_protection_audit.handler.Init",
+	}
+	ctx := utils.NewContext()
+	f[utils.XREQUESTID] = ctx.Value(utils.XREQUESTID)
+	log.WithFields(f).Debug("initializing...")
+
+	// General initialization
+	ini.Init()
+
+	var awsErr error
+	awsSession, awsErr = ini.GetAWSSession()
+	if awsErr != nil {
+		log.WithFields(f).WithError(awsErr).Panic("unable to load AWS session")
+	}
+
+	// Need to initialize the system to load the configuration which contains a number of SSM parameters
+	stage = os.Getenv("STAGE")
+	if stage == "" {
+		log.WithFields(f).Panic("unable to determine STAGE - please set in the environment variable: 'STAGE' - expected one of [DEV, STAGING, PROD]")
+	}
+
+	dynamodbRegion := os.Getenv("DYNAMODB_AWS_REGION")
+	if dynamodbRegion == "" {
+		log.WithFields(f).Panic("unable to determine DYNAMODB_AWS_REGION - please set in the environment variable: 'DYNAMODB_AWS_REGION'")
+	}
+
+	var configErr error
+	configFile, configErr = config.LoadConfig("", awsSession, stage)
+	if configErr != nil {
+		log.WithFields(f).WithError(configErr).Panicf("Unable to load config - Error: %v", configErr)
+	}
+
+	if configFile.GitHub.AppID == 0 {
+		log.WithFields(f).Panic("unable to determine configFile.GitHub.AppID value - please set the configuration")
+	}
+	if configFile.GitHub.AppPrivateKey == "" {
+		log.WithFields(f).Panic("unable to determine configFile.GitHub.AppPrivateKey value - please set the configuration")
+	}
+
+	github.Init(configFile.GitHub.AppID, configFile.GitHub.AppPrivateKey, configFile.GitHub.AccessToken)
+}
+
+// Handler is invoked each time the lambda is triggered - https://docs.aws.amazon.com/lambda/latest/dg/golang-handler.html
+func Handler(ctx context.Context) error {
+	f := logrus.Fields{
+		"functionName": "cmd.github_branch_protection_audit.handler.Handler",
+	}
+
+	// Add the x-request-id to the context
+	ctx = utils.NewContextFromParent(ctx)
+	f[utils.XREQUESTID] = ctx.Value(utils.XREQUESTID)
+
+	// Repository Layer
+	usersRepo := users.NewRepository(awsSession, stage)
+	eventsRepo := events.NewRepository(awsSession, stage)
+	v1CompanyRepo := v1Company.NewRepository(awsSession, stage)
+	gerritRepo := gerrits.NewRepository(awsSession, stage)
+	v1ProjectClaGroupRepo := projects_cla_groups.NewRepository(awsSession, stage)
+	gitV1Repository := v1Repositories.NewRepository(awsSession, stage)
+	githubOrganizationsRepo := github_organizations.NewRepository(awsSession, stage)
+	github.InitEnterpriseApps(github_organizations.NewEnterpriseAppLoader(githubOrganizationsRepo))
+	v1CLAGroupRepo := repository.NewRepository(awsSession, stage, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo)
+
+	// Service Layer
+	type combinedRepo struct {
+		users.UserRepository
+		v1Company.IRepository
+		repository.ProjectRepository
+		projects_cla_groups.Repository
+	}
+
+	eventsService := events.NewService(eventsRepo, combinedRepo{
+		usersRepo,
+		v1CompanyRepo,
+		v1CLAGroupRepo,
+		v1ProjectClaGroupRepo,
+	})
+
+	v1ProjectService := service.NewService(v1CLAGroupRepo, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo, usersRepo)
+	emailTemplateService := emails.NewEmailTemplateService(v1CLAGroupRepo, v1ProjectClaGroupRepo, v1ProjectService, configFile.CorporateConsoleV1URL, configFile.CorporateConsoleV2URL)
+	emailService := emails.NewService(emailTemplateService, v1ProjectService)
+
+	utils.SetSnsEmailSender(awsSession, configFile.SNSEventTopicARN, configFile.SenderEmailAddress)
+
+	log.WithFields(f).Debug("start - auditing the branch protection of the github repositories")
+	summary, err := newAuditor(githubOrganizationsRepo, gitV1Repository, eventsService, emailService).Run(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem auditing the branch protection of the github repositories")
+		return err
+	}
+
+	log.WithFields(f).Debugf("done - audited %d repositories of %d github organizations, drifted %d, remediated %d, failed %d",
+		summary.Repositories, summary.Organizations, summary.Drifted, summary.Remediated, summary.Failed)
+	return nil
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
 	"github.com/communitybridge/easycla/cla-backend-go/project/service"
 
+	gitea_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitea-activity"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_sign"
 	gitlab_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitlab-activity"
 
 	"github.com/go-openapi/strfmt"
@@ -26,6 +29,10 @@ import (
 
 	gitlab "github.com/communitybridge/easycla/cla-backend-go/gitlab_api"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/gitlab_sign"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/resign_campaigns"
+
+	"github.com/communitybridge/easycla/cla-backend-go/exemptions"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/exemption_rules"
 
 	"github.com/communitybridge/easycla/cla-backend-go/emails"
 
@@ -260,10 +267,13 @@ func server(localMode bool) http.Handler {
 	v1CLAGroupRepo := repository.NewRepository(awsSession, stage, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo)
 	metricsRepo := metrics.NewRepository(awsSession, stage, configFile.APIGatewayURL, v1ProjectClaGroupRepo)
 	githubOrganizationsRepo := github_organizations.NewRepository(awsSession, stage)
+	github.InitEnterpriseApps(github_organizations.NewEnterpriseAppLoader(githubOrganizationsRepo))
 	gitlabOrganizationRepo := gitlab_organizations.NewRepository(awsSession, stage)
+	giteaOrganizationRepo := gitea_organizations.NewRepository(awsSession, stage)
 	claManagerReqRepo := cla_manager.NewRepository(awsSession, stage)
 	storeRepository := store.NewRepository(awsSession, stage)
 	approvalsRepo := approvals.NewRepository(stage, awsSession, fmt.Sprintf("cla-%s-approvals", stage))
+	exemptionsRepo := exemptions.NewRepository(awsSession, stage)
 
 	// Our service layer handlers
 	eventsService := events.NewService(eventsRepo, combinedRepo{
@@ -274,6 +284,7 @@ func server(localMode bool) http.Handler {
 	})
 
 	gerritService := gerrits.NewService(gerritRepo)
+	exemptionsService := exemptions.NewService(exemptionsRepo, gitV1Repository, v1CLAGroupRepo, v1ProjectClaGroupRepo, eventsService)
 
 	// Signature repository handler
 	signaturesRepo := signatures.NewRepository(awsSession, stage, v1CompanyRepo, usersRepo, eventsService, gitV1Repository, githubOrganizationsRepo, gerritService, approvalsRepo)
@@ -301,21 +312,32 @@ func server(localMode bool) http.Handler {
 	v2RepositoriesService := v2Repositories.NewService(gitV1Repository, gitV2Repository, v1ProjectClaGroupRepo, githubOrganizationsRepo, gitlabOrganizationRepo, eventsService)
 	githubOrganizationsService := github_organizations.NewService(githubOrganizationsRepo, gitV1Repository, v1ProjectClaGroupRepo)
 	gitlabOrganizationsService := gitlab_organizations.NewService(gitlabOrganizationRepo, v2RepositoriesService, v1ProjectClaGroupRepo, storeRepository, usersService, signaturesRepo, v1CompanyRepo)
-	v1SignaturesService := signatures.NewService(signaturesRepo, v1CompanyService, usersService, eventsService, githubOrgValidation, v1RepositoriesService, githubOrganizationsService, v1ProjectService, gitlabApp, configFile.ClaV1ApiURL, configFile.CLALandingPage, configFile.CLALogoURL)
+	v1SignaturesService := signatures.NewService(signaturesRepo, v1CompanyService, usersService, eventsService, githubOrgValidation, v1RepositoriesService, githubOrganizationsService, v1ProjectService, gitlabApp, exemptionsService, configFile.ClaV1ApiURL, configFile.CLALandingPage, configFile.CLALogoURL)
 	v2SignatureService := v2Signatures.NewService(awsSession, configFile.SignatureFilesBucket, v1ProjectService, v1CompanyService, v1SignaturesService, v1ProjectClaGroupRepo, signaturesRepo, usersService, approvalsRepo)
 	v1ClaManagerService := cla_manager.NewService(claManagerReqRepo, v1ProjectClaGroupRepo, v1CompanyService, v1ProjectService, usersService, v1SignaturesService, eventsService, emailTemplateService, configFile.CorporateConsoleV1URL)
 	v2ClaManagerService := v2ClaManager.NewService(emailTemplateService, v1CompanyService, v1ProjectService, v1ClaManagerService, usersService, v1RepositoriesService, v2CompanyService, eventsService, v1ProjectClaGroupRepo)
 	v1ApprovalListService := approval_list.NewService(approvalListRepo, v1ProjectClaGroupRepo, v1ProjectService, usersRepo, v1CompanyRepo, v1CLAGroupRepo, signaturesRepo, emailTemplateService, configFile.CorporateConsoleV2URL, http.DefaultClient)
 	authorizer := auth.NewAuthorizer(authValidator, userRepo)
 	v2MetricsService := metrics.NewService(metricsRepo, v1ProjectClaGroupRepo)
-	gitlabActivityService := gitlab_activity.NewService(gitV1Repository, gitV2Repository, usersRepo, signaturesRepo, v1ProjectClaGroupRepo, v1CompanyRepo, signaturesRepo, gitlabOrganizationsService)
+	gitlabActivityService := gitlab_activity.NewService(gitV1Repository, gitV2Repository, usersRepo, signaturesRepo, v1ProjectClaGroupRepo, v1CLAGroupRepo, v1CompanyRepo, signaturesRepo, gitlabOrganizationsService, exemptionsService)
 	gitlabSignService := gitlab_sign.NewService(v2RepositoriesService, usersService, storeRepository, gitlabApp, gitlabOrganizationsService)
+	giteaOrganizationsService := gitea_organizations.NewService(giteaOrganizationRepo, gitV2Repository, v1ProjectClaGroupRepo)
+	giteaActivityService := gitea_activity.NewService(giteaOrganizationsService, usersRepo, signaturesRepo, v1CompanyRepo)
+	giteaSignService := gitea_sign.NewService(giteaOrganizationsService, usersService, storeRepository)
 	v2GithubOrganizationsService := v2GithubOrganizations.NewService(githubOrganizationsRepo, gitV1Repository, v1ProjectClaGroupRepo, githubOrganizationsService)
 	autoEnableService := dynamo_events.NewAutoEnableService(v1RepositoriesService, gitV1Repository, githubOrganizationsRepo, v1ProjectClaGroupRepo, v1ProjectService)
-	v2GithubActivityService := v2GithubActivity.NewService(gitV1Repository, githubOrganizationsRepo, eventsService, autoEnableService, emailService)
+	v2GithubActivityService := v2GithubActivity.NewService(gitV1Repository, githubOrganizationsRepo, eventsService, autoEnableService, emailService, usersRepo, v1SignaturesService, v1CLAGroupRepo, exemptionsService, storeRepository, configFile.ClaV1ApiURL, configFile.CLALandingPage, configFile.CLALogoURL)
 
 	v2ClaGroupService := cla_groups.NewService(v1ProjectService, templateService, v1ProjectClaGroupRepo, v1ClaManagerService, v1SignaturesService, metricsRepo, gerritService, v1RepositoriesService, eventsService)
-	v2SignService := sign.NewService(configFile.ClaAPIV4Base, configFile.ClaV1ApiURL, v1CompanyRepo, v1CLAGroupRepo, v1ProjectClaGroupRepo, v1CompanyService, v2ClaGroupService, configFile.DocuSignPrivateKey, usersService, v1SignaturesService, storeRepository, v1RepositoriesService, githubOrganizationsService, gitlabOrganizationsService, configFile.CLALandingPage, configFile.CLALogoURL, emailService, eventsService, gitlabActivityService, gitlabApp, gerritService)
+	v2SignService := sign.NewService(configFile.ClaAPIV4Base, configFile.ClaV1ApiURL, v1CompanyRepo, v1CLAGroupRepo, v1ProjectClaGroupRepo, v1CompanyService, v2ClaGroupService, configFile.DocuSignPrivateKey, usersService, v1SignaturesService, storeRepository, v1RepositoriesService, githubOrganizationsService, gitlabOrganizationsService, configFile.CLALandingPage, configFile.CLALogoURL, emailService, eventsService, gitlabActivityService, gitlabApp, gerritService, giteaOrganizationsService, giteaActivityService, v2GithubActivityService)
+	lfGroup := &gerrits.LFGroup{
+		LfBaseURL:     configFile.LFGroup.ClientURL,
+		ClientID:      configFile.LFGroup.ClientID,
+		ClientSecret:  configFile.LFGroup.ClientSecret,
+		RefreshToken:  configFile.LFGroup.RefreshToken,
+		EventsService: eventsService,
+	}
+	resignCampaignService := resign_campaigns.NewService(v1ProjectService, signaturesRepo, usersService, storeRepository, eventsService, gerritService, lfGroup, configFile.CLALandingPage)
 
 	sessionStore, err := dynastore.New(dynastore.Path("/"), dynastore.HTTPOnly(), dynastore.TableName(configFile.SessionStoreTableName), dynastore.DynamoDB(dynamodb.New(awsSession)))
 	if err != nil {
@@ -353,6 +375,9 @@ func server(localMode bool) http.Handler {
 	gitlab_organizations.Configure(v2API, gitlabOrganizationsService, eventsService, sessionStore, configFile.CLAContributorv2Base)
 	gitlab_sign.Configure(v2API, gitlabSignService, eventsService, configFile.CLAContributorv2Base, sessionStore)
 	gitlab_activity.Configure(v2API, gitlabActivityService, gitlabOrganizationsService, eventsService, gitlabApp, gitlabSignService, configFile.CLAContributorv2Base, sessionStore)
+	gitea_organizations.Configure(v2API, giteaOrganizationsService, eventsService)
+	gitea_sign.Configure(v2API, giteaSignService, giteaOrganizationsService, eventsService, configFile.CLAContributorv2Base, sessionStore)
+	gitea_activity.Configure(v2API, giteaActivityService)
 	v1Repositories.Configure(api, v1RepositoriesService, eventsService)
 	v2Repositories.Configure(v2API, v2RepositoriesService, eventsService)
 	gerrits.Configure(api, gerritService, v1ProjectService, eventsService)
@@ -362,12 +387,22 @@ func server(localMode bool) http.Handler {
 	v2ClaManager.Configure(v2API, v2ClaManagerService, v1CompanyService, configFile.LFXPortalURL, configFile.CorporateConsoleV2URL, v1ProjectClaGroupRepo, userRepo)
 	cla_groups.Configure(v2API, v2ClaGroupService, v1ProjectService, v1ProjectClaGroupRepo, eventsService)
 	sign.Configure(v2API, v2SignService, usersService)
+	resign_campaigns.Configure(v2API, resignCampaignService, v1ProjectClaGroupRepo)
+	exemption_rules.Configure(v2API, exemptionsService)
 	v2GithubActivity.Configure(v2API, v2GithubActivityService)
 
-	v2API.AddMiddlewareFor("POST", "/signed/individual/{installation_id}/{github_repository_id}/{change_request_id}", sign.DocusignMiddleware)
-	v2API.AddMiddlewareFor("POST", "/signed/corporate/{project_id}/{company_id}", sign.CCLADocusignMiddleware)
-	v2API.AddMiddlewareFor("POST", "/signed/gitlab/individual/{user_id}/{organization_id}/{gitlab_repository_id}/{merge_request_id}", sign.DocusignMiddleware)
-	v2API.AddMiddlewareFor("POST", "/signed/gerrit/individual/{user_id}", sign.DocusignMiddleware)
+	if len(configFile.DocuSignConnectHMACKeys) == 0 {
+		log.WithFields(f).Warn("security - no DocuSign Connect HMAC keys configured - the signed callbacks can not be verified")
+	}
+	if len(configFile.DocuSignConnectHMACKeys) > 0 && configFile.DocuSignConnectHMACDisabled {
+		log.WithFields(f).Warn("security - DocuSign Connect HMAC validation is disabled - the signed callbacks which fail the validation are logged and processed")
+	}
+	v2API.AddMiddlewareFor("POST", "/signed/individual/{installation_id}/{github_repository_id}/{change_request_id}", sign.DocusignMiddleware(eventsService))
+	v2API.AddMiddlewareFor("POST", "/signed/corporate/{project_id}/{company_id}", sign.CCLADocusignMiddleware(eventsService))
+	v2API.AddMiddlewareFor("POST", "/signed/gitlab/individual/{user_id}/{organization_id}/{gitlab_repository_id}/{merge_request_id}", sign.DocusignMiddleware(eventsService))
+	v2API.AddMiddlewareFor("POST", "/signed/gerrit/individual/{user_id}", sign.DocusignMiddleware(eventsService))
+	v2API.AddMiddlewareFor("POST", "/signed/gitea/individual/{user_id}/{organization_id}/{gitea_repository_id}/{pull_request_id}", sign.DocusignMiddleware(eventsService))
+	v2API.AddMiddlewareFor("POST", "/gitea/activity", gitea_activity.WebhookMiddleware())
 
 	userCreaterMiddleware := func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
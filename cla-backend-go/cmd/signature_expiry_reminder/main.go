@@ -0,0 +1,11 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import "github.com/communitybridge/easycla/cla-backend-go/cmd/signature_expiry_reminder/handler"
+
+func main() {
+	handler.Init()
+	handler.RunHandler()
+}
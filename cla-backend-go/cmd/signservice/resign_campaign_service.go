@@ -0,0 +1,72 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package signservice
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	v1Company "github.com/communitybridge/easycla/cla-backend-go/company"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gerrits"
+	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
+	"github.com/communitybridge/easycla/cla-backend-go/project/service"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	v1Repositories "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/approvals"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/resign_campaigns"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
+)
+
+// NewResignCampaignService wires up the re-sign campaign service and its dependencies for the lambdas which enforce
+// the re-sign deadlines outside of the API server
+func NewResignCampaignService(awsSession *session.Session, stage string, configFile config.Config) resign_campaigns.Service {
+	// Repository Layer
+	usersRepo := users.NewRepository(awsSession, stage)
+	gitV1Repository := v1Repositories.NewRepository(awsSession, stage)
+	gerritRepo := gerrits.NewRepository(awsSession, stage)
+	v1CompanyRepo := v1Company.NewRepository(awsSession, stage)
+	eventsRepo := events.NewRepository(awsSession, stage)
+	v1ProjectClaGroupRepo := projects_cla_groups.NewRepository(awsSession, stage)
+	v1CLAGroupRepo := repository.NewRepository(awsSession, stage, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo)
+	githubOrganizationsRepo := github_organizations.NewRepository(awsSession, stage)
+	storeRepository := store.NewRepository(awsSession, stage)
+	approvalsRepo := approvals.NewRepository(stage, awsSession, fmt.Sprintf("cla-%s-approvals", stage))
+
+	// Service Layer
+	type combinedRepo struct {
+		users.UserRepository
+		v1Company.IRepository
+		repository.ProjectRepository
+		projects_cla_groups.Repository
+	}
+
+	eventsService := events.NewService(eventsRepo, combinedRepo{
+		usersRepo,
+		v1CompanyRepo,
+		v1CLAGroupRepo,
+		v1ProjectClaGroupRepo,
+	})
+
+	gerritService := gerrits.NewService(gerritRepo)
+	signaturesRepo := signatures.NewRepository(awsSession, stage, v1CompanyRepo, usersRepo, eventsService, gitV1Repository, githubOrganizationsRepo, gerritService, approvalsRepo)
+	usersService := users.NewService(usersRepo, eventsService)
+	v1ProjectService := service.NewService(v1CLAGroupRepo, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo, usersRepo)
+	lfGroup := &gerrits.LFGroup{
+		LfBaseURL:     configFile.LFGroup.ClientURL,
+		ClientID:      configFile.LFGroup.ClientID,
+		ClientSecret:  configFile.LFGroup.ClientSecret,
+		RefreshToken:  configFile.LFGroup.RefreshToken,
+		EventsService: eventsService,
+	}
+
+	utils.SetSnsEmailSender(awsSession, configFile.SNSEventTopicARN, configFile.SenderEmailAddress)
+
+	return resign_campaigns.NewService(v1ProjectService, signaturesRepo, usersService, storeRepository, eventsService, gerritService, lfGroup, configFile.CLALandingPage)
+}
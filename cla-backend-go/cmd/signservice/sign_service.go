@@ -0,0 +1,125 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package signservice
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/communitybridge/easycla/cla-backend-go/cla_manager"
+	v1Company "github.com/communitybridge/easycla/cla-backend-go/company"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/docraptor"
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/exemptions"
+	"github.com/communitybridge/easycla/cla-backend-go/gerrits"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
+	gitlab "github.com/communitybridge/easycla/cla-backend-go/gitlab_api"
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
+	"github.com/communitybridge/easycla/cla-backend-go/project/service"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	v1Repositories "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/template"
+	"github.com/communitybridge/easycla/cla-backend-go/user"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/approvals"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/cla_groups"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/dynamo_events"
+	gitea_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitea-activity"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	v2GithubActivity "github.com/communitybridge/easycla/cla-backend-go/v2/github_activity"
+	gitlab_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitlab-activity"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitlab_organizations"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/metrics"
+	organization_service "github.com/communitybridge/easycla/cla-backend-go/v2/organization-service"
+	project_service "github.com/communitybridge/easycla/cla-backend-go/v2/project-service"
+	v2Repositories "github.com/communitybridge/easycla/cla-backend-go/v2/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/sign"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
+	user_service "github.com/communitybridge/easycla/cla-backend-go/v2/user-service"
+)
+
+// NewSignService wires up the sign service and its dependencies for the lambdas which process signatures outside of
+// the API server
+func NewSignService(awsSession *session.Session, stage string, configFile config.Config) (sign.Service, error) {
+	docraptorClient, err := docraptor.NewDocraptorClient(configFile.Docraptor.APIKey, configFile.Docraptor.TestMode)
+	if err != nil {
+		return nil, err
+	}
+
+	github.Init(configFile.GitHub.AppID, configFile.GitHub.AppPrivateKey, configFile.GitHub.AccessToken)
+	gitlabApp := gitlab.Init(configFile.Gitlab.AppClientID, configFile.Gitlab.AppClientSecret, configFile.Gitlab.AppPrivateKey)
+
+	// Repository Layer
+	userRepo := user.NewDynamoRepository(awsSession, stage)
+	usersRepo := users.NewRepository(awsSession, stage)
+	gitV1Repository := v1Repositories.NewRepository(awsSession, stage)
+	gitV2Repository := v2Repositories.NewRepository(awsSession, stage)
+	gerritRepo := gerrits.NewRepository(awsSession, stage)
+	templateRepo := template.NewRepository(awsSession, stage)
+	v1CompanyRepo := v1Company.NewRepository(awsSession, stage)
+	eventsRepo := events.NewRepository(awsSession, stage)
+	v1ProjectClaGroupRepo := projects_cla_groups.NewRepository(awsSession, stage)
+	v1CLAGroupRepo := repository.NewRepository(awsSession, stage, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo)
+	metricsRepo := metrics.NewRepository(awsSession, stage, configFile.APIGatewayURL, v1ProjectClaGroupRepo)
+	githubOrganizationsRepo := github_organizations.NewRepository(awsSession, stage)
+	github.InitEnterpriseApps(github_organizations.NewEnterpriseAppLoader(githubOrganizationsRepo))
+	gitlabOrganizationRepo := gitlab_organizations.NewRepository(awsSession, stage)
+	giteaOrganizationRepo := gitea_organizations.NewRepository(awsSession, stage)
+	claManagerReqRepo := cla_manager.NewRepository(awsSession, stage)
+	storeRepository := store.NewRepository(awsSession, stage)
+	approvalsRepo := approvals.NewRepository(stage, awsSession, fmt.Sprintf("cla-%s-approvals", stage))
+	exemptionsRepo := exemptions.NewRepository(awsSession, stage)
+
+	// Service Layer
+	type combinedRepo struct {
+		users.UserRepository
+		v1Company.IRepository
+		repository.ProjectRepository
+		projects_cla_groups.Repository
+	}
+
+	eventsService := events.NewService(eventsRepo, combinedRepo{
+		usersRepo,
+		v1CompanyRepo,
+		v1CLAGroupRepo,
+		v1ProjectClaGroupRepo,
+	})
+
+	gerritService := gerrits.NewService(gerritRepo)
+	exemptionsService := exemptions.NewService(exemptionsRepo, gitV1Repository, v1CLAGroupRepo, v1ProjectClaGroupRepo, eventsService)
+	signaturesRepo := signatures.NewRepository(awsSession, stage, v1CompanyRepo, usersRepo, eventsService, gitV1Repository, githubOrganizationsRepo, gerritService, approvalsRepo)
+
+	user_service.InitClient(configFile.PlatformAPIGatewayURL, configFile.AcsAPIKey)
+	project_service.InitClient(configFile.PlatformAPIGatewayURL)
+	organization_service.InitClient(configFile.PlatformAPIGatewayURL, eventsService)
+
+	usersService := users.NewService(usersRepo, eventsService)
+	templateService := template.NewService(stage, templateRepo, docraptorClient, awsSession)
+	v1ProjectService := service.NewService(v1CLAGroupRepo, gitV1Repository, gerritRepo, v1ProjectClaGroupRepo, usersRepo)
+	emailTemplateService := emails.NewEmailTemplateService(v1CLAGroupRepo, v1ProjectClaGroupRepo, v1ProjectService, configFile.CorporateConsoleV1URL, configFile.CorporateConsoleV2URL)
+	emailService := emails.NewService(emailTemplateService, v1ProjectService)
+	v1CompanyService := v1Company.NewService(v1CompanyRepo, configFile.CorporateConsoleV1URL, userRepo, usersService)
+	v1RepositoriesService := v1Repositories.NewService(gitV1Repository, githubOrganizationsRepo, v1ProjectClaGroupRepo)
+	v2RepositoriesService := v2Repositories.NewService(gitV1Repository, gitV2Repository, v1ProjectClaGroupRepo, githubOrganizationsRepo, gitlabOrganizationRepo, eventsService)
+	githubOrganizationsService := github_organizations.NewService(githubOrganizationsRepo, gitV1Repository, v1ProjectClaGroupRepo)
+	gitlabOrganizationsService := gitlab_organizations.NewService(gitlabOrganizationRepo, v2RepositoriesService, v1ProjectClaGroupRepo, storeRepository, usersService, signaturesRepo, v1CompanyRepo)
+	v1SignaturesService := signatures.NewService(signaturesRepo, v1CompanyService, usersService, eventsService, true, v1RepositoriesService, githubOrganizationsService, v1ProjectService, gitlabApp, exemptionsService, configFile.ClaV1ApiURL, configFile.CLALandingPage, configFile.CLALogoURL)
+	v1ClaManagerService := cla_manager.NewService(claManagerReqRepo, v1ProjectClaGroupRepo, v1CompanyService, v1ProjectService, usersService, v1SignaturesService, eventsService, emailTemplateService, configFile.CorporateConsoleV1URL)
+	gitlabActivityService := gitlab_activity.NewService(gitV1Repository, gitV2Repository, usersRepo, signaturesRepo, v1ProjectClaGroupRepo, v1CLAGroupRepo, v1CompanyRepo, signaturesRepo, gitlabOrganizationsService, exemptionsService)
+	giteaOrganizationsService := gitea_organizations.NewService(giteaOrganizationRepo, gitV2Repository, v1ProjectClaGroupRepo)
+	giteaActivityService := gitea_activity.NewService(giteaOrganizationsService, usersRepo, signaturesRepo, v1CompanyRepo)
+	autoEnableService := dynamo_events.NewAutoEnableService(v1RepositoriesService, gitV1Repository, githubOrganizationsRepo, v1ProjectClaGroupRepo, v1ProjectService)
+	v2GithubActivityService := v2GithubActivity.NewService(gitV1Repository, githubOrganizationsRepo, eventsService, autoEnableService, emailService, usersRepo, v1SignaturesService, v1CLAGroupRepo, exemptionsService, storeRepository, configFile.ClaV1ApiURL, configFile.CLALandingPage, configFile.CLALogoURL)
+	v2ClaGroupService := cla_groups.NewService(v1ProjectService, templateService, v1ProjectClaGroupRepo, v1ClaManagerService, v1SignaturesService, metricsRepo, gerritService, v1RepositoriesService, eventsService)
+
+	utils.SetSnsEmailSender(awsSession, configFile.SNSEventTopicARN, configFile.SenderEmailAddress)
+	utils.SetS3Storage(awsSession, configFile.SignatureFilesBucket)
+
+	return sign.NewService(configFile.ClaAPIV4Base, configFile.ClaV1ApiURL, v1CompanyRepo, v1CLAGroupRepo, v1ProjectClaGroupRepo, v1CompanyService, v2ClaGroupService, configFile.DocuSignPrivateKey, usersService, v1SignaturesService, storeRepository, v1RepositoriesService, githubOrganizationsService, gitlabOrganizationsService, configFile.CLALandingPage, configFile.CLALogoURL, emailService, eventsService, gitlabActivityService, gitlabApp, gerritService, giteaOrganizationsService, giteaActivityService, v2GithubActivityService), nil
+}
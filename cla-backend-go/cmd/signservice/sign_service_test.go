@@ -0,0 +1,67 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package signservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSession(t *testing.T) *session.Session {
+	awsSession, err := session.NewSession(&aws.Config{Region: aws.String("us-east-1")})
+	assert.NoError(t, err)
+	return awsSession
+}
+
+func testConfig() config.Config {
+	return config.Config{
+		Docraptor:             config.Docraptor{APIKey: "docraptor-key", TestMode: true},
+		ClaAPIV4Base:          "https://api.test/v4",
+		ClaV1ApiURL:           "https://api.test",
+		PlatformAPIGatewayURL: "https://platform.test",
+		SNSEventTopicARN:      "arn:aws:sns:us-east-1:123456789012:cla-events",
+		SenderEmailAddress:    "admin@test.org",
+		SignatureFilesBucket:  "cla-signature-files-test",
+	}
+}
+
+func TestNewSignService(t *testing.T) {
+	previousEmailSender := utils.GetEmailSender()
+	t.Cleanup(func() {
+		utils.SetEmailSender(previousEmailSender)
+		utils.SetS3StorageClient(nil)
+	})
+
+	t.Run("sign service is wired up", func(tt *testing.T) {
+		utils.SetEmailSender(nil)
+		signService, err := NewSignService(testSession(tt), "test", testConfig())
+		assert.NoError(tt, err)
+		assert.NotNil(tt, signService)
+		// the lambdas send the signatory emails and store the signed documents
+		assert.NotNil(tt, utils.GetEmailSender())
+	})
+
+	t.Run("missing docraptor key is an error", func(tt *testing.T) {
+		configFile := testConfig()
+		configFile.Docraptor.APIKey = ""
+		signService, err := NewSignService(testSession(tt), "test", configFile)
+		assert.Error(tt, err)
+		assert.Nil(tt, signService)
+	})
+}
+
+func TestNewResignCampaignService(t *testing.T) {
+	previousEmailSender := utils.GetEmailSender()
+	t.Cleanup(func() { utils.SetEmailSender(previousEmailSender) })
+	utils.SetEmailSender(nil)
+
+	resignCampaignService := NewResignCampaignService(testSession(t), "test", testConfig())
+	assert.NotNil(t, resignCampaignService)
+	assert.NotNil(t, utils.GetEmailSender())
+}
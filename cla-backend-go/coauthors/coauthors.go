@@ -0,0 +1,80 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package coauthors
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	coAuthorRegex = regexp.MustCompile(`(?mi)^\s*Co-authored-by:\s*(.*?)\s*<([^<>\s]+)>\s*$`)
+
+	// <id>+<login>@users.noreply.github.com, or <login>@users.noreply.github.com for the older accounts
+	gitHubNoreplyRegex = regexp.MustCompile(`(?i)^(?:(\d+)\+)?([a-z0-9](?:[a-z0-9-]*[a-z0-9])?)@users\.noreply\.github\.com$`)
+	// <id>-<username>@users.noreply.gitlab.com
+	gitLabNoreplyRegex = regexp.MustCompile(`(?i)^(\d+)-([a-z0-9_.-]+)@users\.noreply\.gitlab\.com$`)
+)
+
+// CoAuthor is a single Co-authored-by trailer
+type CoAuthor struct {
+	Name  string
+	Email string
+}
+
+// String returns the co-author in the git trailer format
+func (c CoAuthor) String() string {
+	return fmt.Sprintf("%s <%s>", c.Name, c.Email)
+}
+
+// Parse returns the Co-authored-by trailers of the commit message, the same co-author listed twice is returned once
+func Parse(message string) []CoAuthor {
+	var coAuthors []CoAuthor
+	seen := map[string]bool{}
+	for _, match := range coAuthorRegex.FindAllStringSubmatch(message, -1) {
+		email := strings.TrimSpace(match[2])
+		if seen[strings.ToLower(email)] {
+			continue
+		}
+		seen[strings.ToLower(email)] = true
+		coAuthors = append(coAuthors, CoAuthor{
+			Name:  strings.TrimSpace(match[1]),
+			Email: email,
+		})
+	}
+	return coAuthors
+}
+
+// GitHubNoreply returns the GitHub user ID and login of a GitHub noreply email address - the ID is 0 for the older
+// address format which only carries the login
+func GitHubNoreply(email string) (int64, string, bool) {
+	match := gitHubNoreplyRegex.FindStringSubmatch(strings.TrimSpace(email))
+	if match == nil {
+		return 0, "", false
+	}
+	var id int64
+	if match[1] != "" {
+		parsed, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return 0, "", false
+		}
+		id = parsed
+	}
+	return id, match[2], true
+}
+
+// GitLabNoreply returns the GitLab user ID and username of a GitLab noreply email address
+func GitLabNoreply(email string) (int, string, bool) {
+	match := gitLabNoreplyRegex.FindStringSubmatch(strings.TrimSpace(email))
+	if match == nil {
+		return 0, "", false
+	}
+	id, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, "", false
+	}
+	return id, match[2], true
+}
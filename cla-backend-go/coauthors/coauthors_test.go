@@ -0,0 +1,45 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package coauthors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	message := "Fix the build\n\nSome details.\n\nCo-authored-by: Jane Doe <jane@example.org>\nco-authored-by: John Doe <john@example.org>\nCo-Authored-By: Jane Doe <Jane@Example.org>\nSigned-off-by: Other <other@example.org>"
+	assert.Equal(t, []CoAuthor{
+		{Name: "Jane Doe", Email: "jane@example.org"},
+		{Name: "John Doe", Email: "john@example.org"},
+	}, Parse(message))
+
+	assert.Empty(t, Parse("Fix the build\n\nNo trailers here."))
+}
+
+func TestGitHubNoreply(t *testing.T) {
+	id, login, ok := GitHubNoreply("1002+new-contributor@users.noreply.github.com")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1002), id)
+	assert.Equal(t, "new-contributor", login)
+
+	id, login, ok = GitHubNoreply("octo-contributor@users.noreply.github.com")
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), id)
+	assert.Equal(t, "octo-contributor", login)
+
+	_, _, ok = GitHubNoreply("jane@example.org")
+	assert.False(t, ok)
+}
+
+func TestGitLabNoreply(t *testing.T) {
+	id, username, ok := GitLabNoreply("4242-jane.doe@users.noreply.gitlab.com")
+	assert.True(t, ok)
+	assert.Equal(t, 4242, id)
+	assert.Equal(t, "jane.doe", username)
+
+	_, _, ok = GitLabNoreply("jane.doe@users.noreply.gitlab.com")
+	assert.False(t, ok)
+}
@@ -54,6 +54,9 @@ type Config struct {
 	// Gitlab Application
 	Gitlab Gitlab `json:"gitlab"`
 
+	// Gitea/Forgejo instances
+	Gitea Gitea `json:"gitea"`
+
 	// Dynamo Session Store
 	SessionStoreTableName string `json:"sessionStoreTableName"`
 
@@ -99,6 +102,16 @@ type Config struct {
 
 	// DocuSignPrivateKey is the private key for the DocuSign API
 	DocuSignPrivateKey string `json:"docuSignPrivateKey"`
+
+	// DocuSignConnectHMACKeysCommaSeparated is the list of active DocuSign Connect HMAC keys - more than one key is
+	// active while a key is being rotated
+	DocuSignConnectHMACKeysCommaSeparated string   `json:"docuSignConnectHMACKeysCommaSeparated"`
+	DocuSignConnectHMACKeys               []string `json:"-"`
+
+	// DocuSignConnectHMACDisabled turns off the rejection of the DocuSign Connect callbacks which fail the HMAC signature
+	// validation - the callbacks are rejected whenever an HMAC key is configured, unless this rollout switch is set, in
+	// which case the failures are only logged
+	DocuSignConnectHMACDisabled bool `json:"docuSignConnectHMACDisabled"`
 }
 
 // Auth0 model
@@ -147,6 +160,8 @@ type GitHub struct {
 	TestOrganizationInstallationID string `json:"test_organization_installation_id"`
 	TestRepository                 string `json:"test_repository"`
 	TestRepositoryID               string `json:"test_repository_id"`
+	// EnterpriseKey encrypts the app private keys of the GitHub Enterprise Server organizations
+	EnterpriseKey string `json:"enterprise_key"`
 }
 
 // Gitlab config data model
@@ -158,6 +173,13 @@ type Gitlab struct {
 	WebHookURI      string `json:"app_web_hook_uri"`
 }
 
+// Gitea config data model - the instance URL, access token and OAuth2 application are stored per Gitea organization
+type Gitea struct {
+	TokenKey    string `json:"token_key"`
+	RedirectURI string `json:"redirect_uri"`
+	WebHookURI  string `json:"web_hook_uri"`
+}
+
 // MetricsReport keeps the config needed to send the metrics data report
 type MetricsReport struct {
 	AwsSQSRegion   string `json:"aws_sqs_region"`
@@ -195,5 +217,13 @@ func LoadConfig(configFilePath string, awsSession *session.Session, awsStage str
 	// Convert the allowed origins into an array of values
 	easyCLAConfig.AllowedOrigins = strings.Split(easyCLAConfig.AllowedOriginsCommaSeparated, ",")
 
+	// Convert the DocuSign Connect HMAC keys into an array of values
+	easyCLAConfig.DocuSignConnectHMACKeys = nil
+	for _, key := range strings.Split(easyCLAConfig.DocuSignConnectHMACKeysCommaSeparated, ",") {
+		if strings.TrimSpace(key) != "" {
+			easyCLAConfig.DocuSignConnectHMACKeys = append(easyCLAConfig.DocuSignConnectHMACKeys, strings.TrimSpace(key))
+		}
+	}
+
 	return easyCLAConfig, nil
 }
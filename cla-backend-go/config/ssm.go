@@ -69,12 +69,16 @@ func loadSSMConfig(awsSession *session.Session, stage string) Config { //nolint
 		fmt.Sprintf("cla-gh-test-organization-installation-id-%s", stage),
 		fmt.Sprintf("cla-gh-test-repository-%s", stage),
 		fmt.Sprintf("cla-gh-test-repository-id-%s", stage),
+		fmt.Sprintf("cla-gh-enterprise-key-%s", stage),
 		//fmt.Sprintf("cla-gitlab-oauth-secret-go-backend-%s", stage),
 		fmt.Sprintf("cla-gitlab-app-id-%s", stage),
 		fmt.Sprintf("cla-gitlab-app-secret-%s", stage),
 		fmt.Sprintf("cla-gitlab-app-private-key-%s", stage),
 		fmt.Sprintf("cla-gitlab-app-redirect-uri-%s", stage),
 		fmt.Sprintf("cla-gitlab-app-web-hook-uri-%s", stage),
+		fmt.Sprintf("cla-gitea-token-key-%s", stage),
+		fmt.Sprintf("cla-gitea-redirect-uri-%s", stage),
+		fmt.Sprintf("cla-gitea-web-hook-uri-%s", stage),
 		fmt.Sprintf("cla-corporate-base-%s", stage),
 		fmt.Sprintf("cla-corporate-v1-base-%s", stage),
 		fmt.Sprintf("cla-corporate-v2-base-%s", stage),
@@ -107,6 +111,14 @@ func loadSSMConfig(awsSession *session.Session, stage string) Config { //nolint
 		fmt.Sprintf("cla-landing-page-%s", stage),
 		fmt.Sprintf("cla-logo-url-%s", stage),
 		fmt.Sprintf("cla-docusign-private-key-%s", stage),
+		fmt.Sprintf("cla-docusign-connect-hmac-keys-%s", stage),
+		fmt.Sprintf("cla-docusign-connect-hmac-disabled-%s", stage),
+	}
+
+	// The optional keys are not required to be defined in SSM - they are rolled out after the code is deployed
+	optionalSSMKeys := map[string]bool{
+		fmt.Sprintf("cla-docusign-connect-hmac-keys-%s", stage):     true,
+		fmt.Sprintf("cla-docusign-connect-hmac-disabled-%s", stage): true,
 	}
 
 	// For each key to lookup
@@ -115,7 +127,10 @@ func loadSSMConfig(awsSession *session.Session, stage string) Config { //nolint
 		go func(theKey string) {
 			theValue, err := getSSMString(ssmClient, theKey)
 			if err != nil {
-				log.WithFields(f).WithError(err).Fatalf("error looking up key: %s", theKey)
+				if !optionalSSMKeys[theKey] {
+					log.WithFields(f).WithError(err).Fatalf("error looking up key: %s", theKey)
+				}
+				log.WithFields(f).WithError(err).Warnf("optional key: %s is not defined - using the default value", theKey)
 			}
 			// Send the response back through the channel
 			responseChannel <- configLookupResponse{
@@ -159,6 +174,8 @@ func loadSSMConfig(awsSession *session.Session, stage string) Config { //nolint
 			config.GitHub.TestRepository = resp.value
 		case fmt.Sprintf("cla-gh-test-repository-id-%s", stage):
 			config.GitHub.TestRepositoryID = resp.value
+		case fmt.Sprintf("cla-gh-enterprise-key-%s", stage):
+			config.GitHub.EnterpriseKey = resp.value
 
 		//	gitlab ssm
 		case fmt.Sprintf("cla-gitlab-app-id-%s", stage):
@@ -177,6 +194,14 @@ func loadSSMConfig(awsSession *session.Session, stage string) Config { //nolint
 			config.Gitlab.RedirectURI = resp.value
 		case fmt.Sprintf("cla-gitlab-app-web-hook-uri-%s", stage):
 			config.Gitlab.WebHookURI = resp.value
+
+		//	gitea ssm
+		case fmt.Sprintf("cla-gitea-token-key-%s", stage):
+			config.Gitea.TokenKey = resp.value
+		case fmt.Sprintf("cla-gitea-redirect-uri-%s", stage):
+			config.Gitea.RedirectURI = resp.value
+		case fmt.Sprintf("cla-gitea-web-hook-uri-%s", stage):
+			config.Gitea.WebHookURI = resp.value
 		case fmt.Sprintf("cla-contributor-v2-base-%s", stage):
 			config.CLAContributorv2Base = resp.value
 		case fmt.Sprintf("cla-api-v4-base-%s", stage):
@@ -266,6 +291,21 @@ func loadSSMConfig(awsSession *session.Session, stage string) Config { //nolint
 			}
 		case fmt.Sprintf("cla-docusign-private-key-%s", stage):
 			config.DocuSignPrivateKey = resp.value
+		case fmt.Sprintf("cla-docusign-connect-hmac-keys-%s", stage):
+			config.DocuSignConnectHMACKeysCommaSeparated = resp.value
+		case fmt.Sprintf("cla-docusign-connect-hmac-disabled-%s", stage):
+			if resp.value == "" {
+				config.DocuSignConnectHMACDisabled = false
+				break
+			}
+			boolVal, err := strconv.ParseBool(resp.value)
+			if err != nil {
+				log.WithFields(f).WithError(err).Warnf("unable to convert %s value to a boolean - setting value to false in the configuration",
+					fmt.Sprintf("cla-docusign-connect-hmac-disabled-%s", stage))
+				config.DocuSignConnectHMACDisabled = false
+			} else {
+				config.DocuSignConnectHMACDisabled = boolVal
+			}
 		}
 	}
 
@@ -0,0 +1,163 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package dco
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// InfoURL is the Developer Certificate of Origin text, used as the status target URL
+const InfoURL = "https://developercertificate.org/"
+
+// CommentMarker identifies the DCO comment so the same comment is updated on the next check
+const CommentMarker = "<!-- easycla-dco-check -->"
+
+// StatusPassed and StatusFailed are the commit status descriptions for the DCO check
+const (
+	StatusPassed = "DCO check passed. All commits are signed off."
+	StatusFailed = "DCO check failed. Missing or invalid Signed-off-by."
+)
+
+var signOffRegex = regexp.MustCompile(`(?mi)^\s*Signed-off-by:\s*(.*?)\s*<([^<>\s]+)>\s*$`)
+
+// Commit is the provider neutral commit information needed for the DCO check
+type Commit struct {
+	SHA         string
+	AuthorName  string
+	AuthorEmail string
+	Message     string
+	IsMerge     bool
+}
+
+// SignOff is a single Signed-off-by trailer
+type SignOff struct {
+	Name  string
+	Email string
+}
+
+// String returns the sign-off in the git trailer format
+func (s SignOff) String() string {
+	return fmt.Sprintf("%s <%s>", s.Name, s.Email)
+}
+
+// Result is the DCO check result for a single commit
+type Result struct {
+	Commit   Commit
+	SignOffs []SignOff
+	Passed   bool
+	Reason   string
+}
+
+// ParseSignOffs returns the Signed-off-by trailers of the commit message
+func ParseSignOffs(message string) []SignOff {
+	var signOffs []SignOff
+	for _, match := range signOffRegex.FindAllStringSubmatch(message, -1) {
+		signOffs = append(signOffs, SignOff{
+			Name:  strings.TrimSpace(match[1]),
+			Email: strings.TrimSpace(match[2]),
+		})
+	}
+	return signOffs
+}
+
+// CheckCommit verifies the commit has a Signed-off-by trailer matching the commit author name and email
+func CheckCommit(commit Commit) Result {
+	result := Result{
+		Commit:   commit,
+		SignOffs: ParseSignOffs(commit.Message),
+	}
+
+	// merge commits are created by the tooling, the commits being merged are checked on their own
+	if commit.IsMerge {
+		result.Passed = true
+		return result
+	}
+
+	if len(result.SignOffs) == 0 {
+		result.Reason = "missing Signed-off-by"
+		return result
+	}
+
+	for _, signOff := range result.SignOffs {
+		if strings.EqualFold(signOff.Email, commit.AuthorEmail) && strings.EqualFold(signOff.Name, strings.TrimSpace(commit.AuthorName)) {
+			result.Passed = true
+			return result
+		}
+	}
+
+	found := make([]string, 0, len(result.SignOffs))
+	for _, signOff := range result.SignOffs {
+		found = append(found, signOff.String())
+	}
+	result.Reason = fmt.Sprintf("expected Signed-off-by: %s <%s>, found: %s", commit.AuthorName, commit.AuthorEmail, strings.Join(found, ", "))
+	return result
+}
+
+// CheckCommits runs the DCO check for each commit, returning the passed and failed results
+func CheckCommits(commits []Commit) ([]Result, []Result) {
+	var passed, failed []Result
+	for _, commit := range commits {
+		result := CheckCommit(commit)
+		if result.Passed {
+			passed = append(passed, result)
+		} else {
+			failed = append(failed, result)
+		}
+	}
+	return passed, failed
+}
+
+// StatusDescription returns the commit status description for the check results
+func StatusDescription(failed []Result) string {
+	if len(failed) > 0 {
+		return StatusFailed
+	}
+	return StatusPassed
+}
+
+// CommentBody returns the pull/merge request comment for the check results, including the remediation
+// instructions when one or more commits failed - the commits are rebased onto the base branch of the change
+func CommentBody(passed, failed []Result, baseBranch string) string {
+	var sb strings.Builder
+	sb.WriteString(CommentMarker)
+	sb.WriteString("\n")
+
+	if len(failed) == 0 {
+		sb.WriteString(fmt.Sprintf(":white_check_mark: All %d commits are signed off according to the [Developer Certificate of Origin](%s).\n", len(passed), InfoURL))
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf(":x: %d of %d commits are not signed off according to the [Developer Certificate of Origin](%s).\n\n", len(failed), len(passed)+len(failed), InfoURL))
+	sb.WriteString("| Commit | Author | Problem |\n")
+	sb.WriteString("| --- | --- | --- |\n")
+	for _, result := range failed {
+		sb.WriteString(fmt.Sprintf("| %s | %s <%s> | %s |\n", shortSHA(result.Commit.SHA), result.Commit.AuthorName, result.Commit.AuthorEmail, result.Reason))
+	}
+
+	sb.WriteString("\nEach commit must include a `Signed-off-by` line matching the commit author name and email. ")
+	sb.WriteString("To sign off the most recent commit run:\n\n")
+	sb.WriteString("```\ngit commit --amend --no-edit --signoff\ngit push --force-with-lease\n```\n\n")
+	sb.WriteString("To sign off all the commits of this change run:\n\n")
+	sb.WriteString(fmt.Sprintf("```\ngit rebase --signoff origin/%s\ngit push --force-with-lease\n```\n\n", rebaseBranch(baseBranch)))
+	sb.WriteString("If the author name or email is wrong, fix your git `user.name` and `user.email` settings and amend the commits with `--reset-author`.\n")
+	return sb.String()
+}
+
+// rebaseBranch returns the branch the commits are rebased onto, the placeholder is only shown when the base branch of
+// the change is unknown
+func rebaseBranch(baseBranch string) string {
+	if baseBranch == "" {
+		return "<base-branch>"
+	}
+	return baseBranch
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
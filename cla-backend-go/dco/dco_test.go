@@ -0,0 +1,84 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package dco
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSignOffs(t *testing.T) {
+	message := "Fix the build\n\nSome details.\n\nSigned-off-by: Jane Doe <jane@example.org>\nsigned-off-by: John Doe <john@example.org>\nCo-authored-by: Other <other@example.org>"
+	signOffs := ParseSignOffs(message)
+	assert.Equal(t, []SignOff{
+		{Name: "Jane Doe", Email: "jane@example.org"},
+		{Name: "John Doe", Email: "john@example.org"},
+	}, signOffs)
+
+	assert.Empty(t, ParseSignOffs("Fix the build\n\nNo trailers here."))
+}
+
+func TestCheckCommit(t *testing.T) {
+	testCases := []struct {
+		name   string
+		commit Commit
+		passed bool
+		reason string
+	}{
+		{
+			name:   "matching sign-off",
+			commit: Commit{SHA: "a1", AuthorName: "Jane Doe", AuthorEmail: "Jane@Example.org", Message: "Fix\n\nSigned-off-by: Jane Doe <jane@example.org>"},
+			passed: true,
+		},
+		{
+			name:   "missing sign-off",
+			commit: Commit{SHA: "a2", AuthorName: "Jane Doe", AuthorEmail: "jane@example.org", Message: "Fix"},
+			reason: "missing Signed-off-by",
+		},
+		{
+			name:   "sign-off by another identity",
+			commit: Commit{SHA: "a3", AuthorName: "Jane Doe", AuthorEmail: "jane@example.org", Message: "Fix\n\nSigned-off-by: John Doe <john@example.org>"},
+			reason: "expected Signed-off-by: Jane Doe <jane@example.org>, found: John Doe <john@example.org>",
+		},
+		{
+			name:   "merge commit",
+			commit: Commit{SHA: "a4", AuthorName: "Jane Doe", AuthorEmail: "jane@example.org", Message: "Merge branch 'main'", IsMerge: true},
+			passed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := CheckCommit(tc.commit)
+			assert.Equal(t, tc.passed, result.Passed)
+			assert.Equal(t, tc.reason, result.Reason)
+		})
+	}
+}
+
+func TestCommentBody(t *testing.T) {
+	passed, failed := CheckCommits([]Commit{
+		{SHA: "1234567890", AuthorName: "Jane Doe", AuthorEmail: "jane@example.org", Message: "Fix\n\nSigned-off-by: Jane Doe <jane@example.org>"},
+		{SHA: "abcdef1234", AuthorName: "John Doe", AuthorEmail: "john@example.org", Message: "Fix again"},
+	})
+	assert.Len(t, passed, 1)
+	assert.Len(t, failed, 1)
+	assert.Equal(t, StatusFailed, StatusDescription(failed))
+
+	body := CommentBody(passed, failed, "release-1.2")
+	assert.True(t, strings.HasPrefix(body, CommentMarker))
+	assert.Contains(t, body, "1 of 2 commits are not signed off")
+	assert.Contains(t, body, "| abcdef1 | John Doe <john@example.org> | missing Signed-off-by |")
+	assert.Contains(t, body, "git commit --amend --no-edit --signoff")
+	// the commits are rebased onto the base branch of the change
+	assert.Contains(t, body, "git rebase --signoff origin/release-1.2")
+	assert.NotContains(t, body, "origin/main")
+	assert.Contains(t, CommentBody(passed, failed, ""), "git rebase --signoff origin/<base-branch>")
+
+	body = CommentBody(passed, nil, "main")
+	assert.Contains(t, body, "All 1 commits are signed off")
+	assert.Equal(t, StatusPassed, StatusDescription(nil))
+}
@@ -142,3 +142,151 @@ func RenderGithubRepositoryTransferredTemplate(svc EmailTemplateService, claGrou
 	return RenderTemplate(params.CLAGroupTemplateParams.Version, GithubRepositoryTransferredFailedTemplateName, GithubRepositoryTransferredFailedTemplate, params)
 
 }
+
+// GithubBranchProtectionDriftRepository is a repository listed in the GithubBranchProtectionDriftTemplate
+type GithubBranchProtectionDriftRepository struct {
+	RepositoryName string
+	BranchName     string
+	Drift          []string
+	// Remediated is set when the branch protection was re-applied, RemediationFailed when re-applying it failed
+	Remediated        bool
+	RemediationFailed bool
+}
+
+// GithubBranchProtectionDriftTemplateParams is email params for GithubBranchProtectionDriftTemplate
+type GithubBranchProtectionDriftTemplateParams struct {
+	CommonEmailParams
+	CLAGroupTemplateParams
+	Repositories []GithubBranchProtectionDriftRepository
+}
+
+const (
+	// GithubBranchProtectionDriftTemplateName is email template name for GithubBranchProtectionDriftTemplate
+	GithubBranchProtectionDriftTemplateName = "GithubBranchProtectionDriftTemplate"
+	// GithubBranchProtectionDriftTemplate is email template for the digest of the branch protection audit
+	GithubBranchProtectionDriftTemplate = `
+<p>Hello {{.RecipientName}},</p>
+<p>This is a notification email from EasyCLA regarding the Github Repositories associated with the CLA Group {{.CLAGroupName}}.</p>
+<p>EasyCLA audited the branch protection of the Github Repositories and found that the branch protection of the following repositories no longer matches the EasyCLA configuration, the pull requests may be merged without a signed CLA:</p>
+<ul>
+{{range .Repositories}}<li>{{.RepositoryName}} ({{.BranchName}}): {{range $i, $drift := .Drift}}{{if $i}}, {{end}}{{$drift}}{{end}}{{if .Remediated}} - the branch protection was re-applied{{else if .RemediationFailed}} - re-applying the branch protection failed, please review it on Github{{else}} - please review the branch protection on Github{{end}}</li>
+{{end}}</ul>
+`
+)
+
+// RenderGithubBranchProtectionDriftTemplate renders GithubBranchProtectionDriftTemplate
+func RenderGithubBranchProtectionDriftTemplate(svc EmailTemplateService, claGroupID string, params GithubBranchProtectionDriftTemplateParams) (string, error) {
+	claGroupParams, err := svc.GetCLAGroupTemplateParamsFromCLAGroup(claGroupID)
+	if err != nil {
+		return "", err
+	}
+
+	// assign the prefilled struct
+	params.CLAGroupTemplateParams = claGroupParams
+	return RenderTemplate(params.CLAGroupTemplateParams.Version, GithubBranchProtectionDriftTemplateName, GithubBranchProtectionDriftTemplate, params)
+}
+
+// GithubAppInstallationTemplateParams is email params for the EasyCLA GitHub App installation templates
+type GithubAppInstallationTemplateParams struct {
+	CommonEmailParams
+	CLAGroupTemplateParams
+	GithubOrgName string
+	Repositories  []string
+}
+
+const (
+	// GithubAppUninstalledTemplateName is email template name for GithubAppUninstalledTemplate
+	GithubAppUninstalledTemplateName = "GithubAppUninstalledTemplate"
+	// GithubAppUninstalledTemplate is email template for the app installation deleted from a Github Organization
+	GithubAppUninstalledTemplate = `
+<p>Hello {{.RecipientName}},</p>
+<p>This is a notification email from EasyCLA regarding the Github Organization {{.GithubOrgName}} associated with the CLA Group {{.CLAGroupName}}.</p>
+<p>EasyCLA was notified that the EasyCLA GitHub App was uninstalled from the Github Organization {{.GithubOrgName}}. The pull requests of its repositories are no longer checked by EasyCLA.</p>
+{{if .Repositories}}<p>The following Github Repositories are now disabled from EasyCLA platform:</p>
+<ul>
+{{range .Repositories}}<li>{{.}}</li>
+{{end}}</ul>
+{{end}}<p>To continue checking the pull requests, install the EasyCLA GitHub App on the Github Organization and enable the repositories again.</p>
+`
+)
+
+// RenderGithubAppUninstalledTemplate renders GithubAppUninstalledTemplate
+func RenderGithubAppUninstalledTemplate(svc EmailTemplateService, claGroupID string, params GithubAppInstallationTemplateParams) (string, error) {
+	claGroupParams, err := svc.GetCLAGroupTemplateParamsFromCLAGroup(claGroupID)
+	if err != nil {
+		return "", err
+	}
+
+	// assign the prefilled struct
+	params.CLAGroupTemplateParams = claGroupParams
+	return RenderTemplate(params.CLAGroupTemplateParams.Version, GithubAppUninstalledTemplateName, GithubAppUninstalledTemplate, params)
+}
+
+const (
+	// GithubAppSuspendedTemplateName is email template name for GithubAppSuspendedTemplate
+	GithubAppSuspendedTemplateName = "GithubAppSuspendedTemplate"
+	// GithubAppSuspendedTemplate is email template for the app installation suspended on a Github Organization
+	GithubAppSuspendedTemplate = `
+<p>Hello {{.RecipientName}},</p>
+<p>This is a notification email from EasyCLA regarding the Github Organization {{.GithubOrgName}} associated with the CLA Group {{.CLAGroupName}}.</p>
+<p>EasyCLA was notified that the EasyCLA GitHub App was suspended on the Github Organization {{.GithubOrgName}}. Github no longer notifies EasyCLA of the pull requests, they are not checked until the EasyCLA GitHub App is unsuspended.</p>
+`
+)
+
+// RenderGithubAppSuspendedTemplate renders GithubAppSuspendedTemplate
+func RenderGithubAppSuspendedTemplate(svc EmailTemplateService, claGroupID string, params GithubAppInstallationTemplateParams) (string, error) {
+	claGroupParams, err := svc.GetCLAGroupTemplateParamsFromCLAGroup(claGroupID)
+	if err != nil {
+		return "", err
+	}
+
+	// assign the prefilled struct
+	params.CLAGroupTemplateParams = claGroupParams
+	return RenderTemplate(params.CLAGroupTemplateParams.Version, GithubAppSuspendedTemplateName, GithubAppSuspendedTemplate, params)
+}
+
+const (
+	// GithubAppUnsuspendedTemplateName is email template name for GithubAppUnsuspendedTemplate
+	GithubAppUnsuspendedTemplateName = "GithubAppUnsuspendedTemplate"
+	// GithubAppUnsuspendedTemplate is email template for the app installation unsuspended on a Github Organization
+	GithubAppUnsuspendedTemplate = `
+<p>Hello {{.RecipientName}},</p>
+<p>This is a notification email from EasyCLA regarding the Github Organization {{.GithubOrgName}} associated with the CLA Group {{.CLAGroupName}}.</p>
+<p>EasyCLA was notified that the EasyCLA GitHub App was unsuspended on the Github Organization {{.GithubOrgName}}. The pull requests are checked again, the pull requests updated while the app was suspended are checked on their next update or with the /easycla recheck comment.</p>
+`
+)
+
+// RenderGithubAppUnsuspendedTemplate renders GithubAppUnsuspendedTemplate
+func RenderGithubAppUnsuspendedTemplate(svc EmailTemplateService, claGroupID string, params GithubAppInstallationTemplateParams) (string, error) {
+	claGroupParams, err := svc.GetCLAGroupTemplateParamsFromCLAGroup(claGroupID)
+	if err != nil {
+		return "", err
+	}
+
+	// assign the prefilled struct
+	params.CLAGroupTemplateParams = claGroupParams
+	return RenderTemplate(params.CLAGroupTemplateParams.Version, GithubAppUnsuspendedTemplateName, GithubAppUnsuspendedTemplate, params)
+}
+
+const (
+	// GithubAppPermissionsAcceptedTemplateName is email template name for GithubAppPermissionsAcceptedTemplate
+	GithubAppPermissionsAcceptedTemplateName = "GithubAppPermissionsAcceptedTemplate"
+	// GithubAppPermissionsAcceptedTemplate is email template for the new app permissions accepted by a Github Organization
+	GithubAppPermissionsAcceptedTemplate = `
+<p>Hello {{.RecipientName}},</p>
+<p>This is a notification email from EasyCLA regarding the Github Organization {{.GithubOrgName}} associated with the CLA Group {{.CLAGroupName}}.</p>
+<p>EasyCLA was notified that the Github Organization {{.GithubOrgName}} accepted the new permissions requested by the EasyCLA GitHub App. No action is required.</p>
+`
+)
+
+// RenderGithubAppPermissionsAcceptedTemplate renders GithubAppPermissionsAcceptedTemplate
+func RenderGithubAppPermissionsAcceptedTemplate(svc EmailTemplateService, claGroupID string, params GithubAppInstallationTemplateParams) (string, error) {
+	claGroupParams, err := svc.GetCLAGroupTemplateParamsFromCLAGroup(claGroupID)
+	if err != nil {
+		return "", err
+	}
+
+	// assign the prefilled struct
+	params.CLAGroupTemplateParams = claGroupParams
+	return RenderTemplate(params.CLAGroupTemplateParams.Version, GithubAppPermissionsAcceptedTemplateName, GithubAppPermissionsAcceptedTemplate, params)
+}
@@ -128,3 +128,70 @@ func TestGithubRepositoryTransferredFailedTemplate(t *testing.T) {
 	assert.Contains(t, result, "EasyCLA is not enabled for the new Github Organization johnsNewGithubOrg")
 	assert.Contains(t, result, "The Github Repository johnsNewRepository is now disabled")
 }
+
+func TestGithubBranchProtectionDriftTemplate(t *testing.T) {
+	params := GithubBranchProtectionDriftTemplateParams{
+		CommonEmailParams: CommonEmailParams{
+			RecipientName: "CLA Manager",
+		},
+		CLAGroupTemplateParams: CLAGroupTemplateParams{
+			CLAGroupName: "JohnsProject",
+		},
+		Repositories: []GithubBranchProtectionDriftRepository{
+			{
+				RepositoryName: "johnsOrg/johnsRepository",
+				BranchName:     "**/**",
+				Drift:          []string{"status checks not required", "admin enforcement disabled"},
+				Remediated:     true,
+			},
+			{
+				RepositoryName:    "johnsOrg/johnsOtherRepository",
+				BranchName:        "**/**",
+				Drift:             []string{"branch protection removed"},
+				RemediationFailed: true,
+			},
+			{
+				RepositoryName: "johnsOrg/johnsLastRepository",
+				BranchName:     "**/**",
+				Drift:          []string{"EasyCLA status check not required"},
+			},
+		},
+	}
+
+	result, err := RenderTemplate(utils.V2, GithubBranchProtectionDriftTemplateName, GithubBranchProtectionDriftTemplate,
+		params)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "Hello CLA Manager")
+	assert.Contains(t, result, "associated with the CLA Group JohnsProject")
+	assert.Contains(t, result, "<li>johnsOrg/johnsRepository (**/**): status checks not required, admin enforcement disabled - the branch protection was re-applied</li>")
+	assert.Contains(t, result, "<li>johnsOrg/johnsOtherRepository (**/**): branch protection removed - re-applying the branch protection failed")
+	assert.Contains(t, result, "<li>johnsOrg/johnsLastRepository (**/**): EasyCLA status check not required - please review the branch protection on Github</li>")
+}
+
+func TestGithubAppUninstalledTemplate(t *testing.T) {
+	params := GithubAppInstallationTemplateParams{
+		CommonEmailParams: CommonEmailParams{
+			RecipientName: "CLA Manager",
+		},
+		CLAGroupTemplateParams: CLAGroupTemplateParams{
+			CLAGroupName: "JohnsProject",
+		},
+		GithubOrgName: "johnsOrg",
+		Repositories:  []string{"johnsOrg/johnsRepository", "johnsOrg/johnsOtherRepository"},
+	}
+
+	result, err := RenderTemplate(utils.V2, GithubAppUninstalledTemplateName, GithubAppUninstalledTemplate,
+		params)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "Hello CLA Manager")
+	assert.Contains(t, result, "regarding the Github Organization johnsOrg associated with the CLA Group JohnsProject")
+	assert.Contains(t, result, "the EasyCLA GitHub App was uninstalled from the Github Organization johnsOrg")
+	assert.Contains(t, result, "<li>johnsOrg/johnsRepository</li>")
+	assert.Contains(t, result, "<li>johnsOrg/johnsOtherRepository</li>")
+
+	params.Repositories = nil
+	result, err = RenderTemplate(utils.V2, GithubAppUninstalledTemplateName, GithubAppUninstalledTemplate,
+		params)
+	assert.NoError(t, err)
+	assert.NotContains(t, result, "are now disabled")
+}
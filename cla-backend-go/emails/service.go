@@ -16,6 +16,7 @@ import (
 type Service interface {
 	EmailTemplateService
 	NotifyClaManagersForClaGroupID(ctx context.Context, claGrpoupID, subject, body string) error
+	NotifyProjectManagersForClaGroupID(ctx context.Context, claGroupID, subject, body string) error
 }
 
 type service struct {
@@ -48,3 +49,26 @@ func (s *service) NotifyClaManagersForClaGroupID(ctx context.Context, claGrpoupI
 
 	return utils.SendEmail(subject, body, recipientEmails)
 }
+
+// NotifyProjectManagersForClaGroupID emails the project managers of the CLA group - the project managers are the users
+// on the ACL of the CLA group, which is seeded with the project manager who created the CLA group
+func (s *service) NotifyProjectManagersForClaGroupID(ctx context.Context, claGroupID, subject, body string) error {
+	projectManagers, err := s.claService.GetCLAManagers(ctx, claGroupID)
+	if err != nil {
+		return fmt.Errorf("fetching project managers for cla group : %s failed : %v", claGroupID, err)
+	}
+
+	var recipientEmails []string
+	for _, projectManager := range projectManagers {
+		if projectManager.UserEmail == "" {
+			continue
+		}
+		recipientEmails = append(recipientEmails, projectManager.UserEmail)
+	}
+
+	if len(recipientEmails) == 0 {
+		return fmt.Errorf("no project managers registered for the claGroup : %s, none to notify", claGroupID)
+	}
+
+	return utils.SendEmail(subject, body, recipientEmails)
+}
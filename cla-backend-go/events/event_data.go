@@ -5,6 +5,7 @@ package events
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
@@ -89,6 +90,52 @@ type RepositoryBranchProtectionUpdatedEventData struct {
 	RepositoryName string
 }
 
+// RepositoryEnforcementModeUpdatedEventData event data model
+type RepositoryEnforcementModeUpdatedEventData struct {
+	RepositoryName  string
+	EnforcementMode string
+}
+
+// RepositoryCheckRunUpdatedEventData event data model
+type RepositoryCheckRunUpdatedEventData struct {
+	RepositoryName  string
+	CheckRunEnabled bool
+}
+
+// RepositoryTrivialChangeRulesUpdatedEventData event data model
+type RepositoryTrivialChangeRulesUpdatedEventData struct {
+	RepositoryName string
+	RuleCount      int
+}
+
+// RepositoryBranchesUpdatedEventData event data model
+type RepositoryBranchesUpdatedEventData struct {
+	RepositoryName  string
+	IncludeBranches []string
+	ExcludeBranches []string
+}
+
+// RepositoryCLAGroupBindingsUpdatedEventData event data model
+type RepositoryCLAGroupBindingsUpdatedEventData struct {
+	RepositoryName string
+	CLAGroupIDs    []string
+}
+
+// RepositoryPullRequestRecheckedEventData event data model
+type RepositoryPullRequestRecheckedEventData struct {
+	RepositoryName string
+	PullRequestID  int
+	RequestedBy    string
+}
+
+// RepositoryBranchProtectionDriftEventData event data model
+type RepositoryBranchProtectionDriftEventData struct {
+	RepositoryName string
+	BranchName     string
+	Drift          []string
+	Remediated     bool
+}
+
 // GerritProjectDeletedEventData event data model
 type GerritProjectDeletedEventData struct {
 	DeletedCount int
@@ -194,10 +241,20 @@ type GitHubOrganizationDeletedEventData struct {
 
 // GitHubOrganizationUpdatedEventData data model
 type GitHubOrganizationUpdatedEventData struct {
-	GitHubOrganizationName  string
-	AutoEnabled             bool
-	AutoEnabledClaGroupID   string
-	BranchProtectionEnabled bool
+	GitHubOrganizationName          string
+	AutoEnabled                     bool
+	AutoEnabledClaGroupID           string
+	BranchProtectionEnabled         bool
+	BranchProtectionAutoRemediation *bool
+}
+
+// GitHubOrganizationInstallationEventData data model
+type GitHubOrganizationInstallationEventData struct {
+	GitHubOrganizationName string
+	InstallationID         int64
+	// InstallationAction describes what happened to the installation, e.g. uninstalled or suspended
+	InstallationAction   string
+	DisabledRepositories []string
 }
 
 // GitLabOrganizationAddedEventData data model
@@ -221,6 +278,24 @@ type GitLabOrganizationUpdatedEventData struct {
 	AutoEnabledClaGroupID  string
 }
 
+// GiteaOrganizationAddedEventData data model
+type GiteaOrganizationAddedEventData struct {
+	GiteaURL              string
+	GiteaOrganizationName string
+}
+
+// GiteaOrganizationDeletedEventData data model
+type GiteaOrganizationDeletedEventData struct {
+	GiteaURL              string
+	GiteaOrganizationName string
+}
+
+// GiteaRepositoryAddedEventData data model
+type GiteaRepositoryAddedEventData struct {
+	RepositoryName string
+	RepositoryURL  string
+}
+
 // CCLAApprovalListRequestCreatedEventData data model
 type CCLAApprovalListRequestCreatedEventData struct {
 	RequestID string
@@ -451,6 +526,96 @@ type IndividualSignatureSignedEventData struct {
 	ProjectID   string
 }
 
+// DocuSignConnectValidationFailedEventData event data model - the DocuSign Connect HMAC signature of a signed callback
+// could not be verified, the callback is rejected when the HMAC validation is enforced
+type DocuSignConnectValidationFailedEventData struct {
+	Path          string
+	RemoteAddress string
+	Reason        string
+	Enforced      bool
+}
+
+// CCLASignatoryReminderSentEventData event data model - a reminder was sent to the CLA signatory of a pending CCLA
+type CCLASignatoryReminderSentEventData struct {
+	SignatureID    string
+	SignatoryName  string
+	SignatoryEmail string
+	ReminderCount  int
+}
+
+// CCLASignatoryRequestExpiredEventData event data model - a pending CCLA signature request was voided after the CLA
+// group expiry period
+type CCLASignatoryRequestExpiredEventData struct {
+	SignatureID    string
+	SignatoryName  string
+	SignatoryEmail string
+	RequestedOn    string
+	ExpiryDays     int64
+}
+
+// ResignCampaignStarted event data model - a new major version of the CLA group documents was published and the signers
+// of the previous versions were asked to re-sign
+type ResignCampaignStartedEventData struct {
+	CampaignID           string
+	ClaType              string
+	DocumentMajorVersion int
+	Policy               string
+	ResignDeadline       string
+	SignerCount          int
+}
+
+// SignatureExpiryUpdatedEventData event data model - an admin set or removed the expiry date of a signature
+type SignatureExpiryUpdatedEventData struct {
+	SignatureID string
+	ClaType     string
+	OldExpiry   string
+	NewExpiry   string
+}
+
+// SignatureExpiryReminderSentEventData event data model - the signer and the CLA managers were asked to renew a
+// signature which is about to expire
+type SignatureExpiryReminderSentEventData struct {
+	SignatureID string
+	ClaType     string
+	ExpiresOn   string
+	Recipients  []string
+}
+
+// SignedDocumentVerifiedEventData event data model - the stored signed document of a signature was re-hashed and
+// compared with the digest recorded when it was stored
+type SignedDocumentVerifiedEventData struct {
+	SignatureID string
+	Verified    bool
+	Message     string
+}
+
+// ExemptionRuleCreatedEventData event data model - a bot or automation account was exempted from the CLA check
+type ExemptionRuleCreatedEventData struct {
+	ExemptionRuleID string
+	Scope           string
+	ScopeID         string
+	MatchType       string
+	Pattern         string
+}
+
+// ExemptionRuleDeletedEventData event data model
+type ExemptionRuleDeletedEventData struct {
+	ExemptionRuleID string
+	Scope           string
+	ScopeID         string
+	MatchType       string
+	Pattern         string
+}
+
+// ExemptionAppliedEventData event data model - a commit author was matched by an exemption rule and not asked to sign
+type ExemptionAppliedEventData struct {
+	ExemptionRuleID string
+	Scope           string
+	Author          string
+	RepositoryName  string
+	PullRequestID   string
+}
+
 type CorporateSignatureSignedEventData struct {
 	ProjectName   string
 	CompanyName   string
@@ -664,6 +829,83 @@ func (ed *RepositoryBranchProtectionUpdatedEventData) GetEventDetailsString(args
 	return data, true
 }
 
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryEnforcementModeUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s enforcement mode was set to %s for the project %s", ed.RepositoryName, ed.EnforcementMode, args.ProjectName)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryCheckRunUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s check run option was set to %t for the project %s", ed.RepositoryName, ed.CheckRunEnabled, args.ProjectName)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryTrivialChangeRulesUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s trivial change rules were set to %d rules for the project %s", ed.RepositoryName, ed.RuleCount, args.ProjectName)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryBranchesUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s branches were set to include: [%s] exclude: [%s] for the project %s",
+		ed.RepositoryName, strings.Join(ed.IncludeBranches, ", "), strings.Join(ed.ExcludeBranches, ", "), args.ProjectName)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryCLAGroupBindingsUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s CLA group bindings were set to %d bindings of the CLA groups: [%s] for the project %s",
+		ed.RepositoryName, len(ed.CLAGroupIDs), strings.Join(ed.CLAGroupIDs, ", "), args.ProjectName)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryPullRequestRecheckedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The CLA check of the pull request %d of the repository %s was rechecked on request of the GitHub user %s",
+		ed.PullRequestID, ed.RepositoryName, ed.RequestedBy)
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *RepositoryBranchProtectionDriftEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The branch protection of the branch %s of the repository %s drifted from the EasyCLA configuration: [%s]",
+		ed.BranchName, ed.RepositoryName, strings.Join(ed.Drift, ", "))
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if ed.Remediated {
+		data = data + ", the branch protection was re-applied"
+	}
+	data = data + "."
+	return data, true
+}
+
 // GetEventDetailsString returns the details string for this event
 func (ed *UserCreatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
 	data := fmt.Sprintf("User was added : %+v", args.UserModel)
@@ -793,6 +1035,9 @@ func (ed *GitHubOrganizationUpdatedEventData) GetEventDetailsString(args *LogEve
 	data := fmt.Sprintf("The GitHub Organization '%s' was updated", ed.GitHubOrganizationName)
 	data = data + fmt.Sprintf(" with auto-enabled set to %t", ed.AutoEnabled)
 	data = data + fmt.Sprintf(" with branch protection set to %t", ed.BranchProtectionEnabled)
+	if ed.BranchProtectionAutoRemediation != nil {
+		data = data + fmt.Sprintf(" with branch protection auto-remediation set to %t", *ed.BranchProtectionAutoRemediation)
+	}
 	if ed.AutoEnabledClaGroupID != "" {
 		data = data + fmt.Sprintf(" with auto-enabled-cla-group ID value of %s", ed.AutoEnabledClaGroupID)
 	}
@@ -806,6 +1051,23 @@ func (ed *GitHubOrganizationUpdatedEventData) GetEventDetailsString(args *LogEve
 	return data, true
 }
 
+// GetEventDetailsString returns the details string for this event
+func (ed *GitHubOrganizationInstallationEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The EasyCLA GitHub App installation %d of the GitHub Organization '%s' was %s",
+		ed.InstallationID, ed.GitHubOrganizationName, ed.InstallationAction)
+	if len(ed.DisabledRepositories) > 0 {
+		data = data + fmt.Sprintf(", the repositories %s were disabled", strings.Join(ed.DisabledRepositories, ", "))
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
 // GetEventDetailsString returns the details string for this event
 func (ed *GitLabOrganizationAddedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
 	data := fmt.Sprintf("GitLab Group: %s was added with auto-enabled: %t, with branch protection enabled: %t",
@@ -830,6 +1092,39 @@ func (ed *GitLabOrganizationDeletedEventData) GetEventDetailsString(args *LogEve
 	return data, true
 }
 
+// GetEventDetailsString returns the details string for this event
+func (ed *GiteaOrganizationAddedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("Gitea Organization: %s on %s was added", ed.GiteaOrganizationName, ed.GiteaURL)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *GiteaOrganizationDeletedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("Gitea Organization: %s on %s was deleted", ed.GiteaOrganizationName, ed.GiteaURL)
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventDetailsString returns the details string for this event
+func (ed *GiteaRepositoryAddedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("Gitea Repository: %s with URL: %s was added", ed.RepositoryName, ed.RepositoryURL)
+	if args.CLAGroupID != "" {
+		data = data + fmt.Sprintf(" for CLA Group ID: %s", args.CLAGroupID)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
 // GetEventDetailsString returns the details string for this event
 func (ed *GitLabOrganizationUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
 	data := "GitLab Group" // nolint
@@ -1754,6 +2049,111 @@ func (ed *RepositoryBranchProtectionUpdatedEventData) GetEventSummaryString(args
 	return data, true
 }
 
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryEnforcementModeUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s enforcement mode was set to %s", ed.RepositoryName, ed.EnforcementMode)
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryCheckRunUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s check run option was set to %t", ed.RepositoryName, ed.CheckRunEnabled)
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryTrivialChangeRulesUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s trivial change rules were set to %d rules", ed.RepositoryName, ed.RuleCount)
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryBranchesUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s branches were set to include: [%s] exclude: [%s]",
+		ed.RepositoryName, strings.Join(ed.IncludeBranches, ", "), strings.Join(ed.ExcludeBranches, ", "))
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryCLAGroupBindingsUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The repository %s CLA group bindings were set to %d bindings", ed.RepositoryName, len(ed.CLAGroupIDs))
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryPullRequestRecheckedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The GitHub user %s requested a recheck of the CLA check of the pull request %d of the repository %s",
+		ed.RequestedBy, ed.PullRequestID, ed.RepositoryName)
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *RepositoryBranchProtectionDriftEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The branch protection of the repository %s drifted from the EasyCLA configuration", ed.RepositoryName)
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if ed.Remediated {
+		data = data + ", it was re-applied automatically"
+	}
+	data = data + "."
+	return data, true
+}
+
 // GetEventSummaryString returns the summary string for this event
 func (ed *UserCreatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
 	data := fmt.Sprintf("The user %s was added with the user details: %+v.", args.UserName, args.UserModel)
@@ -1882,6 +2282,9 @@ func (ed *GitHubOrganizationUpdatedEventData) GetEventSummaryString(args *LogEve
 	data := fmt.Sprintf("The GitHub Organization '%s' was updated", ed.GitHubOrganizationName)
 	data = data + fmt.Sprintf(" with auto-enabled set to %t", ed.AutoEnabled)
 	data = data + fmt.Sprintf(" with branch protection set to %t", ed.BranchProtectionEnabled)
+	if ed.BranchProtectionAutoRemediation != nil {
+		data = data + fmt.Sprintf(" with branch protection auto-remediation set to %t", *ed.BranchProtectionAutoRemediation)
+	}
 	if ed.AutoEnabledClaGroupID != "" {
 		data = data + fmt.Sprintf(" with auto-enabled-cla-group ID value of %s", ed.AutoEnabledClaGroupID)
 	}
@@ -1895,6 +2298,23 @@ func (ed *GitHubOrganizationUpdatedEventData) GetEventSummaryString(args *LogEve
 	return data, true
 }
 
+// GetEventSummaryString returns the summary string for this event
+func (ed *GitHubOrganizationInstallationEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The EasyCLA GitHub App installation of the GitHub Organization %s was %s",
+		ed.GitHubOrganizationName, ed.InstallationAction)
+	if len(ed.DisabledRepositories) > 0 {
+		data = data + fmt.Sprintf(", %d repositories were disabled", len(ed.DisabledRepositories))
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
 // GetEventSummaryString returns the summary string for this event
 func (ed *GitLabOrganizationAddedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
 	data := fmt.Sprintf("The GitLab group %s was added with auto-enabled set to %t with branch protection enabled set to %t",
@@ -1931,6 +2351,48 @@ func (ed *GitLabOrganizationDeletedEventData) GetEventSummaryString(args *LogEve
 	return data, true
 }
 
+// GetEventSummaryString returns the summary string for this event
+func (ed *GiteaOrganizationAddedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The Gitea organization %s on %s was added", ed.GiteaOrganizationName, ed.GiteaURL)
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *GiteaOrganizationDeletedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The Gitea organization %s on %s was deleted", ed.GiteaOrganizationName, ed.GiteaURL)
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
+// GetEventSummaryString returns the summary string for this event
+func (ed *GiteaRepositoryAddedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The Gitea repository %s was added", ed.RepositoryName)
+	if args.CLAGroupName != "" {
+		data = data + fmt.Sprintf(" for the CLA Group %s", args.CLAGroupName)
+	}
+	if args.ProjectName != "" {
+		data = data + fmt.Sprintf(" for the project %s", args.ProjectName)
+	}
+	if args.UserName != "" {
+		data = data + fmt.Sprintf(" by the user %s", args.UserName)
+	}
+	data = data + "."
+	return data, true
+}
+
 // GetEventSummaryString returns the summary string for this event
 func (ed *GitLabOrganizationUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
 	data := "The GitLab group" // nolint
@@ -2769,3 +3231,154 @@ func (ed *IndividualSignatureSignedEventData) GetEventDetailsString(args *LogEve
 		args.LfUsername, ed.ProjectName)
 	return data, false
 }
+
+func (ed *DocuSignConnectValidationFailedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	outcome := "was allowed through"
+	if ed.Enforced {
+		outcome = "was rejected"
+	}
+	data := fmt.Sprintf("A DocuSign Connect callback to %s from %s %s", ed.Path, ed.RemoteAddress, outcome)
+	if ed.Reason != "" {
+		data = data + fmt.Sprintf(" - %s", ed.Reason)
+	}
+	return data + ".", false
+}
+
+func (ed *DocuSignConnectValidationFailedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	outcome := "was allowed through, the HMAC validation is not enforced"
+	if ed.Enforced {
+		outcome = "was rejected"
+	}
+	data := fmt.Sprintf("The DocuSign Connect callback to %s from %s %s - the HMAC signature could not be verified", ed.Path, ed.RemoteAddress, outcome)
+	if ed.Reason != "" {
+		data = data + fmt.Sprintf(": %s", ed.Reason)
+	}
+	return data + ".", false
+}
+
+func (ed *CCLASignatoryReminderSentEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("A reminder to sign the corporate CLA for the project %s and company %s was sent to the CLA signatory %s <%s>",
+		args.ProjectName, args.CompanyName, ed.SignatoryName, ed.SignatoryEmail)
+	return data + ".", true
+}
+
+func (ed *CCLASignatoryReminderSentEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("Reminder %d to sign the corporate CLA for the project %s and company %s was sent to the CLA signatory %s <%s> for the signature %s",
+		ed.ReminderCount, args.ProjectName, args.CompanyName, ed.SignatoryName, ed.SignatoryEmail, ed.SignatureID)
+	return data + ".", true
+}
+
+func (ed *CCLASignatoryRequestExpiredEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The corporate CLA signature request for the project %s and company %s sent to the CLA signatory %s <%s> has expired",
+		args.ProjectName, args.CompanyName, ed.SignatoryName, ed.SignatoryEmail)
+	return data + ".", true
+}
+
+func (ed *CCLASignatoryRequestExpiredEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The corporate CLA signature request %s for the project %s and company %s sent to the CLA signatory %s <%s> on %s was voided after %d days without a signature",
+		ed.SignatureID, args.ProjectName, args.CompanyName, ed.SignatoryName, ed.SignatoryEmail, ed.RequestedOn, ed.ExpiryDays)
+	return data + ".", true
+}
+
+func (ed *ResignCampaignStartedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("A re-sign campaign for version %d of the %s document of the CLA group %s was started for %d signers",
+		ed.DocumentMajorVersion, strings.ToUpper(ed.ClaType), args.ProjectName, ed.SignerCount)
+	return data + ".", true
+}
+
+func (ed *ResignCampaignStartedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The re-sign campaign %s for version %d of the %s document of the CLA group %s was started for %d signers with the %s policy - the previous signatures are valid until %s",
+		ed.CampaignID, ed.DocumentMajorVersion, strings.ToUpper(ed.ClaType), args.ProjectName, ed.SignerCount, ed.Policy, ed.ResignDeadline)
+	return data + ".", true
+}
+
+func (ed *SignatureExpiryUpdatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	if ed.NewExpiry == "" {
+		return fmt.Sprintf("The expiry date of the %s signature for the CLA group %s was removed.", strings.ToUpper(ed.ClaType), args.ProjectName), true
+	}
+	data := fmt.Sprintf("The expiry date of the %s signature for the CLA group %s was set to %s", strings.ToUpper(ed.ClaType), args.ProjectName, ed.NewExpiry)
+	return data + ".", true
+}
+
+func (ed *SignatureExpiryUpdatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The expiry date of the %s signature %s for the CLA group %s was changed from '%s' to '%s'",
+		strings.ToUpper(ed.ClaType), ed.SignatureID, args.ProjectName, ed.OldExpiry, ed.NewExpiry)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *SignatureExpiryReminderSentEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("A reminder to renew the %s for the CLA group %s which expires on %s was sent", strings.ToUpper(ed.ClaType), args.ProjectName, ed.ExpiresOn)
+	return data + ".", true
+}
+
+func (ed *SignatureExpiryReminderSentEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("A reminder to renew the %s signature %s for the CLA group %s which expires on %s was sent to %s",
+		strings.ToUpper(ed.ClaType), ed.SignatureID, args.ProjectName, ed.ExpiresOn, strings.Join(ed.Recipients, ", "))
+	return data + ".", true
+}
+
+func (ed *SignedDocumentVerifiedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	result := "failed"
+	if ed.Verified {
+		result = "succeeded"
+	}
+	data := fmt.Sprintf("The verification of a signed document for the CLA group %s %s", args.ProjectName, result)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s, requested by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *SignedDocumentVerifiedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The signed document of the signature %s for the CLA group %s was verified - %s", ed.SignatureID, args.ProjectName, ed.Message)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s, requested by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *ExemptionRuleCreatedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The %s %s was exempted from the CLA check at the %s level", ed.MatchType, ed.Pattern, ed.Scope)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *ExemptionRuleCreatedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The exemption rule %s for the %s %s was added to the %s %s", ed.ExemptionRuleID, ed.MatchType, ed.Pattern, ed.Scope, ed.ScopeID)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *ExemptionRuleDeletedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The %s %s is no longer exempted from the CLA check at the %s level", ed.MatchType, ed.Pattern, ed.Scope)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s, removed by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *ExemptionRuleDeletedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The exemption rule %s for the %s %s was removed from the %s %s", ed.ExemptionRuleID, ed.MatchType, ed.Pattern, ed.Scope, ed.ScopeID)
+	if args.UserName != "" {
+		data = fmt.Sprintf("%s by the user %s", data, args.UserName)
+	}
+	return data + ".", true
+}
+
+func (ed *ExemptionAppliedEventData) GetEventSummaryString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The commit author %s was exempted from the CLA check of the pull request %s of the repository %s", ed.Author, ed.PullRequestID, ed.RepositoryName)
+	return data + ".", true
+}
+
+func (ed *ExemptionAppliedEventData) GetEventDetailsString(args *LogEventArgs) (string, bool) {
+	data := fmt.Sprintf("The commit author %s of the pull request %s of the repository %s was exempted from the CLA check of the CLA group %s by the %s exemption rule %s",
+		ed.Author, ed.PullRequestID, ed.RepositoryName, args.CLAGroupID, ed.Scope, ed.ExemptionRuleID)
+	return data + ".", true
+}
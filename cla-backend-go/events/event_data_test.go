@@ -146,3 +146,40 @@ func TestCLAGroupUpdatedEventData_GetEventDetailsString(t *testing.T) {
 		})
 	}
 }
+
+func TestCCLASignatoryReminderSentEventData_GetEventStrings(t *testing.T) {
+	eventData := &CCLASignatoryReminderSentEventData{
+		SignatureID:    "signature-1",
+		SignatoryName:  "Jane",
+		SignatoryEmail: "jane@example.org",
+		ReminderCount:  2,
+	}
+	args := &LogEventArgs{ProjectName: "Project", CompanyName: "Acme"}
+
+	summary, containsPII := eventData.GetEventSummaryString(args)
+	assert.Equal(t, "A reminder to sign the corporate CLA for the project Project and company Acme was sent to the CLA signatory Jane <jane@example.org>.", summary)
+	assert.True(t, containsPII)
+
+	details, containsPII := eventData.GetEventDetailsString(args)
+	assert.Equal(t, "Reminder 2 to sign the corporate CLA for the project Project and company Acme was sent to the CLA signatory Jane <jane@example.org> for the signature signature-1.", details)
+	assert.True(t, containsPII)
+}
+
+func TestCCLASignatoryRequestExpiredEventData_GetEventStrings(t *testing.T) {
+	eventData := &CCLASignatoryRequestExpiredEventData{
+		SignatureID:    "signature-1",
+		SignatoryName:  "Jane",
+		SignatoryEmail: "jane@example.org",
+		RequestedOn:    "2024-05-01T10:00:00Z",
+		ExpiryDays:     30,
+	}
+	args := &LogEventArgs{ProjectName: "Project", CompanyName: "Acme"}
+
+	summary, containsPII := eventData.GetEventSummaryString(args)
+	assert.Equal(t, "The corporate CLA signature request for the project Project and company Acme sent to the CLA signatory Jane <jane@example.org> has expired.", summary)
+	assert.True(t, containsPII)
+
+	details, containsPII := eventData.GetEventDetailsString(args)
+	assert.Equal(t, "The corporate CLA signature request signature-1 for the project Project and company Acme sent to the CLA signatory Jane <jane@example.org> on 2024-05-01T10:00:00Z was voided after 30 days without a signature.", details)
+	assert.True(t, containsPII)
+}
@@ -40,6 +40,13 @@ const (
 	RepositoryBranchProtectionAdded    = "repository.branchprotection.updated"
 	RepositoryBranchProtectionDisabled = "repository.branchprotection.updated"
 	RepositoryBranchProtectionUpdated  = "repository.branchprotection.updated"
+	RepositoryEnforcementModeUpdated   = "repository.enforcementmode.updated"
+	RepositoryCheckRunUpdated          = "repository.checkrun.updated"
+	RepositoryTrivialChangesUpdated    = "repository.trivialchangerules.updated"
+	RepositoryBranchesUpdated          = "repository.branches.updated"
+	RepositoryCLAGroupBindingsUpdated  = "repository.clagroupbindings.updated"
+	RepositoryPullRequestRechecked     = "repository.pullrequest.rechecked"
+	RepositoryBranchProtectionDrift    = "repository.branchprotection.drift"
 
 	GerritRepositoryAdded   = "gerrit_repository.added"
 	GerritRepositoryDeleted = "gerrit_repository.deleted"
@@ -50,10 +57,19 @@ const (
 	GitHubOrganizationDeleted = "github_organization.deleted"
 	GitHubOrganizationUpdated = "github_organization.updated"
 
+	GitHubOrganizationInstallationDeleted             = "github_organization.installation.deleted"
+	GitHubOrganizationInstallationSuspended           = "github_organization.installation.suspended"
+	GitHubOrganizationInstallationUnsuspended         = "github_organization.installation.unsuspended"
+	GitHubOrganizationInstallationPermissionsAccepted = "github_organization.installation.permissions_accepted"
+
 	GitlabOrganizationAdded   = "gitlab_organization.added"
 	GitlabOrganizationDeleted = "gitlab_organization.deleted"
 	GitlabOrganizationUpdated = "gitlab_organization.updated"
 
+	GiteaOrganizationAdded   = "gitea_organization.added"
+	GiteaOrganizationDeleted = "gitea_organization.deleted"
+	GiteaRepositoryAdded     = "gitea_repository.added"
+
 	CompanyACLUserAdded       = "company_acl.user_added"
 	CompanyACLRequestAdded    = "company_acl.request_added"
 	CompanyACLRequestApproved = "company_acl.request_approved"
@@ -99,4 +115,20 @@ const (
 
 	IndividualSignatureSigned = "individual.signature.signed"
 	CorporateSignatureSigned  = "corporate.signature.signed"
+
+	DocuSignConnectValidationFailed = "docusign.connect.validation_failed"
+
+	CCLASignatoryReminderSent   = "ccla.signatory.reminder.sent"
+	CCLASignatoryRequestExpired = "ccla.signatory.request.expired"
+
+	ResignCampaignStarted = "resign.campaign.started"
+
+	SignatureExpiryUpdated      = "signature.expiry.updated"
+	SignatureExpiryReminderSent = "signature.expiry.reminder.sent"
+
+	SignedDocumentVerified = "signature.document.verified"
+
+	ExemptionRuleCreated = "exemption_rule.created"
+	ExemptionRuleDeleted = "exemption_rule.deleted"
+	ExemptionApplied     = "exemption_rule.applied"
 )
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemptions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Author is the provider neutral identity of a commit author checked against the exemption rules
+type Author struct {
+	Provider string
+	Username string
+	UserID   string
+	Email    string
+}
+
+// String returns the author identity used in the logs and the events
+func (a Author) String() string {
+	switch {
+	case a.Username != "":
+		return a.Username
+	case a.Email != "":
+		return a.Email
+	default:
+		return a.UserID
+	}
+}
+
+// Match returns the first rule exempting the author, or nil when no rule matches - the usernames and the user IDs
+// must match exactly (the usernames are not case sensitive) and the email patterns may use * as a wildcard
+func Match(rules []*ExemptionRule, author Author) *ExemptionRule {
+	for _, rule := range rules {
+		if rule.Provider != "" && author.Provider != "" && !strings.EqualFold(rule.Provider, author.Provider) {
+			continue
+		}
+
+		switch rule.MatchType {
+		case MatchUsername:
+			if author.Username != "" && strings.EqualFold(rule.Pattern, author.Username) {
+				return rule
+			}
+		case MatchUserID:
+			if author.UserID != "" && rule.Pattern == author.UserID {
+				return rule
+			}
+		case MatchEmail:
+			if author.Email != "" && emailPatternRegex(rule.Pattern).MatchString(author.Email) {
+				return rule
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePattern returns an error if the pattern cannot be used with the match type
+func ValidatePattern(matchType, pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("the exemption rule pattern is empty")
+	}
+
+	switch matchType {
+	case MatchUsername:
+		if strings.Contains(pattern, "*") {
+			return fmt.Errorf("wildcards are only supported in the email patterns")
+		}
+	case MatchUserID:
+		for _, c := range pattern {
+			if c < '0' || c > '9' {
+				return fmt.Errorf("the user ID must be numeric: %s", pattern)
+			}
+		}
+	case MatchEmail:
+		if !strings.Contains(pattern, "@") {
+			return fmt.Errorf("the email pattern must contain the @ sign: %s", pattern)
+		}
+		if strings.Trim(pattern, "*@") == "" {
+			return fmt.Errorf("the email pattern would exempt every commit author: %s", pattern)
+		}
+	default:
+		return fmt.Errorf("unsupported exemption rule match type: %s", matchType)
+	}
+
+	return nil
+}
+
+// emailPatternRegex returns the case insensitive regex of the email pattern
+func emailPatternRegex(pattern string) *regexp.Regexp {
+	quoted := strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSpace(pattern)), `\*`, ".*")
+	return regexp.MustCompile("(?i)^" + quoted + "$")
+}
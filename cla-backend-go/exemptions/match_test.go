@@ -0,0 +1,54 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	rules := []*ExemptionRule{
+		{ExemptionRuleID: "dependabot", Provider: "github", MatchType: MatchUsername, Pattern: "dependabot[bot]"},
+		{ExemptionRuleID: "renovate", Provider: "github", MatchType: MatchUserID, Pattern: "29139614"},
+		{ExemptionRuleID: "automation", MatchType: MatchEmail, Pattern: "*@automation.example.org"},
+	}
+
+	testCases := []struct {
+		name   string
+		author Author
+		ruleID string
+	}{
+		{name: "username", author: Author{Provider: "github", Username: "Dependabot[bot]", UserID: "49699333"}, ruleID: "dependabot"},
+		{name: "username of another provider", author: Author{Provider: "gitlab", Username: "dependabot[bot]"}},
+		{name: "user ID", author: Author{Provider: "github", Username: "renovate[bot]", UserID: "29139614"}, ruleID: "renovate"},
+		{name: "email pattern", author: Author{Provider: "gitlab", Username: "release", Email: "Release@Automation.example.org"}, ruleID: "automation"},
+		{name: "email of a sub-domain", author: Author{Provider: "gitlab", Email: "release@automation.example.org.evil.com"}},
+		{name: "contributor", author: Author{Provider: "github", Username: "octo-contributor", UserID: "1001", Email: "octo@example.org"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := Match(rules, tc.author)
+			if tc.ruleID == "" {
+				assert.Nil(t, rule)
+			} else if assert.NotNil(t, rule) {
+				assert.Equal(t, tc.ruleID, rule.ExemptionRuleID)
+			}
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	assert.NoError(t, ValidatePattern(MatchUsername, "dependabot[bot]"))
+	assert.NoError(t, ValidatePattern(MatchUserID, "29139614"))
+	assert.NoError(t, ValidatePattern(MatchEmail, "*[bot]@users.noreply.github.com"))
+
+	assert.Error(t, ValidatePattern(MatchUsername, "*bot"))
+	assert.Error(t, ValidatePattern(MatchUserID, "renovate"))
+	assert.Error(t, ValidatePattern(MatchEmail, "*@*"))
+	assert.Error(t, ValidatePattern(MatchEmail, "automation"))
+	assert.Error(t, ValidatePattern("name", "renovate"))
+}
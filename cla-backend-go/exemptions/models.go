@@ -0,0 +1,51 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemptions
+
+import (
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
+)
+
+// exemption rule scopes - the rules of the foundation, the CLA group and the repository all apply to a pull request
+const (
+	ScopeFoundation = "foundation"
+	ScopeCLAGroup   = "cla-group"
+	ScopeRepository = "repository"
+)
+
+// exemption rule match types
+const (
+	MatchUsername = "username"
+	MatchUserID   = "user-id"
+	MatchEmail    = "email"
+)
+
+// ExemptionRule is the exemption rule record of the exemption rules table
+type ExemptionRule struct {
+	ExemptionRuleID string `dynamodbav:"exemption_rule_id"`
+	Scope           string `dynamodbav:"scope"`
+	ScopeID         string `dynamodbav:"scope_id"`
+	Provider        string `dynamodbav:"provider"`
+	MatchType       string `dynamodbav:"match_type"`
+	Pattern         string `dynamodbav:"pattern"`
+	Description     string `dynamodbav:"description"`
+	CreatedBy       string `dynamodbav:"created_by"`
+	DateCreated     string `dynamodbav:"date_created"`
+	DateModified    string `dynamodbav:"date_modified"`
+	Version         string `dynamodbav:"version"`
+}
+
+func (r *ExemptionRule) toModel() *models.ExemptionRule {
+	return &models.ExemptionRule{
+		ExemptionRuleID: r.ExemptionRuleID,
+		Scope:           r.Scope,
+		ScopeID:         r.ScopeID,
+		Provider:        r.Provider,
+		MatchType:       r.MatchType,
+		Pattern:         r.Pattern,
+		Description:     r.Description,
+		CreatedBy:       r.CreatedBy,
+		DateCreated:     r.DateCreated,
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// errors
+var (
+	ErrExemptionRuleNotFound = errors.New("exemption rule not found")
+)
+
+const scopeIDIndex = "exemption-rule-scope-id-index"
+
+// Repository defines functions of the exemption rules table
+type Repository interface {
+	AddExemptionRule(ctx context.Context, rule *ExemptionRule) (*ExemptionRule, error)
+	GetExemptionRule(ctx context.Context, exemptionRuleID string) (*ExemptionRule, error)
+	GetExemptionRulesByScopeID(ctx context.Context, scopeID string) ([]*ExemptionRule, error)
+	DeleteExemptionRule(ctx context.Context, exemptionRuleID string) error
+}
+
+// NewRepository creates a new exemption rules repository
+func NewRepository(awsSession *session.Session, stage string) Repository {
+	return &repo{
+		stage:          stage,
+		dynamoDBClient: dynamodb.New(awsSession),
+		tableName:      fmt.Sprintf("cla-%s-exemption-rules", stage),
+	}
+}
+
+type repo struct {
+	stage          string
+	dynamoDBClient *dynamodb.DynamoDB
+	tableName      string
+}
+
+// AddExemptionRule creates a new exemption rule
+func (repo *repo) AddExemptionRule(ctx context.Context, rule *ExemptionRule) (*ExemptionRule, error) {
+	f := logrus.Fields{
+		"functionName":   "exemptions.repository.AddExemptionRule",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"scope":          rule.Scope,
+		"scopeID":        rule.ScopeID,
+	}
+
+	exemptionRuleID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	_, currentTime := utils.CurrentTime()
+	rule.ExemptionRuleID = exemptionRuleID.String()
+	rule.DateCreated = currentTime
+	rule.DateModified = currentTime
+	rule.Version = "v1"
+
+	av, err := dynamodbattribute.MarshalMap(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repo.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		Item:      av,
+		TableName: aws.String(repo.tableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("cannot add exemption rule in dynamodb")
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetExemptionRule returns the exemption rule based on the ID
+func (repo *repo) GetExemptionRule(ctx context.Context, exemptionRuleID string) (*ExemptionRule, error) {
+	f := logrus.Fields{
+		"functionName":    "exemptions.repository.GetExemptionRule",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"exemptionRuleID": exemptionRuleID,
+	}
+
+	result, err := repo.dynamoDBClient.GetItem(&dynamodb.GetItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"exemption_rule_id": {
+				S: aws.String(exemptionRuleID),
+			},
+		},
+		TableName: aws.String(repo.tableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("error getting exemption rule")
+		return nil, err
+	}
+	if len(result.Item) == 0 {
+		return nil, ErrExemptionRuleNotFound
+	}
+
+	var rule ExemptionRule
+	err = dynamodbattribute.UnmarshalMap(result.Item, &rule)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to unmarshal exemption rule")
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// GetExemptionRulesByScopeID returns the exemption rules of the foundation, CLA group or repository ID
+func (repo *repo) GetExemptionRulesByScopeID(ctx context.Context, scopeID string) ([]*ExemptionRule, error) {
+	f := logrus.Fields{
+		"functionName":   "exemptions.repository.GetExemptionRulesByScopeID",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"scopeID":        scopeID,
+	}
+
+	condition := expression.Key("scope_id").Equal(expression.Value(scopeID))
+	expr, err := expression.NewBuilder().WithKeyCondition(condition).Build()
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("error building expression for exemption rules query")
+		return nil, err
+	}
+
+	queryInput := &dynamodb.QueryInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		KeyConditionExpression:    expr.KeyCondition(),
+		TableName:                 aws.String(repo.tableName),
+		IndexName:                 aws.String(scopeIDIndex),
+	}
+
+	rules := make([]*ExemptionRule, 0)
+	for {
+		results, err := repo.dynamoDBClient.Query(queryInput)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("error retrieving exemption rules")
+			return nil, err
+		}
+
+		var page []*ExemptionRule
+		err = dynamodbattribute.UnmarshalListOfMaps(results.Items, &page)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("error unmarshalling exemption rules from database")
+			return nil, err
+		}
+		rules = append(rules, page...)
+
+		if len(results.LastEvaluatedKey) != 0 {
+			queryInput.ExclusiveStartKey = results.LastEvaluatedKey
+		} else {
+			break
+		}
+	}
+
+	// Sort by creation date so the oldest matching rule is reported
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].DateCreated < rules[j].DateCreated
+	})
+
+	return rules, nil
+}
+
+// DeleteExemptionRule removes the exemption rule based on the ID
+func (repo *repo) DeleteExemptionRule(ctx context.Context, exemptionRuleID string) error {
+	f := logrus.Fields{
+		"functionName":    "exemptions.repository.DeleteExemptionRule",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"exemptionRuleID": exemptionRuleID,
+	}
+
+	_, err := repo.dynamoDBClient.DeleteItem(&dynamodb.DeleteItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"exemption_rule_id": {
+				S: aws.String(exemptionRuleID),
+			},
+		},
+		TableName: aws.String(repo.tableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("error deleting exemption rule")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,341 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/xanzy/go-gitlab"
+)
+
+// errors
+var (
+	ErrInvalidScope = errors.New("invalid exemption rule scope")
+)
+
+// Service defines the exemption rules functions
+type Service interface {
+	CreateExemptionRule(ctx context.Context, scope, scopeID string, input *models.ExemptionRuleInput, createdBy string) (*models.ExemptionRule, error)
+	ListExemptionRules(ctx context.Context, scope, scopeID string) (*models.ExemptionRuleList, error)
+	DeleteExemptionRule(ctx context.Context, scope, scopeID, exemptionRuleID, deletedBy string) error
+	GetScopeProjectSFIDs(ctx context.Context, scope, scopeID string) ([]string, error)
+	ExemptGitHubAuthors(ctx context.Context, claGroupID, repositoryID, repositoryName string, pullRequestID int, authors []*github.UserCommitSummary)
+	ExemptGitLabUsers(ctx context.Context, claGroupID, repositoryID, repositoryName string, mergeRequestID int, users []*gitlab.User) ([]*gitlab.User, []*gitlab.User)
+}
+
+type service struct {
+	repo                  Repository
+	gitV1Repository       repositories.RepositoryInterface
+	claGroupRepository    repository.ProjectRepository
+	projectsClaGroupsRepo projects_cla_groups.Repository
+	eventsService         events.Service
+}
+
+// NewService creates a new exemption rules service
+func NewService(repo Repository, gitV1Repository repositories.RepositoryInterface, claGroupRepository repository.ProjectRepository, projectsClaGroupsRepo projects_cla_groups.Repository, eventsService events.Service) Service {
+	return &service{
+		repo:                  repo,
+		gitV1Repository:       gitV1Repository,
+		claGroupRepository:    claGroupRepository,
+		projectsClaGroupsRepo: projectsClaGroupsRepo,
+		eventsService:         eventsService,
+	}
+}
+
+// CreateExemptionRule adds an exemption rule to the foundation, CLA group or repository
+func (s *service) CreateExemptionRule(ctx context.Context, scope, scopeID string, input *models.ExemptionRuleInput, createdBy string) (*models.ExemptionRule, error) {
+	f := logrus.Fields{
+		"functionName":   "exemptions.service.CreateExemptionRule",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"scope":          scope,
+		"scopeID":        scopeID,
+		"createdBy":      createdBy,
+	}
+
+	if !validScope(scope) {
+		return nil, ErrInvalidScope
+	}
+	matchType := utils.StringValue(input.MatchType)
+	pattern := strings.TrimSpace(utils.StringValue(input.Pattern))
+	if err := ValidatePattern(matchType, pattern); err != nil {
+		return nil, err
+	}
+	if input.Provider != "" && input.Provider != utils.GitHubType && input.Provider != utils.GitLabLower {
+		return nil, fmt.Errorf("unsupported exemption rule provider: %s", input.Provider)
+	}
+
+	rule, err := s.repo.AddExemptionRule(ctx, &ExemptionRule{
+		Scope:       scope,
+		ScopeID:     scopeID,
+		Provider:    input.Provider,
+		MatchType:   matchType,
+		Pattern:     pattern,
+		Description: input.Description,
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to add the exemption rule")
+		return nil, err
+	}
+
+	args := s.scopeEventArgs(ctx, scope, scopeID)
+	args.EventType = events.ExemptionRuleCreated
+	args.LfUsername = createdBy
+	args.UserName = createdBy
+	args.EventData = &events.ExemptionRuleCreatedEventData{
+		ExemptionRuleID: rule.ExemptionRuleID,
+		Scope:           scope,
+		ScopeID:         scopeID,
+		MatchType:       matchType,
+		Pattern:         pattern,
+	}
+	s.eventsService.LogEventWithContext(ctx, args)
+
+	return rule.toModel(), nil
+}
+
+// ListExemptionRules returns the exemption rules of the foundation, CLA group or repository
+func (s *service) ListExemptionRules(ctx context.Context, scope, scopeID string) (*models.ExemptionRuleList, error) {
+	if !validScope(scope) {
+		return nil, ErrInvalidScope
+	}
+
+	rules, err := s.repo.GetExemptionRulesByScopeID(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*models.ExemptionRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Scope == scope {
+			list = append(list, rule.toModel())
+		}
+	}
+	return &models.ExemptionRuleList{List: list}, nil
+}
+
+// DeleteExemptionRule removes an exemption rule of the foundation, CLA group or repository
+func (s *service) DeleteExemptionRule(ctx context.Context, scope, scopeID, exemptionRuleID, deletedBy string) error {
+	f := logrus.Fields{
+		"functionName":    "exemptions.service.DeleteExemptionRule",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"scope":           scope,
+		"scopeID":         scopeID,
+		"exemptionRuleID": exemptionRuleID,
+		"deletedBy":       deletedBy,
+	}
+
+	rule, err := s.repo.GetExemptionRule(ctx, exemptionRuleID)
+	if err != nil {
+		return err
+	}
+	// the rule must belong to the scope the caller was authorized for
+	if rule.Scope != scope || rule.ScopeID != scopeID {
+		log.WithFields(f).Warnf("exemption rule belongs to the %s %s", rule.Scope, rule.ScopeID)
+		return ErrExemptionRuleNotFound
+	}
+
+	if err = s.repo.DeleteExemptionRule(ctx, exemptionRuleID); err != nil {
+		return err
+	}
+
+	args := s.scopeEventArgs(ctx, scope, scopeID)
+	args.EventType = events.ExemptionRuleDeleted
+	args.LfUsername = deletedBy
+	args.UserName = deletedBy
+	args.EventData = &events.ExemptionRuleDeletedEventData{
+		ExemptionRuleID: exemptionRuleID,
+		Scope:           scope,
+		ScopeID:         scopeID,
+		MatchType:       rule.MatchType,
+		Pattern:         rule.Pattern,
+	}
+	s.eventsService.LogEventWithContext(ctx, args)
+
+	return nil
+}
+
+// GetScopeProjectSFIDs returns the project SFIDs the caller must be authorized for to manage the exemption rules of
+// the foundation, CLA group or repository
+func (s *service) GetScopeProjectSFIDs(ctx context.Context, scope, scopeID string) ([]string, error) {
+	switch scope {
+	case ScopeFoundation:
+		return []string{scopeID}, nil
+	case ScopeCLAGroup:
+		projectCLAGroups, err := s.projectsClaGroupsRepo.GetProjectsIdsForClaGroup(ctx, scopeID)
+		if err != nil {
+			return nil, err
+		}
+		var projectSFIDs []string
+		for _, projectCLAGroup := range projectCLAGroups {
+			projectSFIDs = append(projectSFIDs, projectCLAGroup.ProjectSFID)
+		}
+		return projectSFIDs, nil
+	case ScopeRepository:
+		repo, err := s.gitV1Repository.GitHubGetRepository(ctx, scopeID)
+		if err != nil {
+			return nil, err
+		}
+		return []string{repo.RepositoryProjectSfid}, nil
+	default:
+		return nil, ErrInvalidScope
+	}
+}
+
+// ExemptGitHubAuthors marks the pull request commit authors matched by the exemption rules of the foundation, the
+// CLA group or the repository as exempt and records an event for each exempt author - the email rules only match the
+// authors which are not linked to a GitHub account
+func (s *service) ExemptGitHubAuthors(ctx context.Context, claGroupID, repositoryID, repositoryName string, pullRequestID int, authors []*github.UserCommitSummary) {
+	rules := s.getRules(ctx, claGroupID, repositoryID)
+	if len(rules) == 0 {
+		return
+	}
+
+	recorded := map[string]bool{}
+	for _, author := range authors {
+		identity := Author{
+			Provider: utils.GitHubType,
+			Username: author.GetCommitAuthorUsername(),
+			UserID:   author.GetCommitAuthorID(),
+		}
+		// the git emails are self-declared by the committer, so the email rules only apply to the authors which are
+		// not linked to a GitHub account - the linked authors are matched by their GitHub login or ID
+		if identity.UserID == "" {
+			identity.Email = author.GitAuthorEmail
+			if author.CoAuthor || identity.Email == "" {
+				identity.Email = author.GetCommitAuthorEmail()
+			}
+		}
+		rule := Match(rules, identity)
+		if rule == nil {
+			continue
+		}
+		author.Exempt = true
+		if !recorded[identity.String()] {
+			recorded[identity.String()] = true
+			s.logExemptionApplied(ctx, claGroupID, repositoryName, strconv.Itoa(pullRequestID), identity, rule)
+		}
+	}
+}
+
+// ExemptGitLabUsers splits the merge request participants into the users which must be checked and the users matched
+// by the exemption rules of the foundation, the CLA group or the repository, and records an event for each exempt user
+func (s *service) ExemptGitLabUsers(ctx context.Context, claGroupID, repositoryID, repositoryName string, mergeRequestID int, users []*gitlab.User) ([]*gitlab.User, []*gitlab.User) {
+	rules := s.getRules(ctx, claGroupID, repositoryID)
+	if len(rules) == 0 {
+		return users, nil
+	}
+
+	var remaining, exempt []*gitlab.User
+	for _, user := range users {
+		identity := Author{
+			Provider: utils.GitLabLower,
+			Username: user.Username,
+			UserID:   strconv.Itoa(user.ID),
+			Email:    user.Email,
+		}
+		rule := Match(rules, identity)
+		if rule == nil {
+			remaining = append(remaining, user)
+			continue
+		}
+		exempt = append(exempt, user)
+		s.logExemptionApplied(ctx, claGroupID, repositoryName, strconv.Itoa(mergeRequestID), identity, rule)
+	}
+	return remaining, exempt
+}
+
+// getRules returns the exemption rules of the foundation of the CLA group, the CLA group and the repository - a
+// failure to load the rules is logged and the check continues without the exemptions
+func (s *service) getRules(ctx context.Context, claGroupID, repositoryID string) []*ExemptionRule {
+	f := logrus.Fields{
+		"functionName":   "exemptions.service.getRules",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"claGroupID":     claGroupID,
+		"repositoryID":   repositoryID,
+	}
+
+	scopeIDs := []string{claGroupID, repositoryID}
+	claGroup, err := s.claGroupRepository.GetCLAGroupByID(ctx, claGroupID, repository.DontLoadRepoDetails)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the CLA group, skipping the foundation exemption rules")
+	} else if claGroup.FoundationSFID != "" {
+		scopeIDs = append(scopeIDs, claGroup.FoundationSFID)
+	}
+
+	var rules []*ExemptionRule
+	for _, scopeID := range scopeIDs {
+		if scopeID == "" {
+			continue
+		}
+		scopeRules, err := s.repo.GetExemptionRulesByScopeID(ctx, scopeID)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("unable to load the exemption rules of : %s", scopeID)
+			continue
+		}
+		rules = append(rules, scopeRules...)
+	}
+	return rules
+}
+
+func (s *service) logExemptionApplied(ctx context.Context, claGroupID, repositoryName, pullRequestID string, author Author, rule *ExemptionRule) {
+	log.WithFields(logrus.Fields{
+		"functionName":    "exemptions.service.logExemptionApplied",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"claGroupID":      claGroupID,
+		"exemptionRuleID": rule.ExemptionRuleID,
+	}).Debugf("commit author %s is exempt from the CLA check", author)
+
+	s.eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:  events.ExemptionApplied,
+		LfUsername: "easycla system",
+		UserID:     "easycla system",
+		CLAGroupID: claGroupID,
+		ProjectID:  claGroupID,
+		EventData: &events.ExemptionAppliedEventData{
+			ExemptionRuleID: rule.ExemptionRuleID,
+			Scope:           rule.Scope,
+			Author:          author.String(),
+			RepositoryName:  repositoryName,
+			PullRequestID:   pullRequestID,
+		},
+	})
+}
+
+// scopeEventArgs returns the event arguments identifying the foundation, CLA group or repository of the rule
+func (s *service) scopeEventArgs(ctx context.Context, scope, scopeID string) *events.LogEventArgs {
+	switch scope {
+	case ScopeFoundation:
+		return &events.LogEventArgs{ProjectSFID: scopeID}
+	case ScopeCLAGroup:
+		return &events.LogEventArgs{CLAGroupID: scopeID, ProjectID: scopeID}
+	default:
+		repo, err := s.gitV1Repository.GitHubGetRepository(ctx, scopeID)
+		if err != nil {
+			return &events.LogEventArgs{}
+		}
+		return &events.LogEventArgs{
+			CLAGroupID:  repo.RepositoryClaGroupID,
+			ProjectID:   repo.RepositoryClaGroupID,
+			ProjectSFID: repo.RepositoryProjectSfid,
+		}
+	}
+}
+
+func validScope(scope string) bool {
+	return scope == ScopeFoundation || scope == ScopeCLAGroup || scope == ScopeRepository
+}
@@ -0,0 +1,97 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemptions
+
+import (
+	"context"
+	"testing"
+
+	eventsMock "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	mock_project "github.com/communitybridge/easycla/cla-backend-go/project/mocks"
+	"github.com/golang/mock/gomock"
+	githubAPI "github.com/google/go-github/v37/github"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testCLAGroupID     = "9b5e3ff3-6a35-4bb1-8dbb-3a5e9b6e2cb2"
+	testRepositoryID   = "b0f1e7a5-9fbd-4a3c-9a63-6c8d3b1d4a10"
+	testFoundationSFID = "a0941000002wBz4AAE"
+)
+
+// fakeRepository keeps the exemption rules of each scope in memory
+type fakeRepository struct {
+	rules map[string][]*ExemptionRule
+}
+
+func (r *fakeRepository) AddExemptionRule(ctx context.Context, rule *ExemptionRule) (*ExemptionRule, error) {
+	r.rules[rule.ScopeID] = append(r.rules[rule.ScopeID], rule)
+	return rule, nil
+}
+
+func (r *fakeRepository) GetExemptionRule(ctx context.Context, exemptionRuleID string) (*ExemptionRule, error) {
+	for _, rules := range r.rules {
+		for _, rule := range rules {
+			if rule.ExemptionRuleID == exemptionRuleID {
+				return rule, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeRepository) GetExemptionRulesByScopeID(ctx context.Context, scopeID string) ([]*ExemptionRule, error) {
+	return r.rules[scopeID], nil
+}
+
+func (r *fakeRepository) DeleteExemptionRule(ctx context.Context, exemptionRuleID string) error {
+	return nil
+}
+
+func linkedAuthor(id int64, login, gitEmail string) *github.UserCommitSummary {
+	return &github.UserCommitSummary{
+		SHA:            "1111111",
+		CommitAuthor:   &githubAPI.User{ID: githubAPI.Int64(id), Login: githubAPI.String(login)},
+		GitAuthorName:  login,
+		GitAuthorEmail: gitEmail,
+	}
+}
+
+func TestExemptGitHubAuthors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claGroupRepo := mock_project.NewMockProjectRepository(ctrl)
+	claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), testCLAGroupID, gomock.Any()).Return(&v1Models.ClaGroup{FoundationSFID: testFoundationSFID}, nil)
+
+	eventsService := eventsMock.NewMockService(ctrl)
+	// one event per exempt author
+	eventsService.EXPECT().LogEventWithContext(gomock.Any(), gomock.Any()).Times(3)
+
+	repo := &fakeRepository{rules: map[string][]*ExemptionRule{
+		testFoundationSFID: {{ExemptionRuleID: "dependabot", Provider: "github", MatchType: MatchUsername, Pattern: "dependabot[bot]"}},
+		testCLAGroupID:     {{ExemptionRuleID: "renovate", Provider: "github", MatchType: MatchUserID, Pattern: "29139614"}},
+		testRepositoryID:   {{ExemptionRuleID: "automation", MatchType: MatchEmail, Pattern: "*@automation.example.org"}},
+	}}
+	s := NewService(repo, nil, claGroupRepo, nil, eventsService).(*service)
+
+	dependabot := linkedAuthor(49699333, "dependabot[bot]", "49699333+dependabot[bot]@users.noreply.github.com")
+	renovate := linkedAuthor(29139614, "renovate[bot]", "bot@renovateapp.com")
+	// a contributor linked to a GitHub account who declared an exempt git email
+	spoofed := linkedAuthor(1001, "octo-contributor", "release@automation.example.org")
+	// the release automation commits are not linked to a GitHub account
+	automation := &github.UserCommitSummary{SHA: "2222222", GitAuthorName: "release", GitAuthorEmail: "release@automation.example.org"}
+	contributor := linkedAuthor(1002, "new-contributor", "new-contributor@example.org")
+
+	s.ExemptGitHubAuthors(context.Background(), testCLAGroupID, testRepositoryID, "easycla-test-org/easycla-test-repo", 12,
+		[]*github.UserCommitSummary{dependabot, renovate, spoofed, automation, contributor})
+
+	assert.True(t, dependabot.Exempt)
+	assert.True(t, renovate.Exempt)
+	assert.False(t, spoofed.Exempt, "the git email of a linked author is not matched")
+	assert.True(t, automation.Exempt)
+	assert.False(t, contributor.Exempt)
+}
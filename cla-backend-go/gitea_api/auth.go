@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/go-resty/resty/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// OAuthApp is the OAuth2 application registered on the Gitea instance for the EasyCLA sign flow
+type OAuthApp struct {
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+}
+
+// AuthCodeURL returns the Gitea authorize URL the contributor is redirected to
+func (a *OAuthApp) AuthCodeURL(state string) string {
+	params := url.Values{}
+	params.Set("client_id", a.ClientID)
+	params.Set("redirect_uri", a.RedirectURI)
+	params.Set("response_type", "code")
+	params.Set("state", state)
+	return fmt.Sprintf("%s/login/oauth/authorize?%s", a.BaseURL, params.Encode())
+}
+
+// FetchOauthCredentials exchanges the authorization code for the contributor access token
+func (a *OAuthApp) FetchOauthCredentials(ctx context.Context, code string) (*OauthSuccessResponse, error) {
+	tokenURL := fmt.Sprintf("%s/login/oauth/access_token", a.BaseURL)
+	f := logrus.Fields{
+		"functionName":   "gitea.auth.FetchOauthCredentials",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"tokenURL":       tokenURL,
+		"redirectURI":    a.RedirectURI,
+	}
+
+	if a.ClientID == "" || a.ClientSecret == "" {
+		return nil, errors.New("gitea oauth application client ID or secret is not set")
+	}
+
+	// For info on this authorization flow, see: https://docs.gitea.com/development/oauth2-provider
+	resp, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetFormData(map[string]string{
+			"client_id":     a.ClientID,
+			"client_secret": a.ClientSecret,
+			"code":          code,
+			"grant_type":    "authorization_code",
+			"redirect_uri":  a.RedirectURI,
+		}).
+		SetResult(&OauthSuccessResponse{}).
+		Post(tokenURL)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem invoking Gitea auth token exchange to: %s", tokenURL)
+		return nil, err
+	}
+
+	if resp.StatusCode() < 200 || resp.StatusCode() > 299 {
+		msg := fmt.Sprintf("problem invoking Gitea auth token exchange to: %s with status code: %d, response: %s", tokenURL, resp.StatusCode(), string(resp.Body()))
+		log.WithFields(f).Warn(msg)
+		return nil, errors.New(msg)
+	}
+
+	return resp.Result().(*OauthSuccessResponse), nil
+}
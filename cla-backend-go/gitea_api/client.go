@@ -0,0 +1,154 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// apiPath is the REST API prefix - Gitea and Forgejo expose the same API
+const apiPath = "/api/v1"
+
+// Client is a minimal Gitea/Forgejo REST API client
+type Client struct {
+	baseURL string
+	rest    *resty.Client
+}
+
+// NewClient creates a new Gitea client for the instance base URL, e.g. https://codeberg.org, using the access token
+func NewClient(baseURL, accessToken string) (*Client, error) {
+	baseURL, err := NormalizeBaseURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	if accessToken == "" {
+		return nil, errors.New("unable to create gitea client - access token is empty")
+	}
+
+	rest := resty.New().
+		SetHostURL(baseURL+apiPath).
+		SetTimeout(30*time.Second).
+		SetHeader("Accept", "application/json").
+		SetHeader("Authorization", fmt.Sprintf("token %s", accessToken))
+
+	return &Client{
+		baseURL: baseURL,
+		rest:    rest,
+	}, nil
+}
+
+// BaseURL returns the Gitea instance base URL
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// NormalizeBaseURL validates the Gitea instance URL and returns it without the trailing slash
+func NormalizeBaseURL(baseURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid gitea url: %s - %v", baseURL, err)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("invalid gitea url: %s - expecting an http(s) url", baseURL)
+	}
+	return strings.TrimRight(fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.Path), "/"), nil
+}
+
+// do executes the API request, the JSON response is decoded into result when not nil
+func (c *Client) do(ctx context.Context, method, path string, body, result interface{}) error {
+	req := c.rest.R().SetContext(ctx)
+	if body != nil {
+		req.SetHeader("Content-Type", "application/json").SetBody(body)
+	}
+	if result != nil {
+		// proxies in front of self-hosted instances do not always keep the content type
+		req.ForceContentType("application/json").SetResult(result)
+	}
+
+	resp, err := req.Execute(method, path)
+	if err != nil {
+		return fmt.Errorf("gitea request %s %s failed : %v", method, path, err)
+	}
+	if resp.StatusCode() == 404 {
+		return &NotFoundError{Path: path}
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() > 299 {
+		return fmt.Errorf("gitea request %s %s failed with status code : %d, response : %s", method, path, resp.StatusCode(), string(resp.Body()))
+	}
+
+	return nil
+}
+
+// NotFoundError is returned when the Gitea resource does not exist or is not visible with the access token
+type NotFoundError struct {
+	Path string
+}
+
+// Error returns the error string
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("gitea resource not found : %s", e.Path)
+}
+
+// EncryptToken encrypts the access token with the base64 encoded AES key
+func EncryptToken(token, key string) (string, error) {
+	keyDecoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("problem decoding gitea token key, error: %v", err)
+	}
+
+	block, err := aes.NewCipher(keyDecoded)
+	if err != nil {
+		return "", err
+	}
+
+	// The IV is stored at the beginning of the cipher text
+	cipherText := make([]byte, aes.BlockSize+len(token))
+	iv := cipherText[:aes.BlockSize]
+	if _, err = io.ReadFull(rand.Reader, iv); err != nil {
+		return "", err
+	}
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(cipherText[aes.BlockSize:], []byte(token))
+
+	return hex.EncodeToString(cipherText), nil
+}
+
+// DecryptToken decrypts the access token encrypted with EncryptToken
+func DecryptToken(encrypted, key string) (string, error) {
+	cipherText, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("problem decoding encrypted gitea token, error: %v", err)
+	}
+	if len(cipherText) < aes.BlockSize {
+		return "", errors.New("encrypted gitea token is too short")
+	}
+
+	keyDecoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("problem decoding gitea token key, error: %v", err)
+	}
+
+	block, err := aes.NewCipher(keyDecoded)
+	if err != nil {
+		return "", err
+	}
+
+	iv := cipherText[:aes.BlockSize]
+	plainText := cipherText[aes.BlockSize:]
+	cipher.NewCFBDecrypter(block, iv).XORKeyStream(plainText, plainText)
+
+	return string(plainText), nil
+}
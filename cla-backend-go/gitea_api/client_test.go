@@ -0,0 +1,140 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var giteaTokenKey = "0WqnDWHnZKo2cmQ8m93EtY9ZBpfzQW4UnnEuRmgtJKM="
+
+func TestNormalizeBaseURL(t *testing.T) {
+	baseURL, err := NormalizeBaseURL("https://codeberg.org/")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://codeberg.org", baseURL)
+
+	baseURL, err = NormalizeBaseURL("http://localhost:3000/gitea/")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:3000/gitea", baseURL)
+
+	_, err = NormalizeBaseURL("codeberg.org")
+	assert.Error(t, err)
+}
+
+func TestEncryptDecryptToken(t *testing.T) {
+	encrypted, err := EncryptToken("a30671b8749ba5d48925712344377f11a5aba43e", giteaTokenKey)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "a30671b8749ba5d48925712344377f11a5aba43e", encrypted)
+
+	decrypted, err := DecryptToken(encrypted, giteaTokenKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "a30671b8749ba5d48925712344377f11a5aba43e", decrypted)
+}
+
+func TestValidateWebhookSignature(t *testing.T) {
+	payload := []byte(`{"action":"opened","number":1}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload) // nolint
+
+	header := http.Header{}
+	header.Set(SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	assert.NoError(t, ValidateWebhookSignature(header, payload, "secret"))
+	assert.Error(t, ValidateWebhookSignature(header, payload, "other-secret"))
+
+	// Forgejo sends the same signature under its own header name
+	forgejoHeader := http.Header{}
+	forgejoHeader.Set(ForgejoSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	assert.NoError(t, ValidateWebhookSignature(forgejoHeader, payload, "secret"))
+
+	assert.Error(t, ValidateWebhookSignature(http.Header{}, payload, "secret"))
+}
+
+func TestFetchPullRequestParticipants(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token access-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "/api/v1/repos/easycla/demo/pulls/3/commits", r.URL.Path)
+		commits := []*Commit{
+			{SHA: "a1", RepoCommit: &RepoCommit{Author: &CommitUser{Name: "Jane Doe", Email: "jane@example.org"}}, Author: &User{ID: 7, UserName: "jane"}},
+			{SHA: "a2", RepoCommit: &RepoCommit{Author: &CommitUser{Name: "Jane Doe", Email: "jane@example.org"}}, Author: &User{ID: 7, UserName: "jane"}},
+			{SHA: "a3", RepoCommit: &RepoCommit{Author: &CommitUser{Name: "John Doe", Email: "john@example.org"}}},
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(commits))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "access-token")
+	assert.NoError(t, err)
+
+	participants, err := FetchPullRequestParticipants(context.Background(), client, "easycla", "demo", 3)
+	assert.NoError(t, err)
+	if assert.Len(t, participants, 2) {
+		assert.Equal(t, int64(7), participants[0].ID)
+		assert.Equal(t, "jane", participants[0].UserName)
+		assert.Equal(t, "jane@example.org", participants[0].Email)
+		assert.Equal(t, int64(0), participants[1].ID)
+		assert.Equal(t, "john@example.org", participants[1].Email)
+	}
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/repos/easycla/demo/statuses/abc123", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "access-token")
+	assert.NoError(t, err)
+
+	err = SetCommitStatus(context.Background(), client, "easycla", "demo", "abc123", CommitStatusFailure, "missing CLA", "https://easycla.example.org/sign")
+	assert.NoError(t, err)
+	assert.Equal(t, "failure", body["state"])
+	assert.Equal(t, statusContext, body["context"])
+	assert.Equal(t, "https://easycla.example.org/sign", body["target_url"])
+}
+
+func TestGetRepositoryNotFound(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "access-token")
+	assert.NoError(t, err)
+
+	_, err = GetRepository(context.Background(), client, "easycla", "missing")
+	_, ok := err.(*NotFoundError)
+	assert.True(t, ok)
+}
+
+// TestGiteaInstance runs against a live Gitea or Forgejo instance, see the README for running one locally
+func TestGiteaInstance(t *testing.T) {
+	baseURL, token := os.Getenv("GITEA_TEST_URL"), os.Getenv("GITEA_TEST_TOKEN")
+	if baseURL == "" || token == "" {
+		t.Skip("GITEA_TEST_URL and GITEA_TEST_TOKEN not set")
+	}
+
+	client, err := NewClient(baseURL, token)
+	assert.NoError(t, err)
+
+	user, err := GetCurrentUser(context.Background(), client)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, user.UserName)
+
+	if org := os.Getenv("GITEA_TEST_ORG"); org != "" {
+		_, err = ListOrganizationRepositories(context.Background(), client, org)
+		assert.NoError(t, err)
+	}
+}
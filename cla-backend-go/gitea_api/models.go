@@ -0,0 +1,108 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+// User is the Gitea user model
+type User struct {
+	ID       int64  `json:"id"`
+	UserName string `json:"login"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+}
+
+// Organization is the Gitea organization model
+type Organization struct {
+	ID       int64  `json:"id"`
+	UserName string `json:"username"`
+	FullName string `json:"full_name"`
+	Website  string `json:"website"`
+}
+
+// Repository is the Gitea repository model
+type Repository struct {
+	ID       int64  `json:"id"`
+	Owner    *User  `json:"owner"`
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+	Private  bool   `json:"private"`
+	Archived bool   `json:"archived"`
+}
+
+// PRBranchInfo is the head/base branch of a pull request
+type PRBranchInfo struct {
+	Ref    string `json:"ref"`
+	Sha    string `json:"sha"`
+	RepoID int64  `json:"repo_id"`
+}
+
+// PullRequest is the Gitea pull request model
+type PullRequest struct {
+	ID      int64         `json:"id"`
+	Index   int64         `json:"number"`
+	Title   string        `json:"title"`
+	State   string        `json:"state"`
+	HTMLURL string        `json:"html_url"`
+	Poster  *User         `json:"user"`
+	Head    *PRBranchInfo `json:"head"`
+	Base    *PRBranchInfo `json:"base"`
+}
+
+// CommitUser is the git author/committer of a commit
+type CommitUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Date  string `json:"date"`
+}
+
+// RepoCommit is the git commit details
+type RepoCommit struct {
+	Message   string      `json:"message"`
+	Author    *CommitUser `json:"author"`
+	Committer *CommitUser `json:"committer"`
+}
+
+// Commit is the Gitea commit model - Author and Committer are only set when the git identity matches a Gitea user
+type Commit struct {
+	SHA        string      `json:"sha"`
+	HTMLURL    string      `json:"html_url"`
+	RepoCommit *RepoCommit `json:"commit"`
+	Author     *User       `json:"author"`
+	Committer  *User       `json:"committer"`
+}
+
+// Comment is the Gitea issue/pull request comment model
+type Comment struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+	User *User  `json:"user"`
+}
+
+// CommitStatusState is the state of a commit status
+type CommitStatusState string
+
+// commit status states
+const (
+	CommitStatusPending CommitStatusState = "pending"
+	CommitStatusSuccess CommitStatusState = "success"
+	CommitStatusError   CommitStatusState = "error"
+	CommitStatusFailure CommitStatusState = "failure"
+)
+
+// Hook is the Gitea repository webhook model
+type Hook struct {
+	ID     int64             `json:"id"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+// OauthSuccessResponse is the Gitea OAuth2 access token response
+type OauthSuccessResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,178 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// statusContext is the commit status context name reported by EasyCLA
+const statusContext = "EasyCLA"
+
+// GetPullRequest returns the pull request for the given repository and pull request number
+func GetPullRequest(ctx context.Context, client *Client, owner, repositoryName string, pullRequestID int64) (*PullRequest, error) {
+	var pullRequest PullRequest
+	err := client.do(ctx, http.MethodGet, repositoryPath(owner, repositoryName, fmt.Sprintf("/pulls/%d", pullRequestID)), nil, &pullRequest)
+	if err != nil {
+		return nil, fmt.Errorf("fetching pull request : %d for repository : %s/%s failed : %v", pullRequestID, owner, repositoryName, err)
+	}
+	return &pullRequest, nil
+}
+
+// FetchPullRequestParticipants returns the unique commit authors of the pull request. The git author name and email
+// are always set, the Gitea user ID and username are only set when the commit author email is linked to a Gitea account.
+func FetchPullRequestParticipants(ctx context.Context, client *Client, owner, repositoryName string, pullRequestID int64) ([]*User, error) {
+	f := logrus.Fields{
+		"functionName":   "gitea_api.FetchPullRequestParticipants",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"owner":          owner,
+		"repositoryName": repositoryName,
+		"pullRequestID":  pullRequestID,
+	}
+
+	log.WithFields(f).Debug("fetching pull request participants...")
+	var commits []*Commit
+	for page := 1; ; page++ {
+		var results []*Commit
+		path := repositoryPath(owner, repositoryName, fmt.Sprintf("/pulls/%d/commits?page=%d&limit=%d", pullRequestID, page, pageSize))
+		if err := client.do(ctx, http.MethodGet, path, nil, &results); err != nil {
+			return nil, fmt.Errorf("fetching gitea participants for repository : %s/%s and pull request : %d, failed : %v", owner, repositoryName, pullRequestID, err)
+		}
+		commits = append(commits, results...)
+		if len(results) < pageSize {
+			break
+		}
+	}
+
+	if len(commits) == 0 {
+		log.WithFields(f).Debugf("no commits found for repository : %s/%s and pull request : %d", owner, repositoryName, pullRequestID)
+		return nil, nil
+	}
+
+	var results []*User
+	seen := map[string]bool{}
+	for _, commit := range commits {
+		user := commitAuthor(commit)
+		if user == nil {
+			log.WithFields(f).Warnf("unable to extract the author of commit : %s", commit.SHA)
+			continue
+		}
+
+		key := strings.ToLower(user.Email)
+		if user.ID != 0 {
+			key = fmt.Sprintf("%d", user.ID)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		log.WithFields(f).Debugf("extracted author email: %s, name: %s, username: %s from commit: %s", user.Email, user.FullName, user.UserName, commit.SHA)
+		results = append(results, user)
+	}
+
+	return results, nil
+}
+
+// commitAuthor builds the participant from the git author of the commit, enriched with the linked Gitea account
+func commitAuthor(commit *Commit) *User {
+	// The author is the person who originally wrote the code. The committer, on the other hand, is assumed to be
+	// the person who committed the code on behalf of the original author.
+	if commit.RepoCommit == nil || commit.RepoCommit.Author == nil {
+		return commit.Author
+	}
+
+	user := &User{
+		FullName: commit.RepoCommit.Author.Name,
+		Email:    commit.RepoCommit.Author.Email,
+	}
+	if commit.Author != nil {
+		user.ID = commit.Author.ID
+		user.UserName = commit.Author.UserName
+	}
+	return user
+}
+
+// SetCommitStatus is responsible for setting the EasyCLA status for the commit sha
+func SetCommitStatus(ctx context.Context, client *Client, owner, repositoryName, commitSha string, state CommitStatusState, message, targetURL string) error {
+	f := logrus.Fields{
+		"functionName":   "gitea_api.SetCommitStatus",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"owner":          owner,
+		"repositoryName": repositoryName,
+		"commitSha":      commitSha,
+		"state":          state,
+		"message":        message,
+		"targetURL":      targetURL,
+	}
+
+	log.WithFields(f).Debug("setting commit status...")
+	body := map[string]string{
+		"state":       string(state),
+		"context":     statusContext,
+		"description": message,
+	}
+	if targetURL != "" {
+		body["target_url"] = targetURL
+	}
+
+	err := client.do(ctx, http.MethodPost, repositoryPath(owner, repositoryName, fmt.Sprintf("/statuses/%s", commitSha)), body, nil)
+	if err != nil {
+		return fmt.Errorf("setting commit status for the sha : %s and repository : %s/%s failed : %v", commitSha, owner, repositoryName, err)
+	}
+
+	log.WithFields(f).Debug("commit status set successfully")
+	return nil
+}
+
+// SetPullRequestComment creates the EasyCLA comment on the pull request, the previous EasyCLA comment is updated if present
+func SetPullRequestComment(ctx context.Context, client *Client, owner, repositoryName string, pullRequestID int64, message string) error {
+	f := logrus.Fields{
+		"functionName":   "gitea_api.SetPullRequestComment",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"owner":          owner,
+		"repositoryName": repositoryName,
+		"pullRequestID":  pullRequestID,
+	}
+
+	// pull requests are issues in the Gitea API, the comments are shared
+	var comments []*Comment
+	err := client.do(ctx, http.MethodGet, repositoryPath(owner, repositoryName, fmt.Sprintf("/issues/%d/comments", pullRequestID)), nil, &comments)
+	if err != nil {
+		return fmt.Errorf("fetching comments for repository : %s/%s and pull request : %d : failed %v", owner, repositoryName, pullRequestID, err)
+	}
+
+	var previousComment *Comment
+	for _, c := range comments {
+		if strings.Contains(c.Body, "cla-signed.svg") || strings.Contains(c.Body, "cla-not-signed.svg") || strings.Contains(c.Body, "cla-missing-id.svg") || strings.Contains(c.Body, "cla-confirmation-needed.svg") {
+			previousComment = c
+			break
+		}
+	}
+
+	body := map[string]string{"body": message}
+	if previousComment == nil {
+		log.WithFields(f).Debug("creating comment")
+		err = client.do(ctx, http.MethodPost, repositoryPath(owner, repositoryName, fmt.Sprintf("/issues/%d/comments", pullRequestID)), body, nil)
+		if err != nil {
+			return fmt.Errorf("creating comment for repository : %s/%s and pull request : %d : failed %v", owner, repositoryName, pullRequestID, err)
+		}
+		return nil
+	}
+
+	log.WithFields(f).Debugf("updating previous comment : %d", previousComment.ID)
+	err = client.do(ctx, http.MethodPatch, repositoryPath(owner, repositoryName, fmt.Sprintf("/issues/comments/%d", previousComment.ID)), body, nil)
+	if err != nil {
+		return fmt.Errorf("updating comment for repository : %s/%s and pull request : %d : failed %v", owner, repositoryName, pullRequestID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// pageSize is the page size used for the list requests - the Gitea default maximum
+const pageSize = 50
+
+// GetOrganization returns the Gitea organization by name
+func GetOrganization(ctx context.Context, client *Client, organizationName string) (*Organization, error) {
+	var organization Organization
+	err := client.do(ctx, http.MethodGet, fmt.Sprintf("/orgs/%s", url.PathEscape(organizationName)), nil, &organization)
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// ListOrganizationRepositories returns all the repositories of the Gitea organization
+func ListOrganizationRepositories(ctx context.Context, client *Client, organizationName string) ([]*Repository, error) {
+	f := logrus.Fields{
+		"functionName":     "gitea_api.ListOrganizationRepositories",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": organizationName,
+	}
+
+	var repositories []*Repository
+	for page := 1; ; page++ {
+		var results []*Repository
+		path := fmt.Sprintf("/orgs/%s/repos?page=%d&limit=%d", url.PathEscape(organizationName), page, pageSize)
+		if err := client.do(ctx, http.MethodGet, path, nil, &results); err != nil {
+			return nil, err
+		}
+		repositories = append(repositories, results...)
+		if len(results) < pageSize {
+			break
+		}
+	}
+
+	log.WithFields(f).Debugf("found %d repositories", len(repositories))
+	return repositories, nil
+}
+
+// GetRepository returns the Gitea repository by owner and name
+func GetRepository(ctx context.Context, client *Client, owner, repositoryName string) (*Repository, error) {
+	var repository Repository
+	err := client.do(ctx, http.MethodGet, repositoryPath(owner, repositoryName, ""), nil, &repository)
+	if err != nil {
+		return nil, err
+	}
+	return &repository, nil
+}
+
+// GetRepositoryByID returns the Gitea repository by ID
+func GetRepositoryByID(ctx context.Context, client *Client, repositoryID int64) (*Repository, error) {
+	var repository Repository
+	err := client.do(ctx, http.MethodGet, fmt.Sprintf("/repositories/%d", repositoryID), nil, &repository)
+	if err != nil {
+		return nil, err
+	}
+	return &repository, nil
+}
+
+// repositoryPath returns the API path of the repository resource
+func repositoryPath(owner, repositoryName, resource string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", url.PathEscape(owner), url.PathEscape(repositoryName), resource)
+}
@@ -0,0 +1,31 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetCurrentUser returns the user of the client access token
+func GetCurrentUser(ctx context.Context, client *Client) (*User, error) {
+	var user User
+	if err := client.do(ctx, http.MethodGet, "/user", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UserIDKey returns the Gitea user ID qualified with the instance URL - Gitea user IDs are only unique within an
+// instance so the ID is stored on the user record with the normalized instance URL
+func UserIDKey(baseURL string, userID int64) string {
+	return fmt.Sprintf("%s#%d", baseURL, userID)
+}
+
+// UserNameKey returns the Gitea login qualified with the instance URL, logins are case-insensitive in Gitea
+func UserNameKey(baseURL, userName string) string {
+	return fmt.Sprintf("%s#%s", baseURL, strings.ToLower(userName))
+}
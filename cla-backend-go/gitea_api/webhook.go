@@ -0,0 +1,151 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// webhook headers - Forgejo sends both the Gitea and the Forgejo variants
+const (
+	EventTypeHeader         = "X-Gitea-Event"
+	SignatureHeader         = "X-Gitea-Signature"
+	ForgejoEventTypeHeader  = "X-Forgejo-Event"
+	ForgejoSignatureHeader  = "X-Forgejo-Signature"
+	PullRequestEventType    = "pull_request"
+	IssueCommentEventType   = "issue_comment"
+	PullRequestActionOpened = "opened"
+	PullRequestActionReopen = "reopened"
+	PullRequestActionSync   = "synchronized"
+)
+
+// webhookEvents is the list of events EasyCLA subscribes to
+var webhookEvents = []string{PullRequestEventType, IssueCommentEventType}
+
+// PullRequestEvent is the pull_request webhook payload
+type PullRequestEvent struct {
+	Action      string       `json:"action"`
+	Number      int64        `json:"number"`
+	PullRequest *PullRequest `json:"pull_request"`
+	Repository  *Repository  `json:"repository"`
+	Sender      *User        `json:"sender"`
+}
+
+// IssueCommentEvent is the issue_comment webhook payload, IsPull is set for pull request comments
+type IssueCommentEvent struct {
+	Action string `json:"action"`
+	Issue  *struct {
+		Index int64 `json:"number"`
+	} `json:"issue"`
+	Comment    *Comment    `json:"comment"`
+	Repository *Repository `json:"repository"`
+	Sender     *User       `json:"sender"`
+	IsPull     bool        `json:"is_pull"`
+}
+
+// SetWebHook is responsible for adding the webhook for the given repository, if the webhook is there already
+// it is updated with the expected events and secret, should be idempotent operation
+func SetWebHook(ctx context.Context, client *Client, hookURL, owner, repositoryName, secret string) error {
+	existingWebHook, err := findExistingWebHook(ctx, client, hookURL, owner, repositoryName)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"type":   "gitea",
+		"active": true,
+		"events": webhookEvents,
+		"config": map[string]string{
+			"url":          hookURL,
+			"content_type": "json",
+			"secret":       secret,
+		},
+	}
+
+	if existingWebHook == nil {
+		err = client.do(ctx, http.MethodPost, repositoryPath(owner, repositoryName, "/hooks"), body, nil)
+		if err != nil {
+			return fmt.Errorf("adding web hook for repository : %s/%s, failed : %v", owner, repositoryName, err)
+		}
+		return nil
+	}
+
+	// the secret is not returned by the API, always refresh the hook
+	delete(body, "type")
+	err = client.do(ctx, http.MethodPatch, repositoryPath(owner, repositoryName, fmt.Sprintf("/hooks/%d", existingWebHook.ID)), body, nil)
+	if err != nil {
+		return fmt.Errorf("editing web hook for repository : %s/%s, failed : %v", owner, repositoryName, err)
+	}
+
+	return nil
+}
+
+// RemoveWebHook removes existing webhook from the given repository
+func RemoveWebHook(ctx context.Context, client *Client, hookURL, owner, repositoryName string) error {
+	existingWebHook, err := findExistingWebHook(ctx, client, hookURL, owner, repositoryName)
+	if err != nil {
+		return err
+	}
+
+	if existingWebHook == nil {
+		return nil
+	}
+
+	return client.do(ctx, http.MethodDelete, repositoryPath(owner, repositoryName, fmt.Sprintf("/hooks/%d", existingWebHook.ID)), nil, nil)
+}
+
+func findExistingWebHook(ctx context.Context, client *Client, hookURL, owner, repositoryName string) (*Hook, error) {
+	var hooks []*Hook
+	err := client.do(ctx, http.MethodGet, repositoryPath(owner, repositoryName, "/hooks"), nil, &hooks)
+	if err != nil {
+		return nil, fmt.Errorf("fetching hooks for repository : %s/%s, failed : %v", owner, repositoryName, err)
+	}
+
+	for _, hook := range hooks {
+		if hook.Config["url"] == hookURL {
+			return hook, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// EventType returns the webhook event type from the request headers
+func EventType(header http.Header) string {
+	if eventType := header.Get(EventTypeHeader); eventType != "" {
+		return eventType
+	}
+	return header.Get(ForgejoEventTypeHeader)
+}
+
+// ValidateWebhookSignature checks the hex encoded HMAC-SHA256 signature of the webhook payload
+func ValidateWebhookSignature(header http.Header, payload []byte, secret string) error {
+	signature := header.Get(SignatureHeader)
+	if signature == "" {
+		signature = header.Get(ForgejoSignatureHeader)
+	}
+	if signature == "" {
+		return errors.New("missing gitea webhook signature")
+	}
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return fmt.Errorf("invalid gitea webhook signature : %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload) // nolint
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return errors.New("gitea webhook signature mismatch")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package branch_protection
+
+import "fmt"
+
+const (
+	// DriftProtectionRemoved is reported when the branch protection rule no longer exists
+	DriftProtectionRemoved = "branch protection removed"
+	// DriftStatusChecksNotRequired is reported when the rule no longer requires the status checks to pass
+	DriftStatusChecksNotRequired = "status checks not required"
+	// DriftAdminEnforcementDisabled is reported when the administrators are no longer subject to the rule
+	DriftAdminEnforcementDisabled = "admin enforcement disabled"
+)
+
+// ProtectionDrift compares the branch protection rule with the protection set up by EnableBranchProtection and returns
+// the differences, an empty result means the rule still enforces the required status checks. A nil rule means the
+// branch is no longer protected.
+func ProtectionDrift(protection *BranchProtectionRule, enforceAdmin bool, requiredStatusChecks []string) []string {
+	if protection == nil {
+		return []string{DriftProtectionRemoved}
+	}
+
+	var drift []string
+	if !protection.RequiresStatusChecks {
+		drift = append(drift, DriftStatusChecksNotRequired)
+	}
+
+	for _, check := range requiredStatusChecks {
+		found := false
+		for _, c := range protection.RequiredStatusCheckContexts {
+			if c == check {
+				found = true
+				break
+			}
+		}
+		if !found {
+			drift = append(drift, fmt.Sprintf("%s status check not required", check))
+		}
+	}
+
+	if enforceAdmin && !protection.IsAdminEnforced {
+		drift = append(drift, DriftAdminEnforcementDisabled)
+	}
+
+	return drift
+}
@@ -0,0 +1,70 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package branch_protection
+
+import (
+	"testing"
+
+	"github.com/bmizerany/assert"
+)
+
+func TestProtectionDrift(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Protection    *BranchProtectionRule
+		EnforceAdmin  bool
+		ExpectedDrift []string
+	}{
+		{
+			Name: "protection in place",
+			Protection: &BranchProtectionRule{
+				Pattern:                     "**/**",
+				RequiredStatusCheckContexts: []string{"travis-ci", "EasyCLA"},
+				RequiresStatusChecks:        true,
+				IsAdminEnforced:             true,
+			},
+			EnforceAdmin: true,
+		},
+		{
+			Name:          "protection removed",
+			EnforceAdmin:  true,
+			ExpectedDrift: []string{DriftProtectionRemoved},
+		},
+		{
+			Name: "check removed",
+			Protection: &BranchProtectionRule{
+				Pattern:                     "**/**",
+				RequiredStatusCheckContexts: []string{"travis-ci"},
+				RequiresStatusChecks:        true,
+				IsAdminEnforced:             true,
+			},
+			EnforceAdmin:  true,
+			ExpectedDrift: []string{"EasyCLA status check not required"},
+		},
+		{
+			Name: "status checks and admin enforcement disabled",
+			Protection: &BranchProtectionRule{
+				Pattern:                     "**/**",
+				RequiredStatusCheckContexts: []string{"EasyCLA"},
+			},
+			EnforceAdmin:  true,
+			ExpectedDrift: []string{DriftStatusChecksNotRequired, DriftAdminEnforcementDisabled},
+		},
+		{
+			Name: "admin enforcement not expected",
+			Protection: &BranchProtectionRule{
+				Pattern:                     "**/**",
+				RequiredStatusCheckContexts: []string{"EasyCLA"},
+				RequiresStatusChecks:        true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			drift := ProtectionDrift(tc.Protection, tc.EnforceAdmin, []string{"EasyCLA"})
+			assert.Equal(tt, tc.ExpectedDrift, drift)
+		})
+	}
+}
@@ -25,8 +25,18 @@ type V4BranchProtectionRepository interface {
 	GetRepositoryIDFromName(ctx context.Context, repositoryOwner, repositoryName string) (string, error)
 }
 
-// CombinedRepository is combination of V3Repositories and V4BranchProtectionRepository
+// V3RulesetsRepository has v3 repository rulesets functionality
+type V3RulesetsRepository interface {
+	GetRulesForBranch(ctx context.Context, owner, repo, branch string, opts *github.ListOptions) ([]*BranchRule, *github.Response, error)
+	ListRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *github.ListOptions) ([]*Ruleset, *github.Response, error)
+	GetRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Ruleset, *github.Response, error)
+	CreateRuleset(ctx context.Context, owner, repo string, ruleset *Ruleset) (*Ruleset, *github.Response, error)
+	UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, ruleset *Ruleset) (*Ruleset, *github.Response, error)
+}
+
+// CombinedRepository is combination of V3Repositories, V3RulesetsRepository and V4BranchProtectionRepository
 type CombinedRepository interface {
 	V3Repositories
+	V3RulesetsRepository
 	V4BranchProtectionRepository
 }
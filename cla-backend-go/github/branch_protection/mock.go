@@ -55,6 +55,22 @@ func (mr *MockCombinedRepositoryMockRecorder) CreateBranchProtection(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBranchProtection", reflect.TypeOf((*MockCombinedRepository)(nil).CreateBranchProtection), arg0, arg1)
 }
 
+// CreateRuleset mocks base method
+func (m *MockCombinedRepository) CreateRuleset(arg0 context.Context, arg1, arg2 string, arg3 *Ruleset) (*Ruleset, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRuleset", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*Ruleset)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRuleset indicates an expected call of CreateRuleset
+func (mr *MockCombinedRepositoryMockRecorder) CreateRuleset(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleset", reflect.TypeOf((*MockCombinedRepository)(nil).CreateRuleset), arg0, arg1, arg2, arg3)
+}
+
 // Get mocks base method
 func (m *MockCombinedRepository) Get(arg0 context.Context, arg1, arg2 string) (*github.Repository, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +117,38 @@ func (mr *MockCombinedRepositoryMockRecorder) GetRepositoryIDFromName(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryIDFromName", reflect.TypeOf((*MockCombinedRepository)(nil).GetRepositoryIDFromName), arg0, arg1, arg2)
 }
 
+// GetRulesForBranch mocks base method
+func (m *MockCombinedRepository) GetRulesForBranch(arg0 context.Context, arg1, arg2, arg3 string, arg4 *github.ListOptions) ([]*BranchRule, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRulesForBranch", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*BranchRule)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRulesForBranch indicates an expected call of GetRulesForBranch
+func (mr *MockCombinedRepositoryMockRecorder) GetRulesForBranch(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulesForBranch", reflect.TypeOf((*MockCombinedRepository)(nil).GetRulesForBranch), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetRuleset mocks base method
+func (m *MockCombinedRepository) GetRuleset(arg0 context.Context, arg1, arg2 string, arg3 int64) (*Ruleset, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleset", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*Ruleset)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRuleset indicates an expected call of GetRuleset
+func (mr *MockCombinedRepositoryMockRecorder) GetRuleset(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleset", reflect.TypeOf((*MockCombinedRepository)(nil).GetRuleset), arg0, arg1, arg2, arg3)
+}
+
 // ListByOrg mocks base method
 func (m *MockCombinedRepository) ListByOrg(arg0 context.Context, arg1 string, arg2 *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +165,22 @@ func (mr *MockCombinedRepositoryMockRecorder) ListByOrg(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrg", reflect.TypeOf((*MockCombinedRepository)(nil).ListByOrg), arg0, arg1, arg2)
 }
 
+// ListRulesets mocks base method
+func (m *MockCombinedRepository) ListRulesets(arg0 context.Context, arg1, arg2 string, arg3 bool, arg4 *github.ListOptions) ([]*Ruleset, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRulesets", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*Ruleset)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRulesets indicates an expected call of ListRulesets
+func (mr *MockCombinedRepositoryMockRecorder) ListRulesets(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesets", reflect.TypeOf((*MockCombinedRepository)(nil).ListRulesets), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UpdateBranchProtection mocks base method
 func (m *MockCombinedRepository) UpdateBranchProtection(arg0 context.Context, arg1 *githubv4.UpdateBranchProtectionRuleInput) (*UpdateRepoBranchProtectionMutation, error) {
 	m.ctrl.T.Helper()
@@ -131,3 +195,19 @@ func (mr *MockCombinedRepositoryMockRecorder) UpdateBranchProtection(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBranchProtection", reflect.TypeOf((*MockCombinedRepository)(nil).UpdateBranchProtection), arg0, arg1)
 }
+
+// UpdateRuleset mocks base method
+func (m *MockCombinedRepository) UpdateRuleset(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4 *Ruleset) (*Ruleset, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRuleset", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*Ruleset)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateRuleset indicates an expected call of UpdateRuleset
+func (mr *MockCombinedRepositoryMockRecorder) UpdateRuleset(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRuleset", reflect.TypeOf((*MockCombinedRepository)(nil).UpdateRuleset), arg0, arg1, arg2, arg3, arg4)
+}
@@ -29,12 +29,14 @@ var (
 
 type combinedRepositoryProvider struct {
 	V3Repositories
+	V3RulesetsRepository
 	V4BranchProtectionRepository
 }
 
 type branchProtectionRepositoryConfig struct {
 	enableBlockingLimiter    bool
 	enableNonBlockingLimiter bool
+	baseURL                  *string
 }
 
 // BranchProtectionRepositoryOption enables optional parameters to BranchProtectionRepository
@@ -54,6 +56,14 @@ func EnableNonBlockingLimiter() BranchProtectionRepositoryOption {
 	}
 }
 
+// WithGitHubBaseURL sets the base URL of the GitHub host of the organization, empty for github.com, so the clients are
+// created for the installation on that host
+func WithGitHubBaseURL(baseURL string) BranchProtectionRepositoryOption {
+	return func(config *branchProtectionRepositoryConfig) {
+		config.baseURL = &baseURL
+	}
+}
+
 // BranchProtectionRepository contains helper methods interacting with github api related to branch protection
 type BranchProtectionRepository struct {
 	combinedRepo CombinedRepository
@@ -61,18 +71,28 @@ type BranchProtectionRepository struct {
 
 // NewBranchProtectionRepository creates a new BranchProtectionRepository
 func NewBranchProtectionRepository(installationID int64, opts ...BranchProtectionRepositoryOption) (*BranchProtectionRepository, error) {
-	v4BranchProtectionRepo, err := NewBranchProtectionRepositoryV4(installationID)
+	config := &branchProtectionRepositoryConfig{}
+	for _, o := range opts {
+		o(config)
+	}
+	ctx := context.Background()
+	if config.baseURL != nil {
+		ctx = github.WithBaseURL(ctx, *config.baseURL)
+	}
+
+	v4BranchProtectionRepo, err := newBranchProtectionRepositoryV4(ctx, installationID)
 	if err != nil {
 		return nil, fmt.Errorf("initializing v4 github client failed : %v", err)
 	}
 
-	v3Client, err := github.NewGithubAppClient(installationID)
+	v3Client, err := github.NewGithubAppClient(ctx, installationID)
 	if err != nil {
 		return nil, fmt.Errorf("initializing v3 github client failed : %v", err)
 	}
 
 	combinedRepo := combinedRepositoryProvider{
 		V3Repositories:               v3Client.Repositories,
+		V3RulesetsRepository:         NewRulesetsV3(v3Client),
 		V4BranchProtectionRepository: v4BranchProtectionRepo,
 	}
 
@@ -57,6 +57,26 @@ func (b blockingRateLimitRepositories) UpdateBranchProtection(ctx context.Contex
 	blockingRateLimit.Take()
 	return b.CombinedRepository.UpdateBranchProtection(ctx, input)
 }
+func (b blockingRateLimitRepositories) GetRulesForBranch(ctx context.Context, owner, repo, branch string, opts *githubpkg.ListOptions) ([]*BranchRule, *githubpkg.Response, error) {
+	blockingRateLimit.Take()
+	return b.CombinedRepository.GetRulesForBranch(ctx, owner, repo, branch, opts)
+}
+func (b blockingRateLimitRepositories) ListRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *githubpkg.ListOptions) ([]*Ruleset, *githubpkg.Response, error) {
+	blockingRateLimit.Take()
+	return b.CombinedRepository.ListRulesets(ctx, owner, repo, includesParents, opts)
+}
+func (b blockingRateLimitRepositories) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Ruleset, *githubpkg.Response, error) {
+	blockingRateLimit.Take()
+	return b.CombinedRepository.GetRuleset(ctx, owner, repo, rulesetID)
+}
+func (b blockingRateLimitRepositories) CreateRuleset(ctx context.Context, owner, repo string, ruleset *Ruleset) (*Ruleset, *githubpkg.Response, error) {
+	blockingRateLimit.Take()
+	return b.CombinedRepository.CreateRuleset(ctx, owner, repo, ruleset)
+}
+func (b blockingRateLimitRepositories) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, ruleset *Ruleset) (*Ruleset, *githubpkg.Response, error) {
+	blockingRateLimit.Take()
+	return b.CombinedRepository.UpdateRuleset(ctx, owner, repo, rulesetID, ruleset)
+}
 func (b blockingRateLimitRepositories) GetRepositoryIDFromName(ctx context.Context, repositoryOwner, repositoryName string) (string, error) {
 	blockingRateLimit.Take()
 	return b.CombinedRepository.GetRepositoryIDFromName(ctx, repositoryOwner, repositoryName)
@@ -112,3 +132,38 @@ func (nb nonBlockingRateLimitRepositories) GetRepositoryIDFromName(ctx context.C
 	}
 	return "", fmt.Errorf("too many requests : %w", github.ErrRateLimited)
 }
+
+func (nb nonBlockingRateLimitRepositories) GetRulesForBranch(ctx context.Context, owner, repo, branch string, opts *githubpkg.ListOptions) ([]*BranchRule, *githubpkg.Response, error) {
+	if nonBlockingRateLimit.Allow() {
+		return nb.CombinedRepository.GetRulesForBranch(ctx, owner, repo, branch, opts)
+	}
+	return nil, nil, fmt.Errorf("too many requests : %w", github.ErrRateLimited)
+}
+
+func (nb nonBlockingRateLimitRepositories) ListRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *githubpkg.ListOptions) ([]*Ruleset, *githubpkg.Response, error) {
+	if nonBlockingRateLimit.Allow() {
+		return nb.CombinedRepository.ListRulesets(ctx, owner, repo, includesParents, opts)
+	}
+	return nil, nil, fmt.Errorf("too many requests : %w", github.ErrRateLimited)
+}
+
+func (nb nonBlockingRateLimitRepositories) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Ruleset, *githubpkg.Response, error) {
+	if nonBlockingRateLimit.Allow() {
+		return nb.CombinedRepository.GetRuleset(ctx, owner, repo, rulesetID)
+	}
+	return nil, nil, fmt.Errorf("too many requests : %w", github.ErrRateLimited)
+}
+
+func (nb nonBlockingRateLimitRepositories) CreateRuleset(ctx context.Context, owner, repo string, ruleset *Ruleset) (*Ruleset, *githubpkg.Response, error) {
+	if nonBlockingRateLimit.Allow() {
+		return nb.CombinedRepository.CreateRuleset(ctx, owner, repo, ruleset)
+	}
+	return nil, nil, fmt.Errorf("too many requests : %w", github.ErrRateLimited)
+}
+
+func (nb nonBlockingRateLimitRepositories) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, ruleset *Ruleset) (*Ruleset, *githubpkg.Response, error) {
+	if nonBlockingRateLimit.Allow() {
+		return nb.CombinedRepository.UpdateRuleset(ctx, owner, repo, rulesetID, ruleset)
+	}
+	return nil, nil, fmt.Errorf("too many requests : %w", github.ErrRateLimited)
+}
@@ -83,7 +83,13 @@ type BranchProtectionRepositoryV4 struct {
 
 // NewBranchProtectionRepositoryV4 creates a new BranchProtectionRepositoryV4
 func NewBranchProtectionRepositoryV4(installationID int64) (*BranchProtectionRepositoryV4, error) {
-	client, clientErr := github.NewGithubV4AppClient(installationID)
+	return newBranchProtectionRepositoryV4(context.Background(), installationID)
+}
+
+// newBranchProtectionRepositoryV4 creates a new BranchProtectionRepositoryV4 for the installation on the GitHub host of
+// the context
+func newBranchProtectionRepositoryV4(ctx context.Context, installationID int64) (*BranchProtectionRepositoryV4, error) {
+	client, clientErr := github.NewGithubV4AppClient(ctx, installationID)
 	if clientErr != nil {
 		return nil, clientErr
 	}
@@ -0,0 +1,471 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package branch_protection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	githubpkg "github.com/google/go-github/v37/github"
+)
+
+const (
+	// EasyCLARulesetName is the name of the repository ruleset created and updated by EasyCLA
+	EasyCLARulesetName = "EasyCLA"
+
+	// RulesetSourceRepository is the source type of the rulesets defined on the repository, the other rulesets are
+	// inherited from the organization
+	RulesetSourceRepository = "Repository"
+
+	rulesetTargetBranch          = "branch"
+	rulesetEnforcementActive     = "active"
+	ruleTypeRequiredStatusChecks = "required_status_checks"
+	refHeadsPrefix               = "refs/heads/"
+
+	// repositoryAdminRoleID is the id of the repository admin role in the ruleset bypass actors
+	repositoryAdminRoleID    = int64(5)
+	bypassActorRepoRole      = "RepositoryRole"
+	bypassModeAlways         = "always"
+	rulesetsPageSize         = 100
+	rulesetsAPIVersionHeader = "X-GitHub-Api-Version"
+	rulesetsAPIVersion       = "2022-11-28"
+)
+
+// Ruleset is the data structure that's used to reflect the remote github repository or organization ruleset
+type Ruleset struct {
+	ID           int64                 `json:"id,omitempty"`
+	Name         string                `json:"name"`
+	Target       string                `json:"target,omitempty"`
+	SourceType   string                `json:"source_type,omitempty"`
+	Source       string                `json:"source,omitempty"`
+	Enforcement  string                `json:"enforcement"`
+	BypassActors []*RulesetBypassActor `json:"bypass_actors"`
+	Conditions   *RulesetConditions    `json:"conditions,omitempty"`
+	Rules        []*RulesetRule        `json:"rules"`
+}
+
+// RulesetBypassActor is an actor allowed to bypass the rules of the ruleset
+type RulesetBypassActor struct {
+	ActorID    int64  `json:"actor_id"`
+	ActorType  string `json:"actor_type"`
+	BypassMode string `json:"bypass_mode"`
+}
+
+// RulesetConditions holds the branches the ruleset applies to
+type RulesetConditions struct {
+	RefName *RulesetRefNameCondition `json:"ref_name,omitempty"`
+}
+
+// RulesetRefNameCondition lists the included and excluded ref patterns of the ruleset
+type RulesetRefNameCondition struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetRule is a rule of the ruleset, the parameters depend on the rule type and are kept as they are
+type RulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// BranchRule is an active rule applying to a branch, as returned by the branch rules API
+type BranchRule struct {
+	Type              string          `json:"type"`
+	Parameters        json.RawMessage `json:"parameters,omitempty"`
+	RulesetSourceType string          `json:"ruleset_source_type"`
+	RulesetSource     string          `json:"ruleset_source"`
+	RulesetID         int64           `json:"ruleset_id"`
+}
+
+// RequiredStatusChecksParameters are the parameters of the required_status_checks rule
+type RequiredStatusChecksParameters struct {
+	RequiredStatusChecks             []*RulesetStatusCheck `json:"required_status_checks"`
+	StrictRequiredStatusChecksPolicy bool                  `json:"strict_required_status_checks_policy"`
+	DoNotEnforceOnCreate             bool                  `json:"do_not_enforce_on_create,omitempty"`
+}
+
+// RulesetStatusCheck is a status check required by the ruleset
+type RulesetStatusCheck struct {
+	Context       string `json:"context"`
+	IntegrationID *int64 `json:"integration_id,omitempty"`
+}
+
+// BranchRuleset summarizes an active ruleset applying to a branch with its required status checks
+type BranchRuleset struct {
+	ID                   int64
+	Name                 string
+	SourceType           string
+	Source               string
+	RequiredStatusChecks []string
+}
+
+// RulesetsV3 wraps a v3 github client for the rulesets API, which the go-github release in use doesn't cover
+type RulesetsV3 struct {
+	client *githubpkg.Client
+}
+
+// NewRulesetsV3 creates a new RulesetsV3
+func NewRulesetsV3(client *githubpkg.Client) *RulesetsV3 {
+	return &RulesetsV3{
+		client: client,
+	}
+}
+
+// GetRulesForBranch returns the active rules applying to the branch, including the rules of the organization rulesets
+func (r *RulesetsV3) GetRulesForBranch(ctx context.Context, owner, repo, branch string, opts *githubpkg.ListOptions) ([]*BranchRule, *githubpkg.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/rules/branches/%s%s", owner, repo, url.PathEscape(branch), listQuery(opts, false))
+	var rules []*BranchRule
+	resp, err := r.do(ctx, http.MethodGet, u, nil, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rules, resp, nil
+}
+
+// ListRulesets returns the rulesets of the repository, with the organization rulesets when includesParents is set
+func (r *RulesetsV3) ListRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *githubpkg.ListOptions) ([]*Ruleset, *githubpkg.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/rulesets%s", owner, repo, listQuery(opts, includesParents))
+	var rulesets []*Ruleset
+	resp, err := r.do(ctx, http.MethodGet, u, nil, &rulesets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rulesets, resp, nil
+}
+
+// GetRuleset returns the repository ruleset with its conditions and rules
+func (r *RulesetsV3) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Ruleset, *githubpkg.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repo, rulesetID)
+	ruleset := &Ruleset{}
+	resp, err := r.do(ctx, http.MethodGet, u, nil, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ruleset, resp, nil
+}
+
+// CreateRuleset creates the repository ruleset
+func (r *RulesetsV3) CreateRuleset(ctx context.Context, owner, repo string, ruleset *Ruleset) (*Ruleset, *githubpkg.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/rulesets", owner, repo)
+	created := &Ruleset{}
+	resp, err := r.do(ctx, http.MethodPost, u, ruleset, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateRuleset replaces the repository ruleset
+func (r *RulesetsV3) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, ruleset *Ruleset) (*Ruleset, *githubpkg.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repo, rulesetID)
+	updated := &Ruleset{}
+	resp, err := r.do(ctx, http.MethodPut, u, ruleset, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+func (r *RulesetsV3) do(ctx context.Context, method, u string, body, v interface{}) (*githubpkg.Response, error) {
+	req, err := r.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(rulesetsAPIVersionHeader, rulesetsAPIVersion)
+	return r.client.Do(ctx, req, v)
+}
+
+// listQuery returns the query string of the paginated rulesets requests
+func listQuery(opts *githubpkg.ListOptions, includesParents bool) string {
+	values := url.Values{}
+	if opts != nil && opts.PerPage > 0 {
+		values.Set("per_page", fmt.Sprintf("%d", opts.PerPage))
+	}
+	if opts != nil && opts.Page > 0 {
+		values.Set("page", fmt.Sprintf("%d", opts.Page))
+	}
+	if includesParents {
+		values.Set("includes_parents", "true")
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// GetBranchRulesets returns the active rulesets applying to the branch with their required status checks, the
+// rulesets of the organization included
+func (bp *BranchProtectionRepository) GetBranchRulesets(ctx context.Context, owner, repoName, branchName string) ([]*BranchRuleset, error) {
+	repoName = CleanGithubRepoName(repoName)
+
+	var rules []*BranchRule
+	listOpt := &githubpkg.ListOptions{PerPage: rulesetsPageSize}
+	for {
+		page, resp, err := bp.combinedRepo.GetRulesForBranch(ctx, owner, repoName, branchName, listOpt)
+		if err != nil {
+			if ok, wErr := github.CheckAndWrapForKnownErrors(resp, err); ok {
+				return nil, wErr
+			}
+			return nil, fmt.Errorf("fetching the rules of branch : %s for owner : %s and repo : %s failed : %w", branchName, owner, repoName, err)
+		}
+		rules = append(rules, page...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		listOpt.Page = resp.NextPage
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// the branch rules only reference the rulesets, the names are listed with the rulesets
+	var rulesets []*Ruleset
+	listOpt = &githubpkg.ListOptions{PerPage: rulesetsPageSize}
+	for {
+		page, resp, err := bp.combinedRepo.ListRulesets(ctx, owner, repoName, true, listOpt)
+		if err != nil {
+			if ok, wErr := github.CheckAndWrapForKnownErrors(resp, err); ok {
+				return nil, wErr
+			}
+			return nil, fmt.Errorf("listing the rulesets for owner : %s and repo : %s failed : %w", owner, repoName, err)
+		}
+		rulesets = append(rulesets, page...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		listOpt.Page = resp.NextPage
+	}
+
+	return branchRulesets(rules, rulesets), nil
+}
+
+// EnableRulesetProtection creates or updates the EasyCLA repository ruleset so the branch requires the given status
+// checks. The other rules, branches and status checks of the ruleset are preserved.
+func (bp *BranchProtectionRepository) EnableRulesetProtection(ctx context.Context, owner, repoName, branchName string, enforceAdmin bool, enableStatusChecks, disableStatusChecks []string) error {
+	repoName = CleanGithubRepoName(repoName)
+
+	var current *Ruleset
+	listOpt := &githubpkg.ListOptions{PerPage: rulesetsPageSize}
+	for current == nil {
+		rulesets, resp, err := bp.combinedRepo.ListRulesets(ctx, owner, repoName, false, listOpt)
+		if err != nil {
+			if ok, wErr := github.CheckAndWrapForKnownErrors(resp, err); ok {
+				return wErr
+			}
+			return fmt.Errorf("listing the rulesets for owner : %s and repo : %s failed : %w", owner, repoName, err)
+		}
+		for _, ruleset := range rulesets {
+			if ruleset.Name == EasyCLARulesetName && ruleset.SourceType == RulesetSourceRepository {
+				current = ruleset
+				break
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		listOpt.Page = resp.NextPage
+	}
+
+	if current == nil {
+		if len(enableStatusChecks) == 0 {
+			log.Debugf("EnableRulesetProtection : no ruleset %s for owner : %s and repo : %s and no check to enable", EasyCLARulesetName, owner, repoName)
+			return nil
+		}
+		ruleset, err := prepareRuleset(nil, branchName, enforceAdmin, enableStatusChecks, disableStatusChecks)
+		if err != nil {
+			return err
+		}
+		_, resp, err := bp.combinedRepo.CreateRuleset(ctx, owner, repoName, ruleset)
+		if err != nil {
+			if ok, wErr := github.CheckAndWrapForKnownErrors(resp, err); ok {
+				return wErr
+			}
+			return fmt.Errorf("creating the ruleset for owner : %s and repo : %s failed : %v", owner, repoName, err)
+		}
+		return nil
+	}
+
+	// the listed rulesets have no conditions and rules
+	existing, resp, err := bp.combinedRepo.GetRuleset(ctx, owner, repoName, current.ID)
+	if err != nil {
+		if ok, wErr := github.CheckAndWrapForKnownErrors(resp, err); ok {
+			return wErr
+		}
+		return fmt.Errorf("fetching the ruleset : %d for owner : %s and repo : %s failed : %v", current.ID, owner, repoName, err)
+	}
+	ruleset, err := prepareRuleset(existing, branchName, enforceAdmin, enableStatusChecks, disableStatusChecks)
+	if err != nil {
+		return err
+	}
+	_, resp, err = bp.combinedRepo.UpdateRuleset(ctx, owner, repoName, current.ID, ruleset)
+	if err != nil {
+		if ok, wErr := github.CheckAndWrapForKnownErrors(resp, err); ok {
+			return wErr
+		}
+		return fmt.Errorf("updating the ruleset : %d for owner : %s and repo : %s failed : %v", current.ID, owner, repoName, err)
+	}
+
+	return nil
+}
+
+// RequiresStatusCheck reports whether any of the rulesets requires the status check
+func RequiresStatusCheck(rulesets []*BranchRuleset, statusCheck string) bool {
+	for _, ruleset := range rulesets {
+		for _, check := range ruleset.RequiredStatusChecks {
+			if check == statusCheck {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// branchRulesets groups the branch rules per ruleset, in the order of the rules, named after the listed rulesets
+func branchRulesets(rules []*BranchRule, rulesets []*Ruleset) []*BranchRuleset {
+	names := map[int64]string{}
+	for _, ruleset := range rulesets {
+		names[ruleset.ID] = ruleset.Name
+	}
+
+	var result []*BranchRuleset
+	byID := map[int64]*BranchRuleset{}
+	for _, rule := range rules {
+		branchRuleset, ok := byID[rule.RulesetID]
+		if !ok {
+			branchRuleset = &BranchRuleset{
+				ID:                   rule.RulesetID,
+				Name:                 names[rule.RulesetID],
+				SourceType:           rule.RulesetSourceType,
+				Source:               rule.RulesetSource,
+				RequiredStatusChecks: []string{},
+			}
+			byID[rule.RulesetID] = branchRuleset
+			result = append(result, branchRuleset)
+		}
+		if rule.Type != ruleTypeRequiredStatusChecks {
+			continue
+		}
+
+		params := RequiredStatusChecksParameters{}
+		if err := json.Unmarshal(rule.Parameters, &params); err != nil {
+			log.Warnf("branchRulesets : unable to decode the required status checks of ruleset : %d : %v", rule.RulesetID, err)
+			continue
+		}
+		for _, check := range params.RequiredStatusChecks {
+			branchRuleset.RequiredStatusChecks = append(branchRuleset.RequiredStatusChecks, check.Context)
+		}
+	}
+
+	return result
+}
+
+// prepareRuleset returns the EasyCLA ruleset to create, or the existing one to update, requiring the status checks on
+// the branch - the logic is pulled out so we can unit test it without mocking the connections
+func prepareRuleset(current *Ruleset, branchName string, enforceAdmin bool, enableStatusChecks, disableStatusChecks []string) (*Ruleset, error) {
+	ruleset := &Ruleset{
+		Name:         EasyCLARulesetName,
+		Target:       rulesetTargetBranch,
+		Enforcement:  rulesetEnforcementActive,
+		BypassActors: []*RulesetBypassActor{},
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefNameCondition{Include: []string{}, Exclude: []string{}},
+		},
+		Rules: []*RulesetRule{},
+	}
+	if current != nil {
+		ruleset.Name = current.Name
+		ruleset.Enforcement = current.Enforcement
+		for _, actor := range current.BypassActors {
+			if actor.ActorType == bypassActorRepoRole && actor.ActorID == repositoryAdminRoleID {
+				continue
+			}
+			ruleset.BypassActors = append(ruleset.BypassActors, actor)
+		}
+		if current.Conditions != nil && current.Conditions.RefName != nil {
+			ruleset.Conditions.RefName.Include = append(ruleset.Conditions.RefName.Include, current.Conditions.RefName.Include...)
+			ruleset.Conditions.RefName.Exclude = append(ruleset.Conditions.RefName.Exclude, current.Conditions.RefName.Exclude...)
+		}
+		ruleset.Rules = append(ruleset.Rules, current.Rules...)
+	}
+
+	// the admins bypass the ruleset unless it's enforced for them too
+	if !enforceAdmin {
+		ruleset.BypassActors = append(ruleset.BypassActors, &RulesetBypassActor{
+			ActorID:    repositoryAdminRoleID,
+			ActorType:  bypassActorRepoRole,
+			BypassMode: bypassModeAlways,
+		})
+	}
+
+	branchRef := refHeadsPrefix + branchName
+	included := false
+	for _, ref := range ruleset.Conditions.RefName.Include {
+		if ref == branchRef {
+			included = true
+			break
+		}
+	}
+	if !included {
+		ruleset.Conditions.RefName.Include = append(ruleset.Conditions.RefName.Include, branchRef)
+	}
+
+	var statusChecksRule *RulesetRule
+	params := RequiredStatusChecksParameters{}
+	for _, rule := range ruleset.Rules {
+		if rule.Type == ruleTypeRequiredStatusChecks {
+			statusChecksRule = rule
+			if err := json.Unmarshal(rule.Parameters, &params); err != nil {
+				return nil, fmt.Errorf("decoding the required status checks of ruleset : %s failed : %v", ruleset.Name, err)
+			}
+			break
+		}
+	}
+
+	var currentChecks []string
+	integrationIDs := map[string]*int64{}
+	for _, check := range params.RequiredStatusChecks {
+		currentChecks = append(currentChecks, check.Context)
+		integrationIDs[check.Context] = check.IntegrationID
+	}
+	params.RequiredStatusChecks = []*RulesetStatusCheck{}
+	for _, check := range mergeStatusChecks(currentChecks, enableStatusChecks, disableStatusChecks) {
+		params.RequiredStatusChecks = append(params.RequiredStatusChecks, &RulesetStatusCheck{
+			Context:       check,
+			IntegrationID: integrationIDs[check],
+		})
+	}
+
+	// github doesn't accept a required_status_checks rule without checks
+	if len(params.RequiredStatusChecks) == 0 {
+		rules := []*RulesetRule{}
+		for _, rule := range ruleset.Rules {
+			if rule != statusChecksRule {
+				rules = append(rules, rule)
+			}
+		}
+		ruleset.Rules = rules
+		return ruleset, nil
+	}
+
+	parameters, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	if statusChecksRule == nil {
+		ruleset.Rules = append(ruleset.Rules, &RulesetRule{Type: ruleTypeRequiredStatusChecks, Parameters: parameters})
+		return ruleset, nil
+	}
+	for i, rule := range ruleset.Rules {
+		if rule == statusChecksRule {
+			ruleset.Rules[i] = &RulesetRule{Type: ruleTypeRequiredStatusChecks, Parameters: parameters}
+		}
+	}
+
+	return ruleset, nil
+}
@@ -0,0 +1,172 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package branch_protection
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bmizerany/assert"
+	"github.com/golang/mock/gomock"
+)
+
+func statusChecksRule(t *testing.T, checks ...string) *RulesetRule {
+	params := RequiredStatusChecksParameters{RequiredStatusChecks: []*RulesetStatusCheck{}}
+	for _, check := range checks {
+		params.RequiredStatusChecks = append(params.RequiredStatusChecks, &RulesetStatusCheck{Context: check})
+	}
+	parameters, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("encoding the required status checks failed : %v", err)
+	}
+	return &RulesetRule{Type: ruleTypeRequiredStatusChecks, Parameters: parameters}
+}
+
+func rulesetChecks(t *testing.T, ruleset *Ruleset) []string {
+	checks := []string{}
+	for _, rule := range ruleset.Rules {
+		if rule.Type != ruleTypeRequiredStatusChecks {
+			continue
+		}
+		params := RequiredStatusChecksParameters{}
+		if err := json.Unmarshal(rule.Parameters, &params); err != nil {
+			t.Fatalf("decoding the required status checks failed : %v", err)
+		}
+		for _, check := range params.RequiredStatusChecks {
+			checks = append(checks, check.Context)
+		}
+	}
+	return checks
+}
+
+func TestPrepareRuleset(t *testing.T) {
+	branchName := DefaultBranchName
+
+	testCases := []struct {
+		Name            string
+		Current         *Ruleset
+		EnforceAdmin    bool
+		EnableContexts  []string
+		DisableContexts []string
+		ExpectedChecks  []string
+		ExpectedInclude []string
+		ExpectedBypass  int
+		ExpectedRules   int
+	}{
+		{
+			Name:            "new ruleset",
+			EnforceAdmin:    true,
+			EnableContexts:  []string{"EasyCLA"},
+			ExpectedChecks:  []string{"EasyCLA"},
+			ExpectedInclude: []string{"refs/heads/main"},
+			ExpectedRules:   1,
+		},
+		{
+			Name:            "new ruleset bypassed by the admins",
+			EnableContexts:  []string{"EasyCLA"},
+			ExpectedChecks:  []string{"EasyCLA"},
+			ExpectedInclude: []string{"refs/heads/main"},
+			ExpectedBypass:  1,
+			ExpectedRules:   1,
+		},
+		{
+			Name: "preserve existing rules and checks",
+			Current: &Ruleset{
+				ID:          42,
+				Name:        EasyCLARulesetName,
+				SourceType:  RulesetSourceRepository,
+				Enforcement: "evaluate",
+				BypassActors: []*RulesetBypassActor{
+					{ActorID: repositoryAdminRoleID, ActorType: bypassActorRepoRole, BypassMode: bypassModeAlways},
+					{ActorID: 7, ActorType: "Team", BypassMode: "pull_request"},
+				},
+				Conditions: &RulesetConditions{
+					RefName: &RulesetRefNameCondition{Include: []string{"refs/heads/release"}, Exclude: []string{}},
+				},
+				Rules: []*RulesetRule{
+					{Type: "deletion"},
+					statusChecksRule(t, "circle/ci"),
+				},
+			},
+			EnforceAdmin:    true,
+			EnableContexts:  []string{"EasyCLA"},
+			ExpectedChecks:  []string{"circle/ci", "EasyCLA"},
+			ExpectedInclude: []string{"refs/heads/release", "refs/heads/main"},
+			ExpectedBypass:  1,
+			ExpectedRules:   2,
+		},
+		{
+			Name: "disable the last check",
+			Current: &Ruleset{
+				ID:          42,
+				Name:        EasyCLARulesetName,
+				Enforcement: rulesetEnforcementActive,
+				Conditions: &RulesetConditions{
+					RefName: &RulesetRefNameCondition{Include: []string{"refs/heads/main"}, Exclude: []string{}},
+				},
+				Rules: []*RulesetRule{
+					statusChecksRule(t, "EasyCLA"),
+				},
+			},
+			EnforceAdmin:    true,
+			DisableContexts: []string{"EasyCLA"},
+			ExpectedChecks:  []string{},
+			ExpectedInclude: []string{"refs/heads/main"},
+			ExpectedRules:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			ruleset, err := prepareRuleset(tc.Current, branchName, tc.EnforceAdmin, tc.EnableContexts, tc.DisableContexts)
+			if err != nil {
+				tt.Fatalf("prepare ruleset failed : %v", err)
+			}
+			assert.Equal(tt, int64(0), ruleset.ID)
+			assert.Equal(tt, tc.ExpectedChecks, rulesetChecks(tt, ruleset))
+			assert.Equal(tt, tc.ExpectedInclude, ruleset.Conditions.RefName.Include)
+			assert.Equal(tt, tc.ExpectedBypass, len(ruleset.BypassActors))
+			assert.Equal(tt, tc.ExpectedRules, len(ruleset.Rules))
+			if tc.Current != nil {
+				assert.Equal(tt, tc.Current.Enforcement, ruleset.Enforcement)
+			}
+		})
+	}
+}
+
+func TestGetBranchRulesets(t *testing.T) {
+	owner := "johnrulesets"
+	repo := "johnsreporulesets"
+	branchName := DefaultBranchName
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rules := []*BranchRule{
+		{Type: "deletion", RulesetSourceType: "Organization", RulesetSource: owner, RulesetID: 1},
+		{Type: ruleTypeRequiredStatusChecks, RulesetSourceType: "Organization", RulesetSource: owner, RulesetID: 1, Parameters: statusChecksRule(t, "EasyCLA", "circle/ci").Parameters},
+		{Type: "non_fast_forward", RulesetSourceType: RulesetSourceRepository, RulesetSource: owner + "/" + repo, RulesetID: 2},
+	}
+	rulesets := []*Ruleset{
+		{ID: 1, Name: "org checks", SourceType: "Organization"},
+		{ID: 2, Name: "history", SourceType: RulesetSourceRepository},
+	}
+
+	m := NewMockCombinedRepository(ctrl)
+	m.EXPECT().GetRulesForBranch(gomock.Any(), owner, repo, branchName, gomock.Any()).Return(rules, nil, nil)
+	m.EXPECT().ListRulesets(gomock.Any(), owner, repo, true, gomock.Any()).Return(rulesets, nil, nil)
+
+	result, err := newBranchProtectionRepository(m).GetBranchRulesets(context.Background(), owner, repo, branchName)
+	if err != nil {
+		t.Fatalf("get branch rulesets failed : %v", err)
+	}
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "org checks", result[0].Name)
+	assert.Equal(t, []string{"EasyCLA", "circle/ci"}, result[0].RequiredStatusChecks)
+	assert.Equal(t, "history", result[1].Name)
+	assert.Equal(t, []string{}, result[1].RequiredStatusChecks)
+	assert.Equal(t, true, RequiresStatusCheck(result, "EasyCLA"))
+	assert.Equal(t, false, RequiresStatusCheck(result[1:], "EasyCLA"))
+}
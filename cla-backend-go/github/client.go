@@ -72,8 +72,21 @@ func CheckAndWrapForKnownErrors(resp *github.Response, err error) (bool, error)
 	return false, err
 }
 
-// NewGithubAppClient creates a new github client from the supplied installationID
-func NewGithubAppClient(installationID int64) (*github.Client, error) {
+// NewGithubAppClient creates a new github client from the supplied installationID, the installations of the GitHub
+// Enterprise Server organizations use the app and the API of their instance, see GetEnterpriseApp
+func NewGithubAppClient(ctx context.Context, installationID int64) (*github.Client, error) {
+	app, err := GetEnterpriseApp(ctx, installationID)
+	if err != nil {
+		return nil, err
+	}
+	if app != nil {
+		itr, trErr := app.transport(installationID)
+		if trErr != nil {
+			return nil, trErr
+		}
+		return github.NewEnterpriseClient(app.restURL(), app.uploadURL(), &http.Client{Transport: itr})
+	}
+
 	itr, err := ghinstallation.New(http.DefaultTransport, int64(getGithubAppID()), installationID, []byte(getGithubAppPrivateKey()))
 	if err != nil {
 		return nil, err
@@ -81,8 +94,21 @@ func NewGithubAppClient(installationID int64) (*github.Client, error) {
 	return github.NewClient(&http.Client{Transport: itr}), nil
 }
 
-// NewGithubV4AppClient creates a new github v4 client from the supplied installationID
-func NewGithubV4AppClient(installationID int64) (*githubv4.Client, error) {
+// NewGithubV4AppClient creates a new github v4 client from the supplied installationID, the installations of the
+// GitHub Enterprise Server organizations use the app and the API of their instance, see GetEnterpriseApp
+func NewGithubV4AppClient(ctx context.Context, installationID int64) (*githubv4.Client, error) {
+	app, err := GetEnterpriseApp(ctx, installationID)
+	if err != nil {
+		return nil, err
+	}
+	if app != nil {
+		itr, trErr := app.transport(installationID)
+		if trErr != nil {
+			return nil, trErr
+		}
+		return githubv4.NewEnterpriseClient(app.graphQLURL(), &http.Client{Transport: itr, Timeout: 5 * time.Second}), nil
+	}
+
 	authTransport, err := ghinstallation.New(http.DefaultTransport, int64(getGithubAppID()), installationID, []byte(getGithubAppPrivateKey()))
 	if err != nil {
 		return nil, err
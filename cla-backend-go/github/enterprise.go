@@ -0,0 +1,316 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bradleyfalzon/ghinstallation/v2"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gitHubHost is the host of the public GitHub, the organizations without a base URL are hosted there
+	gitHubHost = "github.com"
+
+	// enterpriseAppsTTL is the delay after which the GitHub Enterprise Server apps are loaded again
+	enterpriseAppsTTL = 5 * time.Minute
+)
+
+// EnterpriseApp is the EasyCLA GitHub App registered on a GitHub Enterprise Server instance
+type EnterpriseApp struct {
+	// BaseURL is the instance URL, e.g. https://github.example.com, the API endpoints are derived from it
+	BaseURL       string
+	AppID         int64
+	AppPrivateKey string
+}
+
+// EnterpriseInstallation identifies an installation of the app - the installation IDs are only unique per host, so an
+// installation is keyed by the base URL of its instance and its installation ID
+type EnterpriseInstallation struct {
+	BaseURL        string
+	InstallationID int64
+}
+
+// EnterpriseApps are the GitHub Enterprise Server apps keyed by their installation, along with the installation IDs of
+// the github.com organizations
+type EnterpriseApps struct {
+	Apps                  map[EnterpriseInstallation]*EnterpriseApp
+	GitHubInstallationIDs map[int64]bool
+}
+
+// EnterpriseAppLoader loads the GitHub Enterprise Server apps
+type EnterpriseAppLoader func(ctx context.Context) (*EnterpriseApps, error)
+
+// ErrEnterpriseAppNotFound is returned when no app is registered for the installation on the GitHub Enterprise Server
+// instance of the context
+var ErrEnterpriseAppNotFound = errors.New("github enterprise app not found")
+
+// enterpriseAppCache keeps the GitHub Enterprise Server apps loaded for the enterpriseAppsTTL
+type enterpriseAppCache struct {
+	sync.Mutex
+	loader   EnterpriseAppLoader
+	apps     *EnterpriseApps
+	loadedAt time.Time
+}
+
+var enterpriseApps = &enterpriseAppCache{}
+
+// baseURLContextKey is the context key of the base URL of the GitHub host of the installation
+type baseURLContextKey struct{}
+
+// WithBaseURL returns the context of the calls to the GitHub host of the base URL - an empty base URL is github.com
+func WithBaseURL(ctx context.Context, baseURL string) context.Context {
+	return context.WithValue(ctx, baseURLContextKey{}, strings.TrimRight(baseURL, "/"))
+}
+
+// BaseURLFromContext returns the base URL of the GitHub host set with WithBaseURL, the second value is false when the
+// host is not known
+func BaseURLFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	baseURL, ok := ctx.Value(baseURLContextKey{}).(string)
+	return baseURL, ok
+}
+
+// BaseURLFromHTMLURL returns the base URL of the GitHub host of a web URL, e.g. the html_url of the webhook sender, or
+// an empty string for github.com
+func BaseURLFromHTMLURL(htmlURL string) string {
+	u, err := url.Parse(htmlURL)
+	if err != nil || u.Host == "" || strings.EqualFold(u.Hostname(), gitHubHost) {
+		return ""
+	}
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+}
+
+// InitEnterpriseApps sets the loader of the GitHub Enterprise Server apps - without a loader every installation is
+// hosted on github.com
+func InitEnterpriseApps(loader EnterpriseAppLoader) {
+	enterpriseApps.Lock()
+	defer enterpriseApps.Unlock()
+	enterpriseApps.loader = loader
+	enterpriseApps.apps = nil
+}
+
+// ResetEnterpriseApps drops the loaded GitHub Enterprise Server apps, e.g. after an organization was registered, so
+// the next client is created with the current apps
+func ResetEnterpriseApps() {
+	enterpriseApps.Lock()
+	defer enterpriseApps.Unlock()
+	enterpriseApps.apps = nil
+}
+
+// GetEnterpriseApp returns the GitHub Enterprise Server app of the installation, or nil for the github.com installations.
+// The installation is looked up on the host of the context, see WithBaseURL - when the host is not known, the
+// installation ID is only routed to an instance if no github.com organization uses it and a single instance has it
+func GetEnterpriseApp(ctx context.Context, installationID int64) (*EnterpriseApp, error) {
+	f := logrus.Fields{
+		"functionName":   "github.enterprise.GetEnterpriseApp",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+	}
+
+	enterpriseApps.Lock()
+	defer enterpriseApps.Unlock()
+	if enterpriseApps.loader == nil {
+		return nil, nil
+	}
+
+	baseURL, hostKnown := BaseURLFromContext(ctx)
+	if hostKnown && baseURL == "" {
+		return nil, nil
+	}
+
+	if enterpriseApps.apps == nil || time.Since(enterpriseApps.loadedAt) > enterpriseAppsTTL {
+		apps, err := enterpriseApps.loader(ctx)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("unable to load the GitHub Enterprise Server apps")
+			// the apps loaded before are still used, without them the host of the installation is unknown
+			if enterpriseApps.apps == nil {
+				return nil, err
+			}
+		} else {
+			enterpriseApps.apps = apps
+		}
+		enterpriseApps.loadedAt = time.Now()
+	}
+
+	if hostKnown {
+		for installation, app := range enterpriseApps.apps.Apps {
+			// the host names are case insensitive
+			if installation.InstallationID == installationID && strings.EqualFold(installation.BaseURL, baseURL) {
+				return app, nil
+			}
+		}
+		return nil, fmt.Errorf("installation: %d on %s - %w", installationID, baseURL, ErrEnterpriseAppNotFound)
+	}
+
+	if enterpriseApps.apps.GitHubInstallationIDs[installationID] {
+		return nil, nil
+	}
+	var found *EnterpriseApp
+	for installation, app := range enterpriseApps.apps.Apps {
+		if installation.InstallationID != installationID {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("installation: %d is used on more than one github enterprise instance, the host is required", installationID)
+		}
+		found = app
+	}
+	if found != nil {
+		log.WithFields(f).Debugf("routing the installation to the github enterprise instance: %s", found.BaseURL)
+	}
+	return found, nil
+}
+
+// NormalizeEnterpriseBaseURL validates the GitHub Enterprise Server URL and returns it without the trailing slash
+func NormalizeEnterpriseBaseURL(baseURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid github enterprise url: %s - %v", baseURL, err)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("invalid github enterprise url: %s - expecting an http(s) url", baseURL)
+	}
+	if strings.EqualFold(u.Hostname(), gitHubHost) || strings.EqualFold(u.Hostname(), "api."+gitHubHost) {
+		return "", fmt.Errorf("invalid github enterprise url: %s - the github.com organizations have no base url", baseURL)
+	}
+	path := strings.TrimSuffix(strings.TrimRight(u.Path, "/"), "/api/v3")
+	return strings.TrimRight(fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, path), "/"), nil
+}
+
+// HTMLURL returns the web URL of the path, e.g. the organization or repository full name, on the GitHub host of the
+// base URL - github.com when the base URL is empty
+func HTMLURL(baseURL, path string) string {
+	if baseURL == "" {
+		baseURL = "https://" + gitHubHost
+	}
+	return fmt.Sprintf("%s/%s", strings.TrimRight(baseURL, "/"), strings.TrimLeft(path, "/"))
+}
+
+// restURL returns the REST API endpoint of the instance
+func (a *EnterpriseApp) restURL() string {
+	return a.BaseURL + "/api/v3/"
+}
+
+// uploadURL returns the upload API endpoint of the instance
+func (a *EnterpriseApp) uploadURL() string {
+	return a.BaseURL + "/api/uploads/"
+}
+
+// graphQLURL returns the GraphQL API endpoint of the instance
+func (a *EnterpriseApp) graphQLURL() string {
+	return a.BaseURL + "/api/graphql"
+}
+
+// transport returns the installation transport authenticated with the app credentials against the instance
+func (a *EnterpriseApp) transport(installationID int64) (*ghinstallation.Transport, error) {
+	itr, err := ghinstallation.New(http.DefaultTransport, a.AppID, installationID, []byte(a.AppPrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	itr.BaseURL = strings.TrimRight(a.restURL(), "/")
+	return itr, nil
+}
+
+// GetEnterpriseInstallation loads the installation of the app with the app credentials, which validates the
+// credentials and tells the account the app is installed on
+func GetEnterpriseInstallation(ctx context.Context, app *EnterpriseApp, installationID int64) (*github.Installation, error) {
+	f := logrus.Fields{
+		"functionName":   "github.enterprise.GetEnterpriseInstallation",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"baseURL":        app.BaseURL,
+		"appID":          app.AppID,
+		"installationID": installationID,
+	}
+
+	atr, err := ghinstallation.NewAppsTransport(http.DefaultTransport, app.AppID, []byte(app.AppPrivateKey))
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to create the github app transport")
+		return nil, err
+	}
+	atr.BaseURL = strings.TrimRight(app.restURL(), "/")
+	client, err := github.NewEnterpriseClient(app.restURL(), app.uploadURL(), &http.Client{Transport: atr})
+	if err != nil {
+		return nil, err
+	}
+
+	installation, resp, err := client.Apps.GetInstallation(ctx, installationID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the github app installation")
+		if ok, wErr := CheckAndWrapForKnownErrors(resp, err); ok {
+			return nil, wErr
+		}
+		return nil, err
+	}
+	return installation, nil
+}
+
+// EncryptAppPrivateKey encrypts the GitHub Enterprise Server app private key with the base64 encoded AES key
+func EncryptAppPrivateKey(privateKey, key string) (string, error) {
+	keyDecoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("problem decoding github enterprise key, error: %v", err)
+	}
+
+	block, err := aes.NewCipher(keyDecoded)
+	if err != nil {
+		return "", err
+	}
+
+	// The IV is stored at the beginning of the cipher text
+	cipherText := make([]byte, aes.BlockSize+len(privateKey))
+	iv := cipherText[:aes.BlockSize]
+	if _, err = io.ReadFull(rand.Reader, iv); err != nil {
+		return "", err
+	}
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(cipherText[aes.BlockSize:], []byte(privateKey))
+
+	return hex.EncodeToString(cipherText), nil
+}
+
+// DecryptAppPrivateKey decrypts the app private key encrypted with EncryptAppPrivateKey
+func DecryptAppPrivateKey(encrypted, key string) (string, error) {
+	cipherText, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("problem decoding encrypted github app private key, error: %v", err)
+	}
+	if len(cipherText) < aes.BlockSize {
+		return "", errors.New("encrypted github app private key is too short")
+	}
+
+	keyDecoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("problem decoding github enterprise key, error: %v", err)
+	}
+
+	block, err := aes.NewCipher(keyDecoded)
+	if err != nil {
+		return "", err
+	}
+
+	iv := cipherText[:aes.BlockSize]
+	plainText := cipherText[aes.BlockSize:]
+	cipher.NewCFBDecrypter(block, iv).XORKeyStream(plainText, plainText)
+
+	return string(plainText), nil
+}
@@ -0,0 +1,259 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testInstallationID = int64(4242)
+	testAppID          = int64(17)
+	testAccessToken    = "ghs_enterprise_token"
+)
+
+// newEnterpriseStub starts a stub of the REST and GraphQL endpoints of a GitHub Enterprise Server instance
+func newEnterpriseStub(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/api/v3/app/installations/%d/access_tokens", testInstallationID), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": %q, "expires_at": "2099-01-01T00:00:00Z"}`, testAccessToken)
+	})
+	mux.HandleFunc(fmt.Sprintf("/api/v3/app/installations/%d", testInstallationID), func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %d, "app_id": %d, "account": {"login": "acme"}}`, testInstallationID, testAppID)
+	})
+	mux.HandleFunc("/api/v3/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token "+testAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"total_count": 1, "repositories": [{"id": 1, "full_name": "acme/widgets"}]}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != "token "+testAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"viewer": {"login": "easycla[bot]"}}}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+func newTestAppPrivateKey(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating the app private key failed : %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+// testEnterpriseAppLoader returns the loader of the apps, keyed by the installation ID on the base URL of their app
+func testEnterpriseAppLoader(githubInstallationIDs []int64, apps ...*EnterpriseApp) EnterpriseAppLoader {
+	return func(ctx context.Context) (*EnterpriseApps, error) {
+		loaded := &EnterpriseApps{
+			Apps:                  make(map[EnterpriseInstallation]*EnterpriseApp),
+			GitHubInstallationIDs: make(map[int64]bool),
+		}
+		for _, app := range apps {
+			loaded.Apps[EnterpriseInstallation{BaseURL: app.BaseURL, InstallationID: testInstallationID}] = app
+		}
+		for _, installationID := range githubInstallationIDs {
+			loaded.GitHubInstallationIDs[installationID] = true
+		}
+		return loaded, nil
+	}
+}
+
+func initTestEnterpriseApps(t *testing.T, baseURL string) *EnterpriseApp {
+	app := &EnterpriseApp{BaseURL: baseURL, AppID: testAppID, AppPrivateKey: newTestAppPrivateKey(t)}
+	InitEnterpriseApps(testEnterpriseAppLoader(nil, app))
+	t.Cleanup(func() { InitEnterpriseApps(nil) })
+	return app
+}
+
+func TestEnterpriseAppClient(t *testing.T) {
+	server := newEnterpriseStub(t)
+	defer server.Close()
+	initTestEnterpriseApps(t, server.URL)
+
+	repos, err := GetInstallationRepositories(context.Background(), testInstallationID)
+	if err != nil {
+		t.Fatalf("listing the installation repositories failed : %v", err)
+	}
+	assert.Equal(t, 1, len(repos))
+	assert.Equal(t, "acme/widgets", repos[0].GetFullName())
+}
+
+func TestEnterpriseV4AppClient(t *testing.T) {
+	server := newEnterpriseStub(t)
+	defer server.Close()
+	initTestEnterpriseApps(t, server.URL)
+
+	client, err := NewGithubV4AppClient(context.Background(), testInstallationID)
+	if err != nil {
+		t.Fatalf("creating the github v4 client failed : %v", err)
+	}
+	var query struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	if err := client.Query(context.Background(), &query, nil); err != nil {
+		t.Fatalf("graphql query failed : %v", err)
+	}
+	assert.Equal(t, githubv4.String("easycla[bot]"), query.Viewer.Login)
+}
+
+func TestGetEnterpriseInstallation(t *testing.T) {
+	server := newEnterpriseStub(t)
+	defer server.Close()
+	app := &EnterpriseApp{BaseURL: server.URL, AppID: testAppID, AppPrivateKey: newTestAppPrivateKey(t)}
+
+	installation, err := GetEnterpriseInstallation(context.Background(), app, testInstallationID)
+	if err != nil {
+		t.Fatalf("loading the installation failed : %v", err)
+	}
+	assert.Equal(t, "acme", installation.GetAccount().GetLogin())
+}
+
+func TestGetEnterpriseAppKeepsAppsOnLoadError(t *testing.T) {
+	app := &EnterpriseApp{BaseURL: "https://github.example.com", AppID: testAppID}
+	InitEnterpriseApps(testEnterpriseAppLoader(nil, app))
+	defer InitEnterpriseApps(nil)
+
+	loaded, err := GetEnterpriseApp(context.Background(), testInstallationID)
+	assert.Nil(t, err)
+	assert.Equal(t, app, loaded)
+
+	// an expired cache failing to load again still routes the known installations
+	enterpriseApps.loader = func(ctx context.Context) (*EnterpriseApps, error) {
+		return nil, errors.New("dynamodb unavailable")
+	}
+	enterpriseApps.loadedAt = enterpriseApps.loadedAt.Add(-2 * enterpriseAppsTTL)
+	loaded, err = GetEnterpriseApp(context.Background(), testInstallationID)
+	assert.Nil(t, err)
+	assert.Equal(t, app, loaded)
+
+	loaded, err = GetEnterpriseApp(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Nil(t, loaded)
+}
+
+func TestGetEnterpriseAppRoutesByHost(t *testing.T) {
+	app := &EnterpriseApp{BaseURL: "https://github.example.com", AppID: testAppID}
+	other := &EnterpriseApp{BaseURL: "https://git.example.org", AppID: testAppID}
+
+	testCases := []struct {
+		Name                  string
+		GitHubInstallationIDs []int64
+		Apps                  []*EnterpriseApp
+		Ctx                   context.Context
+		Expected              *EnterpriseApp
+		Error                 bool
+	}{
+		{Name: "enterprise host", Apps: []*EnterpriseApp{app, other}, Ctx: WithBaseURL(context.Background(), "https://GitHub.example.com/"), Expected: app},
+		{Name: "github.com host", GitHubInstallationIDs: []int64{testInstallationID}, Apps: []*EnterpriseApp{app}, Ctx: WithBaseURL(context.Background(), "")},
+		{Name: "unknown enterprise host", Apps: []*EnterpriseApp{app}, Ctx: WithBaseURL(context.Background(), "https://git.example.org"), Error: true},
+		{Name: "unknown host of a github.com installation", GitHubInstallationIDs: []int64{testInstallationID}, Apps: []*EnterpriseApp{app}, Ctx: context.Background()},
+		{Name: "unknown host of a single enterprise installation", Apps: []*EnterpriseApp{app}, Ctx: context.Background(), Expected: app},
+		{Name: "unknown host of an installation on several instances", Apps: []*EnterpriseApp{app, other}, Ctx: context.Background(), Error: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			InitEnterpriseApps(testEnterpriseAppLoader(tc.GitHubInstallationIDs, tc.Apps...))
+			defer InitEnterpriseApps(nil)
+
+			loaded, err := GetEnterpriseApp(tc.Ctx, testInstallationID)
+			if tc.Error {
+				assert.NotNil(tt, err)
+				return
+			}
+			assert.Nil(tt, err)
+			assert.Equal(tt, tc.Expected, loaded)
+		})
+	}
+}
+
+func TestBaseURLFromHTMLURL(t *testing.T) {
+	assert.Equal(t, "", BaseURLFromHTMLURL("https://github.com/octocat"))
+	assert.Equal(t, "https://github.example.com", BaseURLFromHTMLURL("https://github.example.com/octocat"))
+	assert.Equal(t, "", BaseURLFromHTMLURL(""))
+}
+
+func TestNormalizeEnterpriseBaseURL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		BaseURL  string
+		Expected string
+		Error    bool
+	}{
+		{Name: "instance url", BaseURL: "https://github.example.com", Expected: "https://github.example.com"},
+		{Name: "trailing slash", BaseURL: " https://github.example.com/ ", Expected: "https://github.example.com"},
+		{Name: "api url", BaseURL: "https://github.example.com/api/v3/", Expected: "https://github.example.com"},
+		{Name: "path prefix", BaseURL: "https://example.com/github", Expected: "https://example.com/github"},
+		{Name: "github.com", BaseURL: "https://github.com", Error: true},
+		{Name: "github.com api", BaseURL: "https://api.github.com", Error: true},
+		{Name: "no scheme", BaseURL: "github.example.com", Error: true},
+		{Name: "unsupported scheme", BaseURL: "ssh://github.example.com", Error: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			baseURL, err := NormalizeEnterpriseBaseURL(tc.BaseURL)
+			if tc.Error {
+				assert.NotNil(tt, err)
+				return
+			}
+			assert.Nil(tt, err)
+			assert.Equal(tt, tc.Expected, baseURL)
+		})
+	}
+}
+
+func TestAppPrivateKeyEncryption(t *testing.T) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("generating the encryption key failed : %v", err)
+	}
+	encodedKey := base64.StdEncoding.EncodeToString(key)
+	privateKey := newTestAppPrivateKey(t)
+
+	encrypted, err := EncryptAppPrivateKey(privateKey, encodedKey)
+	assert.Nil(t, err)
+	assert.NotEqual(t, privateKey, encrypted)
+
+	decrypted, err := DecryptAppPrivateKey(encrypted, encodedKey)
+	assert.Nil(t, err)
+	assert.Equal(t, privateKey, decrypted)
+
+	_, err = DecryptAppPrivateKey("abc", encodedKey)
+	assert.NotNil(t, err)
+}
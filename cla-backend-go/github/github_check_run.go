@@ -0,0 +1,270 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// CheckRunName is the name of the EasyCLA check run - the same as the status context so the required checks keep working
+	CheckRunName = "EasyCLA"
+	// CheckRunActionRecheck is the identifier of the check run action which triggers a fresh evaluation
+	CheckRunActionRecheck = "recheck"
+
+	// checkRunActionSignPrefix is followed by the GitHub user ID of the missing author in the Sign CLA action identifier
+	checkRunActionSignPrefix = "sign-"
+
+	// GitHub limits for the check run actions
+	maxCheckRunActions           = 3
+	maxCheckRunActionLabel       = 20
+	maxCheckRunActionDescription = 40
+
+	checkRunCompleted      = "completed"
+	checkRunSuccess        = "success"
+	checkRunActionRequired = "action_required"
+)
+
+// UpdatePullRequestCheckRun publishes the EasyCLA result of the latest commit as a check run, with a summary table of the
+// commit authors, a Sign CLA action per missing author and a re-run action, and updates the pull request comment
+func UpdatePullRequestCheckRun(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*UserCommitSummary, missing []*UserCommitSummary, CLABaseAPIURL, CLALandingPage, CLALogoURL string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_check_run.UpdatePullRequestCheckRun",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"SHA":            latestSHA,
+		"pullRequestID":  pullRequestID,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	if err = updateCLAComment(ctx, client, installationID, pullRequestID, owner, repo, repoID, signed, missing, CLABaseAPIURL, CLALandingPage, CLALogoURL); err != nil {
+		return err
+	}
+
+	signURL := getFullSignURL("github", strconv.FormatInt(installationID, 10), strconv.FormatInt(*repoID, 10), strconv.Itoa(pullRequestID), CLABaseAPIURL)
+	conclusion := checkRunActionRequired
+	detailsURL := missingCLAGroupSignURL(signURL, missing)
+	_, title := assembleCLAStatus(CheckRunName, false)
+	if len(missing) == 0 && len(signed) > 0 {
+		conclusion = checkRunSuccess
+		detailsURL = fmt.Sprintf("%s/#/?version=2", CLALandingPage)
+		_, title = assembleCLAStatus(CheckRunName, true)
+	}
+	summary := CheckRunSummary(signURL, signed, missing)
+
+	log.WithFields(f).Debugf("creating CLA check run with conclusion %s - %d passed, %d missing", conclusion, len(signed), len(missing))
+	_, _, err = client.Checks.CreateCheckRun(ctx, owner, repo, github.CreateCheckRunOptions{
+		Name:        CheckRunName,
+		HeadSHA:     latestSHA,
+		DetailsURL:  &detailsURL,
+		ExternalID:  github.String(strconv.Itoa(pullRequestID)),
+		Status:      github.String(checkRunCompleted),
+		Conclusion:  &conclusion,
+		CompletedAt: &github.Timestamp{Time: time.Now()},
+		Output: &github.CheckRunOutput{
+			Title:   &title,
+			Summary: &summary,
+		},
+		Actions: CheckRunActions(missing),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to create check run")
+		return err
+	}
+
+	return nil
+}
+
+// CheckRunSummary returns the markdown table of the commits and the CLA coverage state of each commit author
+func CheckRunSummary(signURL string, signed, missing []*UserCommitSummary) string {
+	summary := checkRunTable(signURL, signed, missing)
+	if len(missing) > 0 {
+		summary += "\nOnce the CLA is signed, select **Re-run EasyCLA** to check the pull request again.\n"
+	}
+	return summary
+}
+
+// checkRunTable returns the markdown table of the commits and the CLA coverage state of each commit author
+func checkRunTable(signURL string, signed, missing []*UserCommitSummary) string {
+	var sb strings.Builder
+	sb.WriteString("| Commit | Author | CLA |\n")
+	sb.WriteString("| --- | --- | --- |\n")
+
+	for _, summary := range signed {
+		state := ":white_check_mark: Covered by a signed CLA"
+		if summary.Exempt {
+			state = ":white_check_mark: Exempt"
+		} else if summary.Reported {
+			state = fmt.Sprintf(":warning: Co-author not covered by a signed CLA, reported only - [sign the CLA](%s)", signURL)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", shortSHA(summary.SHA), checkRunAuthor(summary), state))
+	}
+	for _, summary := range missing {
+		var state string
+		switch {
+		case summary.CoAuthor:
+			state = fmt.Sprintf(":x: Co-author not covered by a signed CLA - [sign the CLA](%s)", signURL)
+		case summary.GetCommitAuthorID() == "":
+			state = fmt.Sprintf(":x: The commit author is missing the GitHub user ID - [consult GitHub Help](%s)", help)
+		case summary.Affiliated && !summary.Authorized:
+			state = fmt.Sprintf(":warning: Company affiliation must be confirmed - [confirm the affiliation](%s)", signURL)
+		case len(summary.MissingCLAGroups) > 0:
+			links := make([]string, 0, len(summary.MissingCLAGroups))
+			for _, claGroup := range summary.MissingCLAGroups {
+				links = append(links, fmt.Sprintf("[%s](%s)", claGroup.DisplayName(), clagroupbinding.SignURL(signURL, claGroup.ID)))
+			}
+			state = fmt.Sprintf(":x: Not covered by a signed CLA of every CLA group of the changed files - sign %s", strings.Join(links, ", "))
+		default:
+			state = fmt.Sprintf(":x: Not covered by a signed CLA - [sign the CLA](%s)", signURL)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", shortSHA(summary.SHA), checkRunAuthor(summary), state))
+	}
+
+	return sb.String()
+}
+
+// CheckRunActions returns the re-run action and a Sign CLA action for each missing author with a GitHub user ID, up
+// to the GitHub limit on the number of check run actions
+func CheckRunActions(missing []*UserCommitSummary) []*github.CheckRunAction {
+	actions := []*github.CheckRunAction{
+		{
+			Label:       "Re-run EasyCLA",
+			Description: "Check the commit authors again",
+			Identifier:  CheckRunActionRecheck,
+		},
+	}
+	if len(missing) == 0 {
+		return actions
+	}
+
+	added := map[string]bool{}
+	for _, summary := range missing {
+		if len(actions) == maxCheckRunActions {
+			break
+		}
+		authorID := summary.GetCommitAuthorID()
+		if authorID == "" || added[authorID] {
+			continue
+		}
+		added[authorID] = true
+		actions = append(actions, &github.CheckRunAction{
+			Label:       truncate("Sign CLA: "+summary.GetCommitAuthorUsername(), maxCheckRunActionLabel),
+			Description: truncate("Send the signing link to @"+summary.GetCommitAuthorUsername(), maxCheckRunActionDescription),
+			Identifier:  checkRunActionSignPrefix + authorID,
+		})
+	}
+
+	return actions
+}
+
+// ParseSignCheckRunAction returns the GitHub user ID of the missing author from a Sign CLA check run action identifier
+func ParseSignCheckRunAction(identifier string) (string, bool) {
+	if !strings.HasPrefix(identifier, checkRunActionSignPrefix) {
+		return "", false
+	}
+	authorID := strings.TrimPrefix(identifier, checkRunActionSignPrefix)
+	if _, err := strconv.ParseInt(authorID, 10, 64); err != nil {
+		return "", false
+	}
+	return authorID, true
+}
+
+// CreateSignRequestComment mentions the missing author in a pull request comment with the link to sign the CLA
+func CreateSignRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, author *UserCommitSummary, CLABaseAPIURL string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_check_run.CreateSignRequestComment",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"pullRequestID":  pullRequestID,
+		"authorID":       author.GetCommitAuthorID(),
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	signURL := getFullSignURL("github", strconv.FormatInt(installationID, 10), strconv.FormatInt(*repoID, 10), strconv.Itoa(pullRequestID), CLABaseAPIURL)
+	body := fmt.Sprintf("@%s - the commits of this pull request are not covered by a signed CLA. <a href='%s' target='_blank'>Please click here to be authorized</a>, then select Re-run EasyCLA on the EasyCLA check.",
+		author.GetCommitAuthorUsername(), signURL)
+	if _, _, err = client.Issues.CreateComment(ctx, owner, repo, pullRequestID, &github.IssueComment{Body: &body}); err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to create comment")
+		return err
+	}
+
+	return nil
+}
+
+// CreatePullRequestComment adds the comment to the pull request using the GitHub App installation
+func CreatePullRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo, body string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_check_run.CreatePullRequestComment",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"pullRequestID":  pullRequestID,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	if _, _, err = client.Issues.CreateComment(ctx, owner, repo, pullRequestID, &github.IssueComment{Body: &body}); err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to create comment")
+		return err
+	}
+
+	return nil
+}
+
+func checkRunAuthor(summary *UserCommitSummary) string {
+	author := summary.GetCommitAuthorUsername()
+	if author == "" && summary.CoAuthor && summary.CommitAuthor != nil {
+		author = utils.StringValue(summary.CommitAuthor.Name)
+	}
+	if author == "" {
+		return unknown
+	}
+	if summary.CoAuthor {
+		author = fmt.Sprintf("%s (co-author)", author)
+	}
+	return strings.ReplaceAll(author, "|", "\\|")
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return fmt.Sprintf("`%s`", sha[:7])
+	}
+	return fmt.Sprintf("`%s`", sha)
+}
+
+func truncate(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+	return value[:maxLength]
+}
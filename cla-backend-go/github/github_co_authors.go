@@ -0,0 +1,89 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/coauthors"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+)
+
+// coAuthorSummaries returns a commit summary for each Co-authored-by trailer of the commit, the trailers of the
+// commit author are skipped - the GitHub login and user ID are filled in for the GitHub noreply addresses, the other
+// co-authors are resolved by their email
+func coAuthorSummaries(author *UserCommitSummary) []*UserCommitSummary {
+	var summaries []*UserCommitSummary
+	for _, coAuthor := range coauthors.Parse(author.Message) {
+		if strings.EqualFold(coAuthor.Email, author.GitAuthorEmail) {
+			continue
+		}
+
+		commitAuthor := &github.User{
+			Name:  github.String(coAuthor.Name),
+			Email: github.String(coAuthor.Email),
+		}
+		if id, login, ok := coauthors.GitHubNoreply(coAuthor.Email); ok {
+			if strings.EqualFold(login, author.GetCommitAuthorUsername()) {
+				continue
+			}
+			commitAuthor.Login = github.String(login)
+			if id > 0 {
+				commitAuthor.ID = github.Int64(id)
+			}
+		}
+
+		summaries = append(summaries, &UserCommitSummary{
+			SHA:            author.SHA,
+			CommitAuthor:   commitAuthor,
+			CoAuthor:       true,
+			Message:        author.Message,
+			GitAuthorName:  coAuthor.Name,
+			GitAuthorEmail: coAuthor.Email,
+			IsMerge:        author.IsMerge,
+		})
+	}
+	return summaries
+}
+
+// ApplyCoAuthorPolicy applies the co-author policy of the CLA group to the triaged commit authors - with the report
+// policy the co-authors which are not covered by a signed CLA are moved to the signed list and marked as reported, so
+// they are listed in the pull request comment without blocking the pull request
+func ApplyCoAuthorPolicy(policy string, signed, missing []*UserCommitSummary) ([]*UserCommitSummary, []*UserCommitSummary) {
+	if policy == utils.CoAuthorPolicyEnforce {
+		return signed, missing
+	}
+
+	stillMissing := make([]*UserCommitSummary, 0, len(missing))
+	for _, summary := range missing {
+		if summary.CoAuthor {
+			summary.Reported = true
+			signed = append(signed, summary)
+			continue
+		}
+		stillMissing = append(stillMissing, summary)
+	}
+	return signed, stillMissing
+}
+
+// HasCoAuthors returns true if one or more of the commit summaries come from a Co-authored-by trailer
+func HasCoAuthors(authors []*UserCommitSummary) bool {
+	for _, summary := range authors {
+		if summary.CoAuthor {
+			return true
+		}
+	}
+	return false
+}
+
+// hasReportedCoAuthors returns true if one or more co-authors are reported without being covered by a signed CLA
+func hasReportedCoAuthors(signed []*UserCommitSummary) bool {
+	for _, summary := range signed {
+		if summary.Reported {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/dco"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+// DCOCommit returns the commit details needed for the DCO sign-off check
+func (u UserCommitSummary) DCOCommit() dco.Commit {
+	return dco.Commit{
+		SHA:         u.SHA,
+		AuthorName:  u.GitAuthorName,
+		AuthorEmail: u.GitAuthorEmail,
+		Message:     u.Message,
+		IsMerge:     u.IsMerge,
+	}
+}
+
+// UpdatePullRequestDCO sets the EasyCLA status of the latest commit and the pull request comment based on the
+// DCO sign-off check results - the comment is only created when one or more commits failed, its remediation rebases the
+// commits onto the base branch of the pull request
+func UpdatePullRequestDCO(ctx context.Context, installationID int64, pullRequestID int, owner, repo, baseBranch, latestSHA string, passed, failed []dco.Result) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_dco.UpdatePullRequestDCO",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"SHA":            latestSHA,
+		"pullRequestID":  pullRequestID,
+		"baseBranch":     baseBranch,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	previousComment, err := findDCOComment(ctx, client, owner, repo, pullRequestID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to check previous DCO comment for PR: %d", pullRequestID)
+		return err
+	}
+
+	body := dco.CommentBody(passed, failed, baseBranch)
+	if previousComment != nil {
+		log.WithFields(f).Debugf("updating the DCO comment in the PR: %d", pullRequestID)
+		previousComment.Body = &body
+		if _, _, err = client.Issues.EditComment(ctx, owner, repo, previousComment.GetID(), previousComment); err != nil {
+			log.WithFields(f).WithError(err).Warn("unable to edit comment")
+			return err
+		}
+	} else if len(failed) > 0 {
+		log.WithFields(f).Debugf("creating the DCO comment in the PR: %d", pullRequestID)
+		if _, _, err = client.Issues.CreateComment(ctx, owner, repo, pullRequestID, &github.IssueComment{Body: &body}); err != nil {
+			log.WithFields(f).WithError(err).Warn("unable to create comment")
+			return err
+		}
+	}
+
+	return createDCOStatus(ctx, f, client, owner, repo, latestSHA, passed, failed)
+}
+
+// UpdateMergeGroupDCO sets the EasyCLA status of the merge group head commit based on the DCO sign-off check results
+func UpdateMergeGroupDCO(ctx context.Context, installationID int64, owner, repo, headSHA string, passed, failed []dco.Result) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_dco.UpdateMergeGroupDCO",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"SHA":            headSHA,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	return createDCOStatus(ctx, f, client, owner, repo, headSHA, passed, failed)
+}
+
+func createDCOStatus(ctx context.Context, f logrus.Fields, client *github.Client, owner, repo, sha string, passed, failed []dco.Result) error {
+	// the EasyCLA context is kept so the branch protection required checks work in either enforcement mode
+	state := successState
+	if len(failed) > 0 {
+		state = failureState
+	}
+	statusContext := "EasyCLA"
+	description := dco.StatusDescription(failed)
+	targetURL := dco.InfoURL
+	status := Status{
+		State:       &state,
+		TargetURL:   &targetURL,
+		Context:     &statusContext,
+		Description: &description,
+	}
+
+	log.WithFields(f).Debugf("creating DCO %s status - %d passed, %d failed", state, len(passed), len(failed))
+	if _, _, err := CreateStatus(ctx, client, owner, repo, sha, &status); err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to create status: %+v", status)
+		return err
+	}
+
+	return nil
+}
+
+func findDCOComment(ctx context.Context, client *github.Client, owner, repo string, pullRequestID int) (*github.IssueComment, error) {
+	comments, _, err := client.Issues.ListComments(ctx, owner, repo, pullRequestID, &github.IssueListCommentsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		if strings.Contains(comment.GetBody(), dco.CommentMarker) {
+			return comment, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -24,7 +24,7 @@ func GetInstallationRepositories(ctx context.Context, installationID int64) ([]*
 		"installationID": installationID,
 	}
 
-	client, err := NewGithubAppClient(installationID)
+	client, err := NewGithubAppClient(ctx, installationID)
 	if err != nil {
 		msg := fmt.Sprintf("unable to create a github client, error: %+v", err)
 		log.WithFields(f).WithError(err).Warn(msg)
@@ -0,0 +1,214 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// mergeQueueBotLogin is the GitHub user of the merge queue, which authors or commits the commits the merge queue
+	// creates on the merge group branch
+	mergeQueueBotLogin = "github-merge-queue[bot]"
+	// mergeQueueBotEmailSuffix is the suffix of the git email of the merge queue user
+	mergeQueueBotEmailSuffix = "+" + mergeQueueBotLogin + "@users.noreply.github.com"
+	// compareCommitsPageSize is the number of commits loaded per page of the merge group comparison
+	compareCommitsPageSize = 100
+)
+
+// GetMergeGroupCommits returns the author summaries and the changed files of the commits of the merge group, which are
+// the commits between the base and the head commit of the merge queue branch - the commits created by the merge queue
+// are skipped, their changes come from the queued pull requests which were already checked
+func GetMergeGroupCommits(ctx context.Context, installationID int64, owner, repo, baseSHA, headSHA string) ([]*UserCommitSummary, []trivialchange.File, error) {
+	f := logrus.Fields{
+		"functionName":   "github.github_merge_group.GetMergeGroupCommits",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"baseSHA":        baseSHA,
+		"headSHA":        headSHA,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return nil, nil, err
+	}
+
+	commits, comparisonFiles, err := compareMergeGroupCommits(ctx, f, client, owner, repo, baseSHA, headSHA)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.WithFields(f).Debugf("found %d commits and %d files for merge group: %s", len(commits), len(comparisonFiles), headSHA)
+	files := make([]trivialchange.File, 0, len(comparisonFiles))
+	for _, file := range comparisonFiles {
+		files = append(files, trivialchange.File{
+			Path:         file.GetFilename(),
+			PreviousPath: file.GetPreviousFilename(),
+			Additions:    file.GetAdditions(),
+			Deletions:    file.GetDeletions(),
+		})
+	}
+
+	contributed := make([]*github.RepositoryCommit, 0, len(commits))
+	for _, commit := range commits {
+		if isMergeQueueCommit(commit) {
+			log.WithFields(f).Debugf("skipping commit: %s created by the merge queue", commit.GetSHA())
+			continue
+		}
+		contributed = append(contributed, commit)
+	}
+
+	return commitAuthorSummaries(f, contributed), files, nil
+}
+
+// compareMergeGroupCommits loads every page of the comparison of the base and the head commit of the merge group - the
+// comparison lists up to 250 commits when not paged, the changed files are returned with the first page
+func compareMergeGroupCommits(ctx context.Context, f logrus.Fields, client *github.Client, owner, repo, baseSHA, headSHA string) ([]*github.RepositoryCommit, []*github.CommitFile, error) {
+	var commits []*github.RepositoryCommit
+	var files []*github.CommitFile
+	totalCommits := 0
+	listOptions := &github.ListOptions{PerPage: compareCommitsPageSize, Page: 1}
+	for {
+		comparison, resp, err := compareCommits(ctx, client, owner, repo, baseSHA, headSHA, listOptions)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("problem comparing the commits for repo: %s/%s", owner, repo)
+			return nil, nil, err
+		}
+
+		if listOptions.Page == 1 {
+			files = comparison.Files
+			totalCommits = comparison.GetTotalCommits()
+		}
+		commits = append(commits, comparison.Commits...)
+		if resp.NextPage == 0 || len(comparison.Commits) == 0 {
+			break
+		}
+		listOptions.Page = resp.NextPage
+	}
+
+	if len(commits) == 0 {
+		return nil, nil, fmt.Errorf("no commits found for repo: %s/%s merge group: %s", owner, repo, headSHA)
+	}
+	// every commit must be checked, so a partially loaded merge group is not evaluated
+	if totalCommits > len(commits) {
+		return nil, nil, fmt.Errorf("merge group: %s of repo: %s/%s has %d commits, only %d commits were loaded",
+			headSHA, owner, repo, totalCommits, len(commits))
+	}
+
+	return commits, files, nil
+}
+
+// compareCommits compares two commits with the paging options, which the go-github release in use does not support
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#compare-two-commits
+func compareCommits(ctx context.Context, client *github.Client, owner, repo, base, head string, opts *github.ListOptions) (*github.CommitsComparison, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v?per_page=%d&page=%d", owner, repo, url.QueryEscape(base), url.QueryEscape(head), opts.PerPage, opts.Page)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	comparison := new(github.CommitsComparison)
+	resp, err := client.Do(ctx, req, comparison)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comparison, resp, nil
+}
+
+// isMergeQueueCommit returns true when the commit was authored or committed by the merge queue
+func isMergeQueueCommit(commit *github.RepositoryCommit) bool {
+	if commit.GetAuthor().GetLogin() == mergeQueueBotLogin || commit.GetCommitter().GetLogin() == mergeQueueBotLogin {
+		return true
+	}
+	for _, gitUser := range []*github.CommitAuthor{commit.GetCommit().GetAuthor(), commit.GetCommit().GetCommitter()} {
+		if gitUser.GetName() == mergeQueueBotLogin || strings.HasSuffix(gitUser.GetEmail(), mergeQueueBotEmailSuffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateMergeGroup publishes the EasyCLA result of the merge group head commit as a check run or a commit status - the
+// merge group has no pull request, so there is no comment to update and the check run has no actions
+func UpdateMergeGroup(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, signed []*UserCommitSummary, missing []*UserCommitSummary, CLALandingPage string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_merge_group.UpdateMergeGroup",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"SHA":            headSHA,
+		"checkRun":       checkRun,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	// the merge group has no commit authors to check when every commit was created by the merge queue
+	passed := len(missing) == 0
+	statusContext, description := assembleCLAStatus(CheckRunName, passed)
+	targetURL := fmt.Sprintf("%s/#/?version=2", CLALandingPage)
+
+	if checkRun {
+		conclusion := checkRunActionRequired
+		if passed {
+			conclusion = checkRunSuccess
+		}
+		summary := checkRunTable(targetURL, signed, missing)
+		log.WithFields(f).Debugf("creating merge group CLA check run with conclusion %s - %d passed, %d missing", conclusion, len(signed), len(missing))
+		_, _, err = client.Checks.CreateCheckRun(ctx, owner, repo, github.CreateCheckRunOptions{
+			Name:        CheckRunName,
+			HeadSHA:     headSHA,
+			DetailsURL:  &targetURL,
+			Status:      github.String(checkRunCompleted),
+			Conclusion:  &conclusion,
+			CompletedAt: &github.Timestamp{Time: time.Now()},
+			Output: &github.CheckRunOutput{
+				Title:   &description,
+				Summary: &summary,
+			},
+		})
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("unable to create check run")
+			return err
+		}
+		return nil
+	}
+
+	state := failureState
+	if passed {
+		state = successState
+	}
+	status := Status{
+		State:       &state,
+		TargetURL:   &targetURL,
+		Context:     &statusContext,
+		Description: &description,
+	}
+
+	log.WithFields(f).Debugf("creating merge group CLA %s status - %d passed, %d missing", state, len(signed), len(missing))
+	if _, _, err = CreateStatus(ctx, client, owner, repo, headSHA, &status); err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to create status: %+v", status)
+		return err
+	}
+
+	return nil
+}
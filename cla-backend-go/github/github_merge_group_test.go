@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCompareStub starts a stub of the compare endpoint which lists one commit per page
+func newCompareStub(t *testing.T, totalCommits, pages int) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/widgets/compare/base-sha...head-sha", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, strconv.Itoa(compareCommitsPageSize), r.URL.Query().Get("per_page"))
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		assert.NoError(t, err)
+		if page < pages {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/acme/widgets/compare/base-sha...head-sha?per_page=%d&page=%d>; rel="next"`, "http://"+r.Host, compareCommitsPageSize, page+1))
+		}
+		files := `[]`
+		if page == 1 {
+			files = `[{"filename": "README.md", "additions": 1}]`
+		}
+		fmt.Fprintf(w, `{"total_commits": %d, "commits": [{"sha": "sha-%d"}], "files": %s}`, totalCommits, page, files)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+func newCompareStubClient(t *testing.T, server *httptest.Server) *github.Client {
+	client := github.NewClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	assert.NoError(t, err)
+	client.BaseURL = baseURL
+	return client
+}
+
+func TestCompareMergeGroupCommits(t *testing.T) {
+	server := newCompareStub(t, 3, 3)
+	defer server.Close()
+
+	commits, files, err := compareMergeGroupCommits(context.Background(), logrus.Fields{}, newCompareStubClient(t, server), "acme", "widgets", "base-sha", "head-sha")
+	assert.NoError(t, err)
+	if assert.Len(t, commits, 3) {
+		assert.Equal(t, "sha-1", commits[0].GetSHA())
+		assert.Equal(t, "sha-3", commits[2].GetSHA())
+	}
+	if assert.Len(t, files, 1) {
+		assert.Equal(t, "README.md", files[0].GetFilename())
+	}
+}
+
+func TestCompareMergeGroupCommits_PartiallyLoaded(t *testing.T) {
+	server := newCompareStub(t, 3, 2)
+	defer server.Close()
+
+	_, _, err := compareMergeGroupCommits(context.Background(), logrus.Fields{}, newCompareStubClient(t, server), "acme", "widgets", "base-sha", "head-sha")
+	if assert.Error(t, err) {
+		assert.True(t, strings.Contains(err.Error(), "has 3 commits, only 2 commits were loaded"))
+	}
+}
+
+func TestIsMergeQueueCommit(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Commit   *github.RepositoryCommit
+		Expected bool
+	}{
+		{
+			Name: "contributor commit",
+			Commit: &github.RepositoryCommit{
+				Author: &github.User{Login: github.String("new-contributor")},
+				Commit: &github.Commit{Author: &github.CommitAuthor{Name: github.String("New Contributor"), Email: github.String("new-contributor@example.com")}},
+			},
+		},
+		{
+			Name: "contributor commit committed by GitHub",
+			Commit: &github.RepositoryCommit{
+				Author:    &github.User{Login: github.String("new-contributor")},
+				Committer: &github.User{Login: github.String("web-flow")},
+				Commit:    &github.Commit{Committer: &github.CommitAuthor{Name: github.String("GitHub"), Email: github.String("noreply@github.com")}},
+			},
+		},
+		{
+			Name:     "authored by the merge queue",
+			Commit:   &github.RepositoryCommit{Author: &github.User{Login: github.String(mergeQueueBotLogin)}},
+			Expected: true,
+		},
+		{
+			Name:     "committed by the merge queue",
+			Commit:   &github.RepositoryCommit{Committer: &github.User{Login: github.String(mergeQueueBotLogin)}},
+			Expected: true,
+		},
+		{
+			Name: "merge queue git identity",
+			Commit: &github.RepositoryCommit{
+				Commit: &github.Commit{Author: &github.CommitAuthor{Name: github.String("merge-queue"), Email: github.String("118344674" + mergeQueueBotEmailSuffix)}},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			assert.Equal(tt, tc.Expected, isMergeQueueCommit(tc.Commit))
+		})
+	}
+}
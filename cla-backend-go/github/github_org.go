@@ -73,7 +73,7 @@ func GetOrganizationMembers(ctx context.Context, orgName string, installationID
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
 	}
 
-	client, err := NewGithubAppClient(installationID)
+	client, err := NewGithubAppClient(ctx, installationID)
 	if err != nil {
 		msg := fmt.Sprintf("unable to create a github client, error: %+v", err)
 		log.WithFields(f).WithError(err).Warn(msg)
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 	"github.com/sirupsen/logrus"
@@ -37,7 +38,7 @@ func GetGitHubRepository(ctx context.Context, installationID, githubRepositoryID
 		"installationID":     installationID,
 		"githubRepositoryID": githubRepositoryID,
 	}
-	client, clientErr := NewGithubAppClient(installationID)
+	client, clientErr := NewGithubAppClient(ctx, installationID)
 	if clientErr != nil {
 		log.WithFields(f).WithError(clientErr).Warnf("problem loading github client for installation ID: %d", installationID)
 		return nil, clientErr
@@ -81,6 +82,20 @@ type UserCommitSummary struct {
 	CommitAuthor *github.User
 	Affiliated   bool
 	Authorized   bool
+	// git commit details, used by the DCO sign-off check
+	Message        string
+	GitAuthorName  string
+	GitAuthorEmail string
+	IsMerge        bool
+	// CoAuthor is set for the authors listed in a Co-authored-by trailer of the commit, Reported is set for the
+	// co-authors which are not covered by a signed CLA but only reported because of the CLA group co-author policy
+	CoAuthor bool
+	Reported bool
+	// Exempt is set for the authors matched by an exemption rule, such as bots and automation accounts
+	Exempt bool
+	// MissingCLAGroups lists the CLA groups not covering the author when the changes of the pull request are bound to
+	// other CLA groups than the repository CLA group
+	MissingCLAGroups []clagroupbinding.CLAGroup
 }
 
 // GetCommitAuthorID commit author username ID (numeric value as a string) if available, otherwise returns empty string
@@ -98,7 +113,8 @@ func (u UserCommitSummary) GetCommitAuthorUsername() string {
 		if u.CommitAuthor.Login != nil {
 			return *u.CommitAuthor.Login
 		}
-		if u.CommitAuthor.Name != nil {
+		// the name of a co-author comes from the commit trailer, it is not a GitHub username
+		if u.CommitAuthor.Name != nil && !u.CoAuthor {
 			return *u.CommitAuthor.Name
 		}
 	}
@@ -120,6 +136,10 @@ func (u UserCommitSummary) IsValid() bool {
 	valid := false
 	if u.CommitAuthor != nil {
 		valid = u.CommitAuthor.ID != nil && (u.CommitAuthor.Login != nil || u.CommitAuthor.Name != nil)
+		// co-authors without a GitHub noreply address are resolved by their email
+		if u.CoAuthor {
+			valid = u.CommitAuthor.ID != nil || u.CommitAuthor.Email != nil
+		}
 	}
 	return valid
 }
@@ -157,13 +177,17 @@ func (u UserCommitSummary) getUserInfo(tagUser bool) string {
 		tagValue = "@"
 	}
 	if u.CommitAuthor != nil {
-		if *u.CommitAuthor.Login != "" {
+		if utils.StringValue(u.CommitAuthor.Login) != "" {
 			sb.WriteString(fmt.Sprintf("login: %s%s / ", tagValue, *u.CommitAuthor.Login))
 		}
 
 		if u.CommitAuthor.Name != nil {
 			sb.WriteString(fmt.Sprintf("%sname: %s / ", userInfo, utils.StringValue(u.CommitAuthor.Name)))
 		}
+
+		if u.CoAuthor {
+			sb.WriteString("co-author / ")
+		}
 	}
 
 	return strings.Replace(sb.String(), "/ $", "", -1)
@@ -174,26 +198,47 @@ func GetPullRequestCommitAuthors(ctx context.Context, installationID int64, pull
 		"functionName":  "github.github_repository.GetPullRequestCommitAuthors",
 		"pullRequestID": pullRequestID,
 	}
-	var userCommitSummary []*UserCommitSummary
-
-	client, err := NewGithubAppClient(installationID)
+	client, err := NewGithubAppClient(ctx, installationID)
 	if err != nil {
 		log.WithFields(f).WithError(err).Warn("unable to create Github client")
 		return nil, nil, err
 	}
 
-	commits, resp, comErr := client.PullRequests.ListCommits(ctx, owner, repo, pullRequestID, &github.ListOptions{})
-	if comErr != nil {
-		log.WithFields(f).WithError(comErr).Warnf("problem listing commits for repo: %s/%s pull request: %d", owner, repo, pullRequestID)
-		return nil, nil, comErr
+	var commits []*github.RepositoryCommit
+	listOptions := &github.ListOptions{PerPage: 100}
+	for {
+		pageCommits, resp, comErr := client.PullRequests.ListCommits(ctx, owner, repo, pullRequestID, listOptions)
+		if comErr != nil {
+			log.WithFields(f).WithError(comErr).Warnf("problem listing commits for repo: %s/%s pull request: %d", owner, repo, pullRequestID)
+			return nil, nil, comErr
+		}
+		if resp.StatusCode != http.StatusOK {
+			msg := fmt.Sprintf("unexpected status code: %d - expected: %d", resp.StatusCode, http.StatusOK)
+			log.WithFields(f).Warn(msg)
+			return nil, nil, errors.New(msg)
+		}
+
+		commits = append(commits, pageCommits...)
+		if resp.NextPage == 0 {
+			break
+		}
+		listOptions.Page = resp.NextPage
 	}
-	if resp.StatusCode != http.StatusOK {
-		msg := fmt.Sprintf("unexpected status code: %d - expected: %d", resp.StatusCode, http.StatusOK)
-		log.WithFields(f).Warn(msg)
-		return nil, nil, errors.New(msg)
+	if len(commits) == 0 {
+		return nil, nil, fmt.Errorf("no commits found for repo: %s/%s pull request: %d", owner, repo, pullRequestID)
 	}
 
 	log.WithFields(f).Debugf("found %d commits for pull request: %d", len(commits), pullRequestID)
+	userCommitSummary := commitAuthorSummaries(f, commits)
+
+	// get latest commit SHA
+	latestCommitSHA := commits[len(commits)-1].SHA
+	return userCommitSummary, latestCommitSHA, nil
+}
+
+// commitAuthorSummaries returns the author summary of each commit followed by the summaries of its co-authors
+func commitAuthorSummaries(f logrus.Fields, commits []*github.RepositoryCommit) []*UserCommitSummary {
+	var userCommitSummary []*UserCommitSummary
 	for _, commit := range commits {
 		log.WithFields(f).Debugf("loaded commit: %+v", commit)
 		commitAuthor := ""
@@ -205,17 +250,25 @@ func GetPullRequestCommitAuthors(ctx context.Context, installationID int64, pull
 			commitAuthor = utils.StringValue(commit.Author.Login)
 		}
 		log.WithFields(f).Debugf("commitAuthor: %s", commitAuthor)
-		userCommitSummary = append(userCommitSummary, &UserCommitSummary{
-			SHA:          *commit.SHA,
-			CommitAuthor: commit.Author,
-			Affiliated:   false,
-			Authorized:   false,
-		})
-	}
+		authorSummary := &UserCommitSummary{
+			SHA:            *commit.SHA,
+			CommitAuthor:   commit.Author,
+			Affiliated:     false,
+			Authorized:     false,
+			Message:        commit.GetCommit().GetMessage(),
+			GitAuthorName:  commit.GetCommit().GetAuthor().GetName(),
+			GitAuthorEmail: commit.GetCommit().GetAuthor().GetEmail(),
+			IsMerge:        len(commit.Parents) > 1,
+		}
+		userCommitSummary = append(userCommitSummary, authorSummary)
 
-	// get latest commit SHA
-	latestCommitSHA := commits[len(commits)-1].SHA
-	return userCommitSummary, latestCommitSHA, nil
+		coAuthors := coAuthorSummaries(authorSummary)
+		if len(coAuthors) > 0 {
+			log.WithFields(f).Debugf("found %d co-authors for commit: %s", len(coAuthors), authorSummary.SHA)
+			userCommitSummary = append(userCommitSummary, coAuthors...)
+		}
+	}
+	return userCommitSummary
 }
 
 func UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*UserCommitSummary, missing []*UserCommitSummary, CLABaseAPIURL, CLALandingPage, CLALogoURL string) error {
@@ -228,12 +281,73 @@ func UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID
 		"pullRequestID":  pullRequestID,
 	}
 
-	client, err := NewGithubAppClient(installationID)
+	client, err := NewGithubAppClient(ctx, installationID)
 	if err != nil || client == nil {
 		log.WithFields(f).WithError(err).Warn("unable to create Github client")
 		return err
 	}
 
+	if err = updateCLAComment(ctx, client, installationID, pullRequestID, owner, repo, repoID, signed, missing, CLABaseAPIURL, CLALandingPage, CLALogoURL); err != nil {
+		return err
+	}
+
+	// Update/Create the status
+	context := "EasyCLA"
+	var statusBody string
+	var state string
+	var signURL string
+
+	if len(missing) > 0 {
+		state = failureState
+		context, statusBody = assembleCLAStatus(context, false)
+		signURL = missingCLAGroupSignURL(getFullSignURL("github", strconv.Itoa(int(installationID)), strconv.Itoa(int(*repoID)), strconv.Itoa(pullRequestID), CLABaseAPIURL), missing)
+		log.WithFields(f).Debugf("Creating new CLA %s status - %d passed, %d missing, signing url %s", state, len(signed), len(missing), signURL)
+	} else if len(signed) > 0 {
+		state = successState
+		context, statusBody = assembleCLAStatus(context, true)
+		signURL = fmt.Sprintf("%s/#/?version=2", CLALandingPage)
+		log.WithFields(f).Debugf("Creating new CLA %s status - %d passed, %d missing, signing url %s", state, len(signed), len(missing), signURL)
+
+	} else {
+		state = failureState
+		context, statusBody = assembleCLAStatus(context, false)
+		signURL = getFullSignURL("github", strconv.Itoa(int(installationID)), strconv.Itoa(int(*repoID)), strconv.Itoa(pullRequestID), CLABaseAPIURL)
+		log.WithFields(f).Debugf("Creating new CLA %s status - %d passed, %d missing, signing url %s", state, len(signed), len(missing), signURL)
+		log.WithFields(f).Debugf("This is an error condition - should have at least one committer in one of these lists: signed : %+v passed, %+v", signed, missing)
+	}
+
+	status := Status{
+		State:       &state,
+		TargetURL:   &signURL,
+		Context:     &context,
+		Description: &statusBody,
+	}
+
+	log.WithFields(f).Debugf("Creating status: %+v", status)
+
+	_, _, err = CreateStatus(ctx, client, owner, repo, latestSHA, &status)
+	if err != nil {
+		log.WithFields(f).Debugf("unable to create status: %v", status)
+		return err
+	}
+
+	return nil
+}
+
+// updateCLAComment creates or updates the EasyCLA pull request comment - the comment is created only when one or
+// more commit authors are missing and is kept up to date once it exists
+func updateCLAComment(ctx context.Context, client *github.Client, installationID int64, pullRequestID int, owner, repo string, repoID *int64, signed []*UserCommitSummary, missing []*UserCommitSummary, CLABaseAPIURL, CLALandingPage, CLALogoURL string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_repository.updateCLAComment",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"pullRequestID":  pullRequestID,
+	}
+
+	var err error
+
 	// Update comments as necessary
 	log.WithFields(f).Debugf("updating comment for PR: %d... ", pullRequestID)
 
@@ -251,7 +365,8 @@ func UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID
 
 	body := assembleCLAComment(ctx, int(installationID), pullRequestID, repoID, signed, missing, CLABaseAPIURL, CLALogoURL, CLALandingPage)
 
-	if len(missing) == 0 {
+	// the reported co-authors are listed in the comment even though the check passes
+	if len(missing) == 0 && !hasReportedCoAuthors(signed) {
 		// All contributors are passing
 
 		// If we have previously failed, we need to update the comment
@@ -289,7 +404,7 @@ func UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID
 			}
 		} else {
 			// no previous comment - need to create a new comment
-			_, _, err = client.Issues.CreateComment(ctx, owner, repo, pullRequestID, comment)
+			_, _, err = client.Issues.CreateComment(ctx, owner, repo, pullRequestID, &github.IssueComment{Body: &body})
 			if err != nil {
 				log.WithFields(f).Debug("unable to create comment")
 			}
@@ -299,46 +414,6 @@ func UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID
 		}
 	}
 
-	// Update/Create the status
-	context := "EasyCLA"
-	var statusBody string
-	var state string
-	var signURL string
-
-	if len(missing) > 0 {
-		state = failureState
-		context, statusBody = assembleCLAStatus(context, false)
-		signURL = getFullSignURL("github", strconv.Itoa(int(installationID)), strconv.Itoa(int(*repoID)), strconv.Itoa(pullRequestID), CLABaseAPIURL)
-		log.WithFields(f).Debugf("Creating new CLA %s status - %d passed, %d missing, signing url %s", state, len(signed), len(missing), signURL)
-	} else if len(signed) > 0 {
-		state = successState
-		context, statusBody = assembleCLAStatus(context, true)
-		signURL = fmt.Sprintf("%s/#/?version=2", CLALandingPage)
-		log.WithFields(f).Debugf("Creating new CLA %s status - %d passed, %d missing, signing url %s", state, len(signed), len(missing), signURL)
-
-	} else {
-		state = failureState
-		context, statusBody = assembleCLAStatus(context, false)
-		signURL = getFullSignURL("github", strconv.Itoa(int(installationID)), strconv.Itoa(int(*repoID)), strconv.Itoa(pullRequestID), CLABaseAPIURL)
-		log.WithFields(f).Debugf("Creating new CLA %s status - %d passed, %d missing, signing url %s", state, len(signed), len(missing), signURL)
-		log.WithFields(f).Debugf("This is an error condition - should have at least one committer in one of these lists: signed : %+v passed, %+v", signed, missing)
-	}
-
-	status := Status{
-		State:       &state,
-		TargetURL:   &signURL,
-		Context:     &context,
-		Description: &statusBody,
-	}
-
-	log.WithFields(f).Debugf("Creating status: %+v", status)
-
-	_, _, err = CreateStatus(ctx, client, owner, repo, latestSHA, &status)
-	if err != nil {
-		log.WithFields(f).Debugf("unable to create status: %v", status)
-		return err
-	}
-
 	return nil
 }
 
@@ -410,7 +485,7 @@ func assembleCLAComment(ctx context.Context, installationID, pullRequestID int,
 	repositoryType := "github"
 	missingID := false
 	for _, userSummary := range missing {
-		if userSummary.GetCommitAuthorID() == "" {
+		if userSummary.GetCommitAuthorID() == "" && !userSummary.CoAuthor {
 			missingID = true
 		}
 	}
@@ -419,7 +494,7 @@ func assembleCLAComment(ctx context.Context, installationID, pullRequestID int,
 	signURL := getFullSignURL(repositoryType, strconv.Itoa(installationID), strconv.Itoa(int(*repositoryID)), strconv.Itoa(pullRequestID), apiBaseURL)
 	commentBody := getCommentBody(repositoryType, signURL, signed, missing)
 	allSigned := len(missing) == 0
-	badge := getCommentBadge(allSigned, signURL, missingID, false, CLALandingPage, CLALogoURL)
+	badge := getCommentBadge(allSigned, missingCLAGroupSignURL(signURL, missing), missingID, false, CLALandingPage, CLALogoURL)
 	return fmt.Sprintf("%s<br >%s", badge, commentBody)
 }
 
@@ -432,6 +507,7 @@ func getCommentBody(repositoryType, signURL string, signed, missing []*UserCommi
 
 	failed := ":x:"
 	success := ":white_check_mark:"
+	warning := ":warning:"
 	committersComment := strings.Builder{}
 	text := ""
 
@@ -439,8 +515,19 @@ func getCommentBody(repositoryType, signURL string, signed, missing []*UserCommi
 		committersComment.WriteString("<ul>")
 	}
 
-	if len(signed) > 0 {
-		committers := getAuthorInfoCommits(signed, false)
+	var covered, reported, exempt []*UserCommitSummary
+	for _, summary := range signed {
+		if summary.Exempt {
+			exempt = append(exempt, summary)
+		} else if summary.Reported {
+			reported = append(reported, summary)
+		} else {
+			covered = append(covered, summary)
+		}
+	}
+
+	if len(covered) > 0 {
+		committers := getAuthorInfoCommits(covered, false)
 
 		for k, v := range committers {
 			var shas []string
@@ -452,6 +539,34 @@ func getCommentBody(repositoryType, signURL string, signed, missing []*UserCommi
 		}
 	}
 
+	if len(exempt) > 0 {
+		log.WithFields(f).Debugf("processing %d exempt authors", len(exempt))
+		committers := getAuthorInfoCommits(exempt, false)
+
+		for k, v := range committers {
+			var shas []string
+			for _, summary := range v {
+				shas = append(shas, summary.SHA)
+			}
+			committersComment.WriteString(fmt.Sprintf("<li>%s%s(%s) - exempt</li>", success, k, strings.Join(shas, ", ")))
+		}
+	}
+
+	if len(reported) > 0 {
+		log.WithFields(f).Debugf("processing %d reported co-authors", len(reported))
+		committers := getAuthorInfoCommits(reported, true)
+
+		for k, v := range committers {
+			var shas []string
+			for _, summary := range v {
+				shas = append(shas, summary.SHA)
+			}
+			committersComment.WriteString(
+				fmt.Sprintf(`<li>%s %s The co-authored commit (%s) is not covered by a signed CLA for this co-author. The co-author is reported only and does not block the pull request, <a href='%s' target='_blank'>please click here to be authorized</a>.</li>`,
+					warning, k, strings.Join(shas, ", "), signURL))
+		}
+	}
+
 	if len(missing) > 0 {
 		log.WithFields(f).Debugf("processing %d missing contributors", len(missing))
 		supportURL := "https://jira.linuxfoundation.org/servicedesk/customer/portal/4"
@@ -478,6 +593,11 @@ func getCommentBody(repositoryType, signURL string, signed, missing []*UserCommi
 					committersComment.WriteString(
 						fmt.Sprintf(`<li>%s %s The commit (%s). This user is authorized, but they must confirm their affiliation with their company. Start the authorization process <a href='%s' target='_blank'> by clicking here</a>, click \"Corporate\", select the appropriate company from the list, then confirm your affiliation on the page that appears. For further assistance with EasyCLA, <a href='%s' target='_blank'>please submit a support request ticket</a>.</li>`,
 							failed, k, strings.Join(shas, ", "), signURL, supportURL))
+				} else if missingCLAGroups := v[0].MissingCLAGroups; len(missingCLAGroups) > 0 {
+					// the changes are bound to several CLA groups - one link per agreement which is still missing
+					committersComment.WriteString(
+						fmt.Sprintf(`<li><a href='%s' target='_blank'>%s</a> - %s The commit (%s) is not authorized under a signed CLA of every CLA group of the changed files. Please sign the missing agreements, one at a time: %s. For further assistance with EasyCLA, <a href='%s' target='_blank'>please submit a support request ticket</a>.</li>`,
+							clagroupbinding.SignURL(signURL, missingCLAGroups[0].ID), failed, k, strings.Join(shas, ", "), clagroupbinding.SignLinks(signURL, missingCLAGroups), supportURL))
 				} else {
 					committersComment.WriteString(
 						fmt.Sprintf(`<li><a href='%s' target='_blank'>%s</a> - %s The commit (%s) is not authorized under a signed CLA. "<a href='%s' target='_blank'>Please click here to be authorized</a>. For further assistance with EasyCLA, <a href='%s' target='_blank'>please submit a support request ticket</a>.</li>`,
@@ -493,6 +613,9 @@ func getCommentBody(repositoryType, signURL string, signed, missing []*UserCommi
 
 	if len(signed) > 0 && len(missing) == 0 {
 		text = "<br>The committers listed above are authorized under a signed CLA."
+		if len(reported) > 0 {
+			text = fmt.Sprintf("%s The co-authors marked with %s are not covered by a signed CLA and are reported only.", text, warning)
+		}
 	}
 
 	return fmt.Sprintf("%s%s", committersComment.String(), text)
@@ -530,6 +653,17 @@ func getFullSignURL(repositoryType, installationID, githubRepositoryID, pullRequ
 	return fmt.Sprintf("%s/v2/repository-provider/%s/sign/%s/%s/%s/#/?version=2", apiBaseURL, repositoryType, installationID, githubRepositoryID, pullRequestID)
 }
 
+// missingCLAGroupSignURL returns the sign URL of the first CLA group missing for the commit authors, or the sign URL of
+// the repository CLA group when the changes are not bound to other CLA groups
+func missingCLAGroupSignURL(signURL string, missing []*UserCommitSummary) string {
+	for _, summary := range missing {
+		if len(summary.MissingCLAGroups) > 0 {
+			return clagroupbinding.SignURL(signURL, summary.MissingCLAGroups[0].ID)
+		}
+	}
+	return signURL
+}
+
 func getAuthorInfoCommits(userSummary []*UserCommitSummary, tagUser bool) map[string][]*UserCommitSummary {
 	f := logrus.Fields{
 		"functioName": "github.github_repository.getAuthorInfoCommits",
@@ -551,7 +685,7 @@ func getAuthorInfoCommits(userSummary []*UserCommitSummary, tagUser bool) map[st
 
 // GetRepositoryByExternalID finds github repository by github repository id
 func GetRepositoryByExternalID(ctx context.Context, installationID, id int64) (*github.Repository, error) {
-	client, err := NewGithubAppClient(installationID)
+	client, err := NewGithubAppClient(ctx, installationID)
 	if err != nil {
 		return nil, err
 	}
@@ -653,7 +787,7 @@ func GetReturnURL(ctx context.Context, installationID, repositoryID int64, pullR
 		"pullRequestID":  pullRequestID,
 	}
 
-	client, err := NewGithubAppClient(installationID)
+	client, err := NewGithubAppClient(ctx, installationID)
 
 	if err != nil {
 		log.WithFields(f).WithError(err).Warn("unable to create Github client")
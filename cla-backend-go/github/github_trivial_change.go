@@ -0,0 +1,154 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+// GetPullRequestFiles returns the changed files of the pull request with the number of added and deleted lines
+func GetPullRequestFiles(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]trivialchange.File, error) {
+	f := logrus.Fields{
+		"functionName":   "github.github_trivial_change.GetPullRequestFiles",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"owner":          owner,
+		"repo":           repo,
+		"pullRequestID":  pullRequestID,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return nil, err
+	}
+
+	var files []trivialchange.File
+	listOptions := &github.ListOptions{PerPage: 100}
+	for {
+		pageFiles, resp, listErr := client.PullRequests.ListFiles(ctx, owner, repo, pullRequestID, listOptions)
+		if listErr != nil {
+			log.WithFields(f).WithError(listErr).Warnf("problem listing files for repo: %s/%s pull request: %d", owner, repo, pullRequestID)
+			return nil, listErr
+		}
+		if resp.StatusCode != http.StatusOK {
+			msg := fmt.Sprintf("unexpected status code: %d - expected: %d", resp.StatusCode, http.StatusOK)
+			log.WithFields(f).Warn(msg)
+			return nil, errors.New(msg)
+		}
+
+		for _, file := range pageFiles {
+			files = append(files, trivialchange.File{
+				Path:         file.GetFilename(),
+				PreviousPath: file.GetPreviousFilename(),
+				Additions:    file.GetAdditions(),
+				Deletions:    file.GetDeletions(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOptions.Page = resp.NextPage
+	}
+
+	log.WithFields(f).Debugf("found %d changed files for pull request: %d", len(files), pullRequestID)
+	return files, nil
+}
+
+// UpdatePullRequestTrivialChange publishes a passing EasyCLA result, as a check run or a commit status, with the
+// description of the trivial change rule which exempts the pull request from the CLA check
+func UpdatePullRequestTrivialChange(ctx context.Context, installationID int64, pullRequestID int, owner, repo, latestSHA string, checkRun bool, description string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_trivial_change.UpdatePullRequestTrivialChange",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"SHA":            latestSHA,
+		"pullRequestID":  pullRequestID,
+		"checkRun":       checkRun,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	// the check run of the pull request can be re-run, the external ID identifies the pull request to recheck
+	return createTrivialChangeResult(ctx, f, client, owner, repo, latestSHA, checkRun, description, github.String(strconv.Itoa(pullRequestID)), CheckRunActions(nil))
+}
+
+// UpdateMergeGroupTrivialChange publishes a passing EasyCLA result, as a check run or a commit status, with the
+// description of the trivial change rule which exempts the merge group from the CLA check - the merge group has no pull
+// request, so the check run has no external ID and no actions
+func UpdateMergeGroupTrivialChange(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, description string) error {
+	f := logrus.Fields{
+		"functionName":   "github.github_trivial_change.UpdateMergeGroupTrivialChange",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"owner":          owner,
+		"repo":           repo,
+		"SHA":            headSHA,
+		"checkRun":       checkRun,
+	}
+
+	client, err := NewGithubAppClient(ctx, installationID)
+	if err != nil || client == nil {
+		log.WithFields(f).WithError(err).Warn("unable to create Github client")
+		return err
+	}
+
+	return createTrivialChangeResult(ctx, f, client, owner, repo, headSHA, checkRun, description, nil, nil)
+}
+
+func createTrivialChangeResult(ctx context.Context, f logrus.Fields, client *github.Client, owner, repo, sha string, checkRun bool, description string, externalID *string, actions []*github.CheckRunAction) error {
+	if checkRun {
+		log.WithFields(f).Debugf("creating trivial change check run - %s", description)
+		_, _, err := client.Checks.CreateCheckRun(ctx, owner, repo, github.CreateCheckRunOptions{
+			Name:        CheckRunName,
+			HeadSHA:     sha,
+			ExternalID:  externalID,
+			Status:      github.String(checkRunCompleted),
+			Conclusion:  github.String(checkRunSuccess),
+			CompletedAt: &github.Timestamp{Time: time.Now()},
+			Output: &github.CheckRunOutput{
+				Title:   github.String("EasyCLA check skipped - trivial change"),
+				Summary: &description,
+			},
+			Actions: actions,
+		})
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("unable to create check run")
+			return err
+		}
+		return nil
+	}
+
+	state := successState
+	statusContext := "EasyCLA"
+	status := Status{
+		State:       &state,
+		Context:     &statusContext,
+		Description: &description,
+	}
+
+	log.WithFields(f).Debugf("creating trivial change status: %+v", status)
+	if _, _, err := CreateStatus(ctx, client, owner, repo, sha, &status); err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to create status: %+v", status)
+		return err
+	}
+
+	return nil
+}
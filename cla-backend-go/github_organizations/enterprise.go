@@ -0,0 +1,136 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// NewEnterpriseAppLoader returns the loader of the apps of the GitHub Enterprise Server organizations, keyed by the
+// base URL and installation ID of the organization, along with the installation IDs of the github.com organizations
+func NewEnterpriseAppLoader(repo RepositoryInterface) github.EnterpriseAppLoader {
+	return func(ctx context.Context) (*github.EnterpriseApps, error) {
+		f := logrus.Fields{
+			"functionName":   "v1.github_organizations.enterprise.EnterpriseAppLoader",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		}
+
+		githubOrgs, err := repo.GetGitHubInstalledOrganizations(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		apps := &github.EnterpriseApps{
+			Apps:                  make(map[github.EnterpriseInstallation]*github.EnterpriseApp),
+			GitHubInstallationIDs: make(map[int64]bool),
+		}
+		for _, githubOrg := range githubOrgs {
+			if githubOrg.OrganizationInstallationID == 0 {
+				continue
+			}
+			if githubOrg.OrganizationBaseURL == "" {
+				apps.GitHubInstallationIDs[githubOrg.OrganizationInstallationID] = true
+				continue
+			}
+			privateKey, decryptErr := github.DecryptAppPrivateKey(githubOrg.OrganizationAppPrivateKey, config.GetConfig().GitHub.EnterpriseKey)
+			if decryptErr != nil {
+				log.WithFields(f).WithError(decryptErr).Warnf("unable to decrypt the app private key of the github organization: %s", githubOrg.OrganizationName)
+				continue
+			}
+			installation := github.EnterpriseInstallation{
+				BaseURL:        githubOrg.OrganizationBaseURL,
+				InstallationID: githubOrg.OrganizationInstallationID,
+			}
+			apps.Apps[installation] = &github.EnterpriseApp{
+				BaseURL:       githubOrg.OrganizationBaseURL,
+				AppID:         githubOrg.OrganizationAppID,
+				AppPrivateKey: privateKey,
+			}
+		}
+		log.WithFields(f).Debugf("loaded %d github enterprise apps and %d github.com installations", len(apps.Apps), len(apps.GitHubInstallationIDs))
+		return apps, nil
+	}
+}
+
+// PrepareEnterpriseOrganization validates the GitHub Enterprise Server instance and app of the organization and
+// encrypts the app private key before the organization is stored - the github.com organizations are left unchanged
+func PrepareEnterpriseOrganization(ctx context.Context, repo RepositoryInterface, input *models.GithubCreateOrganization) error {
+	organizationName := utils.StringValue(input.OrganizationName)
+	f := logrus.Fields{
+		"functionName":     "v1.github_organizations.enterprise.PrepareEnterpriseOrganization",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": organizationName,
+		"baseURL":          input.GithubBaseURL,
+		"appID":            input.GithubAppID,
+		"installationID":   input.InstallationID,
+	}
+
+	if input.GithubBaseURL == "" {
+		// the installation of the github.com organizations is set by the installation webhook
+		if input.GithubAppID != 0 || input.GithubAppPrivateKey != "" || input.InstallationID != 0 {
+			return errors.New("the github app and installation are only registered for the github enterprise organizations")
+		}
+		return nil
+	}
+
+	baseURL, err := github.NormalizeEnterpriseBaseURL(input.GithubBaseURL)
+	if err != nil {
+		return err
+	}
+	if input.GithubAppID == 0 || input.GithubAppPrivateKey == "" || input.InstallationID == 0 {
+		return errors.New("the github app id, app private key and installation id are required for the github enterprise organizations")
+	}
+	enterpriseKey := config.GetConfig().GitHub.EnterpriseKey
+	if enterpriseKey == "" {
+		return errors.New("github enterprise organizations are not supported - the github enterprise key is not configured")
+	}
+
+	// the calls without a known host are routed by the installation ID, it can't be shared with another organization,
+	// whether it is hosted on github.com or on a github enterprise instance
+	githubOrgs, err := repo.GetGitHubInstalledOrganizations(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the installed github organizations")
+		return err
+	}
+	for _, githubOrg := range githubOrgs {
+		if githubOrg.OrganizationInstallationID == input.InstallationID && !strings.EqualFold(githubOrg.OrganizationName, organizationName) {
+			return fmt.Errorf("installation id: %d is already used by the github organization: %s", input.InstallationID, githubOrg.OrganizationName)
+		}
+	}
+
+	app := &github.EnterpriseApp{
+		BaseURL:       baseURL,
+		AppID:         input.GithubAppID,
+		AppPrivateKey: input.GithubAppPrivateKey,
+	}
+	installation, err := github.GetEnterpriseInstallation(ctx, app, input.InstallationID)
+	if err != nil {
+		return fmt.Errorf("unable to load the installation: %d of the github app: %d on %s - %v", input.InstallationID, input.GithubAppID, baseURL, err)
+	}
+	if !strings.EqualFold(installation.GetAccount().GetLogin(), organizationName) {
+		return fmt.Errorf("installation id: %d belongs to: %s, not to the github organization: %s",
+			input.InstallationID, installation.GetAccount().GetLogin(), organizationName)
+	}
+
+	encryptedKey, err := github.EncryptAppPrivateKey(input.GithubAppPrivateKey, enterpriseKey)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to encrypt the github app private key")
+		return err
+	}
+
+	log.WithFields(f).Debugf("validated the github enterprise installation of the organization on %s", baseURL)
+	input.GithubBaseURL = baseURL
+	input.GithubAppPrivateKey = encryptedKey
+	return nil
+}
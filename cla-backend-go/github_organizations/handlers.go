@@ -60,9 +60,12 @@ func Configure(api *operations.ClaAPI, service ServiceInterface, eventService ev
 				})
 			}
 
-			_, err := github.GetOrganization(ctx, *params.Body.OrganizationName)
-			if err != nil {
-				return github_organizations.NewAddProjectGithubOrganizationNotFound().WithPayload(errorResponse(err))
+			// the GitHub Enterprise Server organizations are validated with their app installation by the service
+			if params.Body.GithubBaseURL == "" {
+				_, err := github.GetOrganization(ctx, *params.Body.OrganizationName)
+				if err != nil {
+					return github_organizations.NewAddProjectGithubOrganizationNotFound().WithPayload(errorResponse(err))
+				}
 			}
 
 			result, err := service.AddGitHubOrganization(ctx, params.ProjectSFID, params.Body)
@@ -109,12 +112,16 @@ func Configure(api *operations.ClaAPI, service ServiceInterface, eventService ev
 			reqID := utils.GetRequestID(params.XREQUESTID)
 			ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID) // nolint
 
-			_, err := github.GetOrganization(ctx, params.OrgName)
-			if err != nil {
-				return github_organizations.NewDeleteProjectGithubOrganizationNotFound().WithPayload(errorResponse(err))
+			// the GitHub Enterprise Server organizations are not found on github.com
+			ghOrg, getErr := service.GetGitHubOrganizationByName(ctx, params.OrgName)
+			if getErr != nil || ghOrg == nil || ghOrg.GithubBaseURL == "" {
+				_, err := github.GetOrganization(ctx, params.OrgName)
+				if err != nil {
+					return github_organizations.NewDeleteProjectGithubOrganizationNotFound().WithPayload(errorResponse(err))
+				}
 			}
 
-			err = service.DeleteGitHubOrganization(ctx, params.ProjectSFID, params.OrgName)
+			err := service.DeleteGitHubOrganization(ctx, params.ProjectSFID, params.OrgName)
 			if err != nil {
 				if _, ok := err.(*v2ProjectServiceClient.GetProjectNotFound); ok {
 					return github_organizations.NewDeleteProjectGithubOrganizationNotFound().WithXRequestID(reqID).WithPayload(&models.ErrorResponse{
@@ -163,14 +170,22 @@ func Configure(api *operations.ClaAPI, service ServiceInterface, eventService ev
 				return github_organizations.NewUpdateProjectGithubOrganizationConfigBadRequest().WithPayload(errorResponse(err))
 			}
 
+			if params.Body.BranchProtectionAutoRemediation != nil {
+				err = service.UpdateGitHubOrganizationAutoRemediation(ctx, params.OrgName, *params.Body.BranchProtectionAutoRemediation)
+				if err != nil {
+					return github_organizations.NewUpdateProjectGithubOrganizationConfigBadRequest().WithPayload(errorResponse(err))
+				}
+			}
+
 			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
 				UserID:      claUser.UserID,
 				EventType:   events.GitHubOrganizationUpdated,
 				ProjectSFID: params.ProjectSFID,
 				LfUsername:  claUser.LFUsername,
 				EventData: &events.GitHubOrganizationUpdatedEventData{
-					GitHubOrganizationName: params.OrgName,
-					AutoEnabled:            *params.Body.AutoEnabled,
+					GitHubOrganizationName:          params.OrgName,
+					AutoEnabled:                     *params.Body.AutoEnabled,
+					BranchProtectionAutoRemediation: params.Body.BranchProtectionAutoRemediation,
 				},
 			})
 
@@ -35,8 +35,17 @@ func buildGithubOrganizationListModels(ctx context.Context, githubOrganizations
 				defer wg.Done()
 				ghorg.GithubInfo = &models.GithubOrganizationGithubInfo{}
 				log.WithFields(f).Debugf("loading GitHub organization details: %s...", ghorg.OrganizationName)
-				user, err := github.GetUserDetails(ghorg.OrganizationName)
-				if err != nil {
+				if ghorg.GithubBaseURL != "" {
+					// the user details API is on github.com, the links of the GitHub Enterprise Server organizations
+					// are built from the instance URL
+					url := strfmt.URI(github.HTMLURL(ghorg.GithubBaseURL, ghorg.OrganizationName))
+					installationURL := strfmt.URI(github.HTMLURL(ghorg.GithubBaseURL,
+						fmt.Sprintf("organizations/%s/settings/installations/%d", ghorg.OrganizationName, ghorg.OrganizationInstallationID)))
+					ghorg.GithubInfo.Details = &models.GithubOrganizationGithubInfoDetails{
+						HTMLURL:         &url,
+						InstallationURL: &installationURL,
+					}
+				} else if user, err := github.GetUserDetails(ghorg.OrganizationName); err != nil {
 					ghorg.GithubInfo.Error = err.Error()
 				} else {
 					url := strfmt.URI(*user.HTMLURL)
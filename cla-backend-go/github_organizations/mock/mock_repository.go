@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	github_organizations "github.com/communitybridge/easycla/cla-backend-go/github_organizations"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -82,6 +83,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) DeleteGitHubOrganizationByParent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitHubOrganizationByParent", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteGitHubOrganizationByParent), ctx, parentProjectSFID, githubOrgName)
 }
 
+// GetGitHubBranchProtectedOrganizations mocks base method.
+func (m *MockRepositoryInterface) GetGitHubBranchProtectedOrganizations(ctx context.Context) ([]*github_organizations.GithubOrganization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGitHubBranchProtectedOrganizations", ctx)
+	ret0, _ := ret[0].([]*github_organizations.GithubOrganization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGitHubBranchProtectedOrganizations indicates an expected call of GetGitHubBranchProtectedOrganizations.
+func (mr *MockRepositoryInterfaceMockRecorder) GetGitHubBranchProtectedOrganizations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitHubBranchProtectedOrganizations", reflect.TypeOf((*MockRepositoryInterface)(nil).GetGitHubBranchProtectedOrganizations), ctx)
+}
+
+// GetGitHubInstalledOrganizations mocks base method.
+func (m *MockRepositoryInterface) GetGitHubInstalledOrganizations(ctx context.Context) ([]*github_organizations.GithubOrganization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGitHubInstalledOrganizations", ctx)
+	ret0, _ := ret[0].([]*github_organizations.GithubOrganization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGitHubInstalledOrganizations indicates an expected call of GetGitHubInstalledOrganizations.
+func (mr *MockRepositoryInterfaceMockRecorder) GetGitHubInstalledOrganizations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitHubInstalledOrganizations", reflect.TypeOf((*MockRepositoryInterface)(nil).GetGitHubInstalledOrganizations), ctx)
+}
+
 // GetGitHubOrganization mocks base method.
 func (m *MockRepositoryInterface) GetGitHubOrganization(ctx context.Context, githubOrganizationName string) (*models.GithubOrganization, error) {
 	m.ctrl.T.Helper()
@@ -155,3 +186,31 @@ func (mr *MockRepositoryInterfaceMockRecorder) UpdateGitHubOrganization(ctx, pro
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGitHubOrganization", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateGitHubOrganization), ctx, projectSFID, organizationName, autoEnabled, autoEnabledClaGroupID, branchProtectionEnabled, enabled)
 }
+
+// UpdateGitHubOrganizationAutoRemediation mocks base method.
+func (m *MockRepositoryInterface) UpdateGitHubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGitHubOrganizationAutoRemediation", ctx, organizationName, autoRemediation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGitHubOrganizationAutoRemediation indicates an expected call of UpdateGitHubOrganizationAutoRemediation.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateGitHubOrganizationAutoRemediation(ctx, organizationName, autoRemediation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGitHubOrganizationAutoRemediation", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateGitHubOrganizationAutoRemediation), ctx, organizationName, autoRemediation)
+}
+
+// UpdateGitHubOrganizationInstallation mocks base method.
+func (m *MockRepositoryInterface) UpdateGitHubOrganizationInstallation(ctx context.Context, organizationName string, installationID int64, suspended bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGitHubOrganizationInstallation", ctx, organizationName, installationID, suspended)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGitHubOrganizationInstallation indicates an expected call of UpdateGitHubOrganizationInstallation.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateGitHubOrganizationInstallation(ctx, organizationName, installationID, suspended interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGitHubOrganizationInstallation", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateGitHubOrganizationInstallation), ctx, organizationName, installationID, suspended)
+}
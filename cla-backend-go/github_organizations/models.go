@@ -19,22 +19,33 @@ type GithubOrganization struct {
 	BranchProtectionEnabled    bool   `json:"branch_protection_enabled"`
 	AutoEnabledClaGroupID      string `json:"auto_enabled_cla_group_id,omitempty"`
 	Version                    string `json:"version,omitempty"`
+	// re-apply the branch protection when the scheduled audit finds it was removed or changed
+	BranchProtectionAutoRemediation bool `json:"branch_protection_auto_remediation"`
+	// the app installation was suspended on the organization, GitHub sends no events until it is unsuspended
+	InstallationSuspended bool `json:"installation_suspended"`
+	// the GitHub Enterprise Server instance and the app registered on it - empty for the github.com organizations
+	OrganizationBaseURL       string `json:"organization_base_url,omitempty"`
+	OrganizationAppID         int64  `json:"organization_app_id,omitempty"`
+	OrganizationAppPrivateKey string `json:"organization_app_private_key,omitempty"` // encrypted
 }
 
 // ToModel converts to models.GithubOrganization
 func ToModel(in *GithubOrganization) *models.GithubOrganization {
 	return &models.GithubOrganization{
-		DateCreated:                in.DateCreated,
-		DateModified:               in.DateModified,
-		OrganizationInstallationID: in.OrganizationInstallationID,
-		OrganizationName:           in.OrganizationName,
-		OrganizationSfid:           in.OrganizationSFID,
-		Version:                    in.Version,
-		Enabled:                    in.Enabled,
-		AutoEnabled:                in.AutoEnabled,
-		AutoEnabledClaGroupID:      in.AutoEnabledClaGroupID,
-		BranchProtectionEnabled:    in.BranchProtectionEnabled,
-		ProjectSFID:                in.ProjectSFID,
+		DateCreated:                     in.DateCreated,
+		DateModified:                    in.DateModified,
+		OrganizationInstallationID:      in.OrganizationInstallationID,
+		OrganizationName:                in.OrganizationName,
+		OrganizationSfid:                in.OrganizationSFID,
+		Version:                         in.Version,
+		Enabled:                         in.Enabled,
+		AutoEnabled:                     in.AutoEnabled,
+		AutoEnabledClaGroupID:           in.AutoEnabledClaGroupID,
+		BranchProtectionEnabled:         in.BranchProtectionEnabled,
+		ProjectSFID:                     in.ProjectSFID,
+		GithubBaseURL:                   in.OrganizationBaseURL,
+		BranchProtectionAutoRemediation: in.BranchProtectionAutoRemediation,
+		InstallationSuspended:           in.InstallationSuspended,
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 )
 
@@ -42,7 +44,11 @@ type RepositoryInterface interface {
 	GetGitHubOrganizationsByParent(ctx context.Context, parentProjectSFID string) (*models.GithubOrganizations, error)
 	GetGitHubOrganization(ctx context.Context, githubOrganizationName string) (*models.GithubOrganization, error)
 	GetGitHubOrganizationByName(ctx context.Context, githubOrganizationName string) (*models.GithubOrganizations, error)
+	GetGitHubInstalledOrganizations(ctx context.Context) ([]*GithubOrganization, error)
+	GetGitHubBranchProtectedOrganizations(ctx context.Context) ([]*GithubOrganization, error)
 	UpdateGitHubOrganization(ctx context.Context, projectSFID string, organizationName string, autoEnabled bool, autoEnabledClaGroupID string, branchProtectionEnabled bool, enabled *bool) error
+	UpdateGitHubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error
+	UpdateGitHubOrganizationInstallation(ctx context.Context, organizationName string, installationID int64, suspended bool) error
 	DeleteGitHubOrganization(ctx context.Context, projectSFID string, githubOrgName string) error
 	DeleteGitHubOrganizationByParent(ctx context.Context, parentProjectSFID string, githubOrgName string) error
 }
@@ -98,6 +104,14 @@ func (repo Repository) AddGitHubOrganization(ctx context.Context, parentProjectS
 		len(existingRecord.List) == 1 &&
 		parentProjectSFID == existingRecord.List[0].OrganizationSfid {
 
+		// the organization name is the table key, the same name can't be registered on another GitHub host
+		if existingRecord.List[0].GithubBaseURL != input.GithubBaseURL {
+			msg := fmt.Sprintf("github organization %s is already registered on %s", utils.StringValue(input.OrganizationName),
+				github.HTMLURL(existingRecord.List[0].GithubBaseURL, ""))
+			log.WithFields(f).Warn(msg)
+			return nil, errors.New(msg)
+		}
+
 		// These are our rules for updating
 		autoEnabled := existingRecord.List[0].AutoEnabled || utils.BoolValue(input.AutoEnabled)
 		branchProtectionEnabled := existingRecord.List[0].BranchProtectionEnabled || utils.BoolValue(input.BranchProtectionEnabled)
@@ -123,6 +137,13 @@ func (repo Repository) AddGitHubOrganization(ctx context.Context, parentProjectS
 			log.WithFields(f).WithError(updateErr).Warn("unable to update existing github organization record")
 			return nil, updateErr
 		}
+		if utils.BoolValue(input.BranchProtectionAutoRemediation) && !existingRecord.List[0].BranchProtectionAutoRemediation {
+			updateErr = repo.UpdateGitHubOrganizationAutoRemediation(ctx, utils.StringValue(input.OrganizationName), true)
+			if updateErr != nil {
+				log.WithFields(f).WithError(updateErr).Warn("unable to update existing github organization record")
+				return nil, updateErr
+			}
+		}
 
 		// we could simply update the record we initially loaded or simply query the updated record again...
 		// we're using a key lookup, so it should be fast...
@@ -147,18 +168,22 @@ func (repo Repository) AddGitHubOrganization(ctx context.Context, parentProjectS
 	_, currentTime := utils.CurrentTime()
 	enabled := true
 	githubOrg := &GithubOrganization{
-		DateCreated:                currentTime,
-		DateModified:               currentTime,
-		OrganizationInstallationID: 0,
-		OrganizationName:           *input.OrganizationName,
-		OrganizationNameLower:      strings.ToLower(*input.OrganizationName),
-		OrganizationSFID:           parentProjectSFID,
-		ProjectSFID:                projectSFID,
-		Enabled:                    aws.BoolValue(&enabled),
-		AutoEnabled:                aws.BoolValue(input.AutoEnabled),
-		AutoEnabledClaGroupID:      input.AutoEnabledClaGroupID,
-		BranchProtectionEnabled:    aws.BoolValue(input.BranchProtectionEnabled),
-		Version:                    "v1",
+		DateCreated:                     currentTime,
+		DateModified:                    currentTime,
+		OrganizationInstallationID:      input.InstallationID,
+		OrganizationName:                *input.OrganizationName,
+		OrganizationNameLower:           strings.ToLower(*input.OrganizationName),
+		OrganizationSFID:                parentProjectSFID,
+		ProjectSFID:                     projectSFID,
+		Enabled:                         aws.BoolValue(&enabled),
+		AutoEnabled:                     aws.BoolValue(input.AutoEnabled),
+		AutoEnabledClaGroupID:           input.AutoEnabledClaGroupID,
+		BranchProtectionEnabled:         aws.BoolValue(input.BranchProtectionEnabled),
+		Version:                         "v1",
+		OrganizationBaseURL:             input.GithubBaseURL,
+		OrganizationAppID:               input.GithubAppID,
+		OrganizationAppPrivateKey:       input.GithubAppPrivateKey,
+		BranchProtectionAutoRemediation: aws.BoolValue(input.BranchProtectionAutoRemediation),
 	}
 
 	log.WithFields(f).Debug("Encoding github organization record for adding to the database...")
@@ -297,6 +322,72 @@ func (repo Repository) GetGitHubOrganizationsByParent(ctx context.Context, paren
 	return &models.GithubOrganizations{List: ghOrgList}, nil
 }
 
+// GetGitHubInstalledOrganizations returns the github organizations with an app installation, on github.com or on a
+// GitHub Enterprise Server instance, including the encrypted app private keys of the enterprise organizations
+func (repo Repository) GetGitHubInstalledOrganizations(ctx context.Context) ([]*GithubOrganization, error) {
+	f := logrus.Fields{
+		"functionName":   "v1.github_organizations.repository.GetGitHubInstalledOrganizations",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"tableName":      repo.githubOrgTableName,
+	}
+
+	filter := expression.AttributeExists(expression.Name("organization_installation_id")).
+		Or(expression.AttributeExists(expression.Name("organization_base_url")))
+	return repo.scanGitHubOrganizations(ctx, f, filter)
+}
+
+// GetGitHubBranchProtectedOrganizations returns the enabled github organizations configured to setup the branch
+// protection on their CLA enabled repositories
+func (repo Repository) GetGitHubBranchProtectedOrganizations(ctx context.Context) ([]*GithubOrganization, error) {
+	f := logrus.Fields{
+		"functionName":   "v1.github_organizations.repository.GetGitHubBranchProtectedOrganizations",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"tableName":      repo.githubOrgTableName,
+	}
+
+	filter := expression.Name("enabled").Equal(expression.Value(true)).
+		And(expression.Name("branch_protection_enabled").Equal(expression.Value(true)))
+	return repo.scanGitHubOrganizations(ctx, f, filter)
+}
+
+// scanGitHubOrganizations returns the github organization records matching the filter
+func (repo Repository) scanGitHubOrganizations(ctx context.Context, f logrus.Fields, filter expression.ConditionBuilder) ([]*GithubOrganization, error) {
+	expr, err := expression.NewBuilder().WithFilter(filter).Build()
+	if err != nil {
+		log.WithFields(f).Warnf("problem building scan expression, error: %+v", err)
+		return nil, err
+	}
+
+	scanInput := &dynamodb.ScanInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		TableName:                 aws.String(repo.githubOrgTableName),
+	}
+	var resultList []map[string]*dynamodb.AttributeValue
+	for {
+		results, err := repo.dynamoDBClient.Scan(scanInput)
+		if err != nil {
+			log.WithFields(f).Warnf("error retrieving the github organizations, error: %v", err)
+			return nil, err
+		}
+		resultList = append(resultList, results.Items...)
+		if len(results.LastEvaluatedKey) == 0 {
+			break
+		}
+		scanInput.ExclusiveStartKey = results.LastEvaluatedKey
+	}
+
+	var output []*GithubOrganization
+	err = dynamodbattribute.UnmarshalListOfMaps(resultList, &output)
+	if err != nil {
+		log.WithFields(f).Warnf("error unmarshalling the github organizations, error: %v", err)
+		return nil, err
+	}
+	log.WithFields(f).Debugf("loaded %d github organizations", len(output))
+	return output, nil
+}
+
 // GetGitHubOrganizationByName get github organization by name
 func (repo Repository) GetGitHubOrganizationByName(ctx context.Context, githubOrganizationName string) (*models.GithubOrganizations, error) {
 	f := logrus.Fields{
@@ -506,6 +597,122 @@ func (repo Repository) UpdateGitHubOrganization(ctx context.Context, projectSFID
 	return nil
 }
 
+// UpdateGitHubOrganizationAutoRemediation sets whether the scheduled branch protection audit re-applies the branch
+// protection of the organization repositories
+func (repo Repository) UpdateGitHubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error {
+	f := logrus.Fields{
+		"functionName":     "v1.github_organizations.repository.UpdateGitHubOrganizationAutoRemediation",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": organizationName,
+		"autoRemediation":  autoRemediation,
+		"tableName":        repo.githubOrgTableName,
+	}
+
+	githubOrg, lookupErr := repo.GetGitHubOrganization(ctx, organizationName)
+	if lookupErr != nil {
+		log.WithFields(f).Warnf("error looking up GitHub organization by name, error: %+v", lookupErr)
+		return lookupErr
+	}
+	if githubOrg == nil {
+		return ErrOrganizationDoesNotExist
+	}
+
+	_, currentTime := utils.CurrentTime()
+	input := &dynamodb.UpdateItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"organization_name": {
+				S: aws.String(githubOrg.OrganizationName),
+			},
+		},
+		ExpressionAttributeNames: map[string]*string{
+			"#R": aws.String("branch_protection_auto_remediation"),
+			"#M": aws.String("date_modified"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":r": {
+				BOOL: aws.Bool(autoRemediation),
+			},
+			":m": {
+				S: aws.String(currentTime),
+			},
+		},
+		UpdateExpression: aws.String("SET #R = :r, #M = :m"),
+		TableName:        aws.String(repo.githubOrgTableName),
+	}
+
+	_, updateErr := repo.dynamoDBClient.UpdateItem(input)
+	if updateErr != nil {
+		log.WithFields(f).Warnf("unable to update GitHub organization record, error: %+v", updateErr)
+		return updateErr
+	}
+
+	return nil
+}
+
+// UpdateGitHubOrganizationInstallation updates the app installation of the github organization, a zero installation ID
+// removes the installation from the organization record
+func (repo Repository) UpdateGitHubOrganizationInstallation(ctx context.Context, organizationName string, installationID int64, suspended bool) error {
+	f := logrus.Fields{
+		"functionName":     "v1.github_organizations.repository.UpdateGitHubOrganizationInstallation",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": organizationName,
+		"installationID":   installationID,
+		"suspended":        suspended,
+		"tableName":        repo.githubOrgTableName,
+	}
+
+	githubOrg, lookupErr := repo.GetGitHubOrganization(ctx, organizationName)
+	if lookupErr != nil {
+		log.WithFields(f).Warnf("error looking up GitHub organization by name, error: %+v", lookupErr)
+		return lookupErr
+	}
+	if githubOrg == nil {
+		return ErrOrganizationDoesNotExist
+	}
+
+	_, currentTime := utils.CurrentTime()
+	expressionAttributeNames := map[string]*string{
+		"#I": aws.String("organization_installation_id"),
+		"#S": aws.String("installation_suspended"),
+		"#M": aws.String("date_modified"),
+	}
+	expressionAttributeValues := map[string]*dynamodb.AttributeValue{
+		":s": {
+			BOOL: aws.Bool(suspended),
+		},
+		":m": {
+			S: aws.String(currentTime),
+		},
+	}
+	updateExpression := "SET #S = :s, #M = :m REMOVE #I"
+	if installationID != 0 {
+		expressionAttributeValues[":i"] = &dynamodb.AttributeValue{
+			N: aws.String(strconv.FormatInt(installationID, 10)),
+		}
+		updateExpression = "SET #I = :i, #S = :s, #M = :m"
+	}
+
+	input := &dynamodb.UpdateItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"organization_name": {
+				S: aws.String(githubOrg.OrganizationName),
+			},
+		},
+		ExpressionAttributeNames:  expressionAttributeNames,
+		ExpressionAttributeValues: expressionAttributeValues,
+		UpdateExpression:          aws.String(updateExpression),
+		TableName:                 aws.String(repo.githubOrgTableName),
+	}
+
+	_, updateErr := repo.dynamoDBClient.UpdateItem(input)
+	if updateErr != nil {
+		log.WithFields(f).Warnf("unable to update GitHub organization record, error: %+v", updateErr)
+		return updateErr
+	}
+
+	return nil
+}
+
 // DeleteGitHubOrganization deletes the github organization by project SFID
 func (repo Repository) DeleteGitHubOrganization(ctx context.Context, projectSFID string, githubOrgName string) error {
 	f := logrus.Fields{
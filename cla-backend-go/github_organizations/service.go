@@ -16,6 +16,7 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
 	"github.com/communitybridge/easycla/cla-backend-go/repositories"
 )
 
@@ -26,6 +27,7 @@ type ServiceInterface interface {
 	GetGitHubOrganizationsByParent(ctx context.Context, parentProjectSFID string) (*models.GithubOrganizations, error)
 	GetGitHubOrganizationByName(ctx context.Context, githubOrgName string) (*models.GithubOrganization, error)
 	UpdateGitHubOrganization(ctx context.Context, projectSFID string, organizationName string, autoEnabled bool, autoEnabledClaGroupID string, branchProtectionEnabled bool) error
+	UpdateGitHubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error
 	DeleteGitHubOrganization(ctx context.Context, projectSFID string, githubOrgName string) error
 	RemoveDuplicates(input []*models.GithubOrganization) []*models.GithubOrganization
 }
@@ -73,7 +75,19 @@ func (s Service) AddGitHubOrganization(ctx context.Context, projectSFID string,
 		}
 	}
 
-	return s.repo.AddGitHubOrganization(ctx, parentProjectSFID, projectSFID, input)
+	if err := PrepareEnterpriseOrganization(ctx, s.repo, input); err != nil {
+		log.WithFields(f).WithError(err).Warn("problem validating the github enterprise organization")
+		return nil, err
+	}
+
+	resp, err := s.repo.AddGitHubOrganization(ctx, parentProjectSFID, projectSFID, input)
+	if err != nil {
+		return nil, err
+	}
+	if input.GithubBaseURL != "" {
+		github.ResetEnterpriseApps()
+	}
+	return resp, nil
 }
 
 // GetGitHubOrganizations returns the GitHub organization for the specified project
@@ -148,6 +162,12 @@ func (s Service) UpdateGitHubOrganization(ctx context.Context, projectSFID strin
 	return s.repo.UpdateGitHubOrganization(ctx, projectSFID, organizationName, autoEnabled, autoEnabledClaGroupID, branchProtectionEnabled, nil)
 }
 
+// UpdateGitHubOrganizationAutoRemediation sets whether the scheduled branch protection audit re-applies the branch
+// protection of the github organization repositories
+func (s Service) UpdateGitHubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error {
+	return s.repo.UpdateGitHubOrganizationAutoRemediation(ctx, organizationName, autoRemediation)
+}
+
 // DeleteGitHubOrganization removes the specified github organization under the projectSFID
 func (s Service) DeleteGitHubOrganization(ctx context.Context, projectSFID string, githubOrgName string) error {
 	f := logrus.Fields{
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/communitybridge/easycla/cla-backend-go/dco"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
@@ -44,6 +46,50 @@ func GetLatestCommit(client *gitlab.Client, projectID int, mergeID int) (*gitlab
 	return commits[0], nil
 }
 
+// FetchMrCommits returns the commits of the merge request
+func FetchMrCommits(client *gitlab.Client, projectID int, mergeID int) ([]*gitlab.Commit, error) {
+	commits, response, err := client.MergeRequests.GetMergeRequestCommits(projectID, mergeID, &gitlab.GetMergeRequestCommitsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("fetching merge request commits : %d for project : %v failed : %v", mergeID, projectID, err)
+	}
+	if response.StatusCode != 200 {
+		return nil, fmt.Errorf("fetching merge request commits for project : %d and merge id : %d, failed with status code : %d", projectID, mergeID, response.StatusCode)
+	}
+
+	return commits, nil
+}
+
+// FetchMrFiles returns the changed files of the merge request with the number of added and deleted lines
+func FetchMrFiles(client *gitlab.Client, projectID int, mergeID int) ([]trivialchange.File, error) {
+	mr, response, err := client.MergeRequests.GetMergeRequestChanges(projectID, mergeID, &gitlab.GetMergeRequestChangesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("fetching merge request changes : %d for project : %v failed : %v", mergeID, projectID, err)
+	}
+	if response.StatusCode != 200 {
+		return nil, fmt.Errorf("fetching merge request changes for project : %d and merge id : %d, failed with status code : %d", projectID, mergeID, response.StatusCode)
+	}
+	// the changes are truncated when the merge request is over the GitLab diff limits
+	if mr.Overflow {
+		return nil, fmt.Errorf("merge request changes for project : %d and merge id : %d are over the diff limits", projectID, mergeID)
+	}
+
+	files := make([]trivialchange.File, 0, len(mr.Changes))
+	for _, change := range mr.Changes {
+		additions, deletions := trivialchange.CountDiffLines(change.Diff)
+		file := trivialchange.File{
+			Path:      change.NewPath,
+			Additions: additions,
+			Deletions: deletions,
+		}
+		if change.RenamedFile {
+			file.PreviousPath = change.OldPath
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
 // FetchMrParticipants is responsible to get unique mr participants
 func FetchMrParticipants(client *gitlab.Client, projectID int, mergeID int) ([]*gitlab.User, error) {
 	f := logrus.Fields{
@@ -132,7 +178,7 @@ func SetMrComment(client *gitlab.Client, projectID int, mergeID int, message str
 
 	if len(notes) > 0 {
 		for _, n := range notes {
-			if strings.Contains(n.Body, "cla-signed.svg") || strings.Contains(n.Body, "cla-not-signed.svg") || strings.Contains(n.Body, "cla-missing-id.svg") || strings.Contains(n.Body, "cla-confirmation-needed.svg") {
+			if strings.Contains(n.Body, "cla-signed.svg") || strings.Contains(n.Body, "cla-not-signed.svg") || strings.Contains(n.Body, "cla-missing-id.svg") || strings.Contains(n.Body, "cla-confirmation-needed.svg") || strings.Contains(n.Body, dco.CommentMarker) {
 				previousNote = n
 				break
 			}
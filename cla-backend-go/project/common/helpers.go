@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	models2 "github.com/communitybridge/easycla/cla-backend-go/project/models"
@@ -16,6 +17,7 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 )
 
@@ -69,6 +71,51 @@ func BuildCLAGroupDocumentModels(dbDocumentModels []models2.DBProjectDocumentMod
 	return response
 }
 
+// BuildCLAGroupCounterSignerModels builds response models based on the array of counter signer db models
+func BuildCLAGroupCounterSignerModels(dbCounterSigners []models2.DBProjectCounterSigner) []*models.ClaGroupCounterSigner {
+	var response []*models.ClaGroupCounterSigner
+	for _, dbCounterSigner := range dbCounterSigners {
+		response = append(response, &models.ClaGroupCounterSigner{
+			Name:  dbCounterSigner.Name,
+			Email: strfmt.Email(dbCounterSigner.Email),
+		})
+	}
+
+	return response
+}
+
+// BuildCLAGroupCounterSignerAttributes builds the DynamoDB list attribute values for the counter signers
+func BuildCLAGroupCounterSignerAttributes(counterSigners []*models.ClaGroupCounterSigner) []*dynamodb.AttributeValue {
+	attributes := []*dynamodb.AttributeValue{}
+	for _, counterSigner := range counterSigners {
+		if counterSigner == nil {
+			continue
+		}
+		attributes = append(attributes, &dynamodb.AttributeValue{
+			M: map[string]*dynamodb.AttributeValue{
+				"name":  {S: aws.String(counterSigner.Name)},
+				"email": {S: aws.String(counterSigner.Email.String())},
+			},
+		})
+	}
+
+	return attributes
+}
+
+// AreCLAGroupCounterSignersEqual returns true if both counter signer lists have the same signers in the same order
+func AreCLAGroupCounterSignersEqual(a, b []*models.ClaGroupCounterSigner) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !strings.EqualFold(a[i].Email.String(), b[i].Email.String()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // GetCurrentDocument returns the current document based on the version and date/time
 func GetCurrentDocument(ctx context.Context, docs []models.ClaGroupDocument) (models.ClaGroupDocument, error) {
 	f := logrus.Fields{
@@ -24,12 +24,28 @@ type DBProjectModel struct {
 	ProjectCclaRequiresIclaSignature bool                     `dynamodbav:"project_ccla_requires_icla_signature"`
 	ProjectIclaEnabled               bool                     `dynamodbav:"project_icla_enabled"`
 	ProjectLive                      bool                     `dynamodbav:"project_live"`
+	ProjectSignatureProvider         string                   `dynamodbav:"project_signature_provider"`
+	ProjectCclaSignatoryReminderDays int64                    `dynamodbav:"project_ccla_signatory_reminder_days"`
+	ProjectCclaSignatoryExpiryDays   int64                    `dynamodbav:"project_ccla_signatory_expiry_days"`
+	ProjectCclaCounterSigners        []DBProjectCounterSigner `dynamodbav:"project_ccla_counter_signers"`
+	ProjectResignPolicy              string                   `dynamodbav:"project_resign_policy"`
+	ProjectResignGraceDays           int64                    `dynamodbav:"project_resign_grace_days"`
+	ProjectSignatureTermDays         int64                    `dynamodbav:"project_signature_term_days"`
+	ProjectSignatureExpiryNoticeDays int64                    `dynamodbav:"project_signature_expiry_notice_days"`
+	ProjectCoAuthorPolicy            string                   `dynamodbav:"project_co_author_policy"`
+	ProjectEnforcementMode           string                   `dynamodbav:"project_enforcement_mode"`
 	ProjectCorporateDocuments        []DBProjectDocumentModel `dynamodbav:"project_corporate_documents"`
 	ProjectIndividualDocuments       []DBProjectDocumentModel `dynamodbav:"project_individual_documents"`
 	ProjectMemberDocuments           []DBProjectDocumentModel `dynamodbav:"project_member_documents"`
 	ProjectACL                       []string                 `dynamodbav:"project_acl"`
 }
 
+// DBProjectCounterSigner is a data model for the CLA Group corporate CLA counter signers
+type DBProjectCounterSigner struct {
+	Name  string `dynamodbav:"name"`
+	Email string `dynamodbav:"email"`
+}
+
 // DBProjectDocumentModel is a data model for the CLA Group Project documents
 type DBProjectDocumentModel struct {
 	DocumentName            string                 `dynamodbav:"document_name"`
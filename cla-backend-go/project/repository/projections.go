@@ -21,6 +21,16 @@ func buildProjection() expression.ProjectionBuilder {
 		expression.Name("project_icla_enabled"),
 		expression.Name("project_ccla_requires_icla_signature"),
 		expression.Name("project_live"),
+		expression.Name("project_signature_provider"),
+		expression.Name("project_ccla_signatory_reminder_days"),
+		expression.Name("project_ccla_signatory_expiry_days"),
+		expression.Name("project_ccla_counter_signers"),
+		expression.Name("project_resign_policy"),
+		expression.Name("project_resign_grace_days"),
+		expression.Name("project_signature_term_days"),
+		expression.Name("project_signature_expiry_notice_days"),
+		expression.Name("project_co_author_policy"),
+		expression.Name("project_enforcement_mode"),
 		expression.Name("project_corporate_documents"),
 		expression.Name("project_individual_documents"),
 		expression.Name("project_member_documents"),
@@ -121,6 +121,16 @@ func (repo *repo) CreateCLAGroup(ctx context.Context, claGroupModel *models.ClaG
 	common.AddBooleanAttribute(input.Item, "project_ccla_enabled", claGroupModel.ProjectCCLAEnabled)
 	common.AddBooleanAttribute(input.Item, "project_ccla_requires_icla_signature", claGroupModel.ProjectCCLARequiresICLA)
 	common.AddBooleanAttribute(input.Item, "project_live", claGroupModel.ProjectLive)
+	common.AddStringAttribute(input.Item, "project_signature_provider", claGroupModel.ProjectSignatureProvider)
+	utils.AddNumberAttribute(input.Item, "project_ccla_signatory_reminder_days", claGroupModel.ProjectCCLASignatoryReminderDays)
+	utils.AddNumberAttribute(input.Item, "project_ccla_signatory_expiry_days", claGroupModel.ProjectCCLASignatoryExpiryDays)
+	common.AddListAttribute(input.Item, "project_ccla_counter_signers", common.BuildCLAGroupCounterSignerAttributes(claGroupModel.ProjectCCLACounterSigners))
+	common.AddStringAttribute(input.Item, "project_resign_policy", claGroupModel.ProjectResignPolicy)
+	utils.AddNumberAttribute(input.Item, "project_resign_grace_days", claGroupModel.ProjectResignGraceDays)
+	utils.AddNumberAttribute(input.Item, "project_signature_term_days", claGroupModel.ProjectSignatureTermDays)
+	utils.AddNumberAttribute(input.Item, "project_signature_expiry_notice_days", claGroupModel.ProjectSignatureExpiryNoticeDays)
+	common.AddStringAttribute(input.Item, "project_co_author_policy", claGroupModel.ProjectCoAuthorPolicy)
+	common.AddStringAttribute(input.Item, "project_enforcement_mode", claGroupModel.ProjectEnforcementMode)
 
 	// Empty documents for now - will add the template details later
 	common.AddListAttribute(input.Item, "project_corporate_documents", []*dynamodb.AttributeValue{})
@@ -734,6 +744,85 @@ func (repo *repo) UpdateCLAGroup(ctx context.Context, claGroupModel *models.ClaG
 		updateExpression = updateExpression + " #PL = :pl, "
 	}
 
+	// An update to the e-signature provider
+	if claGroupModel.ProjectSignatureProvider != "" && claGroupModel.ProjectSignatureProvider != existingCLAGroup.ProjectSignatureProvider {
+		log.WithFields(f).Debugf("adding project_signature_provider: %s", claGroupModel.ProjectSignatureProvider)
+		expressionAttributeNames["#SP"] = aws.String("project_signature_provider")
+		expressionAttributeValues[":sp"] = &dynamodb.AttributeValue{S: aws.String(claGroupModel.ProjectSignatureProvider)}
+		updateExpression = updateExpression + " #SP = :sp, "
+	}
+
+	// An update to the CCLA signatory reminder interval
+	if claGroupModel.ProjectCCLASignatoryReminderDays != existingCLAGroup.ProjectCCLASignatoryReminderDays {
+		log.WithFields(f).Debugf("adding project_ccla_signatory_reminder_days: %d", claGroupModel.ProjectCCLASignatoryReminderDays)
+		expressionAttributeNames["#SRD"] = aws.String("project_ccla_signatory_reminder_days")
+		expressionAttributeValues[":srd"] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(claGroupModel.ProjectCCLASignatoryReminderDays, 10))}
+		updateExpression = updateExpression + " #SRD = :srd, "
+	}
+
+	// An update to the CCLA signatory expiry
+	if claGroupModel.ProjectCCLASignatoryExpiryDays != existingCLAGroup.ProjectCCLASignatoryExpiryDays {
+		log.WithFields(f).Debugf("adding project_ccla_signatory_expiry_days: %d", claGroupModel.ProjectCCLASignatoryExpiryDays)
+		expressionAttributeNames["#SED"] = aws.String("project_ccla_signatory_expiry_days")
+		expressionAttributeValues[":sed"] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(claGroupModel.ProjectCCLASignatoryExpiryDays, 10))}
+		updateExpression = updateExpression + " #SED = :sed, "
+	}
+
+	// An update to the CCLA counter signers
+	if !common.AreCLAGroupCounterSignersEqual(claGroupModel.ProjectCCLACounterSigners, existingCLAGroup.ProjectCCLACounterSigners) {
+		log.WithFields(f).Debugf("adding project_ccla_counter_signers: %d signers", len(claGroupModel.ProjectCCLACounterSigners))
+		expressionAttributeNames["#CS"] = aws.String("project_ccla_counter_signers")
+		expressionAttributeValues[":cs"] = &dynamodb.AttributeValue{L: common.BuildCLAGroupCounterSignerAttributes(claGroupModel.ProjectCCLACounterSigners)}
+		updateExpression = updateExpression + " #CS = :cs, "
+	}
+
+	// An update to the re-sign policy applied when a new major version of the CLA Group documents is published
+	if claGroupModel.ProjectResignPolicy != "" && claGroupModel.ProjectResignPolicy != existingCLAGroup.ProjectResignPolicy {
+		log.WithFields(f).Debugf("adding project_resign_policy: %s", claGroupModel.ProjectResignPolicy)
+		expressionAttributeNames["#RP"] = aws.String("project_resign_policy")
+		expressionAttributeValues[":rp"] = &dynamodb.AttributeValue{S: aws.String(claGroupModel.ProjectResignPolicy)}
+		updateExpression = updateExpression + " #RP = :rp, "
+	}
+
+	// An update to the re-sign grace period
+	if claGroupModel.ProjectResignGraceDays != existingCLAGroup.ProjectResignGraceDays {
+		log.WithFields(f).Debugf("adding project_resign_grace_days: %d", claGroupModel.ProjectResignGraceDays)
+		expressionAttributeNames["#RGD"] = aws.String("project_resign_grace_days")
+		expressionAttributeValues[":rgd"] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(claGroupModel.ProjectResignGraceDays, 10))}
+		updateExpression = updateExpression + " #RGD = :rgd, "
+	}
+
+	// An update to the signature term and the expiry notice period
+	if claGroupModel.ProjectSignatureTermDays != existingCLAGroup.ProjectSignatureTermDays {
+		log.WithFields(f).Debugf("adding project_signature_term_days: %d", claGroupModel.ProjectSignatureTermDays)
+		expressionAttributeNames["#STD"] = aws.String("project_signature_term_days")
+		expressionAttributeValues[":std"] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(claGroupModel.ProjectSignatureTermDays, 10))}
+		updateExpression = updateExpression + " #STD = :std, "
+	}
+
+	if claGroupModel.ProjectSignatureExpiryNoticeDays != existingCLAGroup.ProjectSignatureExpiryNoticeDays {
+		log.WithFields(f).Debugf("adding project_signature_expiry_notice_days: %d", claGroupModel.ProjectSignatureExpiryNoticeDays)
+		expressionAttributeNames["#SEN"] = aws.String("project_signature_expiry_notice_days")
+		expressionAttributeValues[":sen"] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(claGroupModel.ProjectSignatureExpiryNoticeDays, 10))}
+		updateExpression = updateExpression + " #SEN = :sen, "
+	}
+
+	// An update to the handling of the Co-authored-by trailers of the pull request commits
+	if claGroupModel.ProjectCoAuthorPolicy != "" && claGroupModel.ProjectCoAuthorPolicy != existingCLAGroup.ProjectCoAuthorPolicy {
+		log.WithFields(f).Debugf("adding project_co_author_policy: %s", claGroupModel.ProjectCoAuthorPolicy)
+		expressionAttributeNames["#CAP"] = aws.String("project_co_author_policy")
+		expressionAttributeValues[":cap"] = &dynamodb.AttributeValue{S: aws.String(claGroupModel.ProjectCoAuthorPolicy)}
+		updateExpression = updateExpression + " #CAP = :cap, "
+	}
+
+	// An update to the contribution check of the CLA group repositories without their own enforcement mode
+	if claGroupModel.ProjectEnforcementMode != "" && claGroupModel.ProjectEnforcementMode != existingCLAGroup.ProjectEnforcementMode {
+		log.WithFields(f).Debugf("adding project_enforcement_mode: %s", claGroupModel.ProjectEnforcementMode)
+		expressionAttributeNames["#EM"] = aws.String("project_enforcement_mode")
+		expressionAttributeValues[":em"] = &dynamodb.AttributeValue{S: aws.String(claGroupModel.ProjectEnforcementMode)}
+		updateExpression = updateExpression + " #EM = :em, "
+	}
+
 	// We'll update the date modified time
 	_, currentTimeString := utils.CurrentTime()
 	log.WithFields(f).Debugf("adding date_modified: %s", currentTimeString)
@@ -892,25 +981,35 @@ func (repo *repo) buildCLAGroupModel(ctx context.Context, dbModel models2.DBProj
 	}
 
 	return &models.ClaGroup{
-		ProjectID:                    dbModel.ProjectID,
-		FoundationSFID:               dbModel.FoundationSFID,
-		RootProjectRepositoriesCount: dbModel.RootProjectRepositoriesCount,
-		ProjectExternalID:            dbModel.ProjectExternalID,
-		ProjectName:                  dbModel.ProjectName,
-		ProjectDescription:           dbModel.ProjectDescription,
-		ProjectACL:                   dbModel.ProjectACL,
-		ProjectCCLAEnabled:           dbModel.ProjectCclaEnabled,
-		ProjectICLAEnabled:           dbModel.ProjectIclaEnabled,
-		ProjectCCLARequiresICLA:      dbModel.ProjectCclaRequiresIclaSignature,
-		ProjectTemplateID:            dbModel.ProjectTemplateID,
-		ProjectLive:                  dbModel.ProjectLive,
-		ProjectCorporateDocuments:    common.BuildCLAGroupDocumentModels(dbModel.ProjectCorporateDocuments),
-		ProjectIndividualDocuments:   common.BuildCLAGroupDocumentModels(dbModel.ProjectIndividualDocuments),
-		ProjectMemberDocuments:       common.BuildCLAGroupDocumentModels(dbModel.ProjectMemberDocuments),
-		GithubRepositories:           ghOrgs,
-		Gerrits:                      gerrits,
-		DateCreated:                  dbModel.DateCreated,
-		DateModified:                 dbModel.DateModified,
-		Version:                      dbModel.Version,
+		ProjectID:                        dbModel.ProjectID,
+		FoundationSFID:                   dbModel.FoundationSFID,
+		RootProjectRepositoriesCount:     dbModel.RootProjectRepositoriesCount,
+		ProjectExternalID:                dbModel.ProjectExternalID,
+		ProjectName:                      dbModel.ProjectName,
+		ProjectDescription:               dbModel.ProjectDescription,
+		ProjectACL:                       dbModel.ProjectACL,
+		ProjectCCLAEnabled:               dbModel.ProjectCclaEnabled,
+		ProjectICLAEnabled:               dbModel.ProjectIclaEnabled,
+		ProjectCCLARequiresICLA:          dbModel.ProjectCclaRequiresIclaSignature,
+		ProjectTemplateID:                dbModel.ProjectTemplateID,
+		ProjectLive:                      dbModel.ProjectLive,
+		ProjectSignatureProvider:         dbModel.ProjectSignatureProvider,
+		ProjectCCLASignatoryReminderDays: dbModel.ProjectCclaSignatoryReminderDays,
+		ProjectCCLASignatoryExpiryDays:   dbModel.ProjectCclaSignatoryExpiryDays,
+		ProjectCCLACounterSigners:        common.BuildCLAGroupCounterSignerModels(dbModel.ProjectCclaCounterSigners),
+		ProjectResignPolicy:              dbModel.ProjectResignPolicy,
+		ProjectResignGraceDays:           dbModel.ProjectResignGraceDays,
+		ProjectSignatureTermDays:         dbModel.ProjectSignatureTermDays,
+		ProjectSignatureExpiryNoticeDays: dbModel.ProjectSignatureExpiryNoticeDays,
+		ProjectCoAuthorPolicy:            dbModel.ProjectCoAuthorPolicy,
+		ProjectEnforcementMode:           dbModel.ProjectEnforcementMode,
+		ProjectCorporateDocuments:        common.BuildCLAGroupDocumentModels(dbModel.ProjectCorporateDocuments),
+		ProjectIndividualDocuments:       common.BuildCLAGroupDocumentModels(dbModel.ProjectIndividualDocuments),
+		ProjectMemberDocuments:           common.BuildCLAGroupDocumentModels(dbModel.ProjectMemberDocuments),
+		GithubRepositories:               ghOrgs,
+		Gerrits:                          gerrits,
+		DateCreated:                      dbModel.DateCreated,
+		DateModified:                     dbModel.DateModified,
+		Version:                          dbModel.Version,
 	}
 }
@@ -24,6 +24,9 @@ const RepositoryCLAGroupIDColumn = "repository_project_id"
 // RepositoryOrganizationNameColumn constant
 const RepositoryOrganizationNameColumn = "repository_organization_name"
 
+// RepositoryURLColumn constant
+const RepositoryURLColumn = "repository_url"
+
 // RepositoryEnabledColumn constant
 const RepositoryEnabledColumn = "enabled"
 
@@ -33,6 +36,24 @@ const RepositoryNoteColumn = "note"
 // RepositoryDateModifiedColumn constant
 const RepositoryDateModifiedColumn = "date_modified"
 
+// RepositoryEnforcementModeColumn constant
+const RepositoryEnforcementModeColumn = "enforcement_mode"
+
+// RepositoryCheckRunEnabledColumn constant
+const RepositoryCheckRunEnabledColumn = "check_run_enabled"
+
+// RepositoryTrivialChangeRulesColumn constant
+const RepositoryTrivialChangeRulesColumn = "trivial_change_rules"
+
+// RepositoryIncludeBranchesColumn constant
+const RepositoryIncludeBranchesColumn = "include_branches"
+
+// RepositoryExcludeBranchesColumn constant
+const RepositoryExcludeBranchesColumn = "exclude_branches"
+
+// RepositoryCLAGroupBindingsColumn constant
+const RepositoryCLAGroupBindingsColumn = "cla_group_bindings"
+
 // RepositoryEnabled constant
 const RepositoryEnabled = "enabled"
 
@@ -6,8 +6,10 @@ package repositories
 import (
 	"strconv"
 
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
 )
 
 // RepositoryDBModel represent repositories table
@@ -29,6 +31,18 @@ type RepositoryDBModel struct {
 	Version                    string `dynamodbav:"version" json:"version,omitempty"`
 	IsRemoteDeleted            bool   `dynamodbav:"is_remote_deleted" json:"is_transfered,omitempty"`
 	WasCLAEnforced             bool   `dynamodbav:"was_cla_enforced" json:"was_cla_enforced,omitempty"`
+	EnforcementMode            string `dynamodbav:"enforcement_mode" json:"enforcement_mode,omitempty"`
+	CheckRunEnabled            bool   `dynamodbav:"check_run_enabled" json:"check_run_enabled,omitempty"`
+
+	// TrivialChangeRules exempt the trivial pull/merge requests, such as documentation fixes, from the CLA check
+	TrivialChangeRules []trivialchange.Rule `dynamodbav:"trivial_change_rules" json:"trivial_change_rules,omitempty"`
+
+	// IncludeBranches and ExcludeBranches are the base branch globs of the pull/merge requests which are checked
+	IncludeBranches []string `dynamodbav:"include_branches" json:"include_branches,omitempty"`
+	ExcludeBranches []string `dynamodbav:"exclude_branches" json:"exclude_branches,omitempty"`
+
+	// ClaGroupBindings select additional CLA groups by the changed paths and the base branch, for monorepos
+	ClaGroupBindings []clagroupbinding.Binding `dynamodbav:"cla_group_bindings" json:"cla_group_bindings,omitempty"`
 }
 
 func convertModels(dbModels []*RepositoryDBModel) []*models.GithubRepository {
@@ -67,5 +81,11 @@ func (gr *RepositoryDBModel) ToGitHubModel() *models.GithubRepository {
 		Version:                    gr.Version,
 		WasClaEnforced:             gr.WasCLAEnforced,
 		IsRemoteDeleted:            gr.IsRemoteDeleted,
+		EnforcementMode:            gr.EnforcementMode,
+		CheckRunEnabled:            gr.CheckRunEnabled,
+		TrivialChangeRules:         trivialchange.ToModels(gr.TrivialChangeRules),
+		IncludeBranches:            gr.IncludeBranches,
+		ExcludeBranches:            gr.ExcludeBranches,
+		ClaGroupBindings:           clagroupbinding.ToModels(gr.ClaGroupBindings),
 	}
 }
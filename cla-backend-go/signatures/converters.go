@@ -105,6 +105,16 @@ func (repo repository) buildProjectSignatureModels(ctx context.Context, results
 			SignatureReturnURL:            dbSignature.SignatureReturnURL,
 			SignatureReturnURLType:        dbSignature.SignatureReturnURLType,
 			SignatureEnvelopeID:           dbSignature.SignatureEnvelopeID,
+			SignatureStatus:               dbSignature.SignatureStatus,
+			SignatoryEmail:                dbSignature.SignatoryEmail,
+			SignatureRequestedOn:          dbSignature.SignatureRequestedOn,
+			SignatureSigners:              buildSignatureSigners(dbSignature.SignatureSigners),
+			SignatureResignCampaignID:     dbSignature.SignatureResignCampaignID,
+			SignatureResignRequiredOn:     dbSignature.SignatureResignRequiredOn,
+			SignatureExpiresOn:            dbSignature.SignatureExpiresOn,
+			SignatureDocumentSHA256:       dbSignature.SignatureDocumentSHA256,
+			SignatureDocumentSize:         dbSignature.SignatureDocumentSize,
+			SignatureDocumentStoredOn:     dbSignature.SignatureDocumentStoredOn,
 		}
 
 		sigs = append(sigs, sig)
@@ -399,3 +409,19 @@ func (repo repository) buildCompanyIDList(ctx context.Context, results *dynamodb
 
 	return response, nil
 }
+
+// buildSignatureSigners converts the multi-party signature signers to the response models
+func buildSignatureSigners(dbSigners []ItemSignatureSigner) []*models.SignatureSigner {
+	var signers []*models.SignatureSigner
+	for _, dbSigner := range dbSigners {
+		signers = append(signers, &models.SignatureSigner{
+			Name:         dbSigner.Name,
+			Email:        dbSigner.Email,
+			Role:         dbSigner.Role,
+			RoutingOrder: int64(dbSigner.RoutingOrder),
+			Status:       dbSigner.Status,
+			SignedOn:     dbSigner.SignedOn,
+		})
+	}
+	return signers
+}
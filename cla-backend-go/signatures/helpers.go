@@ -4,9 +4,13 @@
 package signatures
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	"github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/user"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
 	"github.com/go-openapi/strfmt"
 )
 
@@ -130,3 +134,90 @@ func (s service) createUserModel(gitHubUsername, gitHubUserID, gitLabUsername, g
 
 	return s.usersService.CreateUser(&userModel, &user.CLAUser{})
 }
+
+// IsResignRequired returns true when the signature was superseded by a new major version of the CLA Group documents
+// and the re-sign deadline has passed - the signature no longer covers the signer
+func IsResignRequired(signature *models.Signature) bool {
+	if signature == nil || signature.SignatureResignRequiredOn == "" {
+		return false
+	}
+
+	requiredOn, err := utils.ParseDateTime(signature.SignatureResignRequiredOn)
+	if err != nil {
+		logging.Warnf("unable to parse the re-sign date: %s of signature: %s, error: %+v", signature.SignatureResignRequiredOn, signature.SignatureID, err)
+		return false
+	}
+
+	return !time.Now().UTC().Before(requiredOn)
+}
+
+// IsSignatureExpired returns true when the signature has an expiry date which has passed - the signature no longer
+// covers the signer until the CLA is renewed
+func IsSignatureExpired(signature *models.Signature) bool {
+	if signature == nil || signature.SignatureExpiresOn == "" {
+		return false
+	}
+
+	expiresOn, err := utils.ParseDateTime(signature.SignatureExpiresOn)
+	if err != nil {
+		logging.Warnf("unable to parse the expiry date: %s of signature: %s, error: %+v", signature.SignatureExpiresOn, signature.SignatureID, err)
+		return false
+	}
+
+	return !time.Now().UTC().Before(expiresOn)
+}
+
+// IsRenewable returns true when a signed signature should be replaced by a new signature through the signing flow -
+// either it was superseded by a new major version of the CLA Group documents or it expires within the notice period
+func IsRenewable(signature *models.Signature, noticeDays int64) bool {
+	if signature == nil {
+		return false
+	}
+	if signature.SignatureResignCampaignID != "" || IsSignatureExpired(signature) {
+		return true
+	}
+	if signature.SignatureExpiresOn == "" || noticeDays <= 0 {
+		return false
+	}
+
+	expiresOn, err := utils.ParseDateTime(signature.SignatureExpiresOn)
+	if err != nil {
+		return false
+	}
+
+	return !time.Now().UTC().Add(time.Duration(noticeDays) * 24 * time.Hour).Before(expiresOn)
+}
+
+// latestDocumentVersionSignature returns the signature of the most recent document major version - after a re-sign
+// campaign the signer may have both the superseded signature and the signature of the new major version, after a
+// renewal the most recently created signature of the same major version wins
+func latestDocumentVersionSignature(sigs []*models.Signature) *models.Signature {
+	var latest *models.Signature
+	latestMajorVersion := -1
+	for _, sig := range sigs {
+		majorVersion, err := strconv.Atoi(sig.SignatureDocumentMajorVersion)
+		if err != nil {
+			majorVersion = 0
+		}
+		if majorVersion > latestMajorVersion || (majorVersion == latestMajorVersion && createdAfter(sig, latest)) {
+			latest = sig
+			latestMajorVersion = majorVersion
+		}
+	}
+
+	return latest
+}
+
+// createdAfter returns true when the signature was created after the other signature
+func createdAfter(sig, other *models.Signature) bool {
+	created, err := utils.ParseDateTime(sig.Created)
+	if err != nil {
+		return false
+	}
+	otherCreated, err := utils.ParseDateTime(other.Created)
+	if err != nil {
+		return true
+	}
+
+	return created.After(otherCreated)
+}
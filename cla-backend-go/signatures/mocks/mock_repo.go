@@ -215,6 +215,51 @@ func (mr *MockSignatureRepositoryMockRecorder) GetActivePullRequestMetadata(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivePullRequestMetadata", reflect.TypeOf((*MockSignatureRepository)(nil).GetActivePullRequestMetadata), ctx, gitHubAuthorUsername, gitHubAuthorEmail)
 }
 
+// GetUnsignedSignaturesWithEnvelope mocks base method.
+func (m *MockSignatureRepository) GetUnsignedSignaturesWithEnvelope(ctx context.Context) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsignedSignaturesWithEnvelope", ctx)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsignedSignaturesWithEnvelope indicates an expected call of GetUnsignedSignaturesWithEnvelope.
+func (mr *MockSignatureRepositoryMockRecorder) GetUnsignedSignaturesWithEnvelope(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsignedSignaturesWithEnvelope", reflect.TypeOf((*MockSignatureRepository)(nil).GetUnsignedSignaturesWithEnvelope), ctx)
+}
+
+// GetSignaturesWithExpiry mocks base method.
+func (m *MockSignatureRepository) GetSignaturesWithExpiry(ctx context.Context) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignaturesWithExpiry", ctx)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignaturesWithExpiry indicates an expected call of GetSignaturesWithExpiry.
+func (mr *MockSignatureRepositoryMockRecorder) GetSignaturesWithExpiry(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignaturesWithExpiry", reflect.TypeOf((*MockSignatureRepository)(nil).GetSignaturesWithExpiry), ctx)
+}
+
+// GetSignaturesWithResignDeadline mocks base method.
+func (m *MockSignatureRepository) GetSignaturesWithResignDeadline(ctx context.Context) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignaturesWithResignDeadline", ctx)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignaturesWithResignDeadline indicates an expected call of GetSignaturesWithResignDeadline.
+func (mr *MockSignatureRepositoryMockRecorder) GetSignaturesWithResignDeadline(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignaturesWithResignDeadline", reflect.TypeOf((*MockSignatureRepository)(nil).GetSignaturesWithResignDeadline), ctx)
+}
+
 // GetCCLASignatures mocks base method.
 func (m *MockSignatureRepository) GetCCLASignatures(ctx context.Context, signed, approved *bool) ([]*signatures0.ItemSignature, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +305,21 @@ func (mr *MockSignatureRepositoryMockRecorder) GetClaGroupICLASignatures(ctx, cl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaGroupICLASignatures", reflect.TypeOf((*MockSignatureRepository)(nil).GetClaGroupICLASignatures), ctx, claGroupID, searchTerm, approved, signed, pageSize, nextKey, withExtraDetails)
 }
 
+// GetClaGroupSignedSignatures mocks base method.
+func (m *MockSignatureRepository) GetClaGroupSignedSignatures(ctx context.Context, claGroupID string) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClaGroupSignedSignatures", ctx, claGroupID)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaGroupSignedSignatures indicates an expected call of GetClaGroupSignedSignatures.
+func (mr *MockSignatureRepositoryMockRecorder) GetClaGroupSignedSignatures(ctx, claGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaGroupSignedSignatures", reflect.TypeOf((*MockSignatureRepository)(nil).GetClaGroupSignedSignatures), ctx, claGroupID)
+}
+
 // GetCompanyIDsWithSignedCorporateSignatures mocks base method.
 func (m *MockSignatureRepository) GetCompanyIDsWithSignedCorporateSignatures(ctx context.Context, claGroupID string) ([]signatures0.SignatureCompanyID, error) {
 	m.ctrl.T.Helper()
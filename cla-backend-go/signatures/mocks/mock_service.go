@@ -130,6 +130,36 @@ func (mr *MockSignatureServiceMockRecorder) DeleteGithubOrganizationFromApproval
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGithubOrganizationFromApprovalList", reflect.TypeOf((*MockSignatureService)(nil).DeleteGithubOrganizationFromApprovalList), ctx, signatureID, approvalListParams, githubAccessToken)
 }
 
+// GetUnsignedSignaturesWithEnvelope mocks base method.
+func (m *MockSignatureService) GetUnsignedSignaturesWithEnvelope(ctx context.Context) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsignedSignaturesWithEnvelope", ctx)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsignedSignaturesWithEnvelope indicates an expected call of GetUnsignedSignaturesWithEnvelope.
+func (mr *MockSignatureServiceMockRecorder) GetUnsignedSignaturesWithEnvelope(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsignedSignaturesWithEnvelope", reflect.TypeOf((*MockSignatureService)(nil).GetUnsignedSignaturesWithEnvelope), ctx)
+}
+
+// GetSignaturesWithExpiry mocks base method.
+func (m *MockSignatureService) GetSignaturesWithExpiry(ctx context.Context) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignaturesWithExpiry", ctx)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignaturesWithExpiry indicates an expected call of GetSignaturesWithExpiry.
+func (mr *MockSignatureServiceMockRecorder) GetSignaturesWithExpiry(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignaturesWithExpiry", reflect.TypeOf((*MockSignatureService)(nil).GetSignaturesWithExpiry), ctx)
+}
+
 // GetCCLASignatures mocks base method.
 func (m *MockSignatureService) GetCCLASignatures(ctx context.Context, signed, approved *bool) ([]*signatures0.ItemSignature, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +220,21 @@ func (mr *MockSignatureServiceMockRecorder) GetClaGroupICLASignatures(ctx, claGr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaGroupICLASignatures", reflect.TypeOf((*MockSignatureService)(nil).GetClaGroupICLASignatures), ctx, claGroupID, searchTerm, approved, signed, pageSize, nextKey, withExtraDetails)
 }
 
+// GetClaGroupSignedSignatures mocks base method.
+func (m *MockSignatureService) GetClaGroupSignedSignatures(ctx context.Context, claGroupID string) ([]*signatures0.ItemSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClaGroupSignedSignatures", ctx, claGroupID)
+	ret0, _ := ret[0].([]*signatures0.ItemSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaGroupSignedSignatures indicates an expected call of GetClaGroupSignedSignatures.
+func (mr *MockSignatureServiceMockRecorder) GetClaGroupSignedSignatures(ctx, claGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaGroupSignedSignatures", reflect.TypeOf((*MockSignatureService)(nil).GetClaGroupSignedSignatures), ctx, claGroupID)
+}
+
 // GetCompanyIDsWithSignedCorporateSignatures mocks base method.
 func (m *MockSignatureService) GetCompanyIDsWithSignedCorporateSignatures(ctx context.Context, claGroupID string) ([]signatures0.SignatureCompanyID, error) {
 	m.ctrl.T.Helper()
@@ -40,6 +40,16 @@ func buildProjection() expression.ProjectionBuilder {
 		expression.Name("user_docusign_date_signed"),
 		expression.Name("user_docusign_name"),
 		expression.Name("auto_create_ecla"),
+		expression.Name("signature_status"),
+		expression.Name("signatory_email"),
+		expression.Name("signature_requested_on"),
+		expression.Name("signature_signers"),
+		expression.Name("signature_resign_campaign_id"),
+		expression.Name("signature_resign_required_on"),
+		expression.Name("signature_expires_on"),
+		expression.Name("signature_document_sha256"),
+		expression.Name("signature_document_size"),
+		expression.Name("signature_document_stored_on"),
 	)
 }
 
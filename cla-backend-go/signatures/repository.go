@@ -83,6 +83,10 @@ type SignatureRepository interface {
 	GetCorporateSignature(ctx context.Context, claGroupID, companyID string, approved, signed *bool) (*models.Signature, error)
 	GetCorporateSignatures(ctx context.Context, claGroupID, companyID string, approved, signed *bool) ([]*models.Signature, error)
 	GetCCLASignatures(ctx context.Context, signed, approved *bool) ([]*ItemSignature, error)
+	GetUnsignedSignaturesWithEnvelope(ctx context.Context) ([]*ItemSignature, error)
+	GetSignaturesWithExpiry(ctx context.Context) ([]*ItemSignature, error)
+	GetSignaturesWithResignDeadline(ctx context.Context) ([]*ItemSignature, error)
+	GetClaGroupSignedSignatures(ctx context.Context, claGroupID string) ([]*ItemSignature, error)
 	GetSignatureACL(ctx context.Context, signatureID string) ([]string, error)
 	GetProjectSignatures(ctx context.Context, params signatures.GetProjectSignaturesParams) (*models.Signatures, error)
 	CreateProjectSummaryReport(ctx context.Context, params signatures.CreateProjectSummaryReportParams) (*models.SignatureReport, error)
@@ -320,6 +324,237 @@ func (repo repository) GetCCLASignatures(ctx context.Context, signed, approved *
 
 }
 
+// GetClaGroupSignedSignatures returns the list of signed and approved ICLA and CCLA signatures for the CLA Group -
+// employee acknowledgements are not included
+func (repo repository) GetClaGroupSignedSignatures(ctx context.Context, claGroupID string) ([]*ItemSignature, error) {
+	f := logrus.Fields{
+		"functionName":   "v1.signatures.repository.GetClaGroupSignedSignatures",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"claGroupID":     claGroupID,
+	}
+
+	condition := expression.Key("signature_project_id").Equal(expression.Value(claGroupID))
+
+	var filterAdded bool
+	var filter expression.ConditionBuilder
+	filter = addAndCondition(filter, expression.Name("signature_signed").Equal(expression.Value(true)), &filterAdded)
+	filter = addAndCondition(filter, expression.Name("signature_approved").Equal(expression.Value(true)), &filterAdded)
+	filter = addAndCondition(filter, expression.Name("signature_user_ccla_company_id").AttributeNotExists(), &filterAdded)
+
+	expr, err := expression.NewBuilder().WithKeyCondition(condition).WithFilter(filter).Build()
+	if err != nil {
+		log.WithFields(f).Warnf("error building expression for CLA Group signatures query, error: %v", err)
+		return nil, err
+	}
+
+	queryInput := &dynamodb.QueryInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
+		TableName:                 aws.String(repo.signatureTableName),
+		IndexName:                 aws.String(SignatureProjectIDIndex),
+		Limit:                     aws.Int64(HugePageSize),
+	}
+
+	var signatures []*ItemSignature
+	for {
+		results, queryErr := repo.dynamoDBClient.Query(queryInput)
+		if queryErr != nil {
+			log.WithFields(f).Warnf("error retrieving CLA Group signatures, error: %v", queryErr)
+			return nil, queryErr
+		}
+
+		var items []*ItemSignature
+		err = dynamodbattribute.UnmarshalListOfMaps(results.Items, &items)
+		if err != nil {
+			log.WithFields(f).Warnf("error unmarshalling CLA Group signatures from database, error: %v", err)
+			return nil, err
+		}
+		signatures = append(signatures, items...)
+
+		// If the result set is truncated, we'll need to issue another query to fetch the next page
+		if results.LastEvaluatedKey == nil {
+			break
+		}
+		queryInput.ExclusiveStartKey = results.LastEvaluatedKey
+	}
+
+	return signatures, nil
+}
+
+// GetUnsignedSignaturesWithEnvelope returns the list of signatures which are not signed but have a signing envelope
+func (repo repository) GetUnsignedSignaturesWithEnvelope(ctx context.Context) ([]*ItemSignature, error) {
+	f := logrus.Fields{
+		"functionName":   "v1.signatures.repository.GetUnsignedSignaturesWithEnvelope",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	pageSize := 1000
+	filter := expression.Name("signature_signed").Equal(expression.Value(false)).
+		And(expression.Name("signature_envelope_id").AttributeExists()).
+		And(expression.Name("signature_envelope_id").NotEqual(expression.Value("")))
+
+	// Use the expression builder to build the expression
+	expr, err := expression.NewBuilder().WithFilter(filter).Build()
+	if err != nil {
+		log.WithFields(f).Warnf("error building expression for unsigned signatures query, error: %v", err)
+		return nil, err
+	}
+
+	// Make the DynamoDB Scan API call
+	input := &dynamodb.ScanInput{
+		TableName:                 aws.String(repo.signatureTableName),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		Limit:                     aws.Int64(int64(pageSize)),
+	}
+
+	var signatures []*ItemSignature
+	for {
+		results, queryErr := repo.dynamoDBClient.Scan(input)
+		if queryErr != nil {
+			log.WithFields(f).Warnf("error retrieving unsigned signatures, error: %v", queryErr)
+			return nil, queryErr
+		}
+
+		var items []*ItemSignature
+		err = dynamodbattribute.UnmarshalListOfMaps(results.Items, &items)
+		if err != nil {
+			log.WithFields(f).Warnf("error unmarshalling unsigned signatures from database, error: %v", err)
+			return nil, err
+		}
+
+		signatures = append(signatures, items...)
+
+		// If the result set is truncated, we'll need to issue another query to fetch the next page
+		if results.LastEvaluatedKey == nil {
+			break
+		}
+		input.ExclusiveStartKey = results.LastEvaluatedKey
+	}
+
+	log.WithFields(f).Debugf("found %d unsigned signatures with an envelope", len(signatures))
+	return signatures, nil
+}
+
+// GetSignaturesWithExpiry returns the list of signed and approved signatures which have an expiry date
+func (repo repository) GetSignaturesWithExpiry(ctx context.Context) ([]*ItemSignature, error) {
+	f := logrus.Fields{
+		"functionName":   "v1.signatures.repository.GetSignaturesWithExpiry",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	pageSize := 1000
+	filter := expression.Name("signature_signed").Equal(expression.Value(true)).
+		And(expression.Name("signature_approved").Equal(expression.Value(true))).
+		And(expression.Name("signature_expires_on").AttributeExists()).
+		And(expression.Name("signature_expires_on").NotEqual(expression.Value("")))
+
+	// Use the expression builder to build the expression
+	expr, err := expression.NewBuilder().WithFilter(filter).Build()
+	if err != nil {
+		log.WithFields(f).Warnf("error building expression for signatures with expiry query, error: %v", err)
+		return nil, err
+	}
+
+	// Make the DynamoDB Scan API call
+	input := &dynamodb.ScanInput{
+		TableName:                 aws.String(repo.signatureTableName),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		Limit:                     aws.Int64(int64(pageSize)),
+	}
+
+	var signatures []*ItemSignature
+	for {
+		results, queryErr := repo.dynamoDBClient.Scan(input)
+		if queryErr != nil {
+			log.WithFields(f).Warnf("error retrieving signatures with expiry, error: %v", queryErr)
+			return nil, queryErr
+		}
+
+		var items []*ItemSignature
+		err = dynamodbattribute.UnmarshalListOfMaps(results.Items, &items)
+		if err != nil {
+			log.WithFields(f).Warnf("error unmarshalling signatures with expiry from database, error: %v", err)
+			return nil, err
+		}
+
+		signatures = append(signatures, items...)
+
+		// If the result set is truncated, we'll need to issue another query to fetch the next page
+		if results.LastEvaluatedKey == nil {
+			break
+		}
+		input.ExclusiveStartKey = results.LastEvaluatedKey
+	}
+
+	log.WithFields(f).Debugf("found %d signatures with an expiry date", len(signatures))
+	return signatures, nil
+}
+
+// GetSignaturesWithResignDeadline returns the list of signed and approved signatures stamped by a re-sign campaign
+// which were not enforced yet
+func (repo repository) GetSignaturesWithResignDeadline(ctx context.Context) ([]*ItemSignature, error) {
+	f := logrus.Fields{
+		"functionName":   "v1.signatures.repository.GetSignaturesWithResignDeadline",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	pageSize := 1000
+	filter := expression.Name("signature_signed").Equal(expression.Value(true)).
+		And(expression.Name("signature_approved").Equal(expression.Value(true))).
+		And(expression.Name("signature_resign_required_on").AttributeExists()).
+		And(expression.Name("signature_resign_required_on").NotEqual(expression.Value(""))).
+		And(expression.Name("signature_resign_enforced_on").AttributeNotExists())
+
+	// Use the expression builder to build the expression
+	expr, err := expression.NewBuilder().WithFilter(filter).Build()
+	if err != nil {
+		log.WithFields(f).Warnf("error building expression for signatures with a re-sign deadline query, error: %v", err)
+		return nil, err
+	}
+
+	// Make the DynamoDB Scan API call
+	input := &dynamodb.ScanInput{
+		TableName:                 aws.String(repo.signatureTableName),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		Limit:                     aws.Int64(int64(pageSize)),
+	}
+
+	var signatures []*ItemSignature
+	for {
+		results, queryErr := repo.dynamoDBClient.Scan(input)
+		if queryErr != nil {
+			log.WithFields(f).Warnf("error retrieving signatures with a re-sign deadline, error: %v", queryErr)
+			return nil, queryErr
+		}
+
+		var items []*ItemSignature
+		err = dynamodbattribute.UnmarshalListOfMaps(results.Items, &items)
+		if err != nil {
+			log.WithFields(f).Warnf("error unmarshalling signatures with a re-sign deadline from database, error: %v", err)
+			return nil, err
+		}
+
+		signatures = append(signatures, items...)
+
+		// If the result set is truncated, we'll need to issue another query to fetch the next page
+		if results.LastEvaluatedKey == nil {
+			break
+		}
+		input.ExclusiveStartKey = results.LastEvaluatedKey
+	}
+
+	log.WithFields(f).Debugf("found %d signatures with a re-sign deadline", len(signatures))
+	return signatures, nil
+}
+
 // UpdateSignature updates an existing signature
 func (repo repository) UpdateSignature(ctx context.Context, signatureID string, updates map[string]interface{}) error {
 	f := logrus.Fields{
@@ -873,7 +1108,7 @@ func (repo repository) GetIndividualSignature(ctx context.Context, claGroupID, u
 		log.WithFields(f).Warnf("found multiple matching ICLA signatures - found %d total", len(sigs))
 	}
 
-	return sigs[0], nil // nolint G602: Potentially accessing slice out of bounds (gosec)
+	return latestDocumentVersionSignature(sigs), nil
 }
 
 // GetIndividualSignature returns the signature record for the specified CLA Group and User
@@ -1097,7 +1332,7 @@ func (repo repository) GetCorporateSignature(ctx context.Context, claGroupID, co
 		log.WithFields(f).Warnf("found multiple matching ICLA signatures - found %d total", len(sigs))
 	}
 
-	return sigs[0], nil // nolint G602: Potentially accessing slice out of bounds (gosec)
+	return latestDocumentVersionSignature(sigs), nil
 }
 
 // GetCorporateSignatures returns the list signature record for the specified CLA Group and Company ID
@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/exemptions"
 	"github.com/communitybridge/easycla/cla-backend-go/users"
 
 	"github.com/LF-Engineering/lfx-kit/auth"
@@ -47,6 +48,9 @@ type SignatureService interface {
 	GetCorporateSignatures(ctx context.Context, claGroupID, companyID string, approved, signed *bool) ([]*models.Signature, error)
 	GetProjectSignatures(ctx context.Context, params signatures.GetProjectSignaturesParams) (*models.Signatures, error)
 	GetCCLASignatures(ctx context.Context, signed, approved *bool) ([]*ItemSignature, error)
+	GetClaGroupSignedSignatures(ctx context.Context, claGroupID string) ([]*ItemSignature, error)
+	GetUnsignedSignaturesWithEnvelope(ctx context.Context) ([]*ItemSignature, error)
+	GetSignaturesWithExpiry(ctx context.Context) ([]*ItemSignature, error)
 	CreateProjectSummaryReport(ctx context.Context, params signatures.CreateProjectSummaryReportParams) (*models.SignatureReport, error)
 	GetProjectCompanySignature(ctx context.Context, companyID, projectID string, approved, signed *bool, nextKey *string, pageSize *int64) (*models.Signature, error)
 	GetProjectCompanySignatures(ctx context.Context, params signatures.GetProjectCompanySignaturesParams) (*models.Signatures, error)
@@ -90,13 +94,14 @@ type service struct {
 	githubOrgService    github_organizations.ServiceInterface
 	claGroupService     service2.Service
 	gitLabApp           *gitlab_api.App
+	exemptionsService   exemptions.Service
 	claBaseAPIURL       string
 	claLandingPage      string
 	claLogoURL          string
 }
 
 // NewService creates a new signature service
-func NewService(repo SignatureRepository, companyService company.IService, usersService users.Service, eventsService events.Service, githubOrgValidation bool, repositoryService repositories.Service, githubOrgService github_organizations.ServiceInterface, claGroupService service2.Service, gitLabApp *gitlab_api.App, CLABaseAPIURL, CLALandingPage, CLALogoURL string) SignatureService {
+func NewService(repo SignatureRepository, companyService company.IService, usersService users.Service, eventsService events.Service, githubOrgValidation bool, repositoryService repositories.Service, githubOrgService github_organizations.ServiceInterface, claGroupService service2.Service, gitLabApp *gitlab_api.App, exemptionsService exemptions.Service, CLABaseAPIURL, CLALandingPage, CLALogoURL string) SignatureService {
 	return service{
 		repo,
 		companyService,
@@ -107,6 +112,7 @@ func NewService(repo SignatureRepository, companyService company.IService, users
 		githubOrgService,
 		claGroupService,
 		gitLabApp,
+		exemptionsService,
 		CLABaseAPIURL,
 		CLALandingPage,
 		CLALogoURL,
@@ -248,6 +254,21 @@ func (s service) GetCCLASignatures(ctx context.Context, signed, approved *bool)
 	return s.repo.GetCCLASignatures(ctx, signed, approved)
 }
 
+// GetClaGroupSignedSignatures returns the list of signed and approved ICLA and CCLA signatures for the CLA Group
+func (s service) GetClaGroupSignedSignatures(ctx context.Context, claGroupID string) ([]*ItemSignature, error) {
+	return s.repo.GetClaGroupSignedSignatures(ctx, claGroupID)
+}
+
+// GetUnsignedSignaturesWithEnvelope returns the list of signatures which are not signed but have a signing envelope
+func (s service) GetUnsignedSignaturesWithEnvelope(ctx context.Context) ([]*ItemSignature, error) {
+	return s.repo.GetUnsignedSignaturesWithEnvelope(ctx)
+}
+
+// GetSignaturesWithExpiry returns the list of signed and approved signatures which have an expiry date
+func (s service) GetSignaturesWithExpiry(ctx context.Context) ([]*ItemSignature, error) {
+	return s.repo.GetSignaturesWithExpiry(ctx)
+}
+
 // GetUserSignatures returns the list of user signatures associated with the specified user
 func (s service) GetUserSignatures(ctx context.Context, params signatures.GetUserSignaturesParams, projectID *string) (*models.Signatures, error) {
 
@@ -1029,6 +1050,18 @@ func (s service) updateChangeRequest(ctx context.Context, ghOrg *models.GithubOr
 	}
 	log.WithFields(f).Debugf("found %d commit authors for %s/%s for PR: %d", len(authors), gitHubOrgName, gitHubRepoName, pullRequestID)
 
+	if s.exemptionsService != nil {
+		// the repository exemption rules are keyed by the EasyCLA repository ID
+		var claRepositoryID string
+		repositoryModel, repoErr := s.repositoryService.GetRepositoryByExternalID(ctx, strconv.FormatInt(repositoryID, 10))
+		if repoErr != nil || repositoryModel == nil {
+			log.WithFields(f).WithError(repoErr).Warn("unable to load the EasyCLA repository - skipping the repository exemption rules")
+		} else {
+			claRepositoryID = repositoryModel.RepositoryID
+		}
+		s.exemptionsService.ExemptGitHubAuthors(ctx, projectID, claRepositoryID, fmt.Sprintf("%s/%s", gitHubOrgName, gitHubRepoName), int(pullRequestID), authors)
+	}
+
 	signed := make([]*github.UserCommitSummary, 0)
 	unsigned := make([]*github.UserCommitSummary, 0)
 
@@ -1036,6 +1069,11 @@ func (s service) updateChangeRequest(ctx context.Context, ghOrg *models.GithubOr
 	log.WithFields(f).Debugf("triaging %d commit authors for PR: %d using repository %s/%s",
 		len(authors), pullRequestID, gitHubOrgName, gitHubRepoName)
 	for _, userSummary := range authors {
+		if userSummary.Exempt {
+			log.WithFields(f).Debugf("commit author is exempt - sha: %s, username: %s", userSummary.SHA, userSummary.GetCommitAuthorUsername())
+			signed = append(signed, userSummary)
+			continue
+		}
 
 		if !userSummary.IsValid() {
 			log.WithFields(f).Debugf("invalid user summary: %+v", *userSummary)
@@ -1113,6 +1151,17 @@ func (s service) updateChangeRequest(ctx context.Context, ghOrg *models.GithubOr
 		}
 	}
 
+	if github.HasCoAuthors(authors) {
+		var coAuthorPolicy string
+		claGroup, claGroupErr := s.claGroupService.GetCLAGroupByID(ctx, projectID)
+		if claGroupErr != nil || claGroup == nil {
+			log.WithFields(f).WithError(claGroupErr).Warnf("unable to load the CLA group: %s - reporting the co-authors only", projectID)
+		} else {
+			coAuthorPolicy = claGroup.ProjectCoAuthorPolicy
+		}
+		signed, unsigned = github.ApplyCoAuthorPolicy(coAuthorPolicy, signed, unsigned)
+	}
+
 	log.WithFields(f).Debugf("commit authors status => signed: %+v and missing: %+v", signed, unsigned)
 
 	// update pull request
@@ -1149,6 +1198,14 @@ func (s service) HasUserSigned(ctx context.Context, user *models.User, projectID
 		log.WithFields(f).WithError(sigErr).Warnf("problem checking for ICLA signature for user: %s", user.UserID)
 		return &hasSigned, &companyAffiliation, sigErr
 	}
+	if signature != nil && IsResignRequired(signature) {
+		log.WithFields(f).Debugf("ICLA signature: %s for user: %s was superseded by a new CLA document version and must be re-signed", signature.SignatureID, user.UserID)
+		signature = nil
+	}
+	if signature != nil && IsSignatureExpired(signature) {
+		log.WithFields(f).Debugf("ICLA signature: %s for user: %s expired on %s and must be renewed", signature.SignatureID, user.UserID, signature.SignatureExpiresOn)
+		signature = nil
+	}
 	if signature != nil {
 		hasSigned = true
 		log.WithFields(f).Debugf("ICLA signature check passed for user: %+v on project : %s", user, projectID)
@@ -1238,6 +1295,11 @@ func (s service) ProcessEmployeeSignature(ctx context.Context, companyModel *mod
 					return &hasSigned, cclaErr
 				}
 
+				if cclaSignature != nil && IsResignRequired(cclaSignature) {
+					log.WithFields(f).Debugf("CCLA signature: %s for company: %s was superseded by a new CLA document version and must be re-signed", cclaSignature.SignatureID, companyID)
+					cclaSignature = nil
+				}
+
 				if cclaSignature != nil {
 					log.WithFields(f).Debug("found ccla signature")
 					userApproved, approvedErr := s.UserIsApproved(ctx, user, cclaSignature)
@@ -1275,6 +1337,12 @@ func (s service) UserIsApproved(ctx context.Context, user *models.User, cclaSign
 		"functionName": "v1.signatures.service.UserIsApproved",
 	}
 
+	// An expired corporate signature no longer covers the employees on the approval lists
+	if IsSignatureExpired(cclaSignature) {
+		log.WithFields(f).Debugf("CCLA signature: %s expired on %s and must be renewed", cclaSignature.SignatureID, cclaSignature.SignatureExpiresOn)
+		return false, nil
+	}
+
 	emails := user.Emails
 
 	if user.LfEmail != "" {
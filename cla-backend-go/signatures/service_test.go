@@ -80,11 +80,22 @@ func TestUserIsApproved(t *testing.T) {
 			},
 			expectedIsApproved: true,
 		},
+		{
+			name: "User in Email approval list of an expired signature",
+			user: &v1Models.User{
+				Emails: []string{"foo@gmail.com"},
+			},
+			cclaSignature: &v1Models.Signature{
+				EmailApprovalList:  []string{"foo@gmail.com"},
+				SignatureExpiresOn: "2020-06-22T09:18:26Z",
+			},
+			expectedIsApproved: false,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			service := NewService(nil, nil, nil, nil, false, nil, nil, nil, nil, "", "", "")
+			service := NewService(nil, nil, nil, nil, false, nil, nil, nil, nil, nil, "", "", "")
 
 			isApproved, err := service.UserIsApproved(ctx, tc.user, tc.cclaSignature)
 
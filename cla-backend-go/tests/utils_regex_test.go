@@ -101,3 +101,50 @@ func TestValidWebsite(t *testing.T) {
 		assert.False(t, utils.ValidWebsite(str), fmt.Sprintf("ValidWebsite - %s", str))
 	}
 }
+
+// TestMatchGlob is a collection of unit tests for the MatchGlob utility function
+func TestMatchGlob(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		value   string
+		match   bool
+	}{
+		{pattern: "main", value: "main", match: true},
+		{pattern: "main", value: "maintenance", match: false},
+		{pattern: "release/*", value: "release/1.0", match: true},
+		{pattern: "release/*", value: "release/1.0/hotfix", match: false},
+		{pattern: "users/**", value: "users/jane/experiment", match: true},
+		{pattern: "**/wip", value: "wip", match: true},
+		{pattern: "**/wip", value: "users/jane/wip", match: true},
+		{pattern: "v?.x", value: "v2.x", match: true},
+		{pattern: "v?.x", value: "v2-x", match: false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.match, utils.MatchGlob(tc.pattern, tc.value), fmt.Sprintf("MatchGlob - %s %s", tc.pattern, tc.value))
+	}
+}
+
+// TestIsBranchEnforced is a collection of unit tests for the IsBranchEnforced utility function
+func TestIsBranchEnforced(t *testing.T) {
+	testCases := []struct {
+		name     string
+		branch   string
+		include  []string
+		exclude  []string
+		enforced bool
+	}{
+		{name: "no patterns", branch: "experiment", enforced: true},
+		{name: "included", branch: "release/1.0", include: []string{"main", "release/*"}, enforced: true},
+		{name: "not included", branch: "experiment", include: []string{"main", "release/*"}, enforced: false},
+		{name: "excluded", branch: "users/jane/wip", exclude: []string{"users/**"}, enforced: false},
+		{name: "not excluded", branch: "main", exclude: []string{"users/**"}, enforced: true},
+		{name: "exclude wins over include", branch: "release/mirror", include: []string{"release/*"}, exclude: []string{"*/mirror"}, enforced: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.enforced, utils.IsBranchEnforced(tc.branch, tc.include, tc.exclude))
+		})
+	}
+}
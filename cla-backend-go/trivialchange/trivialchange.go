@@ -0,0 +1,180 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package trivialchange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+)
+
+// maxStatusDescription is the GitHub commit status description limit
+const maxStatusDescription = 140
+
+// ErrInvalidRule is returned when a rule has neither a path glob nor a changed lines threshold, or has an empty glob
+var ErrInvalidRule = errors.New("invalid trivial change rule")
+
+// Rule exempts a pull/merge request from the CLA check when every changed file matches one of the path globs and the
+// total number of changed lines does not exceed the threshold - an empty paths list matches any file and a zero
+// threshold allows any number of changed lines
+type Rule struct {
+	Paths           []string `dynamodbav:"paths" json:"paths,omitempty"`
+	MaxChangedLines int64    `dynamodbav:"max_changed_lines" json:"max_changed_lines,omitempty"`
+}
+
+// File is the provider neutral changed file information of a pull/merge request
+type File struct {
+	Path         string
+	PreviousPath string
+	Additions    int
+	Deletions    int
+}
+
+// FromModels converts the API rule models
+func FromModels(rules []*models.TrivialChangeRule) []Rule {
+	var response []Rule
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		response = append(response, Rule{
+			Paths:           rule.Paths,
+			MaxChangedLines: rule.MaxChangedLines,
+		})
+	}
+	return response
+}
+
+// ToModels converts the rules to the API rule models
+func ToModels(rules []Rule) []*models.TrivialChangeRule {
+	var response []*models.TrivialChangeRule
+	for _, rule := range rules {
+		response = append(response, &models.TrivialChangeRule{
+			Paths:           rule.Paths,
+			MaxChangedLines: rule.MaxChangedLines,
+		})
+	}
+	return response
+}
+
+// ValidateRule checks the rule has at least one constraint and no empty path glob
+func ValidateRule(rule Rule) error {
+	if len(rule.Paths) == 0 && rule.MaxChangedLines <= 0 {
+		return fmt.Errorf("%w: at least one path or a max changed lines value greater than zero is required", ErrInvalidRule)
+	}
+	if rule.MaxChangedLines < 0 {
+		return fmt.Errorf("%w: max changed lines must not be negative", ErrInvalidRule)
+	}
+	for _, pattern := range rule.Paths {
+		if strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("%w: empty path", ErrInvalidRule)
+		}
+	}
+	return nil
+}
+
+// Match returns the first rule which applies to the changed files, or nil when the change is not trivial
+func Match(rules []Rule, files []File) *Rule {
+	if len(files) == 0 {
+		return nil
+	}
+	changedLines := int64(ChangedLines(files))
+	for i := range rules {
+		rule := rules[i]
+		if ValidateRule(rule) != nil {
+			continue
+		}
+		if rule.MaxChangedLines > 0 && changedLines > rule.MaxChangedLines {
+			continue
+		}
+		if matchFiles(rule.Paths, files) {
+			return &rule
+		}
+	}
+	return nil
+}
+
+// ChangedLines returns the total number of added and deleted lines
+func ChangedLines(files []File) int {
+	total := 0
+	for _, file := range files {
+		total += file.Additions + file.Deletions
+	}
+	return total
+}
+
+// StatusDescription returns the commit status description explaining why the CLA check was skipped
+func StatusDescription(rule *Rule, changedLines int) string {
+	var sb strings.Builder
+	sb.WriteString("EasyCLA check skipped - trivial change")
+	if rule.MaxChangedLines > 0 {
+		sb.WriteString(fmt.Sprintf(" of %d/%d lines", changedLines, rule.MaxChangedLines))
+	}
+	if len(rule.Paths) > 0 {
+		sb.WriteString(" in ")
+		sb.WriteString(strings.Join(rule.Paths, ", "))
+	}
+	description := sb.String()
+	if len(description) > maxStatusDescription {
+		description = description[:maxStatusDescription-3] + "..."
+	}
+	return description
+}
+
+// CountDiffLines returns the number of added and deleted lines of a unified diff without the file headers
+func CountDiffLines(diff string) (int, int) {
+	additions, deletions := 0, 0
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			additions++
+		case strings.HasPrefix(line, "-"):
+			deletions++
+		}
+	}
+	return additions, deletions
+}
+
+// MatchPath reports whether the file path matches the glob - * and ? match within a path segment, ** matches across
+// segments, a trailing / matches everything below the directory and a glob without a / matches the file name in any
+// directory
+func MatchPath(pattern, path string) bool {
+	pattern = strings.TrimPrefix(strings.TrimSpace(pattern), "/")
+	path = strings.TrimPrefix(path, "/")
+	if !strings.Contains(pattern, "/") {
+		path = path[strings.LastIndex(path, "/")+1:]
+	}
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	return utils.MatchGlob(pattern, path)
+}
+
+// matchFiles reports whether every file, and the previous path of the renamed files, matches one of the globs
+func matchFiles(patterns []string, files []File) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, file := range files {
+		if !matchAny(patterns, file.Path) {
+			return false
+		}
+		if file.PreviousPath != "" && !matchAny(patterns, file.PreviousPath) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if MatchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package trivialchange
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPath(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{pattern: "*.md", path: "README.md", match: true},
+		{pattern: "*.md", path: "docs/guide/intro.md", match: true},
+		{pattern: "*.md", path: "main.go", match: false},
+		{pattern: "docs/**", path: "docs/guide/intro.rst", match: true},
+		{pattern: "docs/**", path: "src/docs/intro.rst", match: false},
+		{pattern: "docs/", path: "docs/intro.rst", match: true},
+		{pattern: "/docs/*.txt", path: "docs/notes.txt", match: true},
+		{pattern: "docs/*.txt", path: "docs/sub/notes.txt", match: false},
+		{pattern: "**/testdata/**", path: "testdata/input.json", match: true},
+		{pattern: "**/testdata/**", path: "pkg/a/testdata/input.json", match: true},
+		{pattern: "LICENSE?", path: "LICENSE2", match: true},
+		{pattern: "a+b.txt", path: "a+b.txt", match: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.match, MatchPath(tc.pattern, tc.path))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	docs := Rule{Paths: []string{"docs/**", "*.md"}}
+	small := Rule{MaxChangedLines: 5}
+	smallDocs := Rule{Paths: []string{"docs/**"}, MaxChangedLines: 10}
+
+	testCases := []struct {
+		name     string
+		rules    []Rule
+		files    []File
+		expected *Rule
+	}{
+		{
+			name:     "all files match the globs",
+			rules:    []Rule{docs},
+			files:    []File{{Path: "README.md", Additions: 100}, {Path: "docs/setup.rst", Deletions: 3}},
+			expected: &docs,
+		},
+		{
+			name:  "one file outside the globs",
+			rules: []Rule{docs},
+			files: []File{{Path: "README.md", Additions: 1}, {Path: "main.go", Additions: 1}},
+		},
+		{
+			name:  "renamed from outside the globs",
+			rules: []Rule{docs},
+			files: []File{{Path: "docs/main.go", PreviousPath: "main.go"}},
+		},
+		{
+			name:     "within the changed lines threshold",
+			rules:    []Rule{small},
+			files:    []File{{Path: "main.go", Additions: 2, Deletions: 3}},
+			expected: &small,
+		},
+		{
+			name:     "over the changed lines threshold",
+			rules:    []Rule{small, smallDocs},
+			files:    []File{{Path: "docs/a.rst", Additions: 4, Deletions: 3}},
+			expected: &smallDocs,
+		},
+		{
+			name:  "over every threshold",
+			rules: []Rule{small, smallDocs},
+			files: []File{{Path: "docs/a.rst", Additions: 11}},
+		},
+		{
+			name:  "invalid rule is ignored",
+			rules: []Rule{{}},
+			files: []File{{Path: "main.go", Additions: 1}},
+		},
+		{
+			name:  "no files",
+			rules: []Rule{docs},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Match(tc.rules, tc.files))
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	assert.NoError(t, ValidateRule(Rule{Paths: []string{"docs/**"}}))
+	assert.NoError(t, ValidateRule(Rule{MaxChangedLines: 3}))
+	assert.True(t, errors.Is(ValidateRule(Rule{}), ErrInvalidRule))
+	assert.True(t, errors.Is(ValidateRule(Rule{Paths: []string{" "}}), ErrInvalidRule))
+	assert.True(t, errors.Is(ValidateRule(Rule{Paths: []string{"*.md"}, MaxChangedLines: -1}), ErrInvalidRule))
+}
+
+func TestCountDiffLines(t *testing.T) {
+	additions, deletions := CountDiffLines("@@ -1,3 +1,3 @@\n line\n-old typo\n+new text\n+another\n\\ No newline at end of file\n")
+	assert.Equal(t, 2, additions)
+	assert.Equal(t, 1, deletions)
+}
+
+func TestStatusDescription(t *testing.T) {
+	assert.Equal(t, "EasyCLA check skipped - trivial change of 3/10 lines in docs/**, *.md",
+		StatusDescription(&Rule{Paths: []string{"docs/**", "*.md"}, MaxChangedLines: 10}, 3))
+	assert.Equal(t, "EasyCLA check skipped - trivial change in docs/**", StatusDescription(&Rule{Paths: []string{"docs/**"}}, 30))
+	assert.Len(t, StatusDescription(&Rule{Paths: []string{string(make([]byte, 200))}}, 1), maxStatusDescription)
+}
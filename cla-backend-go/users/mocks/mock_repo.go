@@ -156,6 +156,36 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByGitLabUsername(gitlabUsername
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByGitLabUsername", reflect.TypeOf((*MockUserRepository)(nil).GetUserByGitLabUsername), gitlabUsername)
 }
 
+// GetUserByGiteaID mocks base method.
+func (m *MockUserRepository) GetUserByGiteaID(giteaID string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByGiteaID", giteaID)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByGiteaID indicates an expected call of GetUserByGiteaID.
+func (mr *MockUserRepositoryMockRecorder) GetUserByGiteaID(giteaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByGiteaID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByGiteaID), giteaID)
+}
+
+// GetUserByGiteaUsername mocks base method.
+func (m *MockUserRepository) GetUserByGiteaUsername(giteaUsername string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByGiteaUsername", giteaUsername)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByGiteaUsername indicates an expected call of GetUserByGiteaUsername.
+func (mr *MockUserRepositoryMockRecorder) GetUserByGiteaUsername(giteaUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByGiteaUsername", reflect.TypeOf((*MockUserRepository)(nil).GetUserByGiteaUsername), giteaUsername)
+}
+
 // GetUserByGitlabID mocks base method.
 func (m *MockUserRepository) GetUserByGitlabID(gitlabID int) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -19,6 +19,8 @@ type DBUser struct {
 	UserGithubUsername string   `json:"user_github_username"`
 	UserGitlabID       string   `json:"user_gitlab_id"`
 	UserGitlabUsername string   `json:"user_gitlab_username"`
+	UserGiteaID        string   `json:"user_gitea_id"`
+	UserGiteaUsername  string   `json:"user_gitea_username"`
 	UserCompanyID      string   `json:"user_company_id"`
 	Note               string   `json:"note"`
 }
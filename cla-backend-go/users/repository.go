@@ -46,6 +46,8 @@ type UserRepository interface {
 	GetUserByGitHubUsername(gitHubUsername string) (*models.User, error)
 	GetUserByGitlabID(gitlabID int) (*models.User, error)
 	GetUserByGitLabUsername(gitlabUsername string) (*models.User, error)
+	GetUserByGiteaID(giteaID string) (*models.User, error)
+	GetUserByGiteaUsername(giteaUsername string) (*models.User, error)
 	SearchUsers(searchField string, searchTerm string, fullMatch bool) (*models.Users, error)
 	UpdateUserCompanyID(userID, companyID, note string) error
 	GetUsersByEmail(userEmail string) ([]*models.User, error)
@@ -121,6 +123,18 @@ func (repo repository) CreateUser(user *models.User) (*models.User, error) {
 		}
 	}
 
+	if user.GiteaID != "" {
+		attributes["user_gitea_id"] = &dynamodb.AttributeValue{
+			S: aws.String(user.GiteaID),
+		}
+	}
+
+	if user.GiteaUsername != "" {
+		attributes["user_gitea_username"] = &dynamodb.AttributeValue{
+			S: aws.String(user.GiteaUsername),
+		}
+	}
+
 	if user.LfEmail != "" {
 		attributes["lf_email"] = &dynamodb.AttributeValue{
 			S: aws.String(user.LfEmail.String()),
@@ -1094,6 +1108,69 @@ func (repo repository) GetUserByGitLabUsername(gitLabUsername string) (*models.U
 	return convertDBUserModel(dbUserModels[0]), nil
 }
 
+// GetUserByGiteaID fetches the user record by the instance qualified Gitea ID
+func (repo repository) GetUserByGiteaID(giteaID string) (*models.User, error) {
+	return repo.getUserByGiteaIdentity("user_gitea_id", "gitea-id-index", giteaID)
+}
+
+// GetUserByGiteaUsername fetches the user record by the instance qualified Gitea username
+func (repo repository) GetUserByGiteaUsername(giteaUsername string) (*models.User, error) {
+	return repo.getUserByGiteaIdentity("user_gitea_username", "gitea-username-index", giteaUsername)
+}
+
+// getUserByGiteaIdentity queries the Gitea identity index of the users table
+func (repo repository) getUserByGiteaIdentity(attributeName, indexName, value string) (*models.User, error) {
+	f := logrus.Fields{
+		"functionName":  "users.repository.getUserByGiteaIdentity",
+		"attributeName": attributeName,
+		"value":         value,
+	}
+	// This is the key we want to match
+	condition := expression.Key(attributeName).Equal(expression.Value(value))
+
+	// These are the columns we want returned
+	projection := buildUserProjection()
+
+	// Use the nice builder to create the expression
+	expr, err := expression.NewBuilder().WithKeyCondition(condition).WithProjection(projection).Build()
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("error building expression for %s : %s, error: %v", attributeName, value, err)
+		return nil, err
+	}
+
+	// Assemble the query input parameters
+	queryInput := &dynamodb.QueryInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ProjectionExpression:      expr.Projection(),
+		TableName:                 aws.String(repo.tableName),
+		IndexName:                 aws.String(indexName),
+	}
+
+	// Make the DynamoDB Query API call
+	result, err := repo.dynamoDBClient.Query(queryInput)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("error retrieving user by %s: %s, error: %+v", attributeName, value, err)
+		return nil, err
+	}
+
+	var dbUserModels []DBUser
+	err = dynamodbattribute.UnmarshalListOfMaps(result.Items, &dbUserModels)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("error unmarshalling user record from database for %s: %s, error: %+v", attributeName, value, err)
+		return nil, err
+	}
+
+	if len(dbUserModels) == 0 {
+		return nil, errors.NotFound("user not found when searching by %s: %s", attributeName, value)
+	} else if len(dbUserModels) > 1 {
+		log.WithFields(f).Warnf("retrieved %d results for the %s query when we should return 0 or 1", len(dbUserModels), attributeName)
+	}
+
+	return convertDBUserModel(dbUserModels[0]), nil
+}
+
 func (repo repository) SearchUsers(searchField string, searchTerm string, fullMatch bool) (*models.Users, error) {
 	f := logrus.Fields{
 		"functionName": "users.repository.SearchUsers",
@@ -1269,6 +1346,8 @@ func convertDBUserModel(user DBUser) *models.User {
 		GithubUsername: user.UserGithubUsername,
 		GitlabID:       user.UserGitlabID,
 		GitlabUsername: user.UserGitlabUsername,
+		GiteaID:        user.UserGiteaID,
+		GiteaUsername:  user.UserGiteaUsername,
 		CompanyID:      user.UserCompanyID,
 		Note:           user.Note,
 	}
@@ -1289,6 +1368,8 @@ func buildUserProjection() expression.ProjectionBuilder {
 		expression.Name("user_github_id"),
 		expression.Name("user_gitlab_username"),
 		expression.Name("user_gitlab_id"),
+		expression.Name("user_gitea_username"),
+		expression.Name("user_gitea_id"),
 		expression.Name("date_created"),
 		expression.Name("date_modified"),
 		expression.Name("version"),
@@ -25,6 +25,8 @@ type Service interface {
 	GetUserByGitHubUsername(gitlabUsername string) (*models.User, error)
 	GetUserByGitlabID(gitHubID int) (*models.User, error)
 	GetUserByGitLabUsername(gitlabUsername string) (*models.User, error)
+	GetUserByGiteaID(giteaID string) (*models.User, error)
+	GetUserByGiteaUsername(giteaUsername string) (*models.User, error)
 	SearchUsers(field string, searchTerm string, fullMatch bool) (*models.Users, error)
 	UpdateUserCompanyID(userID, companyID, note string) error
 }
@@ -183,6 +185,22 @@ func (s service) GetUserByGitLabUsername(gitLabUsername string) (*models.User, e
 	return s.repo.GetUserByGitLabUsername(gitLabUsername)
 }
 
+// GetUserByGiteaID fetches the user by the instance qualified Gitea ID
+func (s service) GetUserByGiteaID(giteaID string) (*models.User, error) {
+	if giteaID == "" {
+		return nil, errors.New("giteaID is empty")
+	}
+	return s.repo.GetUserByGiteaID(giteaID)
+}
+
+// GetUserByGiteaUsername fetches the user by the instance qualified Gitea username
+func (s service) GetUserByGiteaUsername(giteaUsername string) (*models.User, error) {
+	if giteaUsername == "" {
+		return nil, errors.New("giteaUsername is empty")
+	}
+	return s.repo.GetUserByGiteaUsername(giteaUsername)
+}
+
 // SearchUsers attempts to locate the user by the searchField and searchTerm fields
 func (s service) SearchUsers(searchField string, searchTerm string, fullMatch bool) (*models.Users, error) {
 	return s.repo.SearchUsers(searchField, searchTerm, fullMatch)
@@ -12,4 +12,6 @@ const (
 	ConnectionFailure = "connection_failure"
 	// NoConnection status
 	NoConnection = "no_connection"
+	// ConnectionSuspended status
+	ConnectionSuspended = "connection_suspended"
 )
@@ -105,6 +105,43 @@ const SignatureTypeCLA = "cla"
 // SignatureTypeCCLA is the ccla signature type in the DB
 const SignatureTypeCCLA = "ccla"
 
+// SignatureStatusPending is the signature status of a CCLA sent to the CLA signatory which has not been signed yet
+const SignatureStatusPending = "pending"
+
+// SignatureStatusAwaitingCounterSignature is the signature status of a multi-party CCLA which was signed by the CLA
+// signatory and is waiting for the counter signers
+const SignatureStatusAwaitingCounterSignature = "awaiting_countersignature"
+
+// SignatureSignerRoleSignatory is the role of the company CLA signatory of a multi-party CCLA
+const SignatureSignerRoleSignatory = "signatory"
+
+// SignatureSignerRoleCounterSigner is the role of a counter signer of a multi-party CCLA
+const SignatureSignerRoleCounterSigner = "counter-signer"
+
+// SignatureStatusSigned is the signature status of a CCLA signature request which was signed by the CLA signatory
+const SignatureStatusSigned = "signed"
+
+// SignatureStatusExpired is the signature status of a CCLA signature request which was voided after the CLA group expiry period
+const SignatureStatusExpired = "expired"
+
+// ResignPolicyNone is the CLA group re-sign policy which keeps the signatures of a previous major document version valid
+const ResignPolicyNone = "none"
+
+// ResignPolicyGracePeriod is the CLA group re-sign policy which keeps the signatures of a previous major document
+// version valid until the re-sign deadline
+const ResignPolicyGracePeriod = "grace-period"
+
+// ResignPolicyImmediate is the CLA group re-sign policy which requires the signers of a previous major document version
+// to re-sign right away
+const ResignPolicyImmediate = "immediate"
+
+// CoAuthorPolicyReport is the CLA group co-author policy which lists the Co-authored-by trailers of the pull request
+// commits in the comment without blocking the pull request - the default when the policy is not set
+const CoAuthorPolicyReport = "report"
+
+// CoAuthorPolicyEnforce is the CLA group co-author policy which requires each co-author to be covered by a signed CLA
+const CoAuthorPolicyEnforce = "enforce"
+
 // FileTypePDF is the pdf file type
 const FileTypePDF = "pdf"
 
@@ -159,6 +196,15 @@ const GitLab = "GitLab"
 // GitLabLower is the GitLab spelled out in lower case
 const GitLabLower = "gitlab"
 
+// Gitea is the Gitea spelled out with the proper case - also used for Forgejo instances
+const Gitea = "Gitea"
+
+// GiteaLower is the Gitea spelled out in lower case
+const GiteaLower = "gitea"
+
+// GiteaRepoNotFound is a string that indicates the Gitea repository is not found
+const GiteaRepoNotFound = "Gitea repository not found"
+
 // GitLabRepoNotFound is a string that indicates the GitLab repository is not found
 const GitLabRepoNotFound = "GitLab repository not found"
 
@@ -217,6 +263,16 @@ const SignatureQueryDefaultActive = "active"
 // GitLabRepositoryType representing the GitLab repository type
 const GitLabRepositoryType = "GitLab"
 
+// EnforcementModeCLA is the repository enforcement mode where contributors must be covered by a signed CLA
+const EnforcementModeCLA = "cla"
+
+// EnforcementModeDCO is the repository enforcement mode where each commit must have a Developer Certificate of Origin sign-off
+const EnforcementModeDCO = "dco"
+
+// EnforcementModeCLAGroup is the repository enforcement mode input which removes the enforcement mode of the repository,
+// the repository then uses the enforcement mode of its CLA group
+const EnforcementModeCLAGroup = "cla-group"
+
 // GitHubRepositoryType representing the GitLab repository type
 const GitHubRepositoryType = "GitHub"
 
@@ -490,6 +490,38 @@ func (e *GitLabRepositoryExists) Unwrap() error {
 	return e.Err
 }
 
+// GiteaRepositoryNotFound is an error model for a Gitea repository not found
+type GiteaRepositoryNotFound struct {
+	Message              string
+	OrganizationName     string
+	RepositoryExternalID int64
+	Err                  error
+}
+
+// Error is an error string function for the GiteaRepositoryNotFound model
+func (e *GiteaRepositoryNotFound) Error() string {
+	msg := GiteaRepoNotFound
+	if e.Message != "" {
+		msg = e.Message
+	}
+	if e.OrganizationName != "" {
+		msg = fmt.Sprintf("%s - organization: %s ", msg, e.OrganizationName)
+	}
+	if e.RepositoryExternalID > 0 {
+		msg = fmt.Sprintf("%s - repository external ID: %d ", msg, e.RepositoryExternalID)
+	}
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s - error: %+v ", msg, e.Err.Error())
+	}
+
+	return strings.TrimSpace(msg)
+}
+
+// Unwrap method returns its contained error
+func (e *GiteaRepositoryNotFound) Unwrap() error {
+	return e.Err
+}
+
 // CLAManagerError is an error model for when a CLA Manager error occurs
 type CLAManagerError struct {
 	Message string
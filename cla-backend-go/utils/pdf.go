@@ -32,3 +32,29 @@ func WatermarkPdf(pdf []byte, text string) ([]byte, error) {
 
 	return b.Bytes(), nil
 }
+
+// StampPdf adds the stamp text to the bottom left corner of every page of the given pdf blob and returns back the new one
+func StampPdf(pdf []byte, text string) ([]byte, error) {
+	readSeek := bytes.NewReader(pdf)
+	var b bytes.Buffer
+	outWriter := bufio.NewWriter(&b)
+
+	// this means it's a stamp
+	onTop := true
+	wm, err := pdfcpu.ParseTextWatermarkDetails(text, "points:9, scale:1 abs, pos:bl, off:20 20, rot:0, op:1", onTop)
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.AddWatermarks(readSeek, outWriter, nil, wm, nil)
+	if err != nil {
+		return nil, fmt.Errorf("applying stamp failed : %w", err)
+	}
+
+	err = outWriter.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
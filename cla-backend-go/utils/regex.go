@@ -5,6 +5,7 @@ package utils
 
 import (
 	"regexp"
+	"strings"
 )
 
 // ValidCompanyName is a routine to indicate if the regex is a valid company name
@@ -43,3 +44,58 @@ func ParseString(regEx, val string) (paramsMap map[string]string) {
 	}
 	return paramsMap
 }
+
+// GlobRegexp converts the glob to an anchored regular expression - * and ? match within a / separated segment and **
+// matches across segments, a **/ prefix also matches no segment
+func GlobRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	// every other character is quoted, so the expression always compiles
+	return regexp.MustCompile(sb.String())
+}
+
+// MatchGlob reports whether the value matches the glob
+func MatchGlob(pattern, value string) bool {
+	return GlobRegexp(pattern).MatchString(value)
+}
+
+// IsBranchEnforced reports whether the checks apply to the pull/merge request base branch - a branch matching one of
+// the exclude globs is never enforced, otherwise the branch is enforced when it matches one of the include globs or
+// no include globs are set
+func IsBranchEnforced(branch string, include, exclude []string) bool {
+	for _, pattern := range exclude {
+		if MatchGlob(pattern, branch) {
+			return false
+		}
+	}
+	if len(include) == 0 {
+		return true
+	}
+	for _, pattern := range include {
+		if MatchGlob(pattern, branch) {
+			return true
+		}
+	}
+	return false
+}
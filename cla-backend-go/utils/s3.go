@@ -5,6 +5,8 @@ package utils
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"io"
 	"os"
@@ -27,6 +29,7 @@ const PresignedURLValidity = 15 * time.Minute
 type S3Storage interface {
 	Upload(fileContent []byte, projectID string, claType string, identifier string, signatureID string) error
 	UploadFile(file *os.File, projectID string, claType string, identifier string, signatureID string) error
+	UploadKey(fileContent []byte, key string) error
 	Download(filename string) ([]byte, error)
 	Delete(filename string) error
 	GetPresignedURL(filename string) (string, error)
@@ -49,6 +52,11 @@ func SetS3Storage(awsSession *session.Session, bucketName string) {
 	}
 }
 
+// SetS3StorageClient sets the S3Storage implementation - useful when working with tests
+func SetS3StorageClient(storage S3Storage) {
+	s3Storage = storage
+}
+
 // Upload file to s3 storage at path contract-group/<project-ID>/<claType>/<identifier>/<signatureID>.pdf
 // claType should be cla or ccla
 // identifier can be user-id or company-id
@@ -72,6 +80,16 @@ func (s3c *S3Client) UploadFile(file *os.File, projectID string, claType string,
 	return err
 }
 
+// UploadKey uploads the file to s3 storage at the specified key
+func (s3c *S3Client) UploadKey(fileContent []byte, key string) error {
+	_, err := s3c.s3.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(s3c.BucketName),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(fileContent),
+	})
+	return err
+}
+
 // Download file from s3
 func (s3c *S3Client) Download(filename string) ([]byte, error) {
 	ou, err := s3c.s3.GetObject(&s3.GetObjectInput{
@@ -137,6 +155,14 @@ func UploadFileToS3(file *os.File, projectID string, claType string, identifier
 	return s3Storage.UploadFile(file, projectID, claType, identifier, signatureID)
 }
 
+// UploadKeyToS3 uploads file to s3 storage at the specified key
+func UploadKeyToS3(body []byte, key string) error {
+	if s3Storage == nil {
+		return errors.New("s3Storage not set")
+	}
+	return s3Storage.UploadKey(body, key)
+}
+
 func DocumentExists(key string) (bool, error) {
 	if s3Storage == nil {
 		return false, errors.New("s3 storage not set")
@@ -197,6 +223,12 @@ func (s3c *S3Client) KeyExists(key string) (bool, error) {
 	return true, nil
 }
 
+// SignedDocumentDigest returns the hex encoded SHA-256 digest of the signed document
+func SignedDocumentDigest(body []byte) string {
+	digest := sha256.Sum256(body)
+	return hex.EncodeToString(digest[:])
+}
+
 // SignedCLAFilename provide s3 bucket url
 func SignedCLAFilename(projectID string, claType string, identifier string, signatureID string) string {
 	return strings.Join([]string{"contract-group", projectID, claType, identifier, signatureID}, "/") + ".pdf"
@@ -206,3 +238,13 @@ func SignedCLAFilename(projectID string, claType string, identifier string, sign
 func SignedClaGroupZipFilename(projectID string, claType string) string {
 	return strings.Join([]string{"contract-group", projectID, claType}, "/") + ".zip"
 }
+
+// ClickThroughDocumentFilename provides the s3 key of a click-through envelope document
+func ClickThroughDocumentFilename(envelopeID string, documentID string) string {
+	return strings.Join([]string{"click-through", envelopeID, documentID}, "/") + ".pdf"
+}
+
+// ClickThroughSignedDocumentFilename provides the s3 key of a signed click-through envelope document
+func ClickThroughSignedDocumentFilename(envelopeID string, documentID string) string {
+	return strings.Join([]string{"click-through", envelopeID, documentID}, "/") + "-signed.pdf"
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/LF-Engineering/lfx-kit/auth"
 	"github.com/communitybridge/easycla/cla-backend-go/events"
 
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
@@ -871,3 +872,18 @@ func (n *ProjectNode) String() string {
 
 	return msg
 }
+
+// toV1CounterSigners converts the v2 CCLA counter signers to the v1 CLA Group counter signers
+func toV1CounterSigners(counterSigners []*models.ClaGroupCounterSigner) []*v1Models.ClaGroupCounterSigner {
+	var response []*v1Models.ClaGroupCounterSigner
+	for _, counterSigner := range counterSigners {
+		if counterSigner == nil {
+			continue
+		}
+		response = append(response, &v1Models.ClaGroupCounterSigner{
+			Name:  counterSigner.Name,
+			Email: counterSigner.Email,
+		})
+	}
+	return response
+}
@@ -138,17 +138,27 @@ func (s *service) CreateCLAGroup(ctx context.Context, authUser *auth.User, input
 	// Create the CLA Group
 	log.WithFields(f).WithField("input", input).Debugf("creating cla group")
 	claGroup, err := s.v1ProjectService.CreateCLAGroup(ctx, &v1Models.ClaGroup{
-		FoundationSFID:          *input.FoundationSfid,
-		FoundationLevelCLA:      foundationLevelCLA,
-		ProjectDescription:      input.ClaGroupDescription,
-		ProjectCCLAEnabled:      *input.CclaEnabled,
-		ProjectCCLARequiresICLA: *input.CclaRequiresIcla,
-		ProjectExternalID:       *input.FoundationSfid,
-		ProjectACL:              []string{projectManagerLFID},
-		ProjectICLAEnabled:      *input.IclaEnabled,
-		ProjectName:             *input.ClaGroupName,
-		ProjectTemplateID:       input.TemplateFields.TemplateID,
-		Version:                 "v2",
+		FoundationSFID:                   *input.FoundationSfid,
+		FoundationLevelCLA:               foundationLevelCLA,
+		ProjectDescription:               input.ClaGroupDescription,
+		ProjectCCLAEnabled:               *input.CclaEnabled,
+		ProjectCCLARequiresICLA:          *input.CclaRequiresIcla,
+		ProjectExternalID:                *input.FoundationSfid,
+		ProjectACL:                       []string{projectManagerLFID},
+		ProjectICLAEnabled:               *input.IclaEnabled,
+		ProjectName:                      *input.ClaGroupName,
+		ProjectTemplateID:                input.TemplateFields.TemplateID,
+		ProjectSignatureProvider:         input.SignatureProvider,
+		ProjectCCLASignatoryReminderDays: input.CclaSignatoryReminderDays,
+		ProjectCCLASignatoryExpiryDays:   input.CclaSignatoryExpiryDays,
+		ProjectCCLACounterSigners:        toV1CounterSigners(input.CclaCounterSigners),
+		ProjectResignPolicy:              input.ResignPolicy,
+		ProjectResignGraceDays:           input.ResignGraceDays,
+		ProjectSignatureTermDays:         input.SignatureTermDays,
+		ProjectSignatureExpiryNoticeDays: input.SignatureExpiryNoticeDays,
+		ProjectCoAuthorPolicy:            input.CoAuthorPolicy,
+		ProjectEnforcementMode:           input.EnforcementMode,
+		Version:                          "v2",
 	})
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("cla group create failed")
@@ -288,6 +298,32 @@ func (s *service) UpdateCLAGroup(ctx context.Context, authUser *auth.User, claGr
 		}
 	}
 
+	// Keep the existing CCLA signatory reminder/expiry, counter signer, re-sign and signature term settings unless provided
+	signatoryReminderDays := claGroupModel.ProjectCCLASignatoryReminderDays
+	if input.CclaSignatoryReminderDays != nil {
+		signatoryReminderDays = *input.CclaSignatoryReminderDays
+	}
+	signatoryExpiryDays := claGroupModel.ProjectCCLASignatoryExpiryDays
+	if input.CclaSignatoryExpiryDays != nil {
+		signatoryExpiryDays = *input.CclaSignatoryExpiryDays
+	}
+	counterSigners := claGroupModel.ProjectCCLACounterSigners
+	if input.CclaCounterSigners != nil {
+		counterSigners = toV1CounterSigners(input.CclaCounterSigners)
+	}
+	resignGraceDays := claGroupModel.ProjectResignGraceDays
+	if input.ResignGraceDays != nil {
+		resignGraceDays = *input.ResignGraceDays
+	}
+	signatureTermDays := claGroupModel.ProjectSignatureTermDays
+	if input.SignatureTermDays != nil {
+		signatureTermDays = *input.SignatureTermDays
+	}
+	signatureExpiryNoticeDays := claGroupModel.ProjectSignatureExpiryNoticeDays
+	if input.SignatureExpiryNoticeDays != nil {
+		signatureExpiryNoticeDays = *input.SignatureExpiryNoticeDays
+	}
+
 	// Update the CLA Group
 	log.WithFields(f).WithField("input", input).Debugf("updating cla group...")
 	claGroup, err := s.v1ProjectService.UpdateCLAGroup(ctx, &v1Models.ClaGroup{
@@ -295,22 +331,32 @@ func (s *service) UpdateCLAGroup(ctx context.Context, authUser *auth.User, claGr
 		ProjectName:        input.ClaGroupName,
 		ProjectDescription: input.ClaGroupDescription,
 		// Copy over the existing values
-		ProjectExternalID:            claGroupModel.ProjectExternalID,
-		FoundationSFID:               claGroupModel.FoundationSFID,
-		FoundationLevelCLA:           claGroupModel.FoundationLevelCLA,
-		Gerrits:                      claGroupModel.Gerrits,
-		GithubRepositories:           claGroupModel.GithubRepositories,
-		ProjectACL:                   claGroupModel.ProjectACL,
-		ProjectICLAEnabled:           claGroupModel.ProjectICLAEnabled,
-		ProjectCCLAEnabled:           claGroupModel.ProjectCCLAEnabled,
-		ProjectTemplateID:            claGroupModel.ProjectTemplateID,
-		ProjectCCLARequiresICLA:      claGroupModel.ProjectCCLARequiresICLA,
-		ProjectIndividualDocuments:   claGroupModel.ProjectIndividualDocuments,
-		ProjectCorporateDocuments:    claGroupModel.ProjectCorporateDocuments,
-		ProjectMemberDocuments:       claGroupModel.ProjectMemberDocuments,
-		ProjectLive:                  claGroupModel.ProjectLive,
-		RootProjectRepositoriesCount: claGroupModel.RootProjectRepositoriesCount,
-		Version:                      claGroupModel.Version,
+		ProjectExternalID:                claGroupModel.ProjectExternalID,
+		FoundationSFID:                   claGroupModel.FoundationSFID,
+		FoundationLevelCLA:               claGroupModel.FoundationLevelCLA,
+		Gerrits:                          claGroupModel.Gerrits,
+		GithubRepositories:               claGroupModel.GithubRepositories,
+		ProjectACL:                       claGroupModel.ProjectACL,
+		ProjectICLAEnabled:               claGroupModel.ProjectICLAEnabled,
+		ProjectCCLAEnabled:               claGroupModel.ProjectCCLAEnabled,
+		ProjectTemplateID:                claGroupModel.ProjectTemplateID,
+		ProjectCCLARequiresICLA:          claGroupModel.ProjectCCLARequiresICLA,
+		ProjectIndividualDocuments:       claGroupModel.ProjectIndividualDocuments,
+		ProjectCorporateDocuments:        claGroupModel.ProjectCorporateDocuments,
+		ProjectMemberDocuments:           claGroupModel.ProjectMemberDocuments,
+		ProjectLive:                      claGroupModel.ProjectLive,
+		ProjectSignatureProvider:         input.SignatureProvider,
+		ProjectCCLASignatoryReminderDays: signatoryReminderDays,
+		ProjectCCLASignatoryExpiryDays:   signatoryExpiryDays,
+		ProjectCCLACounterSigners:        counterSigners,
+		ProjectResignPolicy:              input.ResignPolicy,
+		ProjectResignGraceDays:           resignGraceDays,
+		ProjectSignatureTermDays:         signatureTermDays,
+		ProjectSignatureExpiryNoticeDays: signatureExpiryNoticeDays,
+		ProjectCoAuthorPolicy:            input.CoAuthorPolicy,
+		ProjectEnforcementMode:           input.EnforcementMode,
+		RootProjectRepositoriesCount:     claGroupModel.RootProjectRepositoriesCount,
+		Version:                          claGroupModel.Version,
 	})
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("cla group update failed")
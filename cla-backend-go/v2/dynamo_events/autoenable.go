@@ -15,6 +15,7 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
 	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
@@ -129,7 +130,7 @@ func (a *autoEnableServiceProvider) CreateAutoEnabledRepository(repo *github.Rep
 			RepositoryProjectID:        swag.String(claGroupID),
 			RepositoryName:             swag.String(repositoryFullName),
 			RepositoryType:             swag.String("github"),
-			RepositoryURL:              swag.String("https://github.com/" + repositoryFullName),
+			RepositoryURL:              swag.String(v1Github.HTMLURL(orgModel.GithubBaseURL, repositoryFullName)),
 			RepositoryOrganizationName: swag.String(organizationName),
 			RepositoryExternalID:       swag.String(repositoryExternalID),
 		})
@@ -151,7 +152,7 @@ func (a *autoEnableServiceProvider) CreateAutoEnabledRepository(repo *github.Rep
 			RepositoryProjectID:        swag.String(claGroupID),
 			Enabled:                    &enabled,
 			RepositoryType:             swag.String("github"),
-			RepositoryURL:              swag.String("https://github.com/" + repositoryFullName),
+			RepositoryURL:              swag.String(v1Github.HTMLURL(orgModel.GithubBaseURL, repositoryFullName)),
 		})
 		if err != nil {
 			return nil, err
@@ -4,6 +4,8 @@
 package dynamo_events
 
 import (
+	"strconv"
+
 	"github.com/aws/aws-lambda-go/events"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/project/models"
@@ -54,6 +56,18 @@ func (s *service) ProcessCLAGroupUpdateEvents(event events.DynamoDBEventRecord)
 		log.WithFields(f).Warnf("unable to update cla manager request with updated CLA Group information, error: %+v", approvalListRequestErr)
 	}
 
+	// Start the re-sign campaigns when a new major version of the CLA Group documents was published
+	if maxDocumentMajorVersion(updatedProject.ProjectIndividualDocuments) > maxDocumentMajorVersion(oldProject.ProjectIndividualDocuments) ||
+		maxDocumentMajorVersion(updatedProject.ProjectCorporateDocuments) > maxDocumentMajorVersion(oldProject.ProjectCorporateDocuments) {
+		log.WithFields(f).Debugf("new major document version published for CLA Group: %s - starting re-sign campaigns", updatedProject.ProjectID)
+		campaigns, campaignErr := s.resignCampaignService.StartCampaigns(ctx, updatedProject.ProjectID, "easycla system")
+		if campaignErr != nil {
+			log.WithFields(f).WithError(campaignErr).Warnf("unable to start the re-sign campaigns for CLA Group: %s", updatedProject.ProjectID)
+		} else {
+			log.WithFields(f).Debugf("started %d re-sign campaigns for CLA Group: %s", len(campaigns), updatedProject.ProjectID)
+		}
+	}
+
 	if oldProject.ProjectName != updatedProject.ProjectName {
 		claProjects, err := s.projectsClaGroupRepo.GetProjectsIdsForClaGroup(ctx, updatedProject.ProjectID)
 		if err != nil {
@@ -76,3 +90,15 @@ func (s *service) ProcessCLAGroupUpdateEvents(event events.DynamoDBEventRecord)
 
 	return nil
 }
+
+// maxDocumentMajorVersion returns the highest major version of the CLA Group documents
+func maxDocumentMajorVersion(documents []models.DBProjectDocumentModel) int {
+	maxMajorVersion := 0
+	for _, document := range documents {
+		majorVersion, err := strconv.Atoi(document.DocumentMajorVersion)
+		if err == nil && majorVersion > maxMajorVersion {
+			maxMajorVersion = majorVersion
+		}
+	}
+	return maxMajorVersion
+}
@@ -154,7 +154,8 @@ func (s *service) enableBranchProtectionForGithubOrg(ctx context.Context, newGit
 	}
 
 	log.WithFields(f).Debugf("creating a new GitHub client object for org: %s...", newGitHubOrg.OrganizationName)
-	branchProtectionRepo, err := branch_protection.NewBranchProtectionRepository(newGitHubOrg.OrganizationInstallationID, branch_protection.EnableBlockingLimiter())
+	branchProtectionRepo, err := branch_protection.NewBranchProtectionRepository(newGitHubOrg.OrganizationInstallationID, branch_protection.EnableBlockingLimiter(),
+		branch_protection.WithGitHubBaseURL(newGitHubOrg.OrganizationBaseURL))
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("initializing branch protection repository failed")
 		return err
@@ -111,7 +111,8 @@ func (s *service) EnableBranchProtectionServiceHandler(event events.DynamoDBEven
 			log.WithFields(f).Debug("branch protection is enabled for this organization")
 
 			ctx := context.Background()
-			branchProtectionRepository, err := branch_protection.NewBranchProtectionRepository(gitHubOrg.OrganizationInstallationID, branch_protection.EnableBlockingLimiter())
+			branchProtectionRepository, err := branch_protection.NewBranchProtectionRepository(gitHubOrg.OrganizationInstallationID, branch_protection.EnableBlockingLimiter(),
+				branch_protection.WithGitHubBaseURL(gitHubOrg.GithubBaseURL))
 			if err != nil {
 				log.WithFields(f).WithError(err).Warnf("initializing branch protection repository failed")
 				return err
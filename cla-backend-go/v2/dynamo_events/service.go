@@ -18,6 +18,7 @@ import (
 
 	gitlab_api "github.com/communitybridge/easycla/cla-backend-go/gitlab_api"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/gitlab_organizations"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/resign_campaigns"
 
 	"github.com/communitybridge/easycla/cla-backend-go/gerrits"
 
@@ -75,6 +76,7 @@ type service struct {
 	claManagerRequestsRepo   cla_manager.IRepository
 	approvalListRequestsRepo approval_list.IRepository
 	gitLabApp                *gitlab_api.App
+	resignCampaignService    resign_campaigns.Service
 }
 
 // Service implements DynamoDB stream event handler service
@@ -99,7 +101,8 @@ func NewService(stage string,
 	claManagerRequestsRepo cla_manager.IRepository,
 	approvalListRequestsRepo approval_list.IRepository,
 	gitLabApp *gitlab_api.App,
-	gitlabOrgService gitlab_organizations.ServiceInterface) Service {
+	gitlabOrgService gitlab_organizations.ServiceInterface,
+	resignCampaignService resign_campaigns.Service) Service {
 
 	signaturesTable := fmt.Sprintf("cla-%s-signatures", stage)
 	eventsTable := fmt.Sprintf("cla-%s-events", stage)
@@ -129,6 +132,7 @@ func NewService(stage string,
 		approvalListRequestsRepo: approvalListRequestsRepo,
 		gitLabApp:                gitLabApp,
 		gitLabOrgService:         gitlabOrgService,
+		resignCampaignService:    resignCampaignService,
 	}
 
 	s.registerCallback(signaturesTable, Modify, s.SignatureSignedEvent)
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 	acs_service "github.com/communitybridge/easycla/cla-backend-go/v2/acs-service"
 	organization_service "github.com/communitybridge/easycla/cla-backend-go/v2/organization-service"
@@ -62,6 +64,7 @@ type Signature struct {
 	UserName                      string   `json:"user_name"`
 	UserEmail                     string   `json:"user_email"`
 	SignedOn                      string   `json:"signed_on"`
+	SignatureExpiresOn            string   `json:"signature_expires_on"`
 }
 
 // Assign Contributor role upon CCLA or CCLA/ICLA signing
@@ -153,6 +156,12 @@ func (s *service) SignatureSignedEvent(event events.DynamoDBEventRecord) error {
 			log.WithFields(f).Warnf("failed to add signed_on date/time to signature, error: %+v", err)
 		}
 
+		// Set the expiry date when the CLA group signatures are time-bound
+		err = s.setSignatureExpiry(ctx, &newSignature)
+		if err != nil {
+			log.WithFields(f).Warnf("failed to set the signature expiry date, error: %+v", err)
+		}
+
 		// If oldSigACL CCLA signature...
 		if newSignature.SignatureType == CCLASignatureType {
 			log.WithFields(f).Debugf("processing signature type: %s with %d CLA Managers...",
@@ -617,3 +626,33 @@ func (s *service) updateCLAManagerPermissions(signature Signature, managers []st
 	return nil
 
 }
+
+// setSignatureExpiry sets the expiry date of a newly signed ICLA or CCLA signature based on the CLA group signature term
+func (s *service) setSignatureExpiry(ctx context.Context, signature *Signature) error {
+	f := logrus.Fields{
+		"functionName":   "v2.dynamo_events.signatures.setSignatureExpiry",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+		"claGroupID":     signature.SignatureProjectID,
+	}
+
+	// Employee acknowledgements are covered by the corporate signature and the admins may have set the expiry already
+	if signature.SignatureUserCompanyID != "" || signature.SignatureExpiresOn != "" {
+		return nil
+	}
+
+	claGroup, err := s.projectRepo.GetCLAGroupByID(ctx, signature.SignatureProjectID, repository.DontLoadRepoDetails)
+	if err != nil {
+		return err
+	}
+	if claGroup == nil || claGroup.ProjectSignatureTermDays <= 0 {
+		return nil
+	}
+
+	now, _ := utils.CurrentTime()
+	expiresOn := utils.TimeToString(now.Add(time.Duration(claGroup.ProjectSignatureTermDays) * 24 * time.Hour))
+	log.WithFields(f).Debugf("setting the signature expiry date to: %s", expiresOn)
+	return s.signatureRepo.UpdateSignature(ctx, signature.SignatureID, map[string]interface{}{
+		"signature_expires_on": expiresOn,
+	})
+}
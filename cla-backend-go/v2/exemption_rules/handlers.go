@@ -0,0 +1,133 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package exemption_rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/LF-Engineering/lfx-kit/auth"
+	"github.com/communitybridge/easycla/cla-backend-go/exemptions"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/exemption_rules"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+// Configure setup the exemption rule API handlers
+func Configure(api *operations.EasyclaAPI, service exemptions.Service) {
+	api.ExemptionRulesListExemptionRulesHandler = exemption_rules.ListExemptionRulesHandlerFunc(func(params exemption_rules.ListExemptionRulesParams, authUser *auth.User) middleware.Responder {
+		reqID := utils.GetRequestID(params.XREQUESTID)
+		ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTID, reqID) // nolint
+		utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+		f := logrus.Fields{
+			"functionName":   "v2.exemption_rules.handlers.ExemptionRulesListExemptionRulesHandler",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			"scope":          params.Scope,
+			"scopeID":        params.ScopeID,
+			"authUser":       authUser.UserName,
+		}
+
+		projectSFIDs, lookupErr := service.GetScopeProjectSFIDs(ctx, params.Scope, params.ScopeID)
+		if lookupErr != nil || len(projectSFIDs) == 0 {
+			msg := fmt.Sprintf("unable to lookup the projects of the %s: %s", params.Scope, params.ScopeID)
+			log.WithFields(f).WithError(lookupErr).Warn(msg)
+			return exemption_rules.NewListExemptionRulesNotFound().WithXRequestID(reqID).WithPayload(utils.ErrorResponseNotFoundWithError(reqID, msg, lookupErr))
+		}
+		if !utils.IsUserAuthorizedForAnyProjects(ctx, authUser, projectSFIDs, utils.ALLOW_ADMIN_SCOPE) {
+			msg := fmt.Sprintf("authUser '%s' does not have access to view the exemption rules with Project scope of any %s",
+				authUser.UserName, strings.Join(projectSFIDs, ","))
+			log.WithFields(f).Debug(msg)
+			return exemption_rules.NewListExemptionRulesForbidden().WithXRequestID(reqID).WithPayload(utils.ErrorResponseForbidden(reqID, msg))
+		}
+
+		result, err := service.ListExemptionRules(ctx, params.Scope, params.ScopeID)
+		if err != nil {
+			msg := fmt.Sprintf("unable to load the exemption rules of the %s: %s", params.Scope, params.ScopeID)
+			log.WithFields(f).WithError(err).Warn(msg)
+			return exemption_rules.NewListExemptionRulesInternalServerError().WithXRequestID(reqID).WithPayload(utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+		}
+
+		return exemption_rules.NewListExemptionRulesOK().WithXRequestID(reqID).WithPayload(result)
+	})
+
+	api.ExemptionRulesCreateExemptionRuleHandler = exemption_rules.CreateExemptionRuleHandlerFunc(func(params exemption_rules.CreateExemptionRuleParams, authUser *auth.User) middleware.Responder {
+		reqID := utils.GetRequestID(params.XREQUESTID)
+		ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTID, reqID) // nolint
+		utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+		f := logrus.Fields{
+			"functionName":   "v2.exemption_rules.handlers.ExemptionRulesCreateExemptionRuleHandler",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			"scope":          params.Scope,
+			"scopeID":        params.ScopeID,
+			"authUser":       authUser.UserName,
+		}
+
+		projectSFIDs, lookupErr := service.GetScopeProjectSFIDs(ctx, params.Scope, params.ScopeID)
+		if lookupErr != nil || len(projectSFIDs) == 0 {
+			msg := fmt.Sprintf("unable to lookup the projects of the %s: %s", params.Scope, params.ScopeID)
+			log.WithFields(f).WithError(lookupErr).Warn(msg)
+			return exemption_rules.NewCreateExemptionRuleNotFound().WithXRequestID(reqID).WithPayload(utils.ErrorResponseNotFoundWithError(reqID, msg, lookupErr))
+		}
+		if !utils.IsUserAuthorizedForAnyProjects(ctx, authUser, projectSFIDs, utils.ALLOW_ADMIN_SCOPE) {
+			msg := fmt.Sprintf("authUser '%s' does not have access to create the exemption rules with Project scope of any %s",
+				authUser.UserName, strings.Join(projectSFIDs, ","))
+			log.WithFields(f).Debug(msg)
+			return exemption_rules.NewCreateExemptionRuleForbidden().WithXRequestID(reqID).WithPayload(utils.ErrorResponseForbidden(reqID, msg))
+		}
+
+		result, err := service.CreateExemptionRule(ctx, params.Scope, params.ScopeID, params.Body, authUser.UserName)
+		if err != nil {
+			msg := fmt.Sprintf("unable to create the exemption rule of the %s: %s", params.Scope, params.ScopeID)
+			log.WithFields(f).WithError(err).Warn(msg)
+			return exemption_rules.NewCreateExemptionRuleBadRequest().WithXRequestID(reqID).WithPayload(utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+		}
+
+		return exemption_rules.NewCreateExemptionRuleOK().WithXRequestID(reqID).WithPayload(result)
+	})
+
+	api.ExemptionRulesDeleteExemptionRuleHandler = exemption_rules.DeleteExemptionRuleHandlerFunc(func(params exemption_rules.DeleteExemptionRuleParams, authUser *auth.User) middleware.Responder {
+		reqID := utils.GetRequestID(params.XREQUESTID)
+		ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTID, reqID) // nolint
+		utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+		f := logrus.Fields{
+			"functionName":    "v2.exemption_rules.handlers.ExemptionRulesDeleteExemptionRuleHandler",
+			utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+			"scope":           params.Scope,
+			"scopeID":         params.ScopeID,
+			"exemptionRuleID": params.ExemptionRuleID,
+			"authUser":        authUser.UserName,
+		}
+
+		projectSFIDs, lookupErr := service.GetScopeProjectSFIDs(ctx, params.Scope, params.ScopeID)
+		if lookupErr != nil || len(projectSFIDs) == 0 {
+			msg := fmt.Sprintf("unable to lookup the projects of the %s: %s", params.Scope, params.ScopeID)
+			log.WithFields(f).WithError(lookupErr).Warn(msg)
+			return exemption_rules.NewDeleteExemptionRuleNotFound().WithXRequestID(reqID).WithPayload(utils.ErrorResponseNotFoundWithError(reqID, msg, lookupErr))
+		}
+		if !utils.IsUserAuthorizedForAnyProjects(ctx, authUser, projectSFIDs, utils.ALLOW_ADMIN_SCOPE) {
+			msg := fmt.Sprintf("authUser '%s' does not have access to delete the exemption rules with Project scope of any %s",
+				authUser.UserName, strings.Join(projectSFIDs, ","))
+			log.WithFields(f).Debug(msg)
+			return exemption_rules.NewDeleteExemptionRuleForbidden().WithXRequestID(reqID).WithPayload(utils.ErrorResponseForbidden(reqID, msg))
+		}
+
+		err := service.DeleteExemptionRule(ctx, params.Scope, params.ScopeID, params.ExemptionRuleID, authUser.UserName)
+		if err != nil {
+			if errors.Is(err, exemptions.ErrExemptionRuleNotFound) {
+				msg := fmt.Sprintf("exemption rule %s of the %s: %s not found", params.ExemptionRuleID, params.Scope, params.ScopeID)
+				return exemption_rules.NewDeleteExemptionRuleNotFound().WithXRequestID(reqID).WithPayload(utils.ErrorResponseNotFound(reqID, msg))
+			}
+			msg := fmt.Sprintf("unable to delete the exemption rule %s", params.ExemptionRuleID)
+			log.WithFields(f).WithError(err).Warn(msg)
+			return exemption_rules.NewDeleteExemptionRuleInternalServerError().WithXRequestID(reqID).WithPayload(utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+		}
+
+		return exemption_rules.NewDeleteExemptionRuleNoContent().WithXRequestID(reqID)
+	})
+}
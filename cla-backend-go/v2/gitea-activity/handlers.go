@@ -0,0 +1,76 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_activity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/gitea_activity"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type contextKey string
+
+// payloadContextKey is the request context key of the raw webhook payload, the payload signature is computed over
+// the raw bytes so the parsed body can't be used
+const payloadContextKey contextKey = "gitea_webhook_payload"
+
+// WebhookMiddleware keeps a copy of the raw webhook payload in the request context
+func WebhookMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			payload, err := io.ReadAll(r.Body)
+			if err != nil {
+				log.WithFields(logrus.Fields{"functionName": "v2.gitea-activity.handlers.WebhookMiddleware"}).WithError(err).Warn("unable to read request body")
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+			r.Body.Close() // nolint
+			r.Body = io.NopCloser(bytes.NewBuffer(payload))
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), payloadContextKey, payload)))
+		})
+	}
+}
+
+// Configure setups handlers on api with service
+func Configure(api *operations.EasyclaAPI, service Service) {
+	api.GiteaActivityGiteaActivityHandler = gitea_activity.GiteaActivityHandlerFunc(func(params gitea_activity.GiteaActivityParams) middleware.Responder {
+		requestID, _ := uuid.NewV4()
+		reqID := requestID.String()
+		f := logrus.Fields{
+			"functionName": "gitea_activity.handlers.GiteaActivityGiteaActivityHandler",
+			"requestID":    reqID,
+		}
+		log.WithFields(f).Debugf("handling gitea activity callback")
+		ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID)
+
+		// Same as GitLab - return a 200 even when we fail to process the event, the Gitea webhook delivery is only
+		// retried manually and the pull request can be re-checked with an /easycla comment
+		payload, ok := params.HTTPRequest.Context().Value(payloadContextKey).([]byte)
+		if !ok {
+			log.WithFields(f).Warn("missing gitea webhook payload")
+			return gitea_activity.NewGiteaActivityOK()
+		}
+
+		err := service.ProcessWebhookEvent(ctx, params.HTTPRequest.Header, payload)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("processing gitea event failed")
+			if errors.Is(err, webhookSignatureMismatch) {
+				return gitea_activity.NewGiteaActivityUnauthorized().WithPayload(
+					utils.ErrorResponseUnauthorized(reqID, err.Error()))
+			}
+		}
+
+		return gitea_activity.NewGiteaActivityOK()
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_activity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/gitea_activity"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeService struct {
+	err     error
+	payload []byte
+}
+
+func (f *fakeService) ProcessWebhookEvent(ctx context.Context, header http.Header, payload []byte) error {
+	f.payload = payload
+	return f.err
+}
+
+func (f *fakeService) ProcessPullRequestActivity(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, repositoryExternalID, pullRequestID int64) error {
+	return nil
+}
+
+func TestWebhookMiddleware(t *testing.T) {
+	payload := []byte(`{"action":"opened","number":3}`)
+	var contextPayload, bodyPayload []byte
+	handler := WebhookMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contextPayload, _ = r.Context().Value(payloadContextKey).([]byte)
+		bodyPayload, _ = io.ReadAll(r.Body)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v4/gitea/activity", bytes.NewReader(payload)))
+
+	assert.Equal(t, payload, contextPayload)
+	// the body is still readable by the generated request binder
+	assert.Equal(t, payload, bodyPayload)
+}
+
+func TestGiteaActivityHandler(t *testing.T) {
+	payload := []byte(`{"action":"opened","number":3}`)
+
+	testCases := []struct {
+		Name             string
+		ServiceErr       error
+		WithPayload      bool
+		ExpectedResponse interface{}
+		ExpectedPayload  []byte
+	}{
+		{
+			Name:             "processed event",
+			WithPayload:      true,
+			ExpectedResponse: &gitea_activity.GiteaActivityOK{},
+			ExpectedPayload:  payload,
+		},
+		{
+			Name:             "webhook signature mismatch is unauthorized",
+			ServiceErr:       webhookSignatureMismatch,
+			WithPayload:      true,
+			ExpectedResponse: &gitea_activity.GiteaActivityUnauthorized{},
+			ExpectedPayload:  payload,
+		},
+		{
+			Name:             "processing failure is acknowledged",
+			ServiceErr:       errors.New("gitea is down"),
+			WithPayload:      true,
+			ExpectedResponse: &gitea_activity.GiteaActivityOK{},
+			ExpectedPayload:  payload,
+		},
+		{
+			Name:             "missing raw payload is not processed",
+			ExpectedResponse: &gitea_activity.GiteaActivityOK{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			service := &fakeService{err: tc.ServiceErr}
+			api := &operations.EasyclaAPI{}
+			Configure(api, service)
+
+			req := httptest.NewRequest(http.MethodPost, "/v4/gitea/activity", bytes.NewReader(payload))
+			if tc.WithPayload {
+				req = req.WithContext(context.WithValue(req.Context(), payloadContextKey, payload))
+			}
+
+			responder := api.GiteaActivityGiteaActivityHandler.Handle(gitea_activity.GiteaActivityParams{HTTPRequest: req})
+			assert.IsType(t, tc.ExpectedResponse, responder)
+			assert.Equal(t, tc.ExpectedPayload, service.payload)
+		})
+	}
+}
@@ -0,0 +1,467 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/communitybridge/easycla/cla-backend-go/company"
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	signatures1 "github.com/communitybridge/easycla/cla-backend-go/gen/v1/restapi/operations/signatures"
+	gitea "github.com/communitybridge/easycla/cla-backend-go/gitea_api"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	missingID                 = errors.New("user missing in easyCLA records")
+	missingCompanyAffiliation = errors.New("must confirm affiliation with their company")
+	webhookSignatureMismatch  = errors.New("webhook signature mismatch")
+)
+
+type gatedGiteaUser struct {
+	*gitea.User
+	err error
+}
+
+// Service contains the functions of the Gitea activity service
+type Service interface {
+	ProcessWebhookEvent(ctx context.Context, header http.Header, payload []byte) error
+	ProcessPullRequestActivity(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, repositoryExternalID, pullRequestID int64) error
+}
+
+type service struct {
+	giteaOrgService      gitea_organizations.ServiceInterface
+	usersRepository      users.UserRepository
+	signaturesRepository signatures.SignatureRepository
+	companyRepository    company.IRepository
+}
+
+// NewService creates a new Gitea activity service
+func NewService(giteaOrgService gitea_organizations.ServiceInterface, usersRepository users.UserRepository, signaturesRepository signatures.SignatureRepository, companyRepository company.IRepository) Service {
+	return &service{
+		giteaOrgService:      giteaOrgService,
+		usersRepository:      usersRepository,
+		signaturesRepository: signaturesRepository,
+		companyRepository:    companyRepository,
+	}
+}
+
+// ProcessWebhookEvent handles the pull request and the pull request comment webhook events, the webhook signature is
+// validated with the secret of the organization which owns the repository
+func (s *service) ProcessWebhookEvent(ctx context.Context, header http.Header, payload []byte) error {
+	eventType := gitea.EventType(header)
+	f := logrus.Fields{
+		"functionName":   "v2.gitea-activity.service.ProcessWebhookEvent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"eventType":      eventType,
+	}
+
+	var repository *gitea.Repository
+	var pullRequestID int64
+	switch eventType {
+	case gitea.PullRequestEventType:
+		var event gitea.PullRequestEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return fmt.Errorf("parsing gitea pull request event failed : %v", err)
+		}
+		if event.Action != gitea.PullRequestActionOpened && event.Action != gitea.PullRequestActionReopen && event.Action != gitea.PullRequestActionSync {
+			log.WithFields(f).Debugf("ignoring pull request action : %s, only [opened, reopened, synchronized] accepted", event.Action)
+			return nil
+		}
+		repository = event.Repository
+		pullRequestID = event.Number
+	case gitea.IssueCommentEventType:
+		var event gitea.IssueCommentEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return fmt.Errorf("parsing gitea issue comment event failed : %v", err)
+		}
+		if !event.IsPull || event.Issue == nil || event.Comment == nil || !strings.Contains(event.Comment.Body, "/easycla") {
+			log.WithFields(f).Debug("ignoring comment, not an /easycla pull request comment")
+			return nil
+		}
+		repository = event.Repository
+		pullRequestID = event.Issue.Index
+	default:
+		log.WithFields(f).Debugf("ignoring gitea event type : %s", eventType)
+		return nil
+	}
+
+	if repository == nil || repository.Owner == nil {
+		return errors.New("gitea event is missing the repository details")
+	}
+	f["repositoryName"] = repository.FullName
+	f["pullRequestID"] = pullRequestID
+
+	giteaURL, err := gitea.NormalizeBaseURL(strings.TrimSuffix(repository.HTMLURL, "/"+repository.FullName))
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(f).Debugf("looking up gitea org : %s on %s in easycla records ...", repository.Owner.UserName, giteaURL)
+	giteaOrg, err := s.giteaOrgService.GetGiteaOrganizationByName(ctx, giteaURL, repository.Owner.UserName)
+	if err != nil {
+		return err
+	}
+
+	secret, err := s.giteaOrgService.GetWebhookSecret(giteaOrg)
+	if err != nil {
+		return fmt.Errorf("loading webhook secret for gitea org : %s failed : %v", giteaOrg.OrganizationID, err)
+	}
+	if err = gitea.ValidateWebhookSignature(header, payload, secret); err != nil {
+		log.WithFields(f).WithError(err).Warn("gitea webhook signature validation failed")
+		return webhookSignatureMismatch
+	}
+
+	return s.ProcessPullRequestActivity(ctx, giteaOrg, repository.ID, pullRequestID)
+}
+
+// ProcessPullRequestActivity checks the commit authors of the pull request and updates the commit status and the
+// EasyCLA comment of the pull request
+func (s *service) ProcessPullRequestActivity(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, repositoryExternalID, pullRequestID int64) error {
+	f := logrus.Fields{
+		"functionName":         "v2.gitea-activity.service.ProcessPullRequestActivity",
+		utils.XREQUESTID:       ctx.Value(utils.XREQUESTID),
+		"giteaOrganizationID":  giteaOrg.OrganizationID,
+		"giteaURL":             giteaOrg.GiteaURL,
+		"repositoryExternalID": repositoryExternalID,
+		"pullRequestID":        pullRequestID,
+	}
+
+	giteaRepo, err := s.giteaOrgService.GetGiteaRepositoryByExternalID(ctx, giteaOrg.GiteaURL, repositoryExternalID)
+	if err != nil {
+		return fmt.Errorf("finding internal repository for gitea repository : %d failed : %v", repositoryExternalID, err)
+	}
+	if !giteaRepo.Enabled {
+		log.WithFields(f).Debugf("gitea repository : %s is not enabled, skipping", giteaRepo.RepositoryName)
+		return nil
+	}
+	claGroupID := giteaRepo.RepositoryCLAGroupID
+
+	giteaClient, err := s.giteaOrgService.NewGiteaClient(giteaOrg)
+	if err != nil {
+		return fmt.Errorf("initializing gitea client : %v", err)
+	}
+
+	repository, err := gitea.GetRepositoryByID(ctx, giteaClient, repositoryExternalID)
+	if err != nil {
+		return err
+	}
+	owner := repository.Owner.UserName
+
+	pullRequest, err := gitea.GetPullRequest(ctx, giteaClient, owner, repository.Name, pullRequestID)
+	if err != nil {
+		return err
+	}
+	if pullRequest.Head == nil || pullRequest.Head.Sha == "" {
+		return fmt.Errorf("pull request : %d of repository : %s has no head commit", pullRequestID, repository.FullName)
+	}
+	lastCommitSha := pullRequest.Head.Sha
+	f["lastCommitSha"] = lastCommitSha
+
+	log.WithFields(f).Debugf("loading Gitea pull request participants for pull request: %d", pullRequestID)
+	participants, err := gitea.FetchPullRequestParticipants(ctx, giteaClient, owner, repository.Name, pullRequestID)
+	if err != nil {
+		return err
+	}
+	if len(participants) == 0 {
+		return fmt.Errorf("no participants found in gitea pull request : %d, and repository : %s", pullRequestID, repository.FullName)
+	}
+
+	log.WithFields(f).Debugf("found %d participants for the pull request", len(participants))
+	var missingUsers []*gatedGiteaUser
+	var signedUsers []*gitea.User
+	for _, giteaUser := range participants {
+		userSigned, signedCheckErr := s.hasUserSigned(ctx, giteaOrg.GiteaURL, claGroupID, giteaUser)
+		if signedCheckErr != nil {
+			log.WithFields(f).WithError(signedCheckErr).Warnf("problem checking if user : %s (%s) has signed - assuming not signed", giteaUser.UserName, giteaUser.Email)
+			missingUsers = append(missingUsers, &gatedGiteaUser{User: giteaUser, err: signedCheckErr})
+			continue
+		}
+		if userSigned {
+			log.WithFields(f).Infof("giteaUser: %s (%s) has signed", giteaUser.UserName, giteaUser.Email)
+			signedUsers = append(signedUsers, giteaUser)
+		} else {
+			log.WithFields(f).Infof("giteaUser: %s (%s) has NOT signed", giteaUser.UserName, giteaUser.Email)
+			missingUsers = append(missingUsers, &gatedGiteaUser{User: giteaUser})
+		}
+	}
+
+	signURL := GetFullSignURL(giteaOrg.OrganizationID, repositoryExternalID, pullRequestID)
+	state, message, targetURL := gitea.CommitStatusSuccess, "EasyCLA check passed. You are authorized to contribute.", ""
+	if len(missingUsers) > 0 {
+		log.WithFields(f).Warnf("pull request failed with %d users not passing authorization", len(missingUsers))
+		state, message, targetURL = gitea.CommitStatusFailure, "Missing CLA Authorization", signURL
+	}
+
+	if statusErr := gitea.SetCommitStatus(ctx, giteaClient, owner, repository.Name, lastCommitSha, state, message, targetURL); statusErr != nil {
+		log.WithFields(f).WithError(statusErr).Warnf("problem setting the commit status for pull request: %d, sha: %s", pullRequestID, lastCommitSha)
+		return statusErr
+	}
+
+	if commentErr := gitea.SetPullRequestComment(ctx, giteaClient, owner, repository.Name, pullRequestID, PreparePrCommentContent(missingUsers, signedUsers, signURL)); commentErr != nil {
+		log.WithFields(f).WithError(commentErr).Warnf("problem setting the comment for pull request: %d", pullRequestID)
+		return commentErr
+	}
+
+	return nil
+}
+
+// PreparePrCommentContent renders the EasyCLA pull request comment
+func PreparePrCommentContent(missingUsers []*gatedGiteaUser, signedUsers []*gitea.User, signURL string) string {
+	badgeHyperlink := config.GetConfig().CLALandingPage + "/#/?version=2"
+	if len(missingUsers) > 0 {
+		badgeHyperlink = signURL
+	}
+
+	coveredBadge := fmt.Sprintf(`<a href="%s">
+<img src="https://s3.amazonaws.com/cla-project-logo-dev/cla-signed.svg" alt="CLA Signed" align="left" height="28" width="328" ></a><br/>`, badgeHyperlink)
+	failedBadge := fmt.Sprintf(`<a href="%s">
+<img src="https://s3.amazonaws.com/cla-project-logo-dev/cla-not-signed.svg" alt="CLA Not Signed" align="left" height="28" width="328" ></a><br/>`, badgeHyperlink)
+	confirmationNeededBadge := fmt.Sprintf(`<a href="%s">
+<img src="https://s3.amazonaws.com/cla-project-logo-dev/cla-confirmation-needed.svg" alt="CLA Confirmation Needed" align="left" height="28" width="328" ></a><br/>`, badgeHyperlink)
+
+	easyCLASupportURL := "https://jira.linuxfoundation.org/servicedesk/customer/portal/4"
+	failed := ":x:"
+	success := ":white_check_mark:"
+
+	var body, result string
+	if len(signedUsers) > 0 {
+		result = "<ul>"
+		for _, signed := range signedUsers {
+			result += fmt.Sprintf("<li>%s %s</li>", success, getAuthorInfo(signed))
+		}
+		result += "</ul>"
+		body = coveredBadge
+	}
+
+	if len(missingUsers) > 0 {
+		result += "<ul>"
+		for _, missingUser := range missingUsers {
+			authorInfo := getAuthorInfo(missingUser.User)
+			if errors.Is(missingUser.err, missingCompanyAffiliation) {
+				result += fmt.Sprintf(`<li> %s %s. This user is authorized, but they must confirm their affiliation with their company.
+Start the authorization process <a href='%s'> by clicking here</a>, click "Corporate",
+select the appropriate company from the list, then confirm your affiliation on the page that appears.
+For further assistance with EasyCLA, <a href='%s' target='_blank'>please submit a support request ticket</a>. </li>`, failed, authorInfo, signURL, easyCLASupportURL)
+				body = confirmationNeededBadge
+			} else {
+				result += fmt.Sprintf(`<li><a href='%s' target='_blank'>%s</a> - %s. The commit is not authorized under a signed CLA.
+<a href='%s' target='_blank'>Please click here to be authorized</a>.
+For further assistance with EasyCLA, <a href='%s' target='_blank'>please submit a support request ticket</a>.
+</li>`, signURL, failed, authorInfo, signURL, easyCLASupportURL)
+				body = failedBadge
+			}
+		}
+		result += "</ul>"
+	}
+
+	if result != "" {
+		body += "<br/><br/>" + result
+	}
+
+	return body
+}
+
+// GetFullSignURL returns the sign URL used in the commit status and the pull request comment
+func GetFullSignURL(giteaOrganizationID string, giteaRepositoryID, pullRequestID int64) string {
+	return fmt.Sprintf("%s/v4/repository-provider/%s/sign/%s/%d/%d/#/",
+		config.GetConfig().ClaAPIV4Base,
+		utils.GiteaLower,
+		giteaOrganizationID,
+		giteaRepositoryID,
+		pullRequestID,
+	)
+}
+
+func getAuthorInfo(giteaUser *gitea.User) string {
+	if giteaUser.UserName != "" {
+		return fmt.Sprintf("login:@%s/name:%s", giteaUser.UserName, giteaUser.FullName)
+	} else if giteaUser.Email != "" {
+		return fmt.Sprintf("email:%s/name:%s", giteaUser.Email, giteaUser.FullName)
+	}
+	return fmt.Sprintf("name:%s", giteaUser.FullName)
+}
+
+// hasUserSigned checks the signatures of the CLA users matching the Gitea ID, the Gitea username or the commit author
+// email
+func (s *service) hasUserSigned(ctx context.Context, giteaURL, claGroupID string, giteaUser *gitea.User) (bool, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea-activity.service.hasUserSigned",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"giteaURL":       giteaURL,
+		"giteaUserID":    giteaUser.ID,
+		"giteaUserName":  giteaUser.UserName,
+		"giteaUserEmail": giteaUser.Email,
+	}
+
+	userModels, err := s.findUserModelsForGiteaUser(f, giteaURL, giteaUser)
+	if err != nil {
+		return false, err
+	}
+	if len(userModels) == 0 {
+		log.WithFields(f).Warnf("gitea user: %s (%s) not found in easycla records", giteaUser.UserName, giteaUser.Email)
+		return false, missingID
+	}
+
+	var lastErr error
+	for _, userModel := range userModels {
+		signed, signedErr := s.isSigned(ctx, userModel, claGroupID, giteaUser)
+		if signedErr != nil {
+			log.WithFields(f).Debugf("error checking if user is signed, error: %v", signedErr)
+			lastErr = signedErr
+			continue
+		}
+		if signed {
+			log.WithFields(f).Debugf("found signed user for claGroupID: %s, userID: %s", claGroupID, userModel.UserID)
+			return true, nil
+		}
+	}
+
+	return false, lastErr
+}
+
+// findUserModelsForGiteaUser locates the user models for the Gitea user by the instance qualified Gitea ID, the instance
+// qualified Gitea username and finally by the commit author email
+func (s *service) findUserModelsForGiteaUser(f logrus.Fields, giteaURL string, giteaUser *gitea.User) ([]*models.User, error) {
+	if giteaUser.ID != 0 {
+		giteaID := gitea.UserIDKey(giteaURL, giteaUser.ID)
+		userModel, lookupErr := s.usersRepository.GetUserByGiteaID(giteaID)
+		if lookupErr != nil {
+			log.WithFields(f).WithError(lookupErr).Debugf("unable to locate gitea user via ID: %s", giteaID)
+		} else if userModel != nil {
+			log.WithFields(f).Debugf("located gitea user via ID: %s", giteaID)
+			return []*models.User{userModel}, nil
+		}
+	}
+
+	if giteaUser.UserName != "" {
+		giteaUsername := gitea.UserNameKey(giteaURL, giteaUser.UserName)
+		userModel, lookupErr := s.usersRepository.GetUserByGiteaUsername(giteaUsername)
+		if lookupErr != nil {
+			log.WithFields(f).WithError(lookupErr).Debugf("unable to locate gitea user via username: %s", giteaUsername)
+		} else if userModel != nil {
+			log.WithFields(f).Debugf("located gitea user via username: %s", giteaUsername)
+			return []*models.User{userModel}, nil
+		}
+	}
+
+	if giteaUser.Email == "" {
+		return nil, missingID
+	}
+
+	userModels, err := s.usersRepository.GetUsersByEmail(giteaUser.Email)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to find user model for gitea user email: %s", giteaUser.Email)
+		return nil, err
+	}
+
+	return userModels, nil
+}
+
+func (s *service) isSigned(ctx context.Context, userModel *models.User, claGroupID string, giteaUser *gitea.User) (bool, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea-activity.service.isSigned",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"userID":         userModel.UserID,
+		"claGroupID":     claGroupID,
+	}
+
+	// First check for an ICLA signature
+	icla, err := s.signaturesRepository.GetIndividualSignature(ctx, claGroupID, userModel.UserID, aws.Bool(true), aws.Bool(true))
+	if err != nil {
+		return false, err
+	}
+	if icla != nil && !signatures.IsResignRequired(icla) && !signatures.IsSignatureExpired(icla) {
+		log.WithFields(f).Infof("user has signed the following signature (ICLA): %s, passing", icla.SignatureID)
+		return true, nil
+	}
+
+	if userModel.CompanyID == "" {
+		return false, fmt.Errorf("user hasn't signed yet")
+	}
+
+	companyID := userModel.CompanyID
+	if _, err = s.companyRepository.GetCompany(ctx, companyID); err != nil {
+		return false, fmt.Errorf("can't load company record: %s for user: %s, error: %v", companyID, userModel.UserID, err)
+	}
+
+	corporateSignature, err := s.signaturesRepository.GetCorporateSignature(ctx, claGroupID, companyID, aws.Bool(true), aws.Bool(true))
+	if err != nil {
+		return false, fmt.Errorf("can't load company signature record for company: %s, error : %v", companyID, err)
+	}
+	if corporateSignature == nil {
+		return false, fmt.Errorf("no corporate signature (CCLA) record found for company : %s ", companyID)
+	}
+	if signatures.IsResignRequired(corporateSignature) || signatures.IsSignatureExpired(corporateSignature) {
+		return false, fmt.Errorf("corporate signature (CCLA): %s for company : %s must be re-signed or renewed", corporateSignature.SignatureID, companyID)
+	}
+
+	if !isUserApprovedForSignature(corporateSignature, userModel, giteaUser) {
+		return false, fmt.Errorf("user is not approved in signature : %s", corporateSignature.SignatureID)
+	}
+
+	employeeSignatures, err := s.signaturesRepository.GetProjectCompanyEmployeeSignatures(ctx, signatures1.GetProjectCompanyEmployeeSignaturesParams{
+		CompanyID: companyID,
+		ProjectID: claGroupID,
+		PageSize:  utils.Int64(100),
+	}, &signatures.ApprovalCriteria{UserEmail: giteaUser.Email})
+	if err != nil {
+		return false, fmt.Errorf("can't load employee signature records : %s for user : %s association : %v", companyID, userModel.UserID, err)
+	}
+	if len(employeeSignatures.Signatures) == 0 {
+		log.WithFields(f).Debugf("user is approved in signature : %s but has not confirmed the company affiliation", corporateSignature.SignatureID)
+		return false, missingCompanyAffiliation
+	}
+
+	log.WithFields(f).Debugf("is in signature approval list : %s and has employee signature", corporateSignature.SignatureID)
+	return true, nil
+}
+
+// isUserApprovedForSignature checks the email and the domain approval lists of the corporate signature - Gitea has no
+// username or organization approval lists
+func isUserApprovedForSignature(corporateSignature *models.Signature, user *models.User, giteaUser *gitea.User) bool {
+	userEmails := append([]string{giteaUser.Email}, user.Emails...)
+	if string(user.LfEmail) != "" {
+		userEmails = append(userEmails, string(user.LfEmail))
+	}
+
+	for _, email := range userEmails {
+		for _, approvalEmail := range corporateSignature.EmailApprovalList {
+			if strings.EqualFold(email, approvalEmail) {
+				return true
+			}
+		}
+		for _, domainApprovalPattern := range corporateSignature.DomainApprovalList {
+			if ok, err := regexp.MatchString("^.*@"+domainApprovalRegex(domainApprovalPattern)+"$", email); ok && err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// domainApprovalRegex converts the domain approval list entry to a regular expression, the leading wildcard matches
+// any prefix and the rest of the domain is matched literally
+func domainApprovalRegex(domainApprovalPattern string) string {
+	for _, wildcard := range []string{"*.", "*", "."} {
+		if strings.HasPrefix(domainApprovalPattern, wildcard) {
+			return ".*" + regexp.QuoteMeta(strings.TrimPrefix(domainApprovalPattern, wildcard))
+		}
+	}
+	return regexp.QuoteMeta(domainApprovalPattern)
+}
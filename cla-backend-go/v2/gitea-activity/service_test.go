@@ -0,0 +1,254 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_activity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	gitea "github.com/communitybridge/easycla/cla-backend-go/gitea_api"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGiteaURL   = "https://gitea.example.org"
+	testCLAGroupID = "cla-group-1"
+	testSecret     = "webhook-secret"
+)
+
+// fakeGiteaOrgService returns a single Gitea organization with a disabled repository so the webhook processing stops
+// after the signature validation
+type fakeGiteaOrgService struct {
+	gitea_organizations.ServiceInterface
+	orgLookups   int
+	repoLookups  int
+	orgLookupErr error
+}
+
+func (f *fakeGiteaOrgService) GetGiteaOrganizationByName(ctx context.Context, giteaURL, organizationName string) (*gitea_organizations.GiteaOrganization, error) {
+	f.orgLookups++
+	if f.orgLookupErr != nil {
+		return nil, f.orgLookupErr
+	}
+	return &gitea_organizations.GiteaOrganization{OrganizationID: "org-1", GiteaURL: giteaURL, OrganizationName: organizationName}, nil
+}
+
+func (f *fakeGiteaOrgService) GetWebhookSecret(org *gitea_organizations.GiteaOrganization) (string, error) {
+	return testSecret, nil
+}
+
+func (f *fakeGiteaOrgService) GetGiteaRepositoryByExternalID(ctx context.Context, giteaURL string, repositoryExternalID int64) (*repositories.RepositoryDBModel, error) {
+	f.repoLookups++
+	return &repositories.RepositoryDBModel{RepositoryName: "easycla/demo", RepositoryCLAGroupID: testCLAGroupID, Enabled: false}, nil
+}
+
+func signedHeader(eventType string, payload []byte, secret string) http.Header {
+	header := http.Header{}
+	header.Set(gitea.EventTypeHeader, eventType)
+	if secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload) // nolint
+		header.Set(gitea.SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+	return header
+}
+
+func TestProcessWebhookEvent(t *testing.T) {
+	pullRequestPayload := []byte(`{"action":"opened","number":3,"repository":{"id":11,"name":"demo","full_name":"easycla/demo","html_url":"https://gitea.example.org/easycla/demo","owner":{"id":1,"login":"easycla"}}}`)
+	closedPayload := []byte(`{"action":"closed","number":3,"repository":{"id":11,"name":"demo","full_name":"easycla/demo","html_url":"https://gitea.example.org/easycla/demo","owner":{"id":1,"login":"easycla"}}}`)
+	commentPayload := []byte(`{"action":"created","is_pull":true,"issue":{"number":3},"comment":{"body":"looks good"},"repository":{"id":11,"name":"demo","full_name":"easycla/demo","html_url":"https://gitea.example.org/easycla/demo","owner":{"id":1,"login":"easycla"}}}`)
+
+	testCases := []struct {
+		Name                string
+		Header              http.Header
+		Payload             []byte
+		ExpectedErr         error
+		ExpectedOrgLookups  int
+		ExpectedRepoLookups int
+	}{
+		{
+			Name:                "valid signature is processed",
+			Header:              signedHeader(gitea.PullRequestEventType, pullRequestPayload, testSecret),
+			Payload:             pullRequestPayload,
+			ExpectedOrgLookups:  1,
+			ExpectedRepoLookups: 1,
+		},
+		{
+			Name:               "signature with another secret is rejected",
+			Header:             signedHeader(gitea.PullRequestEventType, pullRequestPayload, "other-secret"),
+			Payload:            pullRequestPayload,
+			ExpectedErr:        webhookSignatureMismatch,
+			ExpectedOrgLookups: 1,
+		},
+		{
+			Name:               "missing signature is rejected",
+			Header:             signedHeader(gitea.PullRequestEventType, pullRequestPayload, ""),
+			Payload:            pullRequestPayload,
+			ExpectedErr:        webhookSignatureMismatch,
+			ExpectedOrgLookups: 1,
+		},
+		{
+			Name:    "closed pull request is ignored",
+			Header:  signedHeader(gitea.PullRequestEventType, closedPayload, testSecret),
+			Payload: closedPayload,
+		},
+		{
+			Name:    "comment without the easycla command is ignored",
+			Header:  signedHeader(gitea.IssueCommentEventType, commentPayload, testSecret),
+			Payload: commentPayload,
+		},
+		{
+			Name:    "unsupported event is ignored",
+			Header:  signedHeader("push", pullRequestPayload, testSecret),
+			Payload: pullRequestPayload,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			orgService := &fakeGiteaOrgService{}
+			s := NewService(orgService, nil, nil, nil)
+
+			err := s.ProcessWebhookEvent(context.Background(), tc.Header, tc.Payload)
+			if tc.ExpectedErr != nil {
+				assert.True(t, errors.Is(err, tc.ExpectedErr), "expected error: %v, got: %v", tc.ExpectedErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedOrgLookups, orgService.orgLookups)
+			assert.Equal(t, tc.ExpectedRepoLookups, orgService.repoLookups)
+		})
+	}
+}
+
+func TestHasUserSigned(t *testing.T) {
+	signedUser := &models.User{UserID: "user-1"}
+	icla := &models.Signature{SignatureID: "icla-1"}
+	notFound := errors.New("user not found")
+
+	testCases := []struct {
+		Name           string
+		GiteaUser      *gitea.User
+		SetupUsersRepo func(usersRepo *mock_users.MockUserRepository)
+		ExpectedSigned bool
+		ExpectedErr    error
+	}{
+		{
+			Name:      "user is resolved by the instance qualified gitea ID before the email",
+			GiteaUser: &gitea.User{ID: 7, UserName: "Jane", Email: "jane@example.org"},
+			SetupUsersRepo: func(usersRepo *mock_users.MockUserRepository) {
+				usersRepo.EXPECT().GetUserByGiteaID("https://gitea.example.org#7").Return(signedUser, nil)
+			},
+			ExpectedSigned: true,
+		},
+		{
+			Name:      "user is resolved by the instance qualified gitea username when the ID is unknown",
+			GiteaUser: &gitea.User{ID: 7, UserName: "Jane", Email: "jane@example.org"},
+			SetupUsersRepo: func(usersRepo *mock_users.MockUserRepository) {
+				usersRepo.EXPECT().GetUserByGiteaID("https://gitea.example.org#7").Return(nil, notFound)
+				usersRepo.EXPECT().GetUserByGiteaUsername("https://gitea.example.org#jane").Return(signedUser, nil)
+			},
+			ExpectedSigned: true,
+		},
+		{
+			Name:      "user is resolved by the commit author email as the last resort",
+			GiteaUser: &gitea.User{ID: 7, UserName: "jane", Email: "jane@example.org"},
+			SetupUsersRepo: func(usersRepo *mock_users.MockUserRepository) {
+				usersRepo.EXPECT().GetUserByGiteaID("https://gitea.example.org#7").Return(nil, notFound)
+				usersRepo.EXPECT().GetUserByGiteaUsername("https://gitea.example.org#jane").Return(nil, notFound)
+				usersRepo.EXPECT().GetUsersByEmail("jane@example.org").Return([]*models.User{signedUser}, nil)
+			},
+			ExpectedSigned: true,
+		},
+		{
+			Name:      "commit author without a gitea account is resolved by email",
+			GiteaUser: &gitea.User{Email: "jane@example.org"},
+			SetupUsersRepo: func(usersRepo *mock_users.MockUserRepository) {
+				usersRepo.EXPECT().GetUsersByEmail("jane@example.org").Return([]*models.User{signedUser}, nil)
+			},
+			ExpectedSigned: true,
+		},
+		{
+			Name:      "unknown user without an email is missing",
+			GiteaUser: &gitea.User{ID: 7, UserName: "jane"},
+			SetupUsersRepo: func(usersRepo *mock_users.MockUserRepository) {
+				usersRepo.EXPECT().GetUserByGiteaID("https://gitea.example.org#7").Return(nil, notFound)
+				usersRepo.EXPECT().GetUserByGiteaUsername("https://gitea.example.org#jane").Return(nil, notFound)
+			},
+			ExpectedErr: missingID,
+		},
+		{
+			Name:      "user not in the easycla records is missing",
+			GiteaUser: &gitea.User{Email: "jane@example.org"},
+			SetupUsersRepo: func(usersRepo *mock_users.MockUserRepository) {
+				usersRepo.EXPECT().GetUsersByEmail("jane@example.org").Return(nil, nil)
+			},
+			ExpectedErr: missingID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usersRepo := mock_users.NewMockUserRepository(ctrl)
+			tc.SetupUsersRepo(usersRepo)
+			signaturesRepo := mock_signatures.NewMockSignatureRepository(ctrl)
+			signaturesRepo.EXPECT().GetIndividualSignature(gomock.Any(), testCLAGroupID, signedUser.UserID, gomock.Any(), gomock.Any()).Return(icla, nil).AnyTimes()
+
+			s := &service{usersRepository: usersRepo, signaturesRepository: signaturesRepo}
+			signed, err := s.hasUserSigned(context.Background(), testGiteaURL, testCLAGroupID, tc.GiteaUser)
+			if tc.ExpectedErr != nil {
+				assert.True(t, errors.Is(err, tc.ExpectedErr), "expected error: %v, got: %v", tc.ExpectedErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedSigned, signed)
+		})
+	}
+}
+
+func TestIsUserApprovedForSignature(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Email            string
+		DomainApproval   []string
+		EmailApproval    []string
+		ExpectedApproved bool
+	}{
+		{Name: "email approval list", Email: "Jane@Example.org", EmailApproval: []string{"jane@example.org"}, ExpectedApproved: true},
+		{Name: "exact domain", Email: "jane@example.org", DomainApproval: []string{"example.org"}, ExpectedApproved: true},
+		{Name: "domain dots are matched literally", Email: "jane@exampleXorg", DomainApproval: []string{"example.org"}},
+		{Name: "domain is anchored", Email: "jane@example.org.evil.com", DomainApproval: []string{"example.org"}},
+		{Name: "wildcard subdomain", Email: "jane@dev.example.org", DomainApproval: []string{"*.example.org"}, ExpectedApproved: true},
+		{Name: "wildcard subdomain dots are matched literally", Email: "jane@devXexampleXorg", DomainApproval: []string{"*.example.org"}},
+		{Name: "leading dot subdomain", Email: "jane@dev.example.org", DomainApproval: []string{".example.org"}, ExpectedApproved: true},
+		{Name: "regex characters in the domain", Email: "jane@example.org", DomainApproval: []string{"(.*)"}},
+		{Name: "other domain", Email: "jane@example.com", DomainApproval: []string{"example.org"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			corporateSignature := &models.Signature{
+				SignatureID:        "ccla-1",
+				DomainApprovalList: tc.DomainApproval,
+				EmailApprovalList:  tc.EmailApproval,
+			}
+			approved := isUserApprovedForSignature(corporateSignature, &models.User{UserID: "user-1"}, &gitea.User{Email: tc.Email})
+			assert.Equal(t, tc.ExpectedApproved, approved)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_organizations
+
+import (
+	"fmt"
+
+	"github.com/LF-Engineering/lfx-kit/auth"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/gitea_organizations"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	projectService "github.com/communitybridge/easycla/cla-backend-go/v2/project-service"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+// Configure setups handlers on api with service
+func Configure(api *operations.EasyclaAPI, service ServiceInterface, eventService events.Service) {
+
+	api.GiteaOrganizationsGetProjectGiteaOrganizationsHandler = gitea_organizations.GetProjectGiteaOrganizationsHandlerFunc(
+		func(params gitea_organizations.GetProjectGiteaOrganizationsParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":   "v2.gitea_organizations.handlers.GiteaOrganizationsGetProjectGiteaOrganizationsHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"authUser":       authUser.UserName,
+				"authEmail":      authUser.Email,
+				"projectSFID":    params.ProjectSFID,
+			}
+
+			psc := projectService.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return gitea_organizations.NewGetProjectGiteaOrganizationsNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Get Project Gitea Organizations for Project '%s' with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return gitea_organizations.NewGetProjectGiteaOrganizationsForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			result, err := service.GetGiteaOrganizationsByProjectSFID(ctx, params.ProjectSFID)
+			if err != nil {
+				msg := fmt.Sprintf("failed to locate Gitea organizations by project SFID: %s, error: %+v", params.ProjectSFID, err)
+				log.WithFields(f).Warn(msg)
+				return gitea_organizations.NewGetProjectGiteaOrganizationsBadRequest().WithPayload(
+					utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+			}
+
+			return gitea_organizations.NewGetProjectGiteaOrganizationsOK().WithPayload(result)
+		})
+
+	api.GiteaOrganizationsAddProjectGiteaOrganizationHandler = gitea_organizations.AddProjectGiteaOrganizationHandlerFunc(
+		func(params gitea_organizations.AddProjectGiteaOrganizationParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":   "v2.gitea_organizations.handlers.GiteaOrganizationsAddProjectGiteaOrganizationHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"authUser":       authUser.UserName,
+				"authEmail":      authUser.Email,
+				"projectSFID":    params.ProjectSFID,
+			}
+
+			psc := projectService.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return gitea_organizations.NewAddProjectGiteaOrganizationNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			parentProjectModel, err := psc.GetParentProjectModel(params.ProjectSFID)
+			if err != nil || (parentProjectModel == nil && !utils.IsProjectHasRootParent(projectModel)) {
+				return gitea_organizations.NewAddProjectGiteaOrganizationNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate parent project from project with ID: %s", params.ProjectSFID)))
+			}
+			parentProjectSFID := params.ProjectSFID
+			if parentProjectModel != nil {
+				parentProjectSFID = parentProjectModel.ID
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Add Project Gitea Organizations for Project '%s' with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return gitea_organizations.NewAddProjectGiteaOrganizationForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			result, err := service.AddGiteaOrganization(ctx, params.ProjectSFID, parentProjectSFID, params.Body)
+			if err != nil {
+				msg := fmt.Sprintf("unable to add Gitea organization, error: %+v", err)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return gitea_organizations.NewAddProjectGiteaOrganizationBadRequest().WithPayload(
+					utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+			}
+
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				LfUsername:  authUser.UserName,
+				EventType:   events.GiteaOrganizationAdded,
+				ProjectSFID: params.ProjectSFID,
+				EventData: &events.GiteaOrganizationAddedEventData{
+					GiteaURL:              result.GiteaURL,
+					GiteaOrganizationName: result.OrganizationName,
+				},
+			})
+
+			return gitea_organizations.NewAddProjectGiteaOrganizationOK().WithPayload(result)
+		})
+
+	api.GiteaOrganizationsDeleteProjectGiteaOrganizationHandler = gitea_organizations.DeleteProjectGiteaOrganizationHandlerFunc(
+		func(params gitea_organizations.DeleteProjectGiteaOrganizationParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":        "v2.gitea_organizations.handlers.GiteaOrganizationsDeleteProjectGiteaOrganizationHandler",
+				utils.XREQUESTID:      ctx.Value(utils.XREQUESTID),
+				"authUser":            authUser.UserName,
+				"authEmail":           authUser.Email,
+				"projectSFID":         params.ProjectSFID,
+				"giteaOrganizationID": params.GiteaOrganizationID,
+			}
+
+			psc := projectService.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return gitea_organizations.NewDeleteProjectGiteaOrganizationNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Delete Project Gitea Organizations for Project '%s' with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return gitea_organizations.NewDeleteProjectGiteaOrganizationForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			org, err := service.DeleteGiteaOrganization(ctx, params.ProjectSFID, params.GiteaOrganizationID)
+			if err != nil {
+				msg := fmt.Sprintf("problem deleting Gitea organization with ID: %s for project SFID: %s", params.GiteaOrganizationID, params.ProjectSFID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return gitea_organizations.NewDeleteProjectGiteaOrganizationBadRequest().WithPayload(
+					utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+			}
+
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				LfUsername:  authUser.UserName,
+				EventType:   events.GiteaOrganizationDeleted,
+				ProjectSFID: params.ProjectSFID,
+				EventData: &events.GiteaOrganizationDeletedEventData{
+					GiteaURL:              org.GiteaURL,
+					GiteaOrganizationName: org.OrganizationName,
+				},
+			})
+
+			return gitea_organizations.NewDeleteProjectGiteaOrganizationNoContent()
+		})
+
+	api.GiteaOrganizationsEnrollProjectGiteaRepositoriesHandler = gitea_organizations.EnrollProjectGiteaRepositoriesHandlerFunc(
+		func(params gitea_organizations.EnrollProjectGiteaRepositoriesParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":        "v2.gitea_organizations.handlers.GiteaOrganizationsEnrollProjectGiteaRepositoriesHandler",
+				utils.XREQUESTID:      ctx.Value(utils.XREQUESTID),
+				"authUser":            authUser.UserName,
+				"authEmail":           authUser.Email,
+				"projectSFID":         params.ProjectSFID,
+				"giteaOrganizationID": params.GiteaOrganizationID,
+			}
+
+			psc := projectService.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return gitea_organizations.NewEnrollProjectGiteaRepositoriesNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Enroll Project Gitea Repositories for Project '%s' with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return gitea_organizations.NewEnrollProjectGiteaRepositoriesForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			result, err := service.EnrollGiteaRepositories(ctx, params.ProjectSFID, params.GiteaOrganizationID, params.Body)
+			if err != nil {
+				msg := fmt.Sprintf("problem enrolling Gitea repositories for organization ID: %s, error: %+v", params.GiteaOrganizationID, err)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return gitea_organizations.NewEnrollProjectGiteaRepositoriesBadRequest().WithPayload(
+					utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+			}
+
+			for _, repo := range result.List {
+				eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+					LfUsername:  authUser.UserName,
+					EventType:   events.GiteaRepositoryAdded,
+					ProjectSFID: params.ProjectSFID,
+					CLAGroupID:  repo.RepositoryClaGroupID,
+					EventData: &events.GiteaRepositoryAddedEventData{
+						RepositoryName: repo.RepositoryName,
+						RepositoryURL:  repo.RepositoryURL,
+					},
+				})
+			}
+
+			return gitea_organizations.NewEnrollProjectGiteaRepositoriesOK().WithPayload(result)
+		})
+}
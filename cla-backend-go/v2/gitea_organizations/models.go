@@ -0,0 +1,69 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_organizations
+
+import (
+	"strconv"
+
+	v2Models "github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
+	repoModels "github.com/communitybridge/easycla/cla-backend-go/repositories"
+)
+
+// GiteaOrganization is the database model of a Gitea/Forgejo organization registered with EasyCLA
+type GiteaOrganization struct {
+	OrganizationID        string `dynamodbav:"organization_id" json:"organization_id"`
+	GiteaURL              string `dynamodbav:"gitea_url" json:"gitea_url"`
+	OrganizationName      string `dynamodbav:"organization_name" json:"organization_name"`
+	OrganizationNameLower string `dynamodbav:"organization_name_lower" json:"organization_name_lower"`
+	OrganizationSFID      string `dynamodbav:"organization_sfid" json:"organization_sfid"`
+	ProjectSFID           string `dynamodbav:"project_sfid" json:"project_sfid"`
+	Enabled               bool   `dynamodbav:"enabled" json:"enabled"`
+	AccessToken           string `dynamodbav:"access_token" json:"-"`   // encrypted
+	WebhookSecret         string `dynamodbav:"webhook_secret" json:"-"` // encrypted
+	OAuthClientID         string `dynamodbav:"oauth_client_id" json:"oauth_client_id"`
+	OAuthClientSecret     string `dynamodbav:"oauth_client_secret" json:"-"` // encrypted
+	DateCreated           string `dynamodbav:"date_created" json:"date_created"`
+	DateModified          string `dynamodbav:"date_modified" json:"date_modified"`
+	Note                  string `dynamodbav:"note" json:"note"`
+	Version               string `dynamodbav:"version" json:"version"`
+}
+
+// toModel converts the database model to the API response model - secrets are never returned
+func (o *GiteaOrganization) toModel(repositories []*repoModels.RepositoryDBModel) *v2Models.GiteaOrganization {
+	response := &v2Models.GiteaOrganization{
+		OrganizationID:   o.OrganizationID,
+		GiteaURL:         o.GiteaURL,
+		OrganizationName: o.OrganizationName,
+		OrganizationSfid: o.OrganizationSFID,
+		ProjectSfid:      o.ProjectSFID,
+		Enabled:          o.Enabled,
+		OauthClientID:    o.OAuthClientID,
+		DateCreated:      o.DateCreated,
+		DateModified:     o.DateModified,
+		Version:          o.Version,
+		Repositories:     []*v2Models.GiteaRepository{},
+	}
+	for _, repo := range repositories {
+		response.Repositories = append(response.Repositories, toRepositoryModel(repo))
+	}
+	return response
+}
+
+// toRepositoryModel converts the repository database model to the API response model
+func toRepositoryModel(repo *repoModels.RepositoryDBModel) *v2Models.GiteaRepository {
+	// Gitea repository IDs are stored as strings as for the other providers
+	externalID, _ := strconv.ParseInt(repo.RepositoryExternalID, 10, 64) // nolint
+	return &v2Models.GiteaRepository{
+		RepositoryID:               repo.RepositoryID,
+		RepositoryExternalID:       externalID,
+		RepositoryName:             repo.RepositoryName,
+		RepositoryOrganizationName: repo.RepositoryOrganizationName,
+		RepositoryURL:              repo.RepositoryURL,
+		RepositoryClaGroupID:       repo.RepositoryCLAGroupID,
+		RepositoryProjectSfid:      repo.ProjectSFID,
+		Enabled:                    repo.Enabled,
+		DateCreated:                repo.DateCreated,
+		DateModified:               repo.DateModified,
+	}
+}
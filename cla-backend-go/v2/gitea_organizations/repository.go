@@ -0,0 +1,222 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_organizations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// indexes
+const (
+	// GiteaOrgProjectSFIDIndex the index for the Project SFID
+	GiteaOrgProjectSFIDIndex = "gitea-project-sfid-index"
+	// GiteaOrgLowerNameIndex the index for the organization name in lower case
+	GiteaOrgLowerNameIndex = "gitea-organization-name-lower-search-index"
+)
+
+// columns
+const (
+	// GiteaOrganizationsOrganizationIDColumn constant
+	GiteaOrganizationsOrganizationIDColumn = "organization_id"
+	// GiteaOrganizationsOrganizationNameLowerColumn constant
+	GiteaOrganizationsOrganizationNameLowerColumn = "organization_name_lower"
+	// GiteaOrganizationsProjectSFIDColumn constant
+	GiteaOrganizationsProjectSFIDColumn = "project_sfid"
+	// GiteaOrganizationsGiteaURLColumn constant
+	GiteaOrganizationsGiteaURLColumn = "gitea_url"
+)
+
+// RepositoryInterface is interface for gitea org data model
+type RepositoryInterface interface {
+	AddGiteaOrganization(ctx context.Context, input *GiteaOrganization) error
+	GetGiteaOrganization(ctx context.Context, organizationID string) (*GiteaOrganization, error)
+	GetGiteaOrganizationByName(ctx context.Context, giteaURL, organizationName string) (*GiteaOrganization, error)
+	GetGiteaOrganizationsByProjectSFID(ctx context.Context, projectSFID string) ([]*GiteaOrganization, error)
+	DeleteGiteaOrganization(ctx context.Context, organizationID string) error
+}
+
+// Repository object/struct
+type Repository struct {
+	stage             string
+	dynamoDBClient    *dynamodb.DynamoDB
+	giteaOrgTableName string
+}
+
+// NewRepository creates a new instance of the giteaOrganizations repository
+func NewRepository(awsSession *session.Session, stage string) RepositoryInterface {
+	return &Repository{
+		stage:             stage,
+		dynamoDBClient:    dynamodb.New(awsSession),
+		giteaOrgTableName: fmt.Sprintf("cla-%s-gitea-orgs", stage),
+	}
+}
+
+// AddGiteaOrganization adds the Gitea organization record
+func (repo *Repository) AddGiteaOrganization(ctx context.Context, input *GiteaOrganization) error {
+	f := logrus.Fields{
+		"functionName":     "v2.gitea_organizations.repository.AddGiteaOrganization",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationID":   input.OrganizationID,
+		"giteaURL":         input.GiteaURL,
+		"organizationName": input.OrganizationName,
+		"projectSFID":      input.ProjectSFID,
+	}
+
+	av, err := dynamodbattribute.MarshalMap(input)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to marshall gitea organization record")
+		return err
+	}
+
+	log.WithFields(f).Debug("adding gitea organization record to the database...")
+	_, err = repo.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		Item:                av,
+		TableName:           aws.String(repo.giteaOrgTableName),
+		ConditionExpression: aws.String("attribute_not_exists(organization_id)"),
+	})
+	if err != nil {
+		if aErr, ok := err.(awserr.Error); ok && aErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			log.WithFields(f).WithError(err).Warn("gitea organization already exists")
+			return fmt.Errorf("gitea organization already exists")
+		}
+		log.WithFields(f).WithError(err).Warn("cannot put gitea organization in dynamodb")
+		return err
+	}
+
+	return nil
+}
+
+// GetGiteaOrganization returns the Gitea organization by the internal ID, nil if not found
+func (repo *Repository) GetGiteaOrganization(ctx context.Context, organizationID string) (*GiteaOrganization, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea_organizations.repository.GetGiteaOrganization",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"organizationID": organizationID,
+	}
+
+	result, err := repo.dynamoDBClient.GetItem(&dynamodb.GetItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			GiteaOrganizationsOrganizationIDColumn: {S: aws.String(organizationID)},
+		},
+		TableName: aws.String(repo.giteaOrgTableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load gitea organization")
+		return nil, err
+	}
+	if len(result.Item) == 0 {
+		log.WithFields(f).Debugf("Unable to find Gitea organization by ID: %s - no results", organizationID)
+		return nil, nil
+	}
+
+	var org GiteaOrganization
+	err = dynamodbattribute.UnmarshalMap(result.Item, &org)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("error unmarshalling gitea organization record")
+		return nil, err
+	}
+	return &org, nil
+}
+
+// GetGiteaOrganizationByName returns the Gitea instance organization by name, nil if not found
+func (repo *Repository) GetGiteaOrganizationByName(ctx context.Context, giteaURL, organizationName string) (*GiteaOrganization, error) {
+	condition := expression.Key(GiteaOrganizationsOrganizationNameLowerColumn).Equal(expression.Value(strings.ToLower(organizationName)))
+	filter := expression.Name(GiteaOrganizationsGiteaURLColumn).Equal(expression.Value(giteaURL))
+	results, err := repo.queryOrganizations(ctx, condition, filter, GiteaOrgLowerNameIndex)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return results[0], nil
+}
+
+// GetGiteaOrganizationsByProjectSFID returns the Gitea organizations registered for the project
+func (repo *Repository) GetGiteaOrganizationsByProjectSFID(ctx context.Context, projectSFID string) ([]*GiteaOrganization, error) {
+	condition := expression.Key(GiteaOrganizationsProjectSFIDColumn).Equal(expression.Value(projectSFID))
+	return repo.queryOrganizations(ctx, condition, expression.Name(GiteaOrganizationsOrganizationIDColumn).AttributeExists(), GiteaOrgProjectSFIDIndex)
+}
+
+// DeleteGiteaOrganization deletes the Gitea organization record
+func (repo *Repository) DeleteGiteaOrganization(ctx context.Context, organizationID string) error {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea_organizations.repository.DeleteGiteaOrganization",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"organizationID": organizationID,
+	}
+
+	_, err := repo.dynamoDBClient.DeleteItem(&dynamodb.DeleteItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			GiteaOrganizationsOrganizationIDColumn: {S: aws.String(organizationID)},
+		},
+		TableName: aws.String(repo.giteaOrgTableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to delete gitea organization")
+		return err
+	}
+
+	return nil
+}
+
+// queryOrganizations runs the query with the condition and filter against the index, following the result pages
+func (repo *Repository) queryOrganizations(ctx context.Context, condition expression.KeyConditionBuilder, filter expression.ConditionBuilder, indexName string) ([]*GiteaOrganization, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea_organizations.repository.queryOrganizations",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"indexName":      indexName,
+	}
+
+	expr, err := expression.NewBuilder().WithKeyCondition(condition).WithFilter(filter).Build()
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem creating builder")
+		return nil, err
+	}
+
+	queryInput := &dynamodb.QueryInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
+		TableName:                 aws.String(repo.giteaOrgTableName),
+		IndexName:                 aws.String(indexName),
+	}
+
+	var organizations []*GiteaOrganization
+	for {
+		results, queryErr := repo.dynamoDBClient.Query(queryInput)
+		if queryErr != nil {
+			log.WithFields(f).WithError(queryErr).Warn("unable to query gitea organizations")
+			return nil, queryErr
+		}
+
+		var page []*GiteaOrganization
+		err = dynamodbattribute.UnmarshalListOfMaps(results.Items, &page)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("problem decoding database results")
+			return nil, err
+		}
+		organizations = append(organizations, page...)
+
+		if len(results.LastEvaluatedKey) == 0 {
+			break
+		}
+		queryInput.ExclusiveStartKey = results.LastEvaluatedKey
+	}
+
+	return organizations, nil
+}
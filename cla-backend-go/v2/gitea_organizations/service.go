@@ -0,0 +1,349 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_organizations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	v2Models "github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
+	gitea "github.com/communitybridge/easycla/cla-backend-go/gitea_api"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	repoModels "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/repositories"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// ServiceInterface contains functions of the gitea organizations service
+type ServiceInterface interface {
+	AddGiteaOrganization(ctx context.Context, projectSFID, parentProjectSFID string, input *v2Models.GiteaCreateOrganization) (*v2Models.GiteaOrganization, error)
+	GetGiteaOrganizationsByProjectSFID(ctx context.Context, projectSFID string) (*v2Models.GiteaOrganizations, error)
+	GetGiteaOrganization(ctx context.Context, organizationID string) (*GiteaOrganization, error)
+	GetGiteaOrganizationByName(ctx context.Context, giteaURL, organizationName string) (*GiteaOrganization, error)
+	DeleteGiteaOrganization(ctx context.Context, projectSFID, organizationID string) (*GiteaOrganization, error)
+	EnrollGiteaRepositories(ctx context.Context, projectSFID, organizationID string, input *v2Models.GiteaRepositoriesEnroll) (*v2Models.GiteaRepositoriesList, error)
+	GetGiteaRepositoryByExternalID(ctx context.Context, giteaURL string, repositoryExternalID int64) (*repoModels.RepositoryDBModel, error)
+	NewGiteaClient(org *GiteaOrganization) (*gitea.Client, error)
+	GetWebhookSecret(org *GiteaOrganization) (string, error)
+	GetOAuthApp(org *GiteaOrganization) (*gitea.OAuthApp, error)
+}
+
+// Service data model
+type Service struct {
+	repo               RepositoryInterface
+	v2GitRepo          repositories.RepositoryInterface
+	claGroupRepository projects_cla_groups.Repository
+}
+
+// NewService creates a new gitea organization service
+func NewService(repo RepositoryInterface, v2GitRepo repositories.RepositoryInterface, claGroupRepository projects_cla_groups.Repository) ServiceInterface {
+	return &Service{
+		repo:               repo,
+		v2GitRepo:          v2GitRepo,
+		claGroupRepository: claGroupRepository,
+	}
+}
+
+// AddGiteaOrganization registers the Gitea organization - the access token is checked against the instance before
+// the record is stored
+func (s *Service) AddGiteaOrganization(ctx context.Context, projectSFID, parentProjectSFID string, input *v2Models.GiteaCreateOrganization) (*v2Models.GiteaOrganization, error) {
+	f := logrus.Fields{
+		"functionName":      "v2.gitea_organizations.service.AddGiteaOrganization",
+		utils.XREQUESTID:    ctx.Value(utils.XREQUESTID),
+		"projectSFID":       projectSFID,
+		"parentProjectSFID": parentProjectSFID,
+		"giteaURL":          utils.StringValue(input.GiteaURL),
+		"organizationName":  utils.StringValue(input.OrganizationName),
+	}
+
+	giteaURL, err := gitea.NormalizeBaseURL(utils.StringValue(input.GiteaURL))
+	if err != nil {
+		return nil, err
+	}
+	organizationName := strings.TrimSpace(utils.StringValue(input.OrganizationName))
+
+	existing, err := s.repo.GetGiteaOrganizationByName(ctx, giteaURL, organizationName)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("gitea organization %s on %s is already registered with project: %s", organizationName, giteaURL, existing.ProjectSFID)
+	}
+
+	client, err := gitea.NewClient(giteaURL, utils.StringValue(input.AccessToken))
+	if err != nil {
+		return nil, err
+	}
+	giteaOrg, err := gitea.GetOrganization(ctx, client, organizationName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the organization with the access token")
+		return nil, fmt.Errorf("unable to load gitea organization %s on %s with the access token: %v", organizationName, giteaURL, err)
+	}
+
+	tokenKey := config.GetConfig().Gitea.TokenKey
+	accessToken, err := gitea.EncryptToken(utils.StringValue(input.AccessToken), tokenKey)
+	if err != nil {
+		return nil, err
+	}
+	oauthClientSecret, err := gitea.EncryptToken(utils.StringValue(input.OauthClientSecret), tokenKey)
+	if err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return nil, err
+	}
+	webhookSecret, err := gitea.EncryptToken(hex.EncodeToString(secret), tokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	_, currentTime := utils.CurrentTime()
+	org := &GiteaOrganization{
+		OrganizationID:        organizationID.String(),
+		GiteaURL:              giteaURL,
+		OrganizationName:      giteaOrg.UserName,
+		OrganizationNameLower: strings.ToLower(giteaOrg.UserName),
+		OrganizationSFID:      parentProjectSFID,
+		ProjectSFID:           projectSFID,
+		Enabled:               true,
+		AccessToken:           accessToken,
+		WebhookSecret:         webhookSecret,
+		OAuthClientID:         utils.StringValue(input.OauthClientID),
+		OAuthClientSecret:     oauthClientSecret,
+		DateCreated:           currentTime,
+		DateModified:          currentTime,
+		Note:                  fmt.Sprintf("created on %s", currentTime),
+		Version:               "v1",
+	}
+	if err = s.repo.AddGiteaOrganization(ctx, org); err != nil {
+		return nil, err
+	}
+
+	log.WithFields(f).Debugf("added gitea organization with ID: %s", org.OrganizationID)
+	return org.toModel(nil), nil
+}
+
+// GetGiteaOrganizationsByProjectSFID returns the Gitea organizations of the project with their enrolled repositories
+func (s *Service) GetGiteaOrganizationsByProjectSFID(ctx context.Context, projectSFID string) (*v2Models.GiteaOrganizations, error) {
+	orgs, err := s.repo.GetGiteaOrganizationsByProjectSFID(ctx, projectSFID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v2Models.GiteaOrganizations{List: []*v2Models.GiteaOrganization{}}
+	for _, org := range orgs {
+		repos, repoErr := s.v2GitRepo.GiteaGetRepositoriesByOrganizationName(ctx, org.GiteaURL, org.OrganizationName)
+		if repoErr != nil {
+			if _, ok := repoErr.(*utils.GiteaRepositoryNotFound); !ok {
+				return nil, repoErr
+			}
+		}
+		response.List = append(response.List, org.toModel(repos))
+	}
+
+	return response, nil
+}
+
+// GetGiteaOrganization returns the Gitea organization database model by ID
+func (s *Service) GetGiteaOrganization(ctx context.Context, organizationID string) (*GiteaOrganization, error) {
+	org, err := s.repo.GetGiteaOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, fmt.Errorf("gitea organization with ID: %s not found", organizationID)
+	}
+	return org, nil
+}
+
+// GetGiteaOrganizationByName returns the Gitea instance organization database model by name
+func (s *Service) GetGiteaOrganizationByName(ctx context.Context, giteaURL, organizationName string) (*GiteaOrganization, error) {
+	org, err := s.repo.GetGiteaOrganizationByName(ctx, giteaURL, organizationName)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, fmt.Errorf("gitea organization %s on %s not found", organizationName, giteaURL)
+	}
+	return org, nil
+}
+
+// DeleteGiteaOrganization removes the EasyCLA webhooks and the repositories of the organization, then the organization
+func (s *Service) DeleteGiteaOrganization(ctx context.Context, projectSFID, organizationID string) (*GiteaOrganization, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea_organizations.service.DeleteGiteaOrganization",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"projectSFID":    projectSFID,
+		"organizationID": organizationID,
+	}
+
+	org, err := s.GetGiteaOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.ProjectSFID != projectSFID {
+		return nil, fmt.Errorf("gitea organization with ID: %s is not registered with project: %s", organizationID, projectSFID)
+	}
+
+	repos, err := s.v2GitRepo.GiteaGetRepositoriesByOrganizationName(ctx, org.GiteaURL, org.OrganizationName)
+	if err != nil {
+		if _, ok := err.(*utils.GiteaRepositoryNotFound); !ok {
+			return nil, err
+		}
+	}
+
+	client, err := s.NewGiteaClient(org)
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repos {
+		// the instance may no longer be reachable or the repository removed - the records are deleted regardless
+		if hookErr := gitea.RemoveWebHook(ctx, client, config.GetConfig().Gitea.WebHookURI, org.OrganizationName, repositoryShortName(repo)); hookErr != nil {
+			log.WithFields(f).WithError(hookErr).Warnf("unable to remove the webhook from repository: %s", repo.RepositoryName)
+		}
+		if err = s.v2GitRepo.GiteaDeleteRepository(ctx, repo.RepositoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.repo.DeleteGiteaOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// EnrollGiteaRepositories registers the EasyCLA webhook on the organization repositories and enrolls them in the CLA Group
+func (s *Service) EnrollGiteaRepositories(ctx context.Context, projectSFID, organizationID string, input *v2Models.GiteaRepositoriesEnroll) (*v2Models.GiteaRepositoriesList, error) {
+	claGroupID := utils.StringValue(input.ClaGroupID)
+	f := logrus.Fields{
+		"functionName":    "v2.gitea_organizations.service.EnrollGiteaRepositories",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"projectSFID":     projectSFID,
+		"organizationID":  organizationID,
+		"claGroupID":      claGroupID,
+		"repositoryNames": strings.Join(input.RepositoryNames, ","),
+	}
+
+	org, err := s.GetGiteaOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.ProjectSFID != projectSFID {
+		return nil, fmt.Errorf("gitea organization with ID: %s is not registered with project: %s", organizationID, projectSFID)
+	}
+
+	associated, err := s.claGroupRepository.IsAssociated(ctx, projectSFID, claGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !associated {
+		return nil, fmt.Errorf("CLA Group: %s is not associated with project: %s", claGroupID, projectSFID)
+	}
+
+	webHookURI := config.GetConfig().Gitea.WebHookURI
+	if webHookURI == "" {
+		return nil, errors.New("gitea web hook uri is not configured")
+	}
+	secret, err := s.GetWebhookSecret(org)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.NewGiteaClient(org)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v2Models.GiteaRepositoriesList{List: []*v2Models.GiteaRepository{}}
+	for _, repositoryName := range input.RepositoryNames {
+		giteaRepo, repoErr := gitea.GetRepository(ctx, client, org.OrganizationName, repositoryName)
+		if repoErr != nil {
+			return nil, fmt.Errorf("unable to load gitea repository %s/%s: %v", org.OrganizationName, repositoryName, repoErr)
+		}
+
+		if hookErr := gitea.SetWebHook(ctx, client, webHookURI, org.OrganizationName, giteaRepo.Name, secret); hookErr != nil {
+			return nil, hookErr
+		}
+
+		existing, getErr := s.v2GitRepo.GiteaGetRepositoryByExternalID(ctx, org.GiteaURL, giteaRepo.ID)
+		if getErr == nil {
+			log.WithFields(f).Debugf("gitea repository: %s is already enrolled", giteaRepo.FullName)
+			response.List = append(response.List, toRepositoryModel(existing))
+			continue
+		}
+		if _, ok := getErr.(*utils.GiteaRepositoryNotFound); !ok {
+			return nil, getErr
+		}
+
+		record, addErr := s.v2GitRepo.GiteaAddRepository(ctx, &repoModels.RepositoryDBModel{
+			RepositoryExternalID:       strconv.FormatInt(giteaRepo.ID, 10),
+			RepositoryName:             giteaRepo.FullName,
+			RepositoryFullPath:         giteaRepo.FullName,
+			RepositoryOrganizationName: org.OrganizationName,
+			RepositoryCLAGroupID:       claGroupID,
+			RepositorySfdcID:           projectSFID,
+			RepositoryURL:              giteaRepo.HTMLURL,
+			ProjectSFID:                projectSFID,
+			Enabled:                    true,
+		})
+		if addErr != nil {
+			return nil, addErr
+		}
+		response.List = append(response.List, toRepositoryModel(record))
+	}
+
+	return response, nil
+}
+
+// GetGiteaRepositoryByExternalID returns the enrolled repository for the Gitea instance repository ID
+func (s *Service) GetGiteaRepositoryByExternalID(ctx context.Context, giteaURL string, repositoryExternalID int64) (*repoModels.RepositoryDBModel, error) {
+	return s.v2GitRepo.GiteaGetRepositoryByExternalID(ctx, giteaURL, repositoryExternalID)
+}
+
+// NewGiteaClient creates the API client for the organization using the stored access token
+func (s *Service) NewGiteaClient(org *GiteaOrganization) (*gitea.Client, error) {
+	accessToken, err := gitea.DecryptToken(org.AccessToken, config.GetConfig().Gitea.TokenKey)
+	if err != nil {
+		return nil, err
+	}
+	return gitea.NewClient(org.GiteaURL, accessToken)
+}
+
+// GetWebhookSecret returns the decrypted webhook secret of the organization
+func (s *Service) GetWebhookSecret(org *GiteaOrganization) (string, error) {
+	return gitea.DecryptToken(org.WebhookSecret, config.GetConfig().Gitea.TokenKey)
+}
+
+// GetOAuthApp returns the OAuth2 application of the organization Gitea instance used for the sign flow
+func (s *Service) GetOAuthApp(org *GiteaOrganization) (*gitea.OAuthApp, error) {
+	clientSecret, err := gitea.DecryptToken(org.OAuthClientSecret, config.GetConfig().Gitea.TokenKey)
+	if err != nil {
+		return nil, err
+	}
+	return &gitea.OAuthApp{
+		BaseURL:      org.GiteaURL,
+		ClientID:     org.OAuthClientID,
+		ClientSecret: clientSecret,
+		RedirectURI:  config.GetConfig().Gitea.RedirectURI,
+	}, nil
+}
+
+// repositoryShortName returns the repository name without the organization prefix
+func repositoryShortName(repo *repoModels.RepositoryDBModel) string {
+	return strings.TrimPrefix(repo.RepositoryName, repo.RepositoryOrganizationName+"/")
+}
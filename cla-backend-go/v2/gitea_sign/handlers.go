@@ -0,0 +1,161 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_sign
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/gitea_sign"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gofrs/uuid"
+	"github.com/savaki/dynastore"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SessionStoreKey for cla-gitea session
+	SessionStoreKey = "cla-gitea"
+)
+
+// Configure setups handlers on api with service
+func Configure(api *operations.EasyclaAPI, service Service, giteaOrgService gitea_organizations.ServiceInterface, eventService events.Service, contributorConsoleV2Base string, sessionStore *dynastore.Store) {
+	api.GiteaSignSignRequestHandler = gitea_sign.SignRequestHandlerFunc(
+		func(srp gitea_sign.SignRequestParams) middleware.Responder {
+			reqID := utils.GetRequestID(srp.XREQUESTID)
+			ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID)
+			f := logrus.Fields{
+				"functionName":   "v2.gitea_sign.handlers.GiteaSignSignRequestHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"organizationID": srp.OrganizationID,
+				"repositoryID":   srp.GiteaRepositoryID,
+				"pullRequestID":  srp.PullRequestID,
+			}
+
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, pr runtime.Producer) {
+				session, err := sessionStore.Get(srp.HTTPRequest, SessionStoreKey)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error with session store lookup")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				giteaOrg, err := giteaOrgService.GetGiteaOrganization(ctx, srp.OrganizationID)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error getting gitea organization")
+					http.Error(rw, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				originURL, err := service.GetOriginURL(ctx, giteaOrg, srp.GiteaRepositoryID, srp.PullRequestID)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error getting origin URL")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				oauthApp, err := giteaOrgService.GetOAuthApp(giteaOrg)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error loading gitea oauth application")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				// the OAuth2 application is registered per Gitea instance, the callback needs the organization to
+				// exchange the code
+				stateID, _ := uuid.NewV4()
+				state := fmt.Sprintf("user:%s", stateID.String())
+				session.Values["gitea_oauth2_state"] = state
+				session.Values["gitea_organization_id"] = srp.OrganizationID
+				session.Values["gitea_repository_id"] = srp.GiteaRepositoryID
+				session.Values["gitea_pull_request_id"] = srp.PullRequestID
+				session.Values["gitea_origin_url"] = originURL
+				if err = session.Save(srp.HTTPRequest, rw); err != nil {
+					log.WithFields(f).WithError(err).Warn("error saving session")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				log.WithFields(f).Debug("redirecting to gitea authorize url...")
+				http.Redirect(rw, srp.HTTPRequest, oauthApp.AuthCodeURL(state), http.StatusFound)
+			})
+		})
+
+	api.GiteaSignUserOauthCallbackHandler = gitea_sign.UserOauthCallbackHandlerFunc(
+		func(params gitea_sign.UserOauthCallbackParams) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID)
+			f := logrus.Fields{
+				"functionName":   "v2.gitea_sign.handlers.GiteaSignUserOauthCallbackHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"state":          params.State,
+			}
+
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+				session, err := sessionStore.Get(params.HTTPRequest, SessionStoreKey)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error with session store lookup")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				values := map[string]string{}
+				for _, key := range []string{"gitea_oauth2_state", "gitea_organization_id", "gitea_repository_id", "gitea_pull_request_id", "gitea_origin_url"} {
+					value, ok := session.Values[key].(string)
+					if !ok {
+						log.WithFields(f).Warnf("error getting %s - missing from session object", key)
+						http.Error(rw, "no session state", http.StatusInternalServerError)
+						return
+					}
+					values[key] = value
+				}
+
+				if params.State != values["gitea_oauth2_state"] {
+					msg := fmt.Sprintf("mismatch state, received: %s from callback, but loaded our state as: %s", params.State, values["gitea_oauth2_state"])
+					log.WithFields(f).Warn(msg)
+					http.Error(rw, msg, http.StatusInternalServerError)
+					return
+				}
+
+				giteaOrg, err := giteaOrgService.GetGiteaOrganization(ctx, values["gitea_organization_id"])
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error getting gitea organization")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				oauthApp, err := giteaOrgService.GetOAuthApp(giteaOrg)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("error loading gitea oauth application")
+					http.Error(rw, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				log.WithFields(f).Debug("fetching access token for user...")
+				token, err := oauthApp.FetchOauthCredentials(ctx, params.Code)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("unable to fetch access token for user")
+					http.Error(rw, "unable to fetch access token for user", http.StatusInternalServerError)
+					return
+				}
+
+				consoleURL, err := service.InitiateSignRequest(ctx, giteaOrg, token.AccessToken, values["gitea_repository_id"], values["gitea_pull_request_id"], values["gitea_origin_url"], contributorConsoleV2Base, eventService)
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("problem initiating sign request")
+					http.Error(rw, "problem initiating sign request", http.StatusInternalServerError)
+					return
+				}
+
+				log.WithFields(f).Debugf("redirecting to :%s ", consoleURL)
+				http.Redirect(rw, params.HTTPRequest, consoleURL, http.StatusSeeOther)
+			})
+		})
+}
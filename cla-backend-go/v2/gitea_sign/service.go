@@ -0,0 +1,236 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package gitea_sign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	gitea "github.com/communitybridge/easycla/cla-backend-go/gitea_api"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+)
+
+// Service contains the functions of the Gitea sign service
+type Service interface {
+	GetOriginURL(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, repositoryID, pullRequestID string) (string, error)
+	InitiateSignRequest(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, userAccessToken, repositoryID, pullRequestID, originURL, contributorBaseURL string, eventService events.Service) (string, error)
+}
+
+type service struct {
+	giteaOrgService gitea_organizations.ServiceInterface
+	userService     users.Service
+	storeRepo       store.Repository
+}
+
+// NewService creates a new Gitea sign service
+func NewService(giteaOrgService gitea_organizations.ServiceInterface, userService users.Service, storeRepo store.Repository) Service {
+	return &service{
+		giteaOrgService: giteaOrgService,
+		userService:     userService,
+		storeRepo:       storeRepo,
+	}
+}
+
+// GetOriginURL returns the pull request URL the contributor is sent back to after signing
+func (s *service) GetOriginURL(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, repositoryID, pullRequestID string) (string, error) {
+	repositoryExternalID, pullRequestIndex, err := parseIDs(repositoryID, pullRequestID)
+	if err != nil {
+		return "", err
+	}
+
+	giteaClient, err := s.giteaOrgService.NewGiteaClient(giteaOrg)
+	if err != nil {
+		return "", err
+	}
+
+	repository, err := gitea.GetRepositoryByID(ctx, giteaClient, repositoryExternalID)
+	if err != nil {
+		return "", err
+	}
+
+	pullRequest, err := gitea.GetPullRequest(ctx, giteaClient, repository.Owner.UserName, repository.Name, pullRequestIndex)
+	if err != nil {
+		return "", err
+	}
+
+	return pullRequest.HTMLURL, nil
+}
+
+// InitiateSignRequest stores the active signature metadata of the contributor and returns the contributor console URL
+func (s *service) InitiateSignRequest(ctx context.Context, giteaOrg *gitea_organizations.GiteaOrganization, userAccessToken, repositoryID, pullRequestID, originURL, contributorBaseURL string, eventService events.Service) (string, error) {
+	f := logrus.Fields{
+		"functionName":        "v2.gitea_sign.service.InitiateSignRequest",
+		utils.XREQUESTID:      ctx.Value(utils.XREQUESTID),
+		"giteaOrganizationID": giteaOrg.OrganizationID,
+		"repositoryID":        repositoryID,
+		"pullRequestID":       pullRequestID,
+		"originURL":           originURL,
+	}
+
+	repositoryExternalID, _, err := parseIDs(repositoryID, pullRequestID)
+	if err != nil {
+		return "", err
+	}
+
+	userClient, err := gitea.NewClient(giteaOrg.GiteaURL, userAccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	claUser, err := s.getOrCreateUser(ctx, userClient, eventService)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to get or create user")
+		return "", err
+	}
+
+	giteaRepo, err := s.giteaOrgService.GetGiteaRepositoryByExternalID(ctx, giteaOrg.GiteaURL, repositoryExternalID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to find repository by external ID")
+		return "", err
+	}
+
+	type StoreValue struct {
+		UserID         string `json:"user_id"`
+		ProjectID      string `json:"project_id"`
+		OrganizationID string `json:"organization_id"`
+		RepositoryID   string `json:"repository_id"`
+		PullRequestID  string `json:"pull_request_id"`
+		ReturnURL      string `json:"return_url"`
+	}
+
+	// set active signature metadata to track the user signing process
+	key := fmt.Sprintf("active_signature:%s", claUser.UserID)
+	jsonData, err := json.Marshal(StoreValue{
+		UserID:         claUser.UserID,
+		ProjectID:      giteaRepo.RepositoryCLAGroupID,
+		OrganizationID: giteaOrg.OrganizationID,
+		RepositoryID:   repositoryID,
+		PullRequestID:  pullRequestID,
+		ReturnURL:      originURL,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	log.WithFields(f).Debugf("setting active signature metadata for user: %s", claUser.UserID)
+	expire := time.Now().AddDate(0, 0, 1).Unix()
+	if activeSignErr := s.storeRepo.SetActiveSignatureMetaData(ctx, key, expire, string(jsonData)); activeSignErr != nil {
+		log.WithFields(f).WithError(activeSignErr).Warn("unable to save signature metadata")
+		return "", activeSignErr
+	}
+
+	return fmt.Sprintf("https://%s/#/cla/project/%s/user/%s?redirect=%s", contributorBaseURL, giteaRepo.RepositoryCLAGroupID, claUser.UserID, url.QueryEscape(originURL)), nil
+}
+
+// getOrCreateUser looks up the CLA user by the Gitea ID, the Gitea username and finally the Gitea account email - the
+// Gitea identity is qualified with the instance URL as Gitea user IDs are only unique within an instance
+func (s *service) getOrCreateUser(ctx context.Context, userClient *gitea.Client, eventsService events.Service) (*models.User, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.gitea_sign.service.getOrCreateUser",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	giteaUser, err := gitea.GetCurrentUser(ctx, userClient)
+	if err != nil {
+		return nil, err
+	}
+	giteaID := gitea.UserIDKey(userClient.BaseURL(), giteaUser.ID)
+	giteaUsername := gitea.UserNameKey(userClient.BaseURL(), giteaUser.UserName)
+
+	log.WithFields(f).Debugf("looking up user by Gitea ID: %s", giteaID)
+	claUser, err := s.userService.GetUserByGiteaID(giteaID)
+	if err == nil && claUser != nil {
+		log.WithFields(f).Debugf("found user by Gitea ID: %s", giteaID)
+		return claUser, nil
+	}
+
+	log.WithFields(f).Debugf("looking up user by Gitea username: %s", giteaUsername)
+	claUser, err = s.userService.GetUserByGiteaUsername(giteaUsername)
+	if err == nil && claUser != nil {
+		log.WithFields(f).Debugf("found user by Gitea username: %s", giteaUsername)
+		return s.recordGiteaIdentity(f, claUser, giteaID, giteaUsername)
+	}
+
+	if giteaUser.Email == "" {
+		return nil, fmt.Errorf("gitea user: %s has no email address", giteaUser.UserName)
+	}
+
+	log.WithFields(f).Debugf("looking up user by Gitea email: %s", giteaUser.Email)
+	claUser, err = s.userService.GetUserByEmail(giteaUser.Email)
+	if err == nil && claUser != nil {
+		log.WithFields(f).Debugf("found user by Gitea email: %s", giteaUser.Email)
+		return s.recordGiteaIdentity(f, claUser, giteaID, giteaUsername)
+	}
+
+	log.WithFields(f).Infof("unable to locate Gitea user - creating a new user record for Gitea user: %s", giteaUser.UserName)
+	userName := giteaUser.FullName
+	if userName == "" {
+		userName = giteaUser.UserName
+	}
+	user := &models.User{
+		GiteaID:       giteaID,
+		GiteaUsername: giteaUsername,
+		LfEmail:       strfmt.Email(giteaUser.Email),
+		Emails:        []string{giteaUser.Email},
+		Username:      userName,
+	}
+	claUser, err = s.userService.CreateUser(user, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsService.LogEvent(&events.LogEventArgs{
+		EventType: events.UserCreated,
+		UserID:    claUser.UserID,
+		UserModel: claUser,
+		EventData: &events.UserCreatedEventData{},
+	})
+
+	return claUser, nil
+}
+
+// recordGiteaIdentity stores the Gitea ID and username on an existing user record so the pull request check can match
+// the commit authors which don't expose their email address
+func (s *service) recordGiteaIdentity(f logrus.Fields, claUser *models.User, giteaID, giteaUsername string) (*models.User, error) {
+	if claUser.GiteaID == giteaID && claUser.GiteaUsername == giteaUsername {
+		return claUser, nil
+	}
+
+	log.WithFields(f).Debugf("recording Gitea ID: %s and username: %s on user: %s", giteaID, giteaUsername, claUser.UserID)
+	updatedUser, err := s.userService.UpdateUser(claUser.UserID, map[string]interface{}{
+		"user_gitea_id":       giteaID,
+		"user_gitea_username": giteaUsername,
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to record the Gitea identity on user: %s", claUser.UserID)
+		return claUser, nil
+	}
+
+	return updatedUser, nil
+}
+
+func parseIDs(repositoryID, pullRequestID string) (int64, int64, error) {
+	repositoryExternalID, err := strconv.ParseInt(repositoryID, 10, 64)
+	if err != nil {
+		return 0, 0, errors.New("invalid gitea repository ID: " + repositoryID)
+	}
+	pullRequestIndex, err := strconv.ParseInt(pullRequestID, 10, 64)
+	if err != nil {
+		return 0, 0, errors.New("invalid gitea pull request ID: " + pullRequestID)
+	}
+	return repositoryExternalID, pullRequestIndex, nil
+}
@@ -0,0 +1,139 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+// ProcessCheckRunEvent handles the re-run and the Sign CLA actions of the EasyCLA check run
+func (s *eventHandlerService) ProcessCheckRunEvent(event *github.CheckRunEvent) error {
+	ctx := newEventContext(event.Sender)
+	f := logrus.Fields{
+		"functionName":   "v2.github_activity.check_run.ProcessCheckRunEvent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if event.Action == nil {
+		return fmt.Errorf("no action found in event payload")
+	}
+	if event.Repo == nil || event.CheckRun == nil || event.Installation == nil {
+		return fmt.Errorf("missing repository, check run or installation object in event payload")
+	}
+
+	f["action"] = event.GetAction()
+	f["repositoryName"] = event.Repo.GetFullName()
+	f["checkRunName"] = event.CheckRun.GetName()
+	if event.CheckRun.GetName() != v1Github.CheckRunName {
+		log.WithFields(f).Debugf("ignoring check run : %s", event.CheckRun.GetName())
+		return nil
+	}
+
+	var identifier string
+	switch event.GetAction() {
+	case "rerequested":
+		identifier = v1Github.CheckRunActionRecheck
+	case "requested_action":
+		if event.RequestedAction == nil {
+			return fmt.Errorf("missing requested action object in event payload")
+		}
+		identifier = event.RequestedAction.Identifier
+	default:
+		log.WithFields(f).Debugf("no handler for check run action : %s", event.GetAction())
+		return nil
+	}
+	f["identifier"] = identifier
+
+	pullRequestIDs := checkRunPullRequestIDs(event.CheckRun)
+	if len(pullRequestIDs) == 0 {
+		log.WithFields(f).Warn("unable to determine the pull request of the check run")
+		return nil
+	}
+
+	repoModel, err := s.getEnabledRepository(ctx, f, event.Repo.GetID())
+	if err != nil || repoModel == nil {
+		return err
+	}
+
+	installationID := event.Installation.GetID()
+	owner := event.Repo.GetOwner().GetLogin()
+	repoName := event.Repo.GetName()
+	for _, pullRequestID := range pullRequestIDs {
+		f["pullRequestID"] = pullRequestID
+		if identifier == v1Github.CheckRunActionRecheck {
+			err = s.checkPullRequest(ctx, f, repoModel, installationID, owner, repoName, event.Repo.ID, pullRequestID, s.checkRunBaseBranch(ctx, f, event.CheckRun, repoModel, installationID, owner, repoName, pullRequestID))
+		} else if authorID, ok := v1Github.ParseSignCheckRunAction(identifier); ok {
+			err = s.requestSignature(ctx, f, installationID, owner, repoName, event.Repo.ID, pullRequestID, authorID)
+		} else {
+			log.WithFields(f).Warnf("unknown check run action identifier : %s", identifier)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// requestSignature mentions the missing commit author in the pull request with the link to sign the CLA
+func (s *eventHandlerService) requestSignature(ctx context.Context, f logrus.Fields, installationID int64, owner, repoName string, repoID *int64, pullRequestID int, authorID string) error {
+	authors, _, err := s.pullRequestClient.GetPullRequestCommitAuthors(ctx, installationID, pullRequestID, owner, repoName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load pull request commit authors")
+		return err
+	}
+
+	for _, author := range authors {
+		if author.GetCommitAuthorID() == authorID {
+			log.WithFields(f).Debugf("requesting the CLA signature of the commit author : %s", author.GetCommitAuthorUsername())
+			return s.pullRequestClient.CreateSignRequestComment(ctx, installationID, pullRequestID, owner, repoName, repoID, author, s.claV1ApiURL)
+		}
+	}
+
+	log.WithFields(f).Warnf("commit author with the GitHub user ID : %s is no longer part of the pull request", authorID)
+	return nil
+}
+
+// checkRunBaseBranch returns the base branch of the pull request of the check run, which is only needed to select the
+// CLA groups bound to the base branch - the pull request is loaded when it is not listed in the check run
+func (s *eventHandlerService) checkRunBaseBranch(ctx context.Context, f logrus.Fields, checkRun *github.CheckRun, repoModel *models.GithubRepository, installationID int64, owner, repoName string, pullRequestID int) string {
+	if len(repoModel.ClaGroupBindings) == 0 {
+		return ""
+	}
+	for _, pullRequest := range checkRun.PullRequests {
+		if pullRequest.GetNumber() == pullRequestID && pullRequest.GetBase().GetRef() != "" {
+			return pullRequest.GetBase().GetRef()
+		}
+	}
+
+	baseBranch, err := s.pullRequestClient.GetPullRequestBaseBranch(ctx, installationID, pullRequestID, owner, repoName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the pull request base branch")
+	}
+	return baseBranch
+}
+
+// checkRunPullRequestIDs returns the pull request numbers of the check run - the EasyCLA check runs carry the pull request
+// number as the external ID because GitHub leaves the pull request list empty for pull requests from forks
+func checkRunPullRequestIDs(checkRun *github.CheckRun) []int {
+	if pullRequestID, err := strconv.Atoi(checkRun.GetExternalID()); err == nil && pullRequestID > 0 {
+		return []int{pullRequestID}
+	}
+
+	var pullRequestIDs []int
+	for _, pullRequest := range checkRun.PullRequests {
+		pullRequestIDs = append(pullRequestIDs, pullRequest.GetNumber())
+	}
+	return pullRequestIDs
+}
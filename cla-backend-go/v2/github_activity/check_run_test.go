@@ -0,0 +1,120 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories/mock"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v37/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadCheckRunEvent(t *testing.T, fixture string) *github.CheckRunEvent {
+	payload, err := os.ReadFile(filepath.Join("testdata", fixture))
+	assert.NoError(t, err)
+	event, err := github.ParseWebHook("check_run", payload)
+	assert.NoError(t, err)
+	return event.(*github.CheckRunEvent)
+}
+
+func TestProcessCheckRunEvent_Rerequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+		CheckRunEnabled:      true,
+	}, nil)
+
+	usersRepo := mock_users.NewMockUserRepository(ctrl)
+	usersRepo.EXPECT().GetUserByGitHubID("1002").Return(nil, nil)
+	usersRepo.EXPECT().GetUserByGitHubUsername("new-contributor").Return(nil, nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		usersRepository:   usersRepo,
+		signatureService:  mock_signatures.NewMockSignatureService(ctrl),
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessCheckRunEvent(loadCheckRunEvent(t, "check_run_rerequested.json"))
+	assert.NoError(t, err)
+	assert.True(t, client.checkRunUpdated)
+	assert.False(t, client.updated)
+	assert.Len(t, client.signed, 0)
+	assert.Len(t, client.missing, 1)
+}
+
+func TestProcessCheckRunEvent_SignAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		CheckRunEnabled:      true,
+	}, nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("1111111", 1001, "octo-contributor", "Update the README"),
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessCheckRunEvent(loadCheckRunEvent(t, "check_run_requested_action.json"))
+	assert.NoError(t, err)
+	assert.False(t, client.checkRunUpdated)
+	if assert.NotNil(t, client.signRequested) {
+		assert.Equal(t, "new-contributor", client.signRequested.GetCommitAuthorUsername())
+	}
+}
+
+func TestCheckRunActions(t *testing.T) {
+	missing := []*v1Github.UserCommitSummary{
+		commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		commitSummary("3333333", 1002, "new-contributor", "Fix another typo"),
+		commitSummary("4444444", 1003, "a-very-long-github-login", "Add tests"),
+		commitSummary("5555555", 1004, "third-contributor", "Add docs"),
+	}
+
+	actions := v1Github.CheckRunActions(missing)
+	if assert.Len(t, actions, 3) {
+		assert.Equal(t, v1Github.CheckRunActionRecheck, actions[0].Identifier)
+		assert.Equal(t, "sign-1002", actions[1].Identifier)
+		assert.Equal(t, "sign-1003", actions[2].Identifier)
+		for _, action := range actions {
+			assert.LessOrEqual(t, len(action.Label), 20)
+			assert.LessOrEqual(t, len(action.Description), 40)
+		}
+	}
+
+	authorID, ok := v1Github.ParseSignCheckRunAction(actions[2].Identifier)
+	assert.True(t, ok)
+	assert.Equal(t, "1003", authorID)
+	_, ok = v1Github.ParseSignCheckRunAction(v1Github.CheckRunActionRecheck)
+	assert.False(t, ok)
+}
@@ -58,7 +58,7 @@ func Configure(api *operations.EasyclaAPI, service Service) {
 				})
 			}
 
-			event, err := github.ParseWebHook(githubEvent, payload)
+			event, err := parseWebHook(githubEvent, payload)
 			if err != nil {
 				return github_activity.NewGithubActivityBadRequest().WithPayload(&models.ErrorResponse{
 					Code:    "400",
@@ -68,10 +68,20 @@ func Configure(api *operations.EasyclaAPI, service Service) {
 
 			var processError error
 			switch event := event.(type) {
+			case *github.InstallationEvent:
+				processError = service.ProcessInstallationEvent(event)
 			case *github.InstallationRepositoriesEvent:
 				processError = service.ProcessInstallationRepositoriesEvent(event)
 			case *github.RepositoryEvent:
 				processError = service.ProcessRepositoryEvent(event)
+			case *github.PullRequestEvent:
+				processError = service.ProcessPullRequestEvent(event)
+			case *github.CheckRunEvent:
+				processError = service.ProcessCheckRunEvent(event)
+			case *github.IssueCommentEvent:
+				processError = service.ProcessIssueCommentEvent(event)
+			case *MergeGroupEvent:
+				processError = service.ProcessMergeGroupEvent(event)
 			default:
 				log.Warnf("unsupported event sent : %s", githubEvent)
 			}
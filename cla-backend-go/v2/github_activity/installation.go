@@ -0,0 +1,280 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1GithubOrg "github.com/communitybridge/easycla/cla-backend-go/github_organizations"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+// ProcessInstallationEvent keeps the github organization and its repositories in line with the EasyCLA GitHub App
+// installation - GitHub sends no pull request events once the app is uninstalled or suspended
+func (s *eventHandlerService) ProcessInstallationEvent(event *github.InstallationEvent) error {
+	ctx := newEventContext(event.Sender)
+	f := logrus.Fields{
+		"functionName":   "v2.github_activity.installation.ProcessInstallationEvent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if event.Action == nil {
+		return fmt.Errorf("no action found in event payload")
+	}
+	if event.Installation == nil || event.Installation.Account == nil || event.Installation.Account.GetLogin() == "" {
+		return fmt.Errorf("missing installation account in event payload")
+	}
+
+	installationID := event.Installation.GetID()
+	organizationName := event.Installation.Account.GetLogin()
+	f["action"] = event.GetAction()
+	f["installationID"] = installationID
+	f["organizationName"] = organizationName
+
+	switch event.GetAction() {
+	case "deleted", "suspend", "unsuspend", "new_permissions_accepted":
+	default:
+		log.WithFields(f).Debugf("no handler for installation action : %s", event.GetAction())
+		return nil
+	}
+
+	githubOrg, err := s.githubOrgRepo.GetGitHubOrganization(ctx, organizationName)
+	if err != nil {
+		if errors.Is(err, v1GithubOrg.ErrOrganizationDoesNotExist) {
+			log.WithFields(f).Warnf("event for non existing github organization : %s, nothing to do", organizationName)
+			return nil
+		}
+		return fmt.Errorf("fetching the github organization : %s failed : %v", organizationName, err)
+	}
+
+	// the organization may have installed the app again since, the events of the previous installation are stale
+	if githubOrg.OrganizationInstallationID != 0 && githubOrg.OrganizationInstallationID != installationID {
+		log.WithFields(f).Warnf("github organization : %s is registered with the installation : %d, ignoring the event",
+			organizationName, githubOrg.OrganizationInstallationID)
+		return nil
+	}
+
+	switch event.GetAction() {
+	case "deleted":
+		return s.handleInstallationDeletedAction(ctx, event.Sender, githubOrg, installationID)
+	case "suspend":
+		return s.handleInstallationSuspendedAction(ctx, event.Sender, githubOrg, installationID, true)
+	case "unsuspend":
+		return s.handleInstallationSuspendedAction(ctx, event.Sender, githubOrg, installationID, false)
+	default:
+		return s.handleInstallationPermissionsAcceptedAction(ctx, event.Sender, githubOrg, installationID)
+	}
+}
+
+// handleInstallationDeletedAction removes the installation from the github organization and disables its repositories
+func (s *eventHandlerService) handleInstallationDeletedAction(ctx context.Context, sender *github.User, githubOrg *models.GithubOrganization, installationID int64) error {
+	f := logrus.Fields{
+		"functionName":     "v2.github_activity.installation.handleInstallationDeletedAction",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": githubOrg.OrganizationName,
+		"installationID":   installationID,
+	}
+
+	if err := s.githubOrgRepo.UpdateGitHubOrganizationInstallation(ctx, githubOrg.OrganizationName, 0, false); err != nil {
+		return fmt.Errorf("removing the installation of the github organization : %s failed : %v", githubOrg.OrganizationName, err)
+	}
+
+	repos, err := s.getOrganizationRepositories(ctx, githubOrg.OrganizationName)
+	if err != nil {
+		return err
+	}
+
+	// the CLA groups are collected before the repositories are disabled so their managers can be notified
+	claGroupIDs := organizationCLAGroupIDs(githubOrg, repos)
+	disabledRepos := map[string][]string{}
+	var disabledRepoNames []string
+	for _, repo := range repos {
+		if !repo.Enabled {
+			continue
+		}
+		log.WithFields(f).Infof("disabling repo : %s", repo.RepositoryName)
+		if err := s.gitV1Repository.GitHubDisableRepository(ctx, repo.RepositoryID); err != nil {
+			log.WithFields(f).Warnf("disabling repo : %s failed : %v", repo.RepositoryName, err)
+			continue
+		}
+		disabledRepos[repo.RepositoryClaGroupID] = append(disabledRepos[repo.RepositoryClaGroupID], repo.RepositoryName)
+		disabledRepoNames = append(disabledRepoNames, repo.RepositoryName)
+
+		s.eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+			EventType:   events.RepositoryDisabled,
+			ProjectSFID: repo.RepositoryProjectSfid,
+			CLAGroupID:  repo.RepositoryClaGroupID,
+			UserID:      sender.GetLogin(),
+			EventData: &events.RepositoryDisabledEventData{
+				RepositoryName: repo.RepositoryName,
+			},
+		})
+	}
+
+	s.eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   events.GitHubOrganizationInstallationDeleted,
+		ProjectSFID: githubOrg.ProjectSFID,
+		UserID:      sender.GetLogin(),
+		EventData: &events.GitHubOrganizationInstallationEventData{
+			GitHubOrganizationName: githubOrg.OrganizationName,
+			InstallationID:         installationID,
+			InstallationAction:     "uninstalled",
+			DisabledRepositories:   disabledRepoNames,
+		},
+	})
+
+	if s.sendEmail {
+		for _, claGroupID := range claGroupIDs {
+			params := emails.GithubAppInstallationTemplateParams{
+				CommonEmailParams: emails.CommonEmailParams{
+					RecipientName: "CLA Manager",
+				},
+				GithubOrgName: githubOrg.OrganizationName,
+				Repositories:  disabledRepos[claGroupID],
+			}
+			body, err := emails.RenderGithubAppUninstalledTemplate(s.emailService, claGroupID, params)
+			s.notifyForGithubAppInstallation(ctx, f, claGroupID, "EasyCLA: Github App Was Uninstalled", body, err)
+		}
+	}
+
+	return nil
+}
+
+// handleInstallationSuspendedAction records the installation of the github organization as suspended or unsuspended,
+// the repositories stay enabled as the pull requests are checked again once the installation is unsuspended
+func (s *eventHandlerService) handleInstallationSuspendedAction(ctx context.Context, sender *github.User, githubOrg *models.GithubOrganization, installationID int64, suspended bool) error {
+	f := logrus.Fields{
+		"functionName":     "v2.github_activity.installation.handleInstallationSuspendedAction",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": githubOrg.OrganizationName,
+		"installationID":   installationID,
+		"suspended":        suspended,
+	}
+
+	if err := s.githubOrgRepo.UpdateGitHubOrganizationInstallation(ctx, githubOrg.OrganizationName, installationID, suspended); err != nil {
+		return fmt.Errorf("updating the installation of the github organization : %s failed : %v", githubOrg.OrganizationName, err)
+	}
+
+	eventType, action := events.GitHubOrganizationInstallationSuspended, "suspended"
+	subject, render := "EasyCLA: Github App Was Suspended", emails.RenderGithubAppSuspendedTemplate
+	if !suspended {
+		eventType, action = events.GitHubOrganizationInstallationUnsuspended, "unsuspended"
+		subject, render = "EasyCLA: Github App Was Unsuspended", emails.RenderGithubAppUnsuspendedTemplate
+	}
+	log.WithFields(f).Infof("the installation of the github organization : %s was %s", githubOrg.OrganizationName, action)
+
+	s.eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   eventType,
+		ProjectSFID: githubOrg.ProjectSFID,
+		UserID:      sender.GetLogin(),
+		EventData: &events.GitHubOrganizationInstallationEventData{
+			GitHubOrganizationName: githubOrg.OrganizationName,
+			InstallationID:         installationID,
+			InstallationAction:     action,
+		},
+	})
+
+	return s.notifyOrganizationCLAManagers(ctx, f, githubOrg, subject, render)
+}
+
+// handleInstallationPermissionsAcceptedAction logs the new app permissions accepted by the github organization
+func (s *eventHandlerService) handleInstallationPermissionsAcceptedAction(ctx context.Context, sender *github.User, githubOrg *models.GithubOrganization, installationID int64) error {
+	f := logrus.Fields{
+		"functionName":     "v2.github_activity.installation.handleInstallationPermissionsAcceptedAction",
+		utils.XREQUESTID:   ctx.Value(utils.XREQUESTID),
+		"organizationName": githubOrg.OrganizationName,
+		"installationID":   installationID,
+	}
+
+	s.eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   events.GitHubOrganizationInstallationPermissionsAccepted,
+		ProjectSFID: githubOrg.ProjectSFID,
+		UserID:      sender.GetLogin(),
+		EventData: &events.GitHubOrganizationInstallationEventData{
+			GitHubOrganizationName: githubOrg.OrganizationName,
+			InstallationID:         installationID,
+			InstallationAction:     "updated with the new permissions",
+		},
+	})
+
+	return s.notifyOrganizationCLAManagers(ctx, f, githubOrg, "EasyCLA: Github App Permissions Were Accepted", emails.RenderGithubAppPermissionsAcceptedTemplate)
+}
+
+// notifyOrganizationCLAManagers notifies the CLA managers of the CLA groups of the github organization repositories
+func (s *eventHandlerService) notifyOrganizationCLAManagers(ctx context.Context, f logrus.Fields, githubOrg *models.GithubOrganization, subject string,
+	render func(emails.EmailTemplateService, string, emails.GithubAppInstallationTemplateParams) (string, error)) error {
+	if !s.sendEmail {
+		return nil
+	}
+
+	repos, err := s.getOrganizationRepositories(ctx, githubOrg.OrganizationName)
+	if err != nil {
+		return err
+	}
+
+	for _, claGroupID := range organizationCLAGroupIDs(githubOrg, repos) {
+		body, err := render(s.emailService, claGroupID, emails.GithubAppInstallationTemplateParams{
+			CommonEmailParams: emails.CommonEmailParams{
+				RecipientName: "CLA Manager",
+			},
+			GithubOrgName: githubOrg.OrganizationName,
+		})
+		s.notifyForGithubAppInstallation(ctx, f, claGroupID, subject, body, err)
+	}
+	return nil
+}
+
+func (s *eventHandlerService) notifyForGithubAppInstallation(ctx context.Context, f logrus.Fields, claGroupID, subject, body string, renderErr error) {
+	if renderErr != nil {
+		log.WithFields(f).Warnf("rendering email template for the CLA group : %s failed : %v", claGroupID, renderErr)
+		return
+	}
+	if err := s.emailService.NotifyClaManagersForClaGroupID(ctx, claGroupID, subject, body); err != nil {
+		log.WithFields(f).Warnf("notifying cla managers of the CLA group : %s via email failed : %v", claGroupID, err)
+	}
+}
+
+// getOrganizationRepositories returns the repositories of the github organization, none when it has no repositories
+func (s *eventHandlerService) getOrganizationRepositories(ctx context.Context, organizationName string) ([]*models.GithubRepository, error) {
+	repos, err := s.gitV1Repository.GitHubGetRepositoriesByOrganizationName(ctx, organizationName)
+	if err != nil {
+		if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching the repositories of the github organization : %s failed : %v", organizationName, err)
+	}
+	return repos, nil
+}
+
+// organizationCLAGroupIDs returns the CLA groups of the enabled repositories of the github organization and the CLA
+// group its new repositories are auto-enabled for
+func organizationCLAGroupIDs(githubOrg *models.GithubOrganization, repos []*models.GithubRepository) []string {
+	var claGroupIDs []string
+	seen := map[string]bool{}
+	add := func(claGroupID string) {
+		if claGroupID == "" || seen[claGroupID] {
+			return
+		}
+		seen[claGroupID] = true
+		claGroupIDs = append(claGroupIDs, claGroupID)
+	}
+
+	for _, repo := range repos {
+		if repo.Enabled {
+			add(repo.RepositoryClaGroupID)
+		}
+	}
+	if githubOrg.AutoEnabled {
+		add(githubOrg.AutoEnabledClaGroupID)
+	}
+	return claGroupIDs
+}
@@ -0,0 +1,105 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	eventsMock "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	githubOrgMock "github.com/communitybridge/easycla/cla-backend-go/github_organizations/mock"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v37/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func installationEvent(action string, installationID int64) *github.InstallationEvent {
+	return &github.InstallationEvent{
+		Action: aws.String(action),
+		Installation: &github.Installation{
+			ID: aws.Int64(installationID),
+			Account: &github.User{
+				Login: aws.String("org1"),
+			},
+		},
+		Sender: &github.User{
+			Login: aws.String("githubLoginValue"),
+		},
+	}
+}
+
+func TestEventHandlerService_ProcessInstallationEvent_HandleInstallationDeletedAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubOrganizationRepo := githubOrgMock.NewMockRepositoryInterface(ctrl)
+	githubOrganizationRepo.EXPECT().GetGitHubOrganization(gomock.Any(), "org1").Return(&models.GithubOrganization{
+		OrganizationName:           "org1",
+		OrganizationInstallationID: 1234,
+		ProjectSFID:                "projectSFID",
+	}, nil)
+	githubOrganizationRepo.EXPECT().UpdateGitHubOrganizationInstallation(gomock.Any(), "org1", int64(0), false).Return(nil)
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoriesByOrganizationName(gomock.Any(), "org1").Return([]*models.GithubRepository{
+		{
+			Enabled:               true,
+			RepositoryID:          "repo1",
+			RepositoryName:        "org1/repo1",
+			RepositoryClaGroupID:  testCLAGroupID,
+			RepositoryProjectSfid: "projectSFID",
+		},
+		{
+			RepositoryID:         "repo2",
+			RepositoryName:       "org1/repo2",
+			RepositoryClaGroupID: testCLAGroupID,
+		},
+	}, nil)
+	githubRepo.EXPECT().GitHubDisableRepository(gomock.Any(), "repo1").Return(nil)
+
+	eventsService := eventsMock.NewMockService(ctrl)
+	eventsService.EXPECT().LogEventWithContext(gomock.Any(), &events.LogEventArgs{
+		EventType:   events.RepositoryDisabled,
+		ProjectSFID: "projectSFID",
+		CLAGroupID:  testCLAGroupID,
+		UserID:      "githubLoginValue",
+		EventData: &events.RepositoryDisabledEventData{
+			RepositoryName: "org1/repo1",
+		},
+	}).Return()
+	eventsService.EXPECT().LogEventWithContext(gomock.Any(), &events.LogEventArgs{
+		EventType:   events.GitHubOrganizationInstallationDeleted,
+		ProjectSFID: "projectSFID",
+		UserID:      "githubLoginValue",
+		EventData: &events.GitHubOrganizationInstallationEventData{
+			GitHubOrganizationName: "org1",
+			InstallationID:         1234,
+			InstallationAction:     "uninstalled",
+			DisabledRepositories:   []string{"org1/repo1"},
+		},
+	}).Return()
+
+	activityService := newService(githubRepo, githubOrganizationRepo, eventsService, nil, nil, false)
+	err := activityService.ProcessInstallationEvent(installationEvent("deleted", 1234))
+	assert.NoError(t, err)
+}
+
+func TestEventHandlerService_ProcessInstallationEvent_StaleInstallation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the organization installed the app again, the events of the previous installation change nothing
+	githubOrganizationRepo := githubOrgMock.NewMockRepositoryInterface(ctrl)
+	githubOrganizationRepo.EXPECT().GetGitHubOrganization(gomock.Any(), "org1").Return(&models.GithubOrganization{
+		OrganizationName:           "org1",
+		OrganizationInstallationID: 5678,
+	}, nil)
+
+	activityService := newService(mock.NewMockRepositoryInterface(ctrl), githubOrganizationRepo, eventsMock.NewMockService(ctrl), nil, nil, false)
+	err := activityService.ProcessInstallationEvent(installationEvent("suspend", 1234))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,246 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// commentCommandPrefix starts the EasyCLA commands of the pull request comments, such as /easycla recheck
+	commentCommandPrefix  = "/easycla"
+	commentCommandRecheck = "recheck"
+	commentCommandHelp    = "help"
+
+	// commentCommandInterval is the minimum delay between two runs of the same command on the same pull request
+	commentCommandInterval = 2 * time.Minute
+)
+
+// ProcessIssueCommentEvent handles the EasyCLA commands of the pull request comments - /easycla recheck re-runs the CLA
+// check of the pull request, for example after the contributor signed the CLA, and /easycla help lists the commands
+func (s *eventHandlerService) ProcessIssueCommentEvent(event *github.IssueCommentEvent) error {
+	ctx := newEventContext(event.Sender)
+	f := logrus.Fields{
+		"functionName":   "v2.github_activity.issue_comment.ProcessIssueCommentEvent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if event.Action == nil {
+		return fmt.Errorf("no action found in event payload")
+	}
+	if event.Repo == nil || event.Issue == nil || event.Comment == nil || event.Installation == nil {
+		return fmt.Errorf("missing repository, issue, comment or installation object in event payload")
+	}
+
+	f["action"] = event.GetAction()
+	f["repositoryName"] = event.Repo.GetFullName()
+	f["pullRequestID"] = event.Issue.GetNumber()
+	// only the new comments are commands - editing a comment would otherwise run its command again
+	if event.GetAction() != "created" {
+		log.WithFields(f).Debugf("no handler for issue comment action : %s", event.GetAction())
+		return nil
+	}
+	if !event.Issue.IsPullRequest() {
+		log.WithFields(f).Debug("ignoring the comment of an issue")
+		return nil
+	}
+	// the comments of the bots, including the replies of EasyCLA, are never commands
+	if event.Comment.GetUser().GetType() == "Bot" {
+		log.WithFields(f).Debugf("ignoring the comment of the bot : %s", event.Comment.GetUser().GetLogin())
+		return nil
+	}
+
+	command, ok := parseCommentCommand(event.Comment.GetBody())
+	if !ok {
+		return nil
+	}
+	f["command"] = command
+	f["commentAuthor"] = event.Comment.GetUser().GetLogin()
+
+	repoModel, err := s.getEnabledRepository(ctx, f, event.Repo.GetID())
+	if err != nil || repoModel == nil {
+		return err
+	}
+
+	installationID := event.Installation.GetID()
+	owner := event.Repo.GetOwner().GetLogin()
+	repoName := event.Repo.GetName()
+	pullRequestID := event.Issue.GetNumber()
+	if command == commentCommandRecheck {
+		return s.recheckPullRequest(ctx, f, repoModel, installationID, owner, repoName, event.Repo.ID, pullRequestID, event.Comment.GetUser())
+	}
+
+	claimed, err := s.claimCommentCommand(ctx, f, commentCommandHelp, repoModel.RepositoryID, pullRequestID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		log.WithFields(f).Debugf("the EasyCLA commands were already listed in the last %s - ignoring the help", commentCommandInterval)
+		return nil
+	}
+
+	log.WithFields(f).Debug("replying with the EasyCLA commands")
+	return s.pullRequestClient.CreatePullRequestComment(ctx, installationID, pullRequestID, owner, repoName, commentCommandHelpBody(event.Comment.GetUser().GetLogin()))
+}
+
+// recheckPullRequest re-runs the CLA check of the pull request at most once per recheck interval
+func (s *eventHandlerService) recheckPullRequest(ctx context.Context, f logrus.Fields, repoModel *models.GithubRepository, installationID int64, owner, repoName string, repoID *int64, pullRequestID int, requestedBy *github.User) error {
+	claimed, err := s.claimCommentCommand(ctx, f, commentCommandRecheck, repoModel.RepositoryID, pullRequestID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		log.WithFields(f).Debugf("the pull request was already rechecked in the last %s - ignoring the recheck", commentCommandInterval)
+		return nil
+	}
+
+	baseBranch, err := s.pullRequestClient.GetPullRequestBaseBranch(ctx, installationID, pullRequestID, owner, repoName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the pull request base branch")
+		return err
+	}
+	f["baseBranch"] = baseBranch
+	if !utils.IsBranchEnforced(baseBranch, repoModel.IncludeBranches, repoModel.ExcludeBranches) {
+		log.WithFields(f).Debugf("base branch: %s is not enforced for repository: %s - skipping CLA check", baseBranch, repoModel.RepositoryName)
+		return nil
+	}
+
+	// the GitHub user is only recorded as the event user when it is an EasyCLA user
+	eventArgs := &events.LogEventArgs{
+		EventType:   events.RepositoryPullRequestRechecked,
+		ProjectSFID: repoModel.RepositoryProjectSfid,
+		CLAGroupID:  repoModel.RepositoryClaGroupID,
+		EventData: &events.RepositoryPullRequestRecheckedEventData{
+			RepositoryName: repoModel.RepositoryName,
+			PullRequestID:  pullRequestID,
+			RequestedBy:    requestedBy.GetLogin(),
+		},
+	}
+	if claUser := s.findUserForGitHubUser(f, requestedBy); claUser != nil {
+		eventArgs.UserID = claUser.UserID
+		eventArgs.LfUsername = claUser.LfUsername
+	}
+	s.eventService.LogEventWithContext(ctx, eventArgs)
+
+	log.WithFields(f).Debugf("rechecking the pull request on request of : %s", requestedBy.GetLogin())
+	return s.checkPullRequest(ctx, f, repoModel, installationID, owner, repoName, repoID, pullRequestID, baseBranch)
+}
+
+// findUserForGitHubUser looks up the EasyCLA user by the GitHub ID and then the GitHub username
+func (s *eventHandlerService) findUserForGitHubUser(f logrus.Fields, githubUser *github.User) *models.User {
+	if s.usersRepository == nil || githubUser == nil {
+		return nil
+	}
+
+	if githubUser.GetID() != 0 {
+		githubID := strconv.FormatInt(githubUser.GetID(), 10)
+		user, err := s.usersRepository.GetUserByGitHubID(githubID)
+		if err != nil {
+			log.WithFields(f).WithError(err).Debugf("unable to get user by github id: %s", githubID)
+		}
+		if user != nil {
+			return user
+		}
+	}
+
+	if githubUser.GetLogin() != "" {
+		user, err := s.usersRepository.GetUserByGitHubUsername(githubUser.GetLogin())
+		if err != nil {
+			log.WithFields(f).WithError(err).Debugf("unable to get user by github username: %s", githubUser.GetLogin())
+		}
+		if user != nil {
+			return user
+		}
+	}
+
+	return nil
+}
+
+// claimCommentCommand records the run of the command on the pull request in the store, returns false when the command
+// already ran on the pull request during the command interval - concurrent runs are resolved with conditional writes,
+// only one claims it
+func (s *eventHandlerService) claimCommentCommand(ctx context.Context, f logrus.Fields, command, repositoryID string, pullRequestID int) (bool, error) {
+	if s.storeRepository == nil {
+		return true, nil
+	}
+
+	key := fmt.Sprintf("github_%s:%s:%d", command, repositoryID, pullRequestID)
+	now := time.Now().UTC()
+	expire := now.Add(commentCommandInterval).Unix()
+	claimed, err := s.storeRepository.SetValueIfNotExists(ctx, key, expire, now.Format(time.RFC3339))
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem saving the command run of the pull request")
+		return false, err
+	}
+	if claimed {
+		return true, nil
+	}
+
+	// The expired store records are only removed eventually - replace the last run once the command interval elapsed,
+	// unless another run replaced it in the meantime
+	lastRun, err := s.storeRepository.GetValue(ctx, key)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem loading the last command run of the pull request")
+		return false, err
+	}
+	if !commentCommandAllowed(lastRun, now) {
+		return false, nil
+	}
+	claimed, err = s.storeRepository.SetValueIfEquals(ctx, key, expire, now.Format(time.RFC3339), lastRun)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem saving the command run of the pull request")
+		return false, err
+	}
+	return claimed, nil
+}
+
+// commentCommandAllowed reports whether the command interval elapsed since the last run, saved in the RFC3339 format
+func commentCommandAllowed(lastRun string, now time.Time) bool {
+	if lastRun == "" {
+		return true
+	}
+	last, err := time.Parse(time.RFC3339, lastRun)
+	if err != nil {
+		return true
+	}
+	return now.Sub(last) >= commentCommandInterval
+}
+
+// parseCommentCommand returns the EasyCLA command of the comment - as the /easycla comments always did, a comment
+// with the /easycla word anywhere is a command, which is help when followed by help and recheck otherwise
+func parseCommentCommand(body string) (string, bool) {
+	fields := strings.Fields(body)
+	for i, field := range fields {
+		if field != commentCommandPrefix {
+			continue
+		}
+		if i+1 < len(fields) && strings.EqualFold(fields[i+1], commentCommandHelp) {
+			return commentCommandHelp, true
+		}
+		return commentCommandRecheck, true
+	}
+	return "", false
+}
+
+// commentCommandHelpBody returns the reply listing the EasyCLA commands
+func commentCommandHelpBody(login string) string {
+	var body strings.Builder
+	fmt.Fprintf(&body, "@%s - ", login)
+	body.WriteString("The EasyCLA commands of the pull request comments are:\n\n")
+	fmt.Fprintf(&body, "- `%s %s` or `%s` - re-runs the CLA check of the pull request, for example after signing the CLA\n", commentCommandPrefix, commentCommandRecheck, commentCommandPrefix)
+	fmt.Fprintf(&body, "- `%s %s` - lists the EasyCLA commands\n\n", commentCommandPrefix, commentCommandHelp)
+	fmt.Fprintf(&body, "Each command runs at most once every %d minutes on a pull request.", int(commentCommandInterval.Minutes()))
+	return body.String()
+}
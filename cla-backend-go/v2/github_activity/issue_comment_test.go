@@ -0,0 +1,273 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	eventsMock "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories/mock"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeStore keeps the store values in memory
+type fakeStore struct {
+	values map[string]string
+}
+
+func (s *fakeStore) SetActiveSignatureMetaData(ctx context.Context, key string, expire int64, value string) error {
+	return nil
+}
+
+func (s *fakeStore) GetActiveSignatureMetaData(ctx context.Context, UserId string) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) DeleteActiveSignatureMetaData(ctx context.Context, key string) error {
+	return nil
+}
+
+func (s *fakeStore) SetValue(ctx context.Context, key string, expire int64, value string) error {
+	s.values[key] = value
+	return nil
+}
+
+func (s *fakeStore) GetValue(ctx context.Context, key string) (string, error) {
+	return s.values[key], nil
+}
+
+func (s *fakeStore) SetValueIfNotExists(ctx context.Context, key string, expire int64, value string) (bool, error) {
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *fakeStore) SetValueIfEquals(ctx context.Context, key string, expire int64, value, expectedValue string) (bool, error) {
+	if current, ok := s.values[key]; !ok || current != expectedValue {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *fakeStore) DeleteValue(ctx context.Context, key string) error {
+	delete(s.values, key)
+	return nil
+}
+
+func loadIssueCommentEvent(t *testing.T, fixture string) *github.IssueCommentEvent {
+	payload, err := os.ReadFile(filepath.Join("testdata", fixture))
+	assert.NoError(t, err)
+	event, err := github.ParseWebHook("issue_comment", payload)
+	assert.NoError(t, err)
+	return event.(*github.IssueCommentEvent)
+}
+
+func TestProcessIssueCommentEvent_Recheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryID:         "8b3a7c52-5d1e-4f0a-9c3b-2e6f1d7a4b90",
+		RepositoryName:       "easycla-test-org/easycla-test-repo",
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+	}, nil).Times(2)
+
+	usersRepo := mock_users.NewMockUserRepository(ctrl)
+	// the comment author is an EasyCLA user who did not sign the CLA yet
+	usersRepo.EXPECT().GetUserByGitHubID("1002").Return(&models.User{UserID: "new-contributor-id", LfUsername: "newcontributor"}, nil)
+	usersRepo.EXPECT().GetUserByGitHubID("1002").Return(nil, nil)
+	usersRepo.EXPECT().GetUserByGitHubUsername("new-contributor").Return(nil, nil)
+
+	eventsService := eventsMock.NewMockService(ctrl)
+	eventsService.EXPECT().LogEventWithContext(gomock.Any(), &events.LogEventArgs{
+		EventType:  events.RepositoryPullRequestRechecked,
+		CLAGroupID: testCLAGroupID,
+		UserID:     "new-contributor-id",
+		LfUsername: "newcontributor",
+		EventData: &events.RepositoryPullRequestRecheckedEventData{
+			RepositoryName: "easycla-test-org/easycla-test-repo",
+			PullRequestID:  12,
+			RequestedBy:    "new-contributor",
+		},
+	}).Return()
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		eventService:      eventsService,
+		usersRepository:   usersRepo,
+		signatureService:  mock_signatures.NewMockSignatureService(ctrl),
+		storeRepository:   &fakeStore{values: map[string]string{}},
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessIssueCommentEvent(loadIssueCommentEvent(t, "issue_comment_created.json"))
+	assert.NoError(t, err)
+	assert.True(t, client.updated)
+	assert.Len(t, client.missing, 1)
+
+	// the second recheck within the recheck interval is ignored
+	client.updated = false
+	err = activityService.ProcessIssueCommentEvent(loadIssueCommentEvent(t, "issue_comment_created.json"))
+	assert.NoError(t, err)
+	assert.False(t, client.updated)
+}
+
+func TestProcessIssueCommentEvent_Help(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryID:         "8b3a7c52-5d1e-4f0a-9c3b-2e6f1d7a4b90",
+		RepositoryClaGroupID: testCLAGroupID,
+	}, nil).Times(2)
+
+	client := &fakePullRequestClient{}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		storeRepository:   &fakeStore{values: map[string]string{}},
+		pullRequestClient: client,
+	}
+
+	event := loadIssueCommentEvent(t, "issue_comment_created.json")
+	event.Comment.Body = aws.String("/easycla help")
+	assert.NoError(t, activityService.ProcessIssueCommentEvent(event))
+	// the second help within the command interval is ignored
+	assert.NoError(t, activityService.ProcessIssueCommentEvent(event))
+	// the /easycla command is case sensitive
+	event.Comment.Body = aws.String("/EasyCLA help")
+	assert.NoError(t, activityService.ProcessIssueCommentEvent(event))
+
+	// the comments of the bots are ignored
+	event.Comment.Body = aws.String("/easycla help")
+	event.Comment.User.Type = aws.String("Bot")
+	assert.NoError(t, activityService.ProcessIssueCommentEvent(event))
+
+	assert.False(t, client.updated)
+	if assert.Len(t, client.comments, 1) {
+		assert.Contains(t, client.comments[0], "@new-contributor - The EasyCLA commands")
+	}
+}
+
+func TestProcessIssueCommentEvent_IgnoredActions(t *testing.T) {
+	client := &fakePullRequestClient{}
+	// the comment is ignored before the repository is loaded
+	activityService := &eventHandlerService{pullRequestClient: client}
+
+	for _, action := range []string{"edited", "deleted"} {
+		t.Run(action, func(tt *testing.T) {
+			event := loadIssueCommentEvent(tt, "issue_comment_created.json")
+			event.Action = aws.String(action)
+			assert.NoError(tt, activityService.ProcessIssueCommentEvent(event))
+		})
+	}
+
+	assert.False(t, client.updated)
+	assert.Empty(t, client.comments)
+}
+
+func TestParseCommentCommand(t *testing.T) {
+	testCases := []struct {
+		body    string
+		command string
+		ok      bool
+	}{
+		{body: "/easycla recheck", command: "recheck", ok: true},
+		{body: "Signed the CLA\r\n  /easycla Recheck please", command: "recheck", ok: true},
+		{body: "/easycla", command: "recheck", ok: true},
+		{body: "/easycla help", command: "help", ok: true},
+		{body: "/easycla HELP", command: "help", ok: true},
+		{body: "/easycla rechek", command: "recheck", ok: true},
+		{body: "> /easycla recheck\r\nwhy was this rechecked?", command: "recheck", ok: true},
+		{body: "run /easycla recheck after signing", command: "recheck", ok: true},
+		{body: "/easyclarecheck"},
+		{body: "/EasyCLA recheck"},
+		{body: "see https://example.com/easycla"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.body, func(t *testing.T) {
+			command, ok := parseCommentCommand(tc.body)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.command, command)
+		})
+	}
+}
+
+func TestCommentCommandAllowed(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	assert.True(t, commentCommandAllowed("", now))
+	assert.True(t, commentCommandAllowed("not a time", now))
+	assert.False(t, commentCommandAllowed(now.Add(-time.Minute).Format(time.RFC3339), now))
+	assert.True(t, commentCommandAllowed(now.Add(-commentCommandInterval).Format(time.RFC3339), now))
+}
+
+func TestClaimCommentCommand(t *testing.T) {
+	ctx := context.Background()
+	key := "github_recheck:repository-id:12"
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		Name        string
+		LastRecheck string
+		Expected    bool
+	}{
+		{Name: "first recheck", Expected: true},
+		{Name: "rechecked during the recheck interval", LastRecheck: now.Add(-time.Minute).Format(time.RFC3339)},
+		{Name: "recheck interval elapsed", LastRecheck: now.Add(-2 * commentCommandInterval).Format(time.RFC3339), Expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			store := &fakeStore{values: map[string]string{}}
+			if tc.LastRecheck != "" {
+				store.values[key] = tc.LastRecheck
+			}
+			activityService := &eventHandlerService{storeRepository: store}
+
+			claimed, err := activityService.claimCommentCommand(ctx, logrus.Fields{}, commentCommandRecheck, "repository-id", 12)
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.Expected, claimed)
+			if tc.Expected {
+				assert.NotEqual(tt, tc.LastRecheck, store.values[key])
+			}
+
+			// a concurrent recheck is not claimed again
+			claimed, err = activityService.claimCommentCommand(ctx, logrus.Fields{}, commentCommandRecheck, "repository-id", 12)
+			assert.NoError(tt, err)
+			assert.False(tt, claimed)
+
+			// the help is limited separately from the recheck
+			claimed, err = activityService.claimCommentCommand(ctx, logrus.Fields{}, commentCommandHelp, "repository-id", 12)
+			assert.NoError(tt, err)
+			assert.True(tt, claimed)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/dco"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+// mergeGroupEventType is the GitHub webhook event of the merge queue, which the go-github release in use does not parse
+const mergeGroupEventType = "merge_group"
+
+// MergeGroupEvent is the merge_group webhook event sent when a merge queue requests the checks of a merge group
+type MergeGroupEvent struct {
+	Action       *string              `json:"action,omitempty"`
+	MergeGroup   *MergeGroup          `json:"merge_group,omitempty"`
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// MergeGroup is the temporary branch of the merge queue with the queued pull requests merged on top of the base branch
+type MergeGroup struct {
+	HeadSHA *string `json:"head_sha,omitempty"`
+	HeadRef *string `json:"head_ref,omitempty"`
+	BaseSHA *string `json:"base_sha,omitempty"`
+	BaseRef *string `json:"base_ref,omitempty"`
+}
+
+// GetAction returns the action of the event, or an empty string
+func (e *MergeGroupEvent) GetAction() string {
+	if e == nil {
+		return ""
+	}
+	return utils.StringValue(e.Action)
+}
+
+// GetBaseBranch returns the base branch name of the merge group without the refs/heads/ prefix
+func (g *MergeGroup) GetBaseBranch() string {
+	if g == nil {
+		return ""
+	}
+	return strings.TrimPrefix(utils.StringValue(g.BaseRef), "refs/heads/")
+}
+
+// parseWebHook parses the webhook payload, including the merge_group events
+func parseWebHook(githubEvent string, payload []byte) (interface{}, error) {
+	if githubEvent != mergeGroupEventType {
+		return github.ParseWebHook(githubEvent, payload)
+	}
+	event := &MergeGroupEvent{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ProcessMergeGroupEvent reports the EasyCLA result on the head commit of the merge group so the queued pull requests
+// are not held by the required EasyCLA check - every commit of the merge group is checked as in the pull requests
+func (s *eventHandlerService) ProcessMergeGroupEvent(event *MergeGroupEvent) error {
+	ctx := newEventContext(event.Sender)
+	f := logrus.Fields{
+		"functionName":   "v2.github_activity.merge_group.ProcessMergeGroupEvent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if event.Action == nil {
+		return fmt.Errorf("no action found in event payload")
+	}
+	if event.Repo == nil || event.MergeGroup == nil || event.Installation == nil {
+		return fmt.Errorf("missing repository, merge group or installation object in event payload")
+	}
+
+	f["action"] = event.GetAction()
+	f["repositoryName"] = event.Repo.GetFullName()
+	f["headSHA"] = utils.StringValue(event.MergeGroup.HeadSHA)
+	if event.GetAction() != "checks_requested" {
+		log.WithFields(f).Debugf("no handler for merge group action : %s", event.GetAction())
+		return nil
+	}
+
+	repoModel, err := s.getEnabledRepository(ctx, f, event.Repo.GetID())
+	if err != nil || repoModel == nil {
+		return err
+	}
+
+	baseBranch := event.MergeGroup.GetBaseBranch()
+	f["baseBranch"] = baseBranch
+	if !utils.IsBranchEnforced(baseBranch, repoModel.IncludeBranches, repoModel.ExcludeBranches) {
+		log.WithFields(f).Debugf("base branch: %s is not enforced for repository: %s - skipping CLA check", baseBranch, repoModel.RepositoryName)
+		return nil
+	}
+
+	installationID := event.Installation.GetID()
+	owner := event.Repo.GetOwner().GetLogin()
+	repoName := event.Repo.GetName()
+	headSHA := utils.StringValue(event.MergeGroup.HeadSHA)
+	log.WithFields(f).Debug("loading merge group commit authors...")
+	authors, files, err := s.pullRequestClient.GetMergeGroupCommits(ctx, installationID, owner, repoName, utils.StringValue(event.MergeGroup.BaseSHA), headSHA)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load merge group commits")
+		return err
+	}
+	if len(authors) == 0 {
+		log.WithFields(f).Debug("every commit of the merge group was created by the merge queue - no commit authors to check")
+	}
+
+	if s.getEnforcementMode(ctx, f, repoModel) == utils.EnforcementModeDCO {
+		commits := make([]dco.Commit, 0, len(authors))
+		for _, summary := range authors {
+			if summary.CoAuthor {
+				continue
+			}
+			commits = append(commits, summary.DCOCommit())
+		}
+		passed, failed := dco.CheckCommits(commits)
+		log.WithFields(f).Debugf("DCO check - %d commits passed, %d commits failed", len(passed), len(failed))
+		return s.pullRequestClient.UpdateMergeGroupDCO(ctx, installationID, owner, repoName, headSHA, passed, failed)
+	}
+
+	// the trivial change rules apply to the changes of the whole merge group - the merge group has no pull request, so
+	// the passing result has no pull request to re-run
+	if len(repoModel.TrivialChangeRules) > 0 {
+		if description, trivial := matchTrivialChange(f, repoModel, files); trivial {
+			return s.pullRequestClient.UpdateMergeGroupTrivialChange(ctx, installationID, owner, repoName, headSHA, repoModel.CheckRunEnabled, description)
+		}
+	}
+
+	if s.exemptionsService != nil {
+		s.exemptionsService.ExemptGitHubAuthors(ctx, repoModel.RepositoryClaGroupID, repoModel.RepositoryID, repoModel.RepositoryName, 0, authors)
+	}
+	claGroups, perCLAGroup := s.requiredCLAGroups(ctx, f, repoModel, baseBranch, files, nil)
+	signed, missing := s.triageCommitAuthors(ctx, f, claGroups, perCLAGroup, authors)
+	if v1Github.HasCoAuthors(authors) {
+		signed, missing = v1Github.ApplyCoAuthorPolicy(s.getCoAuthorPolicy(ctx, f, repoModel.RepositoryClaGroupID), signed, missing)
+	}
+	log.WithFields(f).Debugf("CLA check - %d commit authors signed, %d commit authors missing", len(signed), len(missing))
+
+	return s.pullRequestClient.UpdateMergeGroup(ctx, installationID, owner, repoName, headSHA, repoModel.CheckRunEnabled, signed, missing, s.claLandingPage)
+}
@@ -0,0 +1,155 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories/mock"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadMergeGroupEvent(t *testing.T, fixture string) *MergeGroupEvent {
+	payload, err := os.ReadFile(filepath.Join("testdata", fixture))
+	assert.NoError(t, err)
+	event, err := parseWebHook(mergeGroupEventType, payload)
+	assert.NoError(t, err)
+	return event.(*MergeGroupEvent)
+}
+
+func TestProcessMergeGroupEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+		CheckRunEnabled:      true,
+	}, nil)
+
+	usersRepo := mock_users.NewMockUserRepository(ctrl)
+	usersRepo.EXPECT().GetUserByGitHubID("1002").Return(nil, nil)
+	usersRepo.EXPECT().GetUserByGitHubUsername("new-contributor").Return(nil, nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		usersRepository:   usersRepo,
+		signatureService:  mock_signatures.NewMockSignatureService(ctrl),
+		pullRequestClient: client,
+	}
+
+	event := loadMergeGroupEvent(t, "merge_group_checks_requested.json")
+	assert.Equal(t, "main", event.MergeGroup.GetBaseBranch())
+	err := activityService.ProcessMergeGroupEvent(event)
+	assert.NoError(t, err)
+	assert.True(t, client.mergeGroupUpdated)
+	assert.True(t, client.mergeGroupCheckRun)
+	assert.False(t, client.updated)
+	assert.Len(t, client.missing, 1)
+}
+
+func TestProcessMergeGroupEvent_DCO(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeDCO,
+	}, nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessMergeGroupEvent(loadMergeGroupEvent(t, "merge_group_checks_requested.json"))
+	assert.NoError(t, err)
+	assert.True(t, client.mergeGroupUpdated)
+	if assert.Len(t, client.mergeGroupDCOFailed, 1) {
+		assert.Equal(t, "2222222", client.mergeGroupDCOFailed[0].Commit.SHA)
+	}
+}
+
+func TestProcessMergeGroupEvent_MergeQueueCommits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+	}, nil)
+
+	// every commit of the merge group was created by the merge queue, so there are no commit authors to check
+	client := &fakePullRequestClient{}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessMergeGroupEvent(loadMergeGroupEvent(t, "merge_group_checks_requested.json"))
+	assert.NoError(t, err)
+	assert.True(t, client.mergeGroupUpdated)
+	assert.Empty(t, client.signed)
+	assert.Empty(t, client.missing)
+}
+
+func TestProcessMergeGroupEvent_TrivialChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+		CheckRunEnabled:      true,
+		TrivialChangeRules:   []*models.TrivialChangeRule{{Paths: []string{"docs/**", "*.md"}}},
+	}, nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+		files: []trivialchange.File{{Path: "README.md", Additions: 3}},
+	}
+	// the commit authors of a trivial merge group are not checked, so no signature service is needed
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessMergeGroupEvent(loadMergeGroupEvent(t, "merge_group_checks_requested.json"))
+	assert.NoError(t, err)
+	// the result is published on the merge group, not on a pull request
+	assert.True(t, client.mergeGroupTrivialUpdated)
+	assert.True(t, client.mergeGroupCheckRun)
+	assert.Equal(t, "EasyCLA check skipped - trivial change in docs/**, *.md", client.mergeGroupTrivialDescription)
+	assert.False(t, client.trivialUpdated)
+	assert.False(t, client.mergeGroupUpdated)
+}
@@ -0,0 +1,443 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
+	"github.com/communitybridge/easycla/cla-backend-go/dco"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+)
+
+// pullRequestClient is the GitHub API used by the pull request checks
+type pullRequestClient interface {
+	GetPullRequestCommitAuthors(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]*v1Github.UserCommitSummary, *string, error)
+	UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claBaseAPIURL, claLandingPage, claLogoURL string) error
+	UpdatePullRequestDCO(ctx context.Context, installationID int64, pullRequestID int, owner, repo, baseBranch, latestSHA string, passed, failed []dco.Result) error
+	UpdatePullRequestCheckRun(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claBaseAPIURL, claLandingPage, claLogoURL string) error
+	CreateSignRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, author *v1Github.UserCommitSummary, claBaseAPIURL string) error
+	GetPullRequestFiles(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]trivialchange.File, error)
+	UpdatePullRequestTrivialChange(ctx context.Context, installationID int64, pullRequestID int, owner, repo, latestSHA string, checkRun bool, description string) error
+	GetPullRequestBaseBranch(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) (string, error)
+	CreatePullRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo, body string) error
+	GetMergeGroupCommits(ctx context.Context, installationID int64, owner, repo, baseSHA, headSHA string) ([]*v1Github.UserCommitSummary, []trivialchange.File, error)
+	UpdateMergeGroup(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claLandingPage string) error
+	UpdateMergeGroupDCO(ctx context.Context, installationID int64, owner, repo, headSHA string, passed, failed []dco.Result) error
+	UpdateMergeGroupTrivialChange(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, description string) error
+}
+
+// gitHubPullRequestClient calls the GitHub API using the GitHub App installation
+type gitHubPullRequestClient struct{}
+
+func (gitHubPullRequestClient) GetPullRequestCommitAuthors(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]*v1Github.UserCommitSummary, *string, error) {
+	return v1Github.GetPullRequestCommitAuthors(ctx, installationID, pullRequestID, owner, repo)
+}
+
+func (gitHubPullRequestClient) UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claBaseAPIURL, claLandingPage, claLogoURL string) error {
+	return v1Github.UpdatePullRequest(ctx, installationID, pullRequestID, owner, repo, repoID, latestSHA, signed, missing, claBaseAPIURL, claLandingPage, claLogoURL)
+}
+
+func (gitHubPullRequestClient) UpdatePullRequestDCO(ctx context.Context, installationID int64, pullRequestID int, owner, repo, baseBranch, latestSHA string, passed, failed []dco.Result) error {
+	return v1Github.UpdatePullRequestDCO(ctx, installationID, pullRequestID, owner, repo, baseBranch, latestSHA, passed, failed)
+}
+
+func (gitHubPullRequestClient) UpdatePullRequestCheckRun(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claBaseAPIURL, claLandingPage, claLogoURL string) error {
+	return v1Github.UpdatePullRequestCheckRun(ctx, installationID, pullRequestID, owner, repo, repoID, latestSHA, signed, missing, claBaseAPIURL, claLandingPage, claLogoURL)
+}
+
+func (gitHubPullRequestClient) CreateSignRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, author *v1Github.UserCommitSummary, claBaseAPIURL string) error {
+	return v1Github.CreateSignRequestComment(ctx, installationID, pullRequestID, owner, repo, repoID, author, claBaseAPIURL)
+}
+
+func (gitHubPullRequestClient) GetPullRequestFiles(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]trivialchange.File, error) {
+	return v1Github.GetPullRequestFiles(ctx, installationID, pullRequestID, owner, repo)
+}
+
+func (gitHubPullRequestClient) UpdatePullRequestTrivialChange(ctx context.Context, installationID int64, pullRequestID int, owner, repo, latestSHA string, checkRun bool, description string) error {
+	return v1Github.UpdatePullRequestTrivialChange(ctx, installationID, pullRequestID, owner, repo, latestSHA, checkRun, description)
+}
+
+func (gitHubPullRequestClient) GetPullRequestBaseBranch(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) (string, error) {
+	client, err := v1Github.NewGithubAppClient(ctx, installationID)
+	if err != nil {
+		return "", err
+	}
+	pullRequest, err := v1Github.GetPullRequest(ctx, pullRequestID, owner, repo, client)
+	if err != nil {
+		return "", err
+	}
+	return pullRequest.GetBase().GetRef(), nil
+}
+
+func (gitHubPullRequestClient) CreatePullRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo, body string) error {
+	return v1Github.CreatePullRequestComment(ctx, installationID, pullRequestID, owner, repo, body)
+}
+
+func (gitHubPullRequestClient) GetMergeGroupCommits(ctx context.Context, installationID int64, owner, repo, baseSHA, headSHA string) ([]*v1Github.UserCommitSummary, []trivialchange.File, error) {
+	return v1Github.GetMergeGroupCommits(ctx, installationID, owner, repo, baseSHA, headSHA)
+}
+
+func (gitHubPullRequestClient) UpdateMergeGroup(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claLandingPage string) error {
+	return v1Github.UpdateMergeGroup(ctx, installationID, owner, repo, headSHA, checkRun, signed, missing, claLandingPage)
+}
+
+func (gitHubPullRequestClient) UpdateMergeGroupDCO(ctx context.Context, installationID int64, owner, repo, headSHA string, passed, failed []dco.Result) error {
+	return v1Github.UpdateMergeGroupDCO(ctx, installationID, owner, repo, headSHA, passed, failed)
+}
+
+func (gitHubPullRequestClient) UpdateMergeGroupTrivialChange(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, description string) error {
+	return v1Github.UpdateMergeGroupTrivialChange(ctx, installationID, owner, repo, headSHA, checkRun, description)
+}
+
+// ProcessPullRequestEvent checks the pull request commit authors - the contributors must be covered by a signed CLA,
+// or for repositories in the DCO enforcement mode each commit must be signed off - and updates the pull request
+// status and comment
+func (s *eventHandlerService) ProcessPullRequestEvent(event *github.PullRequestEvent) error {
+	ctx := newEventContext(event.Sender)
+	f := logrus.Fields{
+		"functionName":   "v2.github_activity.pull_request.ProcessPullRequestEvent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if event.Action == nil {
+		return fmt.Errorf("no action found in event payload")
+	}
+	if event.Repo == nil || event.PullRequest == nil || event.Installation == nil {
+		return fmt.Errorf("missing repository, pull request or installation object in event payload")
+	}
+
+	f["action"] = event.GetAction()
+	f["repositoryName"] = event.Repo.GetFullName()
+	f["pullRequestID"] = event.GetNumber()
+	switch event.GetAction() {
+	case "opened", "reopened", "synchronize", "enqueued":
+	default:
+		log.WithFields(f).Debugf("no handler for pull request action : %s", event.GetAction())
+		return nil
+	}
+
+	repoModel, err := s.getEnabledRepository(ctx, f, event.Repo.GetID())
+	if err != nil || repoModel == nil {
+		return err
+	}
+
+	f["baseBranch"] = event.PullRequest.GetBase().GetRef()
+	if !utils.IsBranchEnforced(event.PullRequest.GetBase().GetRef(), repoModel.IncludeBranches, repoModel.ExcludeBranches) {
+		log.WithFields(f).Debugf("base branch: %s is not enforced for repository: %s - skipping CLA check",
+			event.PullRequest.GetBase().GetRef(), repoModel.RepositoryName)
+		return nil
+	}
+
+	return s.checkPullRequest(ctx, f, repoModel, event.Installation.GetID(), event.Repo.GetOwner().GetLogin(), event.Repo.GetName(), event.Repo.ID, event.GetNumber(), event.PullRequest.GetBase().GetRef())
+}
+
+// RefreshPullRequest re-runs the CLA check of the pull request outside a webhook event, e.g. once a contributor signed
+// the CLA requested from the pull request
+func (s *eventHandlerService) RefreshPullRequest(ctx context.Context, installationID, repositoryID int64, pullRequestID int) error {
+	f := logrus.Fields{
+		"functionName":   "v2.github_activity.pull_request.RefreshPullRequest",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"installationID": installationID,
+		"repositoryID":   repositoryID,
+		"pullRequestID":  pullRequestID,
+	}
+
+	repoModel, err := s.getEnabledRepository(ctx, f, repositoryID)
+	if err != nil || repoModel == nil {
+		return err
+	}
+	// the repository name is the full name of the github repository
+	owner, repoName, ok := strings.Cut(repoModel.RepositoryName, "/")
+	if !ok {
+		return fmt.Errorf("unable to determine the owner of the repository: %s", repoModel.RepositoryName)
+	}
+
+	baseBranch, err := s.pullRequestClient.GetPullRequestBaseBranch(ctx, installationID, pullRequestID, owner, repoName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load the pull request base branch")
+		return err
+	}
+	f["baseBranch"] = baseBranch
+	if !utils.IsBranchEnforced(baseBranch, repoModel.IncludeBranches, repoModel.ExcludeBranches) {
+		log.WithFields(f).Debugf("base branch: %s is not enforced for repository: %s - skipping CLA check", baseBranch, repoModel.RepositoryName)
+		return nil
+	}
+
+	return s.checkPullRequest(ctx, f, repoModel, installationID, owner, repoName, &repositoryID, pullRequestID, baseBranch)
+}
+
+// getEnabledRepository returns the EasyCLA repository of the GitHub repository, or nil when it is not enabled in EasyCLA
+func (s *eventHandlerService) getEnabledRepository(ctx context.Context, f logrus.Fields, githubRepositoryID int64) (*models.GithubRepository, error) {
+	repoModel, err := s.gitV1Repository.GitHubGetRepositoryByGithubID(ctx, strconv.FormatInt(githubRepositoryID, 10), true)
+	if err != nil {
+		var notFound *utils.GitHubRepositoryNotFound
+		if errors.As(err, &notFound) {
+			log.WithFields(f).Debug("repository is not enabled in EasyCLA - ignoring event")
+			return nil, nil
+		}
+		return nil, err
+	}
+	f["claGroupID"] = repoModel.RepositoryClaGroupID
+	f["enforcementMode"] = repoModel.EnforcementMode
+	f["checkRunEnabled"] = repoModel.CheckRunEnabled
+	return repoModel, nil
+}
+
+// checkPullRequest evaluates the pull request commits and publishes the result as a DCO status, a CLA check run or a
+// CLA status depending on the repository settings
+func (s *eventHandlerService) checkPullRequest(ctx context.Context, f logrus.Fields, repoModel *models.GithubRepository, installationID int64, owner, repoName string, repoID *int64, pullRequestID int, baseBranch string) error {
+	log.WithFields(f).Debug("loading pull request commit authors...")
+	authors, latestSHA, err := s.pullRequestClient.GetPullRequestCommitAuthors(ctx, installationID, pullRequestID, owner, repoName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load pull request commit authors")
+		return err
+	}
+
+	if s.getEnforcementMode(ctx, f, repoModel) == utils.EnforcementModeDCO {
+		commits := make([]dco.Commit, 0, len(authors))
+		for _, summary := range authors {
+			// the sign-off is checked once per commit, on the commit author
+			if summary.CoAuthor {
+				continue
+			}
+			commits = append(commits, summary.DCOCommit())
+		}
+		passed, failed := dco.CheckCommits(commits)
+		log.WithFields(f).Debugf("DCO check - %d commits passed, %d commits failed", len(passed), len(failed))
+		return s.pullRequestClient.UpdatePullRequestDCO(ctx, installationID, pullRequestID, owner, repoName, baseBranch, utils.StringValue(latestSHA), passed, failed)
+	}
+
+	var files []trivialchange.File
+	var filesErr error
+	if len(repoModel.TrivialChangeRules) > 0 || len(repoModel.ClaGroupBindings) > 0 {
+		files, filesErr = s.pullRequestClient.GetPullRequestFiles(ctx, installationID, pullRequestID, owner, repoName)
+		if filesErr != nil {
+			log.WithFields(f).WithError(filesErr).Warn("unable to load pull request files")
+		}
+	}
+
+	// fall back to the CLA check when the files cannot be loaded
+	if len(repoModel.TrivialChangeRules) > 0 && filesErr == nil {
+		trivial, trivialErr := s.checkTrivialChange(ctx, f, repoModel, installationID, owner, repoName, pullRequestID, utils.StringValue(latestSHA), files)
+		if trivialErr != nil || trivial {
+			return trivialErr
+		}
+	}
+
+	if s.exemptionsService != nil {
+		s.exemptionsService.ExemptGitHubAuthors(ctx, repoModel.RepositoryClaGroupID, repoModel.RepositoryID, repoModel.RepositoryName, pullRequestID, authors)
+	}
+	claGroups, perCLAGroup := s.requiredCLAGroups(ctx, f, repoModel, baseBranch, files, filesErr)
+	signed, missing := s.triageCommitAuthors(ctx, f, claGroups, perCLAGroup, authors)
+	if v1Github.HasCoAuthors(authors) {
+		signed, missing = v1Github.ApplyCoAuthorPolicy(s.getCoAuthorPolicy(ctx, f, repoModel.RepositoryClaGroupID), signed, missing)
+	}
+	log.WithFields(f).Debugf("CLA check - %d commit authors signed, %d commit authors missing", len(signed), len(missing))
+
+	if repoModel.CheckRunEnabled {
+		return s.pullRequestClient.UpdatePullRequestCheckRun(ctx, installationID, pullRequestID, owner, repoName, repoID, utils.StringValue(latestSHA), signed, missing, s.claV1ApiURL, s.claLandingPage, s.claLogoURL)
+	}
+
+	return s.pullRequestClient.UpdatePullRequest(ctx, installationID, pullRequestID, owner, repoName, repoID, utils.StringValue(latestSHA), signed, missing, s.claV1ApiURL, s.claLandingPage, s.claLogoURL)
+}
+
+// checkTrivialChange evaluates the repository trivial change rules against the pull request files - when a rule applies
+// the EasyCLA check passes with the rule description and the CLA coverage of the commit authors is not checked
+func (s *eventHandlerService) checkTrivialChange(ctx context.Context, f logrus.Fields, repoModel *models.GithubRepository, installationID int64, owner, repoName string, pullRequestID int, latestSHA string, files []trivialchange.File) (bool, error) {
+	description, trivial := matchTrivialChange(f, repoModel, files)
+	if !trivial {
+		return false, nil
+	}
+	return true, s.pullRequestClient.UpdatePullRequestTrivialChange(ctx, installationID, pullRequestID, owner, repoName, latestSHA, repoModel.CheckRunEnabled, description)
+}
+
+// matchTrivialChange returns the status description of the repository trivial change rule which applies to the changed
+// files, if any
+func matchTrivialChange(f logrus.Fields, repoModel *models.GithubRepository, files []trivialchange.File) (string, bool) {
+	rule := trivialchange.Match(trivialchange.FromModels(repoModel.TrivialChangeRules), files)
+	if rule == nil {
+		log.WithFields(f).Debugf("no trivial change rule applies to the %d changed files", len(files))
+		return "", false
+	}
+
+	description := trivialchange.StatusDescription(rule, trivialchange.ChangedLines(files))
+	log.WithFields(f).Infof("trivial change rule applies to the %d changed files - %s", len(files), description)
+	return description, true
+}
+
+// requiredCLAGroups returns the CLA groups which must cover the commit authors - the CLA groups bound to the changed paths
+// and the base branch of the pull request, or only the repository CLA group - and whether the missing CLA groups are
+// reported per commit author because the pull request is not only covered by the repository CLA group
+func (s *eventHandlerService) requiredCLAGroups(ctx context.Context, f logrus.Fields, repoModel *models.GithubRepository, baseBranch string, files []trivialchange.File, filesErr error) ([]clagroupbinding.CLAGroup, bool) {
+	defaultCLAGroup := []clagroupbinding.CLAGroup{{ID: repoModel.RepositoryClaGroupID}}
+	if len(repoModel.ClaGroupBindings) == 0 {
+		return defaultCLAGroup, false
+	}
+
+	bindings := clagroupbinding.FromModels(repoModel.ClaGroupBindings)
+	var claGroupIDs []string
+	if filesErr != nil {
+		// without the changed files every CLA group bound to the base branch is required
+		claGroupIDs = clagroupbinding.SelectForBranch(bindings, repoModel.RepositoryClaGroupID, baseBranch)
+	} else {
+		claGroupIDs = clagroupbinding.Select(bindings, repoModel.RepositoryClaGroupID, baseBranch, files)
+	}
+	log.WithFields(f).Debugf("the pull request into the base branch: %s requires the CLA groups: %s", baseBranch, strings.Join(claGroupIDs, ", "))
+	if clagroupbinding.IsDefault(claGroupIDs, repoModel.RepositoryClaGroupID) {
+		return defaultCLAGroup, false
+	}
+
+	claGroups := make([]clagroupbinding.CLAGroup, 0, len(claGroupIDs))
+	for _, claGroupID := range claGroupIDs {
+		claGroup := clagroupbinding.CLAGroup{ID: claGroupID}
+		claGroupModel, err := s.claGroupRepository.GetCLAGroupByID(ctx, claGroupID, repository.DontLoadRepoDetails)
+		if err != nil || claGroupModel == nil {
+			log.WithFields(f).WithError(err).Warnf("unable to load the CLA group: %s - using the ID as the name", claGroupID)
+		} else {
+			claGroup.Name = claGroupModel.ProjectName
+		}
+		claGroups = append(claGroups, claGroup)
+	}
+	return claGroups, true
+}
+
+// triageCommitAuthors splits the commit authors into the authors covered by an ICLA, a CCLA employee acknowledgement
+// or a CCLA approval list of every CLA group and the authors which are missing - with perCLAGroup set the CLA groups
+// which do not cover an author are recorded on the author summary
+func (s *eventHandlerService) triageCommitAuthors(ctx context.Context, f logrus.Fields, claGroups []clagroupbinding.CLAGroup, perCLAGroup bool, authors []*v1Github.UserCommitSummary) ([]*v1Github.UserCommitSummary, []*v1Github.UserCommitSummary) {
+	signed := make([]*v1Github.UserCommitSummary, 0)
+	missing := make([]*v1Github.UserCommitSummary, 0)
+
+	for _, userSummary := range authors {
+		if userSummary.Exempt {
+			log.WithFields(f).Debugf("commit author is exempt - sha: %s, username: %s", userSummary.SHA, userSummary.GetCommitAuthorUsername())
+			signed = append(signed, userSummary)
+			continue
+		}
+
+		if !userSummary.IsValid() {
+			log.WithFields(f).Debugf("invalid user summary for commit: %s", userSummary.SHA)
+			missing = append(missing, userSummary)
+			continue
+		}
+
+		user := s.findUserForCommitAuthor(f, userSummary)
+		if user == nil {
+			log.WithFields(f).Debugf("unable to find user for commit author - sha: %s, user ID: %s, username: %s, email: %s",
+				userSummary.SHA, userSummary.GetCommitAuthorID(), userSummary.GetCommitAuthorUsername(), userSummary.GetCommitAuthorEmail())
+			if perCLAGroup {
+				userSummary.MissingCLAGroups = claGroups
+			}
+			missing = append(missing, userSummary)
+			continue
+		}
+
+		var missingCLAGroups []clagroupbinding.CLAGroup
+		for _, claGroup := range claGroups {
+			userSigned, companyAffiliation, signedErr := s.signatureService.HasUserSigned(ctx, user, claGroup.ID)
+			if signedErr != nil {
+				log.WithFields(f).WithError(signedErr).Warnf("has user signed error - user: %s, CLA group: %s", user.UserID, claGroup.ID)
+				missingCLAGroups = append(missingCLAGroups, claGroup)
+				continue
+			}
+
+			if companyAffiliation != nil && *companyAffiliation {
+				userSummary.Affiliated = true
+			}
+			if userSigned == nil || !*userSigned {
+				missingCLAGroups = append(missingCLAGroups, claGroup)
+			}
+		}
+		userSummary.Authorized = len(missingCLAGroups) == 0
+		if perCLAGroup {
+			userSummary.MissingCLAGroups = missingCLAGroups
+		}
+
+		if userSummary.Authorized {
+			signed = append(signed, userSummary)
+		} else {
+			missing = append(missing, userSummary)
+		}
+	}
+
+	return signed, missing
+}
+
+// getEnforcementMode returns the enforcement mode of the repository, the repositories without an enforcement mode use
+// the enforcement mode of their CLA group and are CLA enforced when the CLA group cannot be loaded
+func (s *eventHandlerService) getEnforcementMode(ctx context.Context, f logrus.Fields, repoModel *models.GithubRepository) string {
+	if repoModel.EnforcementMode != "" {
+		return repoModel.EnforcementMode
+	}
+	claGroup, err := s.claGroupRepository.GetCLAGroupByID(ctx, repoModel.RepositoryClaGroupID, repository.DontLoadRepoDetails)
+	if err != nil || claGroup == nil {
+		log.WithFields(f).WithError(err).Warnf("unable to load the CLA group: %s - enforcing the CLA", repoModel.RepositoryClaGroupID)
+		return utils.EnforcementModeCLA
+	}
+	if claGroup.ProjectEnforcementMode == "" {
+		return utils.EnforcementModeCLA
+	}
+	return claGroup.ProjectEnforcementMode
+}
+
+// getCoAuthorPolicy returns the co-author policy of the CLA group, the co-authors are only reported when the policy
+// is not set or the CLA group cannot be loaded
+func (s *eventHandlerService) getCoAuthorPolicy(ctx context.Context, f logrus.Fields, claGroupID string) string {
+	claGroup, err := s.claGroupRepository.GetCLAGroupByID(ctx, claGroupID, repository.DontLoadRepoDetails)
+	if err != nil || claGroup == nil {
+		log.WithFields(f).WithError(err).Warnf("unable to load the CLA group: %s - reporting the co-authors only", claGroupID)
+		return utils.CoAuthorPolicyReport
+	}
+	if claGroup.ProjectCoAuthorPolicy == "" {
+		return utils.CoAuthorPolicyReport
+	}
+	return claGroup.ProjectCoAuthorPolicy
+}
+
+// findUserForCommitAuthor looks up the EasyCLA user by the GitHub ID, then the GitHub username and then the email
+func (s *eventHandlerService) findUserForCommitAuthor(f logrus.Fields, userSummary *v1Github.UserCommitSummary) *models.User {
+	if commitAuthorID := userSummary.GetCommitAuthorID(); commitAuthorID != "" {
+		user, err := s.usersRepository.GetUserByGitHubID(commitAuthorID)
+		if err != nil {
+			log.WithFields(f).WithError(err).Debugf("unable to get user by github id: %s", commitAuthorID)
+		}
+		if user != nil {
+			return user
+		}
+	}
+
+	if commitAuthorUsername := userSummary.GetCommitAuthorUsername(); commitAuthorUsername != "" {
+		user, err := s.usersRepository.GetUserByGitHubUsername(commitAuthorUsername)
+		if err != nil {
+			log.WithFields(f).WithError(err).Debugf("unable to get user by github username: %s", commitAuthorUsername)
+		}
+		if user != nil {
+			return user
+		}
+	}
+
+	if commitAuthorEmail := userSummary.GetCommitAuthorEmail(); commitAuthorEmail != "" {
+		user, err := s.usersRepository.GetUserByEmail(commitAuthorEmail)
+		if err != nil {
+			log.WithFields(f).WithError(err).Debugf("unable to get user by email: %s", commitAuthorEmail)
+		}
+		if user != nil {
+			return user
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,805 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package github_activity
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
+	"github.com/communitybridge/easycla/cla-backend-go/dco"
+	mock_exemptions "github.com/communitybridge/easycla/cla-backend-go/exemptions/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
+	mock_project "github.com/communitybridge/easycla/cla-backend-go/project/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/repositories/mock"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v37/github"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testInstallationID = int64(30012345)
+	testRepositoryID   = "510012345"
+	testCLAGroupID     = "d5412f8c-e5ba-4d7e-a5e1-6d8b6b4e1b0a"
+	testLatestSHA      = "b7a1e0f3c2d4e5f60718293a4b5c6d7e8f901234"
+)
+
+// fakePullRequestClient records the pull request updates instead of calling the GitHub API
+type fakePullRequestClient struct {
+	authors []*v1Github.UserCommitSummary
+
+	updated bool
+	signed  []*v1Github.UserCommitSummary
+	missing []*v1Github.UserCommitSummary
+
+	dcoUpdated    bool
+	dcoBaseBranch string
+	dcoPassed     []dco.Result
+	dcoFailed     []dco.Result
+
+	checkRunUpdated bool
+
+	signRequested *v1Github.UserCommitSummary
+
+	files    []trivialchange.File
+	filesErr error
+
+	trivialUpdated     bool
+	trivialCheckRun    bool
+	trivialDescription string
+
+	comments []string
+
+	mergeGroupUpdated   bool
+	mergeGroupCheckRun  bool
+	mergeGroupDCOFailed []dco.Result
+
+	mergeGroupTrivialUpdated     bool
+	mergeGroupTrivialDescription string
+}
+
+func (c *fakePullRequestClient) GetPullRequestCommitAuthors(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]*v1Github.UserCommitSummary, *string, error) {
+	if installationID != testInstallationID || pullRequestID != 12 || owner != "easycla-test-org" || repo != "easycla-test-repo" {
+		return nil, nil, os.ErrNotExist
+	}
+	return c.authors, aws.String(testLatestSHA), nil
+}
+
+func (c *fakePullRequestClient) UpdatePullRequest(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claBaseAPIURL, claLandingPage, claLogoURL string) error {
+	c.updated = true
+	c.signed = signed
+	c.missing = missing
+	return nil
+}
+
+func (c *fakePullRequestClient) UpdatePullRequestDCO(ctx context.Context, installationID int64, pullRequestID int, owner, repo, baseBranch, latestSHA string, passed, failed []dco.Result) error {
+	c.dcoUpdated = true
+	c.dcoBaseBranch = baseBranch
+	c.dcoPassed = passed
+	c.dcoFailed = failed
+	return nil
+}
+
+func (c *fakePullRequestClient) UpdatePullRequestCheckRun(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, latestSHA string, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claBaseAPIURL, claLandingPage, claLogoURL string) error {
+	c.checkRunUpdated = true
+	c.signed = signed
+	c.missing = missing
+	return nil
+}
+
+func (c *fakePullRequestClient) CreateSignRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo string, repoID *int64, author *v1Github.UserCommitSummary, claBaseAPIURL string) error {
+	c.signRequested = author
+	return nil
+}
+
+func (c *fakePullRequestClient) GetPullRequestFiles(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) ([]trivialchange.File, error) {
+	return c.files, c.filesErr
+}
+
+func (c *fakePullRequestClient) UpdatePullRequestTrivialChange(ctx context.Context, installationID int64, pullRequestID int, owner, repo, latestSHA string, checkRun bool, description string) error {
+	c.trivialUpdated = true
+	c.trivialCheckRun = checkRun
+	c.trivialDescription = description
+	return nil
+}
+
+func (c *fakePullRequestClient) GetPullRequestBaseBranch(ctx context.Context, installationID int64, pullRequestID int, owner, repo string) (string, error) {
+	return "main", nil
+}
+
+func (c *fakePullRequestClient) GetMergeGroupCommits(ctx context.Context, installationID int64, owner, repo, baseSHA, headSHA string) ([]*v1Github.UserCommitSummary, []trivialchange.File, error) {
+	if installationID != testInstallationID || headSHA != testLatestSHA || owner != "easycla-test-org" || repo != "easycla-test-repo" {
+		return nil, nil, os.ErrNotExist
+	}
+	return c.authors, c.files, nil
+}
+
+func (c *fakePullRequestClient) UpdateMergeGroup(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, signed []*v1Github.UserCommitSummary, missing []*v1Github.UserCommitSummary, claLandingPage string) error {
+	c.mergeGroupUpdated = true
+	c.mergeGroupCheckRun = checkRun
+	c.signed = signed
+	c.missing = missing
+	return nil
+}
+
+func (c *fakePullRequestClient) UpdateMergeGroupDCO(ctx context.Context, installationID int64, owner, repo, headSHA string, passed, failed []dco.Result) error {
+	c.mergeGroupUpdated = true
+	c.mergeGroupDCOFailed = failed
+	return nil
+}
+
+func (c *fakePullRequestClient) UpdateMergeGroupTrivialChange(ctx context.Context, installationID int64, owner, repo, headSHA string, checkRun bool, description string) error {
+	c.mergeGroupTrivialUpdated = true
+	c.mergeGroupCheckRun = checkRun
+	c.mergeGroupTrivialDescription = description
+	return nil
+}
+
+func (c *fakePullRequestClient) CreatePullRequestComment(ctx context.Context, installationID int64, pullRequestID int, owner, repo, body string) error {
+	c.comments = append(c.comments, body)
+	return nil
+}
+
+func loadPullRequestEvent(t *testing.T, fixture string) *github.PullRequestEvent {
+	payload, err := os.ReadFile(filepath.Join("testdata", fixture))
+	assert.NoError(t, err)
+	event, err := github.ParseWebHook("pull_request", payload)
+	assert.NoError(t, err)
+	return event.(*github.PullRequestEvent)
+}
+
+func commitSummary(sha string, id int64, login, message string) *v1Github.UserCommitSummary {
+	return &v1Github.UserCommitSummary{
+		SHA: sha,
+		CommitAuthor: &github.User{
+			ID:    aws.Int64(id),
+			Login: aws.String(login),
+		},
+		Message:        message,
+		GitAuthorName:  login,
+		GitAuthorEmail: login + "@example.org",
+	}
+}
+
+func TestProcessPullRequestEvent_CLA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+	}, nil)
+
+	signedUser := &models.User{UserID: "signed-user-id"}
+	usersRepo := mock_users.NewMockUserRepository(ctrl)
+	usersRepo.EXPECT().GetUserByGitHubID("1001").Return(signedUser, nil)
+	usersRepo.EXPECT().GetUserByGitHubID("1002").Return(nil, nil)
+	usersRepo.EXPECT().GetUserByGitHubUsername("new-contributor").Return(nil, nil)
+
+	signatureService := mock_signatures.NewMockSignatureService(ctrl)
+	signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, testCLAGroupID).Return(aws.Bool(true), aws.Bool(false), nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("1111111", 1001, "octo-contributor", "Update the README"),
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		usersRepository:   usersRepo,
+		signatureService:  signatureService,
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+	assert.NoError(t, err)
+	assert.True(t, client.updated)
+	assert.False(t, client.dcoUpdated)
+	if assert.Len(t, client.signed, 1) && assert.Len(t, client.missing, 1) {
+		assert.Equal(t, "1111111", client.signed[0].SHA)
+		assert.True(t, client.signed[0].Authorized)
+		assert.Equal(t, "2222222", client.missing[0].SHA)
+		assert.False(t, client.missing[0].Authorized)
+	}
+}
+
+func TestProcessPullRequestEvent_DCO(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeDCO,
+	}, nil)
+
+	// no user or signature lookups are expected in the DCO mode
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("1111111", 1001, "octo-contributor", "Update the README\n\nSigned-off-by: octo-contributor <octo-contributor@example.org>"),
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		usersRepository:   mock_users.NewMockUserRepository(ctrl),
+		signatureService:  mock_signatures.NewMockSignatureService(ctrl),
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+	assert.NoError(t, err)
+	assert.False(t, client.updated)
+	assert.True(t, client.dcoUpdated)
+	// the remediation of the comment rebases the commits onto the base branch of the pull request
+	assert.Equal(t, "main", client.dcoBaseBranch)
+	if assert.Len(t, client.dcoPassed, 1) && assert.Len(t, client.dcoFailed, 1) {
+		assert.Equal(t, "2222222", client.dcoFailed[0].Commit.SHA)
+	}
+}
+
+func TestProcessPullRequestEvent_CLAGroupDCO(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the repository has no enforcement mode of its own, the DCO mode of the CLA group applies
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryClaGroupID: testCLAGroupID,
+	}, nil)
+	claGroupRepo := mock_project.NewMockProjectRepository(ctrl)
+	claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), testCLAGroupID, false).Return(&models.ClaGroup{ProjectID: testCLAGroupID, ProjectEnforcementMode: utils.EnforcementModeDCO}, nil)
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:    githubRepo,
+		usersRepository:    mock_users.NewMockUserRepository(ctrl),
+		signatureService:   mock_signatures.NewMockSignatureService(ctrl),
+		claGroupRepository: claGroupRepo,
+		pullRequestClient:  client,
+	}
+
+	err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+	assert.NoError(t, err)
+	assert.False(t, client.updated)
+	assert.True(t, client.dcoUpdated)
+	assert.Len(t, client.dcoFailed, 1)
+}
+
+func TestGetEnforcementMode(t *testing.T) {
+	testCases := []struct {
+		name           string
+		repositoryMode string
+		claGroup       *models.ClaGroup
+		claGroupErr    error
+		expectedMode   string
+	}{
+		{
+			name:           "repository enforcement mode applies over the CLA group enforcement mode",
+			repositoryMode: utils.EnforcementModeCLA,
+			expectedMode:   utils.EnforcementModeCLA,
+		},
+		{
+			name:         "repository without an enforcement mode uses the CLA group enforcement mode",
+			claGroup:     &models.ClaGroup{ProjectID: testCLAGroupID, ProjectEnforcementMode: utils.EnforcementModeDCO},
+			expectedMode: utils.EnforcementModeDCO,
+		},
+		{
+			name:         "CLA group without an enforcement mode is CLA enforced",
+			claGroup:     &models.ClaGroup{ProjectID: testCLAGroupID},
+			expectedMode: utils.EnforcementModeCLA,
+		},
+		{
+			name:         "CLA group which cannot be loaded is CLA enforced",
+			claGroupErr:  errors.New("dynamodb unavailable"),
+			expectedMode: utils.EnforcementModeCLA,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			claGroupRepo := mock_project.NewMockProjectRepository(ctrl)
+			if tc.repositoryMode == "" {
+				claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), testCLAGroupID, false).Return(tc.claGroup, tc.claGroupErr)
+			}
+			activityService := &eventHandlerService{claGroupRepository: claGroupRepo}
+
+			mode := activityService.getEnforcementMode(context.Background(), logrus.Fields{}, &models.GithubRepository{
+				RepositoryClaGroupID: testCLAGroupID,
+				EnforcementMode:      tc.repositoryMode,
+			})
+			assert.Equal(t, tc.expectedMode, mode)
+		})
+	}
+}
+
+func TestProcessPullRequestEvent_IgnoredAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := &fakePullRequestClient{}
+	activityService := &eventHandlerService{
+		gitV1Repository:   mock.NewMockRepositoryInterface(ctrl),
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_closed.json"))
+	assert.NoError(t, err)
+	assert.False(t, client.updated)
+	assert.False(t, client.dcoUpdated)
+}
+
+func TestProcessPullRequestEvent_CoAuthors(t *testing.T) {
+	testCases := []struct {
+		name           string
+		coAuthorPolicy string
+		signed         int
+		missing        int
+	}{
+		{name: "reported co-author", coAuthorPolicy: "", signed: 2, missing: 0},
+		{name: "enforced co-author", coAuthorPolicy: utils.CoAuthorPolicyEnforce, signed: 1, missing: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			githubRepo := mock.NewMockRepositoryInterface(ctrl)
+			githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+				Enabled:              true,
+				RepositoryClaGroupID: testCLAGroupID,
+				EnforcementMode:      utils.EnforcementModeCLA,
+			}, nil)
+
+			claGroupRepo := mock_project.NewMockProjectRepository(ctrl)
+			claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), testCLAGroupID, false).Return(&models.ClaGroup{
+				ProjectID:             testCLAGroupID,
+				ProjectCoAuthorPolicy: tc.coAuthorPolicy,
+			}, nil)
+
+			// the co-author without a GitHub noreply address is resolved by email only
+			signedUser := &models.User{UserID: "signed-user-id"}
+			usersRepo := mock_users.NewMockUserRepository(ctrl)
+			usersRepo.EXPECT().GetUserByGitHubID("1001").Return(signedUser, nil)
+			usersRepo.EXPECT().GetUserByEmail("jane@example.org").Return(nil, nil)
+
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, testCLAGroupID).Return(aws.Bool(true), aws.Bool(false), nil)
+
+			client := &fakePullRequestClient{
+				authors: []*v1Github.UserCommitSummary{
+					commitSummary("1111111", 1001, "octo-contributor", "Update the README\n\nCo-authored-by: Jane Doe <jane@example.org>"),
+					{
+						SHA: "1111111",
+						CommitAuthor: &github.User{
+							Name:  aws.String("Jane Doe"),
+							Email: aws.String("jane@example.org"),
+						},
+						CoAuthor: true,
+					},
+				},
+			}
+			activityService := &eventHandlerService{
+				gitV1Repository:    githubRepo,
+				usersRepository:    usersRepo,
+				signatureService:   signatureService,
+				claGroupRepository: claGroupRepo,
+				pullRequestClient:  client,
+			}
+
+			err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+			assert.NoError(t, err)
+			assert.True(t, client.updated)
+			assert.Len(t, client.signed, tc.signed)
+			assert.Len(t, client.missing, tc.missing)
+			for _, summary := range append(client.signed, client.missing...) {
+				assert.Equal(t, summary.CoAuthor && tc.coAuthorPolicy != utils.CoAuthorPolicyEnforce, summary.Reported)
+			}
+		})
+	}
+}
+
+func TestProcessPullRequestEvent_Exempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	githubRepo := mock.NewMockRepositoryInterface(ctrl)
+	githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+		Enabled:              true,
+		RepositoryID:         "easycla-repository-id",
+		RepositoryName:       "easycla-test-org/easycla-test-repo",
+		RepositoryClaGroupID: testCLAGroupID,
+		EnforcementMode:      utils.EnforcementModeCLA,
+	}, nil)
+
+	// the bot is exempt, no user or signature lookups are expected for it
+	usersRepo := mock_users.NewMockUserRepository(ctrl)
+	usersRepo.EXPECT().GetUserByGitHubID("1002").Return(nil, nil)
+	usersRepo.EXPECT().GetUserByGitHubUsername("new-contributor").Return(nil, nil)
+
+	exemptionsService := mock_exemptions.NewMockService(ctrl)
+	exemptionsService.EXPECT().ExemptGitHubAuthors(gomock.Any(), testCLAGroupID, "easycla-repository-id", "easycla-test-org/easycla-test-repo", 12, gomock.Any()).
+		Do(func(_, _, _, _, _ interface{}, authors []*v1Github.UserCommitSummary) {
+			authors[0].Exempt = true
+		})
+
+	client := &fakePullRequestClient{
+		authors: []*v1Github.UserCommitSummary{
+			commitSummary("1111111", 49699333, "dependabot[bot]", "Bump the dependencies"),
+			commitSummary("2222222", 1002, "new-contributor", "Fix a typo"),
+		},
+	}
+	activityService := &eventHandlerService{
+		gitV1Repository:   githubRepo,
+		usersRepository:   usersRepo,
+		signatureService:  mock_signatures.NewMockSignatureService(ctrl),
+		exemptionsService: exemptionsService,
+		pullRequestClient: client,
+	}
+
+	err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+	assert.NoError(t, err)
+	assert.True(t, client.updated)
+	if assert.Len(t, client.signed, 1) && assert.Len(t, client.missing, 1) {
+		assert.Equal(t, "1111111", client.signed[0].SHA)
+		assert.True(t, client.signed[0].Exempt)
+		assert.Equal(t, "2222222", client.missing[0].SHA)
+	}
+}
+
+func TestProcessPullRequestEvent_TrivialChange(t *testing.T) {
+	docsRule := &models.TrivialChangeRule{Paths: []string{"docs/**", "*.md"}}
+	smallRule := &models.TrivialChangeRule{MaxChangedLines: 5}
+
+	testCases := []struct {
+		name            string
+		rules           []*models.TrivialChangeRule
+		checkRunEnabled bool
+		files           []trivialchange.File
+		filesErr        error
+		trivial         bool
+		description     string
+	}{
+		{
+			name:        "documentation only",
+			rules:       []*models.TrivialChangeRule{docsRule},
+			files:       []trivialchange.File{{Path: "README.md", Additions: 40}, {Path: "docs/install.rst", Deletions: 2}},
+			trivial:     true,
+			description: "EasyCLA check skipped - trivial change in docs/**, *.md",
+		},
+		{
+			name:  "documentation and code",
+			rules: []*models.TrivialChangeRule{docsRule},
+			files: []trivialchange.File{{Path: "README.md", Additions: 1}, {Path: "main.go", Additions: 1}},
+		},
+		{
+			name:            "small change with a check run",
+			rules:           []*models.TrivialChangeRule{docsRule, smallRule},
+			checkRunEnabled: true,
+			files:           []trivialchange.File{{Path: "main.go", Additions: 1, Deletions: 1}},
+			trivial:         true,
+			description:     "EasyCLA check skipped - trivial change of 2/5 lines",
+		},
+		{
+			name:  "over the changed lines threshold",
+			rules: []*models.TrivialChangeRule{smallRule},
+			files: []trivialchange.File{{Path: "main.go", Additions: 4, Deletions: 2}},
+		},
+		{
+			name:     "files cannot be loaded",
+			rules:    []*models.TrivialChangeRule{docsRule},
+			filesErr: os.ErrNotExist,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			githubRepo := mock.NewMockRepositoryInterface(ctrl)
+			githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+				Enabled:              true,
+				RepositoryClaGroupID: testCLAGroupID,
+				EnforcementMode:      utils.EnforcementModeCLA,
+				CheckRunEnabled:      tc.checkRunEnabled,
+				TrivialChangeRules:   tc.rules,
+			}, nil)
+
+			// the commit authors are only checked when no rule applies
+			usersRepo := mock_users.NewMockUserRepository(ctrl)
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			if !tc.trivial {
+				signedUser := &models.User{UserID: "signed-user-id"}
+				usersRepo.EXPECT().GetUserByGitHubID("1001").Return(signedUser, nil)
+				signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, testCLAGroupID).Return(aws.Bool(true), aws.Bool(false), nil)
+			}
+
+			client := &fakePullRequestClient{
+				authors: []*v1Github.UserCommitSummary{
+					commitSummary("1111111", 1001, "octo-contributor", "Fix a typo"),
+				},
+				files:    tc.files,
+				filesErr: tc.filesErr,
+			}
+			activityService := &eventHandlerService{
+				gitV1Repository:   githubRepo,
+				usersRepository:   usersRepo,
+				signatureService:  signatureService,
+				pullRequestClient: client,
+			}
+
+			err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.trivial, client.trivialUpdated)
+			assert.Equal(t, !tc.trivial, client.updated || client.checkRunUpdated)
+			if tc.trivial {
+				assert.Equal(t, tc.checkRunEnabled, client.trivialCheckRun)
+				assert.Equal(t, tc.description, client.trivialDescription)
+			}
+		})
+	}
+}
+
+func TestProcessPullRequestEvent_Branches(t *testing.T) {
+	testCases := []struct {
+		name            string
+		includeBranches []string
+		excludeBranches []string
+		enforced        bool
+	}{
+		{
+			name:     "no branch patterns",
+			enforced: true,
+		},
+		{
+			name:            "base branch included",
+			includeBranches: []string{"main", "release/*"},
+			enforced:        true,
+		},
+		{
+			name:            "base branch not included",
+			includeBranches: []string{"release/*"},
+		},
+		{
+			name:            "base branch excluded",
+			includeBranches: []string{"*"},
+			excludeBranches: []string{"main"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			githubRepo := mock.NewMockRepositoryInterface(ctrl)
+			githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+				Enabled:              true,
+				RepositoryClaGroupID: testCLAGroupID,
+				EnforcementMode:      utils.EnforcementModeCLA,
+				IncludeBranches:      tc.includeBranches,
+				ExcludeBranches:      tc.excludeBranches,
+			}, nil)
+
+			// the commit authors are only checked on the enforced base branches
+			usersRepo := mock_users.NewMockUserRepository(ctrl)
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			if tc.enforced {
+				signedUser := &models.User{UserID: "signed-user-id"}
+				usersRepo.EXPECT().GetUserByGitHubID("1001").Return(signedUser, nil)
+				signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, testCLAGroupID).Return(aws.Bool(true), aws.Bool(false), nil)
+			}
+
+			client := &fakePullRequestClient{
+				authors: []*v1Github.UserCommitSummary{
+					commitSummary("1111111", 1001, "octo-contributor", "Fix a typo"),
+				},
+			}
+			activityService := &eventHandlerService{
+				gitV1Repository:   githubRepo,
+				usersRepository:   usersRepo,
+				signatureService:  signatureService,
+				pullRequestClient: client,
+			}
+
+			err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.enforced, client.updated)
+		})
+	}
+}
+
+func TestProcessPullRequestEvent_CLAGroupBindings(t *testing.T) {
+	const fooCLAGroupID = "0c6d7e5f-4a3b-4c2d-9e1f-8a7b6c5d4e3f"
+	fooBinding := &models.ClaGroupBinding{ClaGroupID: aws.String(fooCLAGroupID), Paths: []string{"projects/foo"}}
+	releaseBinding := &models.ClaGroupBinding{ClaGroupID: aws.String(fooCLAGroupID), Branches: []string{"release/*"}}
+
+	testCases := []struct {
+		name             string
+		bindings         []*models.ClaGroupBinding
+		files            []trivialchange.File
+		fooSigned        bool
+		missingCLAGroups []clagroupbinding.CLAGroup
+	}{
+		{
+			name:     "files outside the bound paths",
+			bindings: []*models.ClaGroupBinding{fooBinding},
+			files:    []trivialchange.File{{Path: "README.md"}},
+		},
+		{
+			name:             "sub-project agreement missing",
+			bindings:         []*models.ClaGroupBinding{fooBinding},
+			files:            []trivialchange.File{{Path: "projects/foo/main.go"}, {Path: "README.md"}},
+			missingCLAGroups: []clagroupbinding.CLAGroup{{ID: fooCLAGroupID, Name: "Foo"}},
+		},
+		{
+			name:      "sub-project agreement signed",
+			bindings:  []*models.ClaGroupBinding{fooBinding},
+			files:     []trivialchange.File{{Path: "projects/foo/main.go"}, {Path: "README.md"}},
+			fooSigned: true,
+		},
+		{
+			name:     "base branch not bound",
+			bindings: []*models.ClaGroupBinding{releaseBinding},
+			files:    []trivialchange.File{{Path: "projects/foo/main.go"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			githubRepo := mock.NewMockRepositoryInterface(ctrl)
+			githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+				Enabled:              true,
+				RepositoryClaGroupID: testCLAGroupID,
+				EnforcementMode:      utils.EnforcementModeCLA,
+				ClaGroupBindings:     tc.bindings,
+			}, nil)
+
+			// the author is covered by the repository CLA group, the foo CLA group is only checked for the foo changes
+			signedUser := &models.User{UserID: "signed-user-id"}
+			usersRepo := mock_users.NewMockUserRepository(ctrl)
+			usersRepo.EXPECT().GetUserByGitHubID("1001").Return(signedUser, nil)
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, testCLAGroupID).Return(aws.Bool(true), aws.Bool(false), nil)
+			claGroupRepo := mock_project.NewMockProjectRepository(ctrl)
+			if len(tc.missingCLAGroups) > 0 || tc.fooSigned {
+				signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, fooCLAGroupID).Return(aws.Bool(tc.fooSigned), aws.Bool(false), nil)
+				claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), fooCLAGroupID, false).Return(&models.ClaGroup{ProjectID: fooCLAGroupID, ProjectName: "Foo"}, nil)
+				claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), testCLAGroupID, false).Return(&models.ClaGroup{ProjectID: testCLAGroupID, ProjectName: "Default"}, nil)
+			}
+
+			client := &fakePullRequestClient{
+				authors: []*v1Github.UserCommitSummary{
+					commitSummary("1111111", 1001, "octo-contributor", "Update the foo sub-project"),
+				},
+				files: tc.files,
+			}
+			activityService := &eventHandlerService{
+				gitV1Repository:    githubRepo,
+				usersRepository:    usersRepo,
+				signatureService:   signatureService,
+				claGroupRepository: claGroupRepo,
+				pullRequestClient:  client,
+			}
+
+			err := activityService.ProcessPullRequestEvent(loadPullRequestEvent(t, "pull_request_opened.json"))
+			assert.NoError(t, err)
+			assert.True(t, client.updated)
+			if len(tc.missingCLAGroups) == 0 {
+				assert.Len(t, client.signed, 1)
+				assert.Empty(t, client.missing)
+				return
+			}
+			if assert.Len(t, client.missing, 1) {
+				assert.False(t, client.missing[0].Authorized)
+				assert.Equal(t, tc.missingCLAGroups, client.missing[0].MissingCLAGroups)
+			}
+		})
+	}
+}
+
+func TestRefreshPullRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		enforcementMode string
+		checkRun        bool
+		excludeBranches []string
+		updated         bool
+		checkRunUpdated bool
+		dcoUpdated      bool
+	}{
+		{
+			name:            "cla status",
+			enforcementMode: utils.EnforcementModeCLA,
+			updated:         true,
+		},
+		{
+			name:            "cla check run",
+			enforcementMode: utils.EnforcementModeCLA,
+			checkRun:        true,
+			checkRunUpdated: true,
+		},
+		{
+			name:            "dco",
+			enforcementMode: utils.EnforcementModeDCO,
+			dcoUpdated:      true,
+		},
+		{
+			name:            "base branch excluded",
+			enforcementMode: utils.EnforcementModeCLA,
+			excludeBranches: []string{"main"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			githubRepo := mock.NewMockRepositoryInterface(ctrl)
+			githubRepo.EXPECT().GitHubGetRepositoryByGithubID(gomock.Any(), testRepositoryID, true).Return(&models.GithubRepository{
+				Enabled:              true,
+				RepositoryName:       "easycla-test-org/easycla-test-repo",
+				RepositoryClaGroupID: testCLAGroupID,
+				EnforcementMode:      tc.enforcementMode,
+				CheckRunEnabled:      tc.checkRun,
+				ExcludeBranches:      tc.excludeBranches,
+			}, nil)
+
+			// the commit authors are only checked against the signatures in the CLA mode, on the enforced base branches
+			usersRepo := mock_users.NewMockUserRepository(ctrl)
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			if tc.updated || tc.checkRunUpdated {
+				signedUser := &models.User{UserID: "signed-user-id"}
+				usersRepo.EXPECT().GetUserByGitHubID("1001").Return(signedUser, nil)
+				signatureService.EXPECT().HasUserSigned(gomock.Any(), signedUser, testCLAGroupID).Return(aws.Bool(true), aws.Bool(false), nil)
+			}
+
+			client := &fakePullRequestClient{
+				authors: []*v1Github.UserCommitSummary{
+					commitSummary("1111111", 1001, "octo-contributor", "Fix a typo\n\nSigned-off-by: octo-contributor <octo-contributor@example.org>"),
+				},
+			}
+			activityService := &eventHandlerService{
+				gitV1Repository:   githubRepo,
+				usersRepository:   usersRepo,
+				signatureService:  signatureService,
+				pullRequestClient: client,
+			}
+
+			err := activityService.RefreshPullRequest(context.Background(), testInstallationID, 510012345, 12)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.updated, client.updated)
+			assert.Equal(t, tc.checkRunUpdated, client.checkRunUpdated)
+			assert.Equal(t, tc.dcoUpdated, client.dcoUpdated)
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/exemptions"
+	v1Github "github.com/communitybridge/easycla/cla-backend-go/github"
 	v1GithubOrg "github.com/communitybridge/easycla/cla-backend-go/github_organizations"
 
 	"github.com/sirupsen/logrus"
@@ -20,10 +22,14 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 
 	"github.com/communitybridge/easycla/cla-backend-go/v2/dynamo_events"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
 
 	"github.com/communitybridge/easycla/cla-backend-go/events"
 
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
 	"github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
 
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/google/go-github/v37/github"
@@ -31,8 +37,14 @@ import (
 
 // Service is responsible for handling the github activity events
 type Service interface {
+	ProcessInstallationEvent(event *github.InstallationEvent) error
 	ProcessInstallationRepositoriesEvent(event *github.InstallationRepositoriesEvent) error
 	ProcessRepositoryEvent(*github.RepositoryEvent) error
+	ProcessPullRequestEvent(event *github.PullRequestEvent) error
+	ProcessCheckRunEvent(event *github.CheckRunEvent) error
+	ProcessIssueCommentEvent(event *github.IssueCommentEvent) error
+	ProcessMergeGroupEvent(event *MergeGroupEvent) error
+	RefreshPullRequest(ctx context.Context, installationID, repositoryID int64, pullRequestID int) error
 }
 
 type eventHandlerService struct {
@@ -42,6 +54,17 @@ type eventHandlerService struct {
 	autoEnableService dynamo_events.AutoEnableService
 	emailService      emails.Service
 	sendEmail         bool
+
+	// pull request checks
+	usersRepository    users.UserRepository
+	signatureService   signatures.SignatureService
+	claGroupRepository repository.ProjectRepository
+	exemptionsService  exemptions.Service
+	storeRepository    store.Repository
+	pullRequestClient  pullRequestClient
+	claV1ApiURL        string
+	claLandingPage     string
+	claLogoURL         string
 }
 
 // NewService creates a new instance of the Event Handler Service
@@ -49,9 +72,25 @@ func NewService(gitV1Repository repositories.RepositoryInterface,
 	githubOrgRepo v1GithubOrg.RepositoryInterface,
 	eventService events.Service,
 	autoEnableService dynamo_events.AutoEnableService,
-	emailService emails.Service) Service {
-
-	return newService(gitV1Repository, githubOrgRepo, eventService, autoEnableService, emailService, true)
+	emailService emails.Service,
+	usersRepository users.UserRepository,
+	signatureService signatures.SignatureService,
+	claGroupRepository repository.ProjectRepository,
+	exemptionsService exemptions.Service,
+	storeRepository store.Repository,
+	claV1ApiURL, claLandingPage, claLogoURL string) Service {
+
+	service := newService(gitV1Repository, githubOrgRepo, eventService, autoEnableService, emailService, true).(*eventHandlerService)
+	service.usersRepository = usersRepository
+	service.signatureService = signatureService
+	service.claGroupRepository = claGroupRepository
+	service.exemptionsService = exemptionsService
+	service.storeRepository = storeRepository
+	service.pullRequestClient = gitHubPullRequestClient{}
+	service.claV1ApiURL = claV1ApiURL
+	service.claLandingPage = claLandingPage
+	service.claLogoURL = claLogoURL
+	return service
 }
 
 func newService(gitV1Repository repositories.RepositoryInterface,
@@ -70,8 +109,18 @@ func newService(gitV1Repository repositories.RepositoryInterface,
 	}
 }
 
-func (s *eventHandlerService) ProcessRepositoryEvent(event *github.RepositoryEvent) error {
+// newEventContext returns the context of a webhook event, with the GitHub host of the event sender - the installation
+// IDs are only unique per host, the installation is looked up on the GitHub Enterprise Server instance of the sender
+func newEventContext(sender *github.User) context.Context {
 	ctx := utils.NewContext()
+	if sender.GetHTMLURL() == "" {
+		return ctx
+	}
+	return v1Github.WithBaseURL(ctx, v1Github.BaseURLFromHTMLURL(sender.GetHTMLURL()))
+}
+
+func (s *eventHandlerService) ProcessRepositoryEvent(event *github.RepositoryEvent) error {
+	ctx := newEventContext(event.Sender)
 	f := logrus.Fields{
 		"functionName":   "v2.github_activity.service.ProcessRepositoryEvent",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
@@ -497,7 +546,7 @@ func (s *eventHandlerService) handleRepositoryArchivedAction(ctx context.Context
 }
 
 func (s *eventHandlerService) ProcessInstallationRepositoriesEvent(event *github.InstallationRepositoriesEvent) error {
-	ctx := utils.NewContext()
+	ctx := newEventContext(event.Sender)
 	f := logrus.Fields{
 		"functionName":   "v2.github_activity.service.ProcessInstallationRepositoriesEvent",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
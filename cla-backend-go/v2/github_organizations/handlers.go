@@ -103,13 +103,17 @@ func Configure(api *operations.EasyclaAPI, service Service, eventService events.
 			f["autoEnabled"] = utils.BoolValue(params.Body.AutoEnabled)
 			f["autoEnabledClaGroupID"] = params.Body.AutoEnabledClaGroupID
 
-			log.WithFields(f).Debug("Loading organization by name")
-			_, err := github.GetOrganization(ctx, *params.Body.OrganizationName)
-			if err != nil {
-				msg := fmt.Sprintf("unable to load organization by name: %s", utils.StringValue(params.Body.OrganizationName))
-				log.WithFields(f).WithError(err).Warn(msg)
-				return github_organizations.NewAddProjectGithubOrganizationBadRequest().WithPayload(
-					utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+			// the GitHub Enterprise Server organizations are validated with their app installation by the service
+			var err error
+			if params.Body.GithubBaseURL == "" {
+				log.WithFields(f).Debug("Loading organization by name")
+				_, err = github.GetOrganization(ctx, *params.Body.OrganizationName)
+				if err != nil {
+					msg := fmt.Sprintf("unable to load organization by name: %s", utils.StringValue(params.Body.OrganizationName))
+					log.WithFields(f).WithError(err).Warn(msg)
+					return github_organizations.NewAddProjectGithubOrganizationBadRequest().WithPayload(
+						utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+				}
 			}
 
 			if !utils.ValidateAutoEnabledClaGroupID(*params.Body.AutoEnabled, params.Body.AutoEnabledClaGroupID) {
@@ -226,16 +230,26 @@ func Configure(api *operations.EasyclaAPI, service Service, eventService events.
 				return github_organizations.NewUpdateProjectGithubOrganizationConfigBadRequest().WithPayload(utils.ErrorResponseBadRequestWithError(reqID, msg, err))
 			}
 
+			if params.Body.BranchProtectionAutoRemediation != nil {
+				err = service.UpdateGithubOrganizationAutoRemediation(ctx, params.OrgName, *params.Body.BranchProtectionAutoRemediation)
+				if err != nil {
+					msg := fmt.Sprintf("problem updating GitHub Organization branch protection auto-remediation for project SFID: %s for organization: %s", params.ProjectSFID, params.OrgName)
+					log.WithFields(f).Debug(msg)
+					return github_organizations.NewUpdateProjectGithubOrganizationConfigBadRequest().WithPayload(utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+				}
+			}
+
 			// Log the event
 			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
 				LfUsername:  authUser.UserName,
 				EventType:   events.GitHubOrganizationUpdated,
 				ProjectSFID: params.ProjectSFID,
 				EventData: &events.GitHubOrganizationUpdatedEventData{
-					GitHubOrganizationName:  params.OrgName,
-					AutoEnabled:             utils.BoolValue(params.Body.AutoEnabled),
-					AutoEnabledClaGroupID:   params.Body.AutoEnabledClaGroupID,
-					BranchProtectionEnabled: params.Body.BranchProtectionEnabled,
+					GitHubOrganizationName:          params.OrgName,
+					AutoEnabled:                     utils.BoolValue(params.Body.AutoEnabled),
+					AutoEnabledClaGroupID:           params.Body.AutoEnabledClaGroupID,
+					BranchProtectionEnabled:         params.Body.BranchProtectionEnabled,
+					BranchProtectionAutoRemediation: params.Body.BranchProtectionAutoRemediation,
 				},
 			})
 
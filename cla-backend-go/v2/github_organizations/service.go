@@ -6,7 +6,6 @@ package github_organizations
 import (
 	"context"
 	"fmt"
-	"net/url"
 	"sort"
 	"strings"
 
@@ -21,6 +20,7 @@ import (
 
 	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
 	v1GithubOrg "github.com/communitybridge/easycla/cla-backend-go/github_organizations"
 	gitV1Repository "github.com/communitybridge/easycla/cla-backend-go/repositories"
 	v2ProjectService "github.com/communitybridge/easycla/cla-backend-go/v2/project-service"
@@ -42,6 +42,7 @@ type Service interface {
 	AddGithubOrganization(ctx context.Context, projectSFID string, input *models.GithubCreateOrganization) (*models.GithubOrganization, error)
 	DeleteGithubOrganization(ctx context.Context, projectSFID string, githubOrgName string) error
 	UpdateGithubOrganization(ctx context.Context, projectSFID string, organizationName string, autoEnabled bool, autoEnabledClaGroupID string, branchProtectionEnabled bool) error
+	UpdateGithubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error
 }
 
 type service struct {
@@ -163,28 +164,27 @@ func (s service) GetGithubOrganizations(ctx context.Context, projectSFID string)
 			}
 		}
 
-		installURL := url.URL{
-			Scheme: "https",
-			Host:   "github.com",
-			Path:   fmt.Sprintf("/organizations/%s/settings/installations/%d", org.OrganizationName, org.OrganizationInstallationID),
-		}
-		installationURL := strfmt.URI(installURL.String())
+		installationURL := strfmt.URI(github.HTMLURL(org.GithubBaseURL,
+			fmt.Sprintf("organizations/%s/settings/installations/%d", org.OrganizationName, org.OrganizationInstallationID)))
 
 		rorg := &models.ProjectGithubOrganization{
-			AutoEnabled:             org.AutoEnabled,
-			AutoEnableCLAGroupID:    org.AutoEnabledClaGroupID,
-			AutoEnabledCLAGroupName: autoEnabledCLAGroupName,
-			BranchProtectionEnabled: org.BranchProtectionEnabled,
-			ConnectionStatus:        "", // updated below
-			GithubOrganizationName:  org.OrganizationName,
-			Repositories:            make([]*models.ProjectGithubRepository, 0),
-			InstallationURL:         &installationURL,
+			AutoEnabled:                     org.AutoEnabled,
+			AutoEnableCLAGroupID:            org.AutoEnabledClaGroupID,
+			AutoEnabledCLAGroupName:         autoEnabledCLAGroupName,
+			BranchProtectionEnabled:         org.BranchProtectionEnabled,
+			BranchProtectionAutoRemediation: org.BranchProtectionAutoRemediation,
+			ConnectionStatus:                "", // updated below
+			GithubOrganizationName:          org.OrganizationName,
+			Repositories:                    make([]*models.ProjectGithubRepository, 0),
+			InstallationURL:                 &installationURL,
 		}
 
 		orgmap[org.OrganizationName] = rorg
 		out.List = append(out.List, rorg)
 		if org.OrganizationInstallationID == 0 {
 			rorg.ConnectionStatus = utils.NoConnection
+		} else if org.InstallationSuspended {
+			rorg.ConnectionStatus = utils.ConnectionSuspended
 		} else {
 			if org.Repositories.Error != "" {
 				rorg.ConnectionStatus = utils.ConnectionFailure
@@ -330,12 +330,20 @@ func (s service) AddGithubOrganization(ctx context.Context, projectSFID string,
 	f["parentProjectSFID"] = parentProjectSFID
 	log.WithFields(f).Debug("located parentProjectID...")
 
+	if err = v1GithubOrg.PrepareEnterpriseOrganization(ctx, s.repo, &in); err != nil {
+		log.WithFields(f).WithError(err).Warn("problem validating the github enterprise organization")
+		return nil, err
+	}
+
 	log.WithFields(f).Debug("adding github organization...")
 	resp, err := s.repo.AddGitHubOrganization(ctx, parentProjectSFID, projectSFID, &in)
 	if err != nil {
 		log.WithFields(f).WithError(err).Warn("problem adding github organization for project")
 		return nil, err
 	}
+	if in.GithubBaseURL != "" {
+		github.ResetEnterpriseApps()
+	}
 
 	return v2GithubOrganizationModel(resp)
 }
@@ -344,6 +352,10 @@ func (s service) UpdateGithubOrganization(ctx context.Context, projectSFID strin
 	return s.repo.UpdateGitHubOrganization(ctx, projectSFID, organizationName, autoEnabled, autoEnabledClaGroupID, branchProtectionEnabled, nil)
 }
 
+func (s service) UpdateGithubOrganizationAutoRemediation(ctx context.Context, organizationName string, autoRemediation bool) error {
+	return s.repo.UpdateGitHubOrganizationAutoRemediation(ctx, organizationName, autoRemediation)
+}
+
 func (s service) DeleteGithubOrganization(ctx context.Context, projectSFID string, githubOrgName string) error {
 	f := logrus.Fields{
 		"functionName":   "v2.github_organizations.service.DeleteGitHubOrganization",
@@ -250,6 +250,9 @@ func Configure(api *operations.EasyclaAPI, service Service, gitlabOrgService git
 						return
 					}
 
+					// optional - only set by the sign links of the changes bound to several CLA groups
+					claGroupID, _ := session.Values["gitlab_cla_group_id"].(string)
+
 					mergeRequestID, ok := session.Values["gitlab_merge_request_id"].(string)
 					if !ok {
 						log.WithFields(f).Warn("Error getting gitlab_merge_request_id - missing from session object")
@@ -286,7 +289,7 @@ func Configure(api *operations.EasyclaAPI, service Service, gitlabOrgService git
 						return
 					}
 
-					consoleURL, err := signService.InitiateSignRequest(ctx, guocp.HTTPRequest, gitlabClient, repositoryID, mergeRequestID, gitlabOriginURL, contributorConsoleV2Base, eventService)
+					consoleURL, err := signService.InitiateSignRequest(ctx, guocp.HTTPRequest, gitlabClient, repositoryID, mergeRequestID, claGroupID, gitlabOriginURL, contributorConsoleV2Base, eventService)
 					log.WithFields(f).Debugf("redirecting to :%s ", *consoleURL)
 					http.Redirect(rw, guocp.HTTPRequest, *consoleURL, http.StatusSeeOther)
 				})
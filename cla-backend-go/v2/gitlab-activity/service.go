@@ -13,16 +13,22 @@ import (
 
 	"github.com/communitybridge/easycla/cla-backend-go/config"
 
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
+	"github.com/communitybridge/easycla/cla-backend-go/coauthors"
 	"github.com/communitybridge/easycla/cla-backend-go/company"
+	"github.com/communitybridge/easycla/cla-backend-go/dco"
+	"github.com/communitybridge/easycla/cla-backend-go/exemptions"
 	signatures1 "github.com/communitybridge/easycla/cla-backend-go/gen/v1/restapi/operations/signatures"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	v2Models "github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
 	gitlab_api "github.com/communitybridge/easycla/cla-backend-go/gitlab_api"
+	"github.com/communitybridge/easycla/cla-backend-go/project/repository"
 	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
 	"github.com/communitybridge/easycla/cla-backend-go/repositories"
 	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
 	"github.com/communitybridge/easycla/cla-backend-go/users"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/common"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/gitlab_organizations"
@@ -38,6 +44,7 @@ var (
 	missingID                 = errors.New("user missing in easyCLA records")
 	missingCompanyAffiliation = errors.New("must confirm affiliation with their company")
 	missingCompanyApproval    = errors.New("missing in company approval lists")
+	missingSignature          = errors.New("not covered by a signed CLA")
 	secretTokenMismatch       = errors.New("secret token mismatch")
 )
 
@@ -55,6 +62,32 @@ type ProcessMergeActivityInput struct {
 type gatedGitlabUser struct {
 	*gitlab.User
 	err error
+	// coAuthor is set for the users listed in a Co-authored-by trailer of the merge request commits
+	coAuthor bool
+	// exempt is set for the users matched by an exemption rule, such as bots and automation accounts
+	exempt bool
+	// missingCLAGroups lists the CLA groups not covering the user when the changes of the merge request are bound to
+	// other CLA groups than the CLA group of the project
+	missingCLAGroups []clagroupbinding.CLAGroup
+}
+
+// mergeRequestClient is the GitLab API used by the trivial change check
+type mergeRequestClient interface {
+	FetchMrFiles(projectID, mergeID int) ([]trivialchange.File, error)
+	SetCommitStatus(projectID int, commitSha string, state gitlab.BuildStateValue, message, targetURL string) error
+}
+
+// gitLabMergeRequestClient calls the GitLab API with the OAuth client of the GitLab group
+type gitLabMergeRequestClient struct {
+	client *gitlab.Client
+}
+
+func (c gitLabMergeRequestClient) FetchMrFiles(projectID, mergeID int) ([]trivialchange.File, error) {
+	return gitlab_api.FetchMrFiles(c.client, projectID, mergeID)
+}
+
+func (c gitLabMergeRequestClient) SetCommitStatus(projectID int, commitSha string, state gitlab.BuildStateValue, message, targetURL string) error {
+	return gitlab_api.SetCommitStatus(c.client, projectID, commitSha, state, message, targetURL)
 }
 
 type Service interface {
@@ -71,23 +104,27 @@ type service struct {
 	gitV2Repository             gitV2Repositories.RepositoryInterface
 	signaturesRepository        signatures.SignatureRepository
 	projectsCLAGroupsRepository projects_cla_groups.Repository
+	claGroupRepository          repository.ProjectRepository
 	companyRepository           company.IRepository
 	signatureRepository         signatures.SignatureRepository
+	exemptionsService           exemptions.Service
 	gitLabApp                   *gitlab_api.App
 }
 
 func NewService(gitRepository repositories.RepositoryInterface, gitV2Repository gitV2Repositories.RepositoryInterface, usersRepository users.UserRepository, signaturesRepository signatures.SignatureRepository, projectsCLAGroupsRepository projects_cla_groups.Repository,
-	companyRepository company.IRepository, signatureRepository signatures.SignatureRepository, gitlabOrgService gitlab_organizations.ServiceInterface) Service {
+	claGroupRepository repository.ProjectRepository, companyRepository company.IRepository, signatureRepository signatures.SignatureRepository, gitlabOrgService gitlab_organizations.ServiceInterface, exemptionsService exemptions.Service) Service {
 	return &service{
 		gitRepository:               gitRepository,
 		gitV2Repository:             gitV2Repository,
 		usersRepository:             usersRepository,
 		signaturesRepository:        signaturesRepository,
 		projectsCLAGroupsRepository: projectsCLAGroupsRepository,
+		claGroupRepository:          claGroupRepository,
 		companyRepository:           companyRepository,
 		signatureRepository:         signatureRepository,
 		gitLabApp:                   gitlab_api.Init(config.GetConfig().Gitlab.AppClientID, config.GetConfig().Gitlab.AppClientSecret, config.GetConfig().Gitlab.AppPrivateKey),
 		gitlabOrgService:            gitlabOrgService,
+		exemptionsService:           exemptionsService,
 	}
 }
 
@@ -215,7 +252,7 @@ func (s *service) ProcessMergeActivity(ctx context.Context, secretToken string,
 	f["lastCommitSha"] = lastCommitSha
 	log.WithFields(f).Debugf("last commit sha for merge request: %d is %s", mergeID, lastCommitSha)
 
-	_, err = gitlab_api.FetchMrInfo(gitlabClient, projectID, mergeID)
+	mrInfo, err := gitlab_api.FetchMrInfo(gitlabClient, projectID, mergeID)
 	if err != nil {
 		return fmt.Errorf("fetching info for mr : %d and project : %d: %s, failed : %v", mergeID, projectID, projectName, err)
 	}
@@ -226,6 +263,24 @@ func (s *service) ProcessMergeActivity(ctx context.Context, secretToken string,
 		return fmt.Errorf("finding internal repository for gitlab org name failed : %v", err)
 	}
 
+	f["targetBranch"] = mrInfo.TargetBranch
+	if !utils.IsBranchEnforced(mrInfo.TargetBranch, gitlabRepo.IncludeBranches, gitlabRepo.ExcludeBranches) {
+		log.WithFields(f).Debugf("target branch: %s is not enforced for repository: %s - skipping CLA check", mrInfo.TargetBranch, repositoryPath)
+		return nil
+	}
+
+	if s.getEnforcementMode(ctx, f, gitlabRepo) == utils.EnforcementModeDCO {
+		log.WithFields(f).Debugf("repository: %s is in the DCO enforcement mode - checking commit sign-offs", repositoryPath)
+		return s.processMergeDCO(ctx, gitlabClient, projectID, mergeID, mrInfo.TargetBranch, lastCommitSha)
+	}
+
+	if len(gitlabRepo.TrivialChangeRules) > 0 {
+		trivial, trivialErr := s.processMergeTrivialChange(ctx, gitLabMergeRequestClient{client: gitlabClient}, gitlabRepo, projectID, mergeID, lastCommitSha)
+		if trivialErr != nil || trivial {
+			return trivialErr
+		}
+	}
+
 	log.WithFields(f).Debugf("loading GitLab merge request participatants for merge request: %d", mergeID)
 	participants, err := gitlab_api.FetchMrParticipants(gitlabClient, projectID, mergeID)
 	if err != nil {
@@ -248,10 +303,35 @@ func (s *service) ProcessMergeActivity(ctx context.Context, secretToken string,
 	missingCLAMsg := "Missing CLA Authorization"
 	signedCLAMsg := "EasyCLA check passed. You are authorized to contribute."
 
+	checkedParticipants := participants
+	var exemptUsers []*gitlab.User
+	if s.exemptionsService != nil {
+		checkedParticipants, exemptUsers = s.exemptionsService.ExemptGitLabUsers(ctx, claGroupID, gitlabRepo.RepositoryID, repositoryPath, mergeID, participants)
+		log.WithFields(f).Debugf("%d participants are exempt from the CLA check", len(exemptUsers))
+	}
+
+	claGroups, perCLAGroup := s.requiredMrCLAGroups(ctx, gitLabMergeRequestClient{client: gitlabClient}, gitlabRepo, claGroupID, mrInfo.TargetBranch, projectID, mergeID)
+
 	var missingUsers []*gatedGitlabUser
 	var signedUsers []*gitlab.User
-	for _, gitlabUser := range participants {
+	for _, gitlabUser := range checkedParticipants {
 		log.WithFields(f).Debugf("checking if GitLab user: %s (%d) with email: %s has signed", gitlabUser.Username, gitlabUser.ID, gitlabUser.Email)
+		if perCLAGroup {
+			missingCLAGroups, signedCheckErr := s.missingCLAGroups(ctx, claGroups, gitlabUser)
+			if len(missingCLAGroups) == 0 {
+				log.WithFields(f).Infof("gitlabUser: %s (%d) has signed every required CLA group", gitlabUser.Username, gitlabUser.ID)
+				signedUsers = append(signedUsers, gitlabUser)
+				continue
+			}
+			log.WithFields(f).Infof("gitlabUser: %s (%d) has NOT signed %d of the required CLA groups", gitlabUser.Username, gitlabUser.ID, len(missingCLAGroups))
+			missingUsers = append(missingUsers, &gatedGitlabUser{
+				User:             gitlabUser,
+				err:              signedCheckErr,
+				missingCLAGroups: missingCLAGroups,
+			})
+			continue
+		}
+
 		userSigned, signedCheckErr := s.hasUserSigned(ctx, claGroupID, gitlabUser)
 		if signedCheckErr != nil {
 			log.WithFields(f).WithError(signedCheckErr).Warnf("problem checking if user : %s (%d) has signed - assuming not signed", gitlabUser.Username, gitlabUser.ID)
@@ -274,11 +354,17 @@ func (s *service) ProcessMergeActivity(ctx context.Context, secretToken string,
 		}
 	}
 
+	coAuthors, missingCoAuthors, err := s.checkMrCoAuthors(ctx, gitlabClient, projectID, mergeID, claGroupID, gitlabRepo.RepositoryID, repositoryPath, participants)
+	if err != nil {
+		return err
+	}
+	missingUsers = append(missingUsers, missingCoAuthors...)
+
 	signURL := GetFullSignURL(gitlabOrg.OrganizationID, strconv.Itoa(int(gitlabRepo.RepositoryExternalID)), strconv.Itoa(mergeID))
-	mrCommentContent := PrepareMrCommentContent(missingUsers, signedUsers, signURL)
+	mrCommentContent := PrepareMrCommentContent(missingUsers, signedUsers, coAuthors, exemptUsers, signURL)
 	if len(missingUsers) > 0 {
 		log.WithFields(f).Errorf("merge request faild with 1 or more users not passing authorization - failed users : %+v", missingUsers)
-		if statusErr := gitlab_api.SetCommitStatus(gitlabClient, projectID, lastCommitSha, gitlab.Failed, missingCLAMsg, signURL); statusErr != nil {
+		if statusErr := gitlab_api.SetCommitStatus(gitlabClient, projectID, lastCommitSha, gitlab.Failed, missingCLAMsg, missingCLAGroupSignURL(signURL, missingUsers)); statusErr != nil {
 			log.WithFields(f).WithError(statusErr).Warnf("problem setting the commit status for merge request ID: %d, sha: %s", mergeID, lastCommitSha)
 			return fmt.Errorf("setting commit status failed : %v", statusErr)
 		}
@@ -305,13 +391,273 @@ func (s *service) ProcessMergeActivity(ctx context.Context, secretToken string,
 	return nil
 }
 
-func PrepareMrCommentContent(missingUsers []*gatedGitlabUser, signedUsers []*gitlab.User, signURL string) string {
+// requiredMrCLAGroups returns the CLA groups which must cover the merge request participants - the CLA groups bound to
+// the changed paths and the target branch, or only the CLA group of the project - and whether the missing CLA groups
+// are reported per participant because the merge request is not only covered by the CLA group of the project
+func (s *service) requiredMrCLAGroups(ctx context.Context, client mergeRequestClient, gitlabRepo *models.GithubRepository, defaultCLAGroupID, targetBranch string, projectID, mergeID int) ([]clagroupbinding.CLAGroup, bool) {
+	f := logrus.Fields{
+		"functionName":    "requiredMrCLAGroups",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"gitlabProjectID": projectID,
+		"mergeID":         mergeID,
+		"targetBranch":    targetBranch,
+		"repositoryName":  gitlabRepo.RepositoryName,
+	}
+
+	defaultCLAGroup := []clagroupbinding.CLAGroup{{ID: defaultCLAGroupID}}
+	if len(gitlabRepo.ClaGroupBindings) == 0 {
+		return defaultCLAGroup, false
+	}
+
+	bindings := clagroupbinding.FromModels(gitlabRepo.ClaGroupBindings)
+	var claGroupIDs []string
+	files, err := client.FetchMrFiles(projectID, mergeID)
+	if err != nil {
+		// without the changed files every CLA group bound to the target branch is required
+		log.WithFields(f).WithError(err).Warn("unable to load merge request files - requiring every CLA group of the target branch")
+		claGroupIDs = clagroupbinding.SelectForBranch(bindings, defaultCLAGroupID, targetBranch)
+	} else {
+		claGroupIDs = clagroupbinding.Select(bindings, defaultCLAGroupID, targetBranch, files)
+	}
+	log.WithFields(f).Debugf("the merge request requires the CLA groups: %s", strings.Join(claGroupIDs, ", "))
+	if clagroupbinding.IsDefault(claGroupIDs, defaultCLAGroupID) {
+		return defaultCLAGroup, false
+	}
+
+	claGroups := make([]clagroupbinding.CLAGroup, 0, len(claGroupIDs))
+	for _, claGroupID := range claGroupIDs {
+		claGroup := clagroupbinding.CLAGroup{ID: claGroupID}
+		claGroupModel, loadErr := s.claGroupRepository.GetCLAGroupByID(ctx, claGroupID, repository.DontLoadRepoDetails)
+		if loadErr != nil || claGroupModel == nil {
+			log.WithFields(f).WithError(loadErr).Warnf("unable to load the CLA group: %s - using the ID as the name", claGroupID)
+		} else {
+			claGroup.Name = claGroupModel.ProjectName
+		}
+		claGroups = append(claGroups, claGroup)
+	}
+	return claGroups, true
+}
+
+// missingCLAGroups returns the CLA groups which do not cover the GitLab user and the reason of the first one
+func (s *service) missingCLAGroups(ctx context.Context, claGroups []clagroupbinding.CLAGroup, gitlabUser *gitlab.User) ([]clagroupbinding.CLAGroup, error) {
+	var missing []clagroupbinding.CLAGroup
+	var reason error
+	for _, claGroup := range claGroups {
+		userSigned, err := s.hasUserSigned(ctx, claGroup.ID, gitlabUser)
+		if userSigned {
+			continue
+		}
+		missing = append(missing, claGroup)
+		if reason == nil {
+			reason = err
+		}
+	}
+	return missing, reason
+}
+
+// checkMrCoAuthors checks the users listed in the Co-authored-by trailers of the merge request commits and returns the
+// co-authors listed in the comment, with the reason set for the co-authors which are only reported, and the co-authors
+// which are missing a signed CLA with the enforce co-author policy of the CLA group
+func (s *service) checkMrCoAuthors(ctx context.Context, gitlabClient *gitlab.Client, projectID, mergeID int, claGroupID, repositoryID, repositoryPath string, participants []*gitlab.User) ([]*gatedGitlabUser, []*gatedGitlabUser, error) {
+	f := logrus.Fields{
+		"functionName":    "checkMrCoAuthors",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"gitlabProjectID": projectID,
+		"mergeID":         mergeID,
+		"claGroupID":      claGroupID,
+	}
+
+	mrCommits, err := gitlab_api.FetchMrCommits(gitlabClient, projectID, mergeID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem loading GitLab merge request commits for merge request: %d", mergeID)
+		return nil, nil, fmt.Errorf("problem loading GitLab merge request commits for merge request: %d - error: %+v", mergeID, err)
+	}
+
+	// the participants are checked on their own
+	seen := map[string]bool{}
+	for _, participant := range participants {
+		seen[strings.ToLower(participant.Email)] = true
+		seen[strings.ToLower(participant.Username)] = true
+	}
+	delete(seen, "")
+
+	var coAuthorUsers []*gitlab.User
+	for _, commit := range mrCommits {
+		for _, coAuthor := range coauthors.Parse(commit.Message) {
+			if strings.EqualFold(coAuthor.Email, commit.AuthorEmail) || seen[strings.ToLower(coAuthor.Email)] {
+				continue
+			}
+			seen[strings.ToLower(coAuthor.Email)] = true
+
+			gitlabUser := &gitlab.User{
+				Name:  coAuthor.Name,
+				Email: coAuthor.Email,
+			}
+			if id, username, ok := coauthors.GitLabNoreply(coAuthor.Email); ok {
+				if seen[strings.ToLower(username)] {
+					continue
+				}
+				gitlabUser.ID = id
+				gitlabUser.Username = username
+			}
+			coAuthorUsers = append(coAuthorUsers, gitlabUser)
+		}
+	}
+	var coAuthors, missing []*gatedGitlabUser
+	if s.exemptionsService != nil && len(coAuthorUsers) > 0 {
+		var exemptCoAuthors []*gitlab.User
+		coAuthorUsers, exemptCoAuthors = s.exemptionsService.ExemptGitLabUsers(ctx, claGroupID, repositoryID, repositoryPath, mergeID, coAuthorUsers)
+		for _, gitlabUser := range exemptCoAuthors {
+			coAuthors = append(coAuthors, &gatedGitlabUser{
+				User:     gitlabUser,
+				coAuthor: true,
+				exempt:   true,
+			})
+		}
+	}
+	if len(coAuthorUsers) == 0 {
+		return coAuthors, nil, nil
+	}
+
+	policy := utils.CoAuthorPolicyReport
+	claGroup, err := s.claGroupRepository.GetCLAGroupByID(ctx, claGroupID, repository.DontLoadRepoDetails)
+	if err != nil || claGroup == nil {
+		log.WithFields(f).WithError(err).Warnf("unable to load the CLA group: %s - reporting the co-authors only", claGroupID)
+	} else if claGroup.ProjectCoAuthorPolicy != "" {
+		policy = claGroup.ProjectCoAuthorPolicy
+	}
+	log.WithFields(f).Debugf("checking %d co-authors with the co-author policy: %s", len(coAuthorUsers), policy)
+
+	for _, gitlabUser := range coAuthorUsers {
+		userSigned, signedCheckErr := s.hasUserSigned(ctx, claGroupID, gitlabUser)
+		coAuthor := &gatedGitlabUser{
+			User:     gitlabUser,
+			coAuthor: true,
+		}
+		if userSigned {
+			log.WithFields(f).Infof("co-author: %s <%s> has signed", gitlabUser.Name, gitlabUser.Email)
+			coAuthors = append(coAuthors, coAuthor)
+			continue
+		}
+
+		log.WithFields(f).WithError(signedCheckErr).Infof("co-author: %s <%s> has NOT signed", gitlabUser.Name, gitlabUser.Email)
+		coAuthor.err = missingSignature
+		if policy == utils.CoAuthorPolicyEnforce {
+			missing = append(missing, coAuthor)
+		} else {
+			coAuthors = append(coAuthors, coAuthor)
+		}
+	}
+
+	return coAuthors, missing, nil
+}
+
+// getEnforcementMode returns the enforcement mode of the repository, the repositories without an enforcement mode use
+// the enforcement mode of their CLA group and are CLA enforced when the CLA group cannot be loaded
+func (s *service) getEnforcementMode(ctx context.Context, f logrus.Fields, gitlabRepo *models.GithubRepository) string {
+	if gitlabRepo.EnforcementMode != "" {
+		return gitlabRepo.EnforcementMode
+	}
+	claGroup, err := s.claGroupRepository.GetCLAGroupByID(ctx, gitlabRepo.RepositoryClaGroupID, repository.DontLoadRepoDetails)
+	if err != nil || claGroup == nil {
+		log.WithFields(f).WithError(err).Warnf("unable to load the CLA group: %s - enforcing the CLA", gitlabRepo.RepositoryClaGroupID)
+		return utils.EnforcementModeCLA
+	}
+	if claGroup.ProjectEnforcementMode == "" {
+		return utils.EnforcementModeCLA
+	}
+	return claGroup.ProjectEnforcementMode
+}
+
+// processMergeDCO checks the Signed-off-by trailers of the merge request commits and sets the commit status and comment,
+// the remediation of the comment rebases the commits onto the target branch of the merge request
+func (s *service) processMergeDCO(ctx context.Context, gitlabClient *gitlab.Client, projectID, mergeID int, targetBranch, lastCommitSha string) error {
+	f := logrus.Fields{
+		"functionName":    "processMergeDCO",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"gitlabProjectID": projectID,
+		"mergeID":         mergeID,
+		"targetBranch":    targetBranch,
+		"lastCommitSha":   lastCommitSha,
+	}
+
+	mrCommits, err := gitlab_api.FetchMrCommits(gitlabClient, projectID, mergeID)
+	if err != nil {
+		return err
+	}
+
+	commits := make([]dco.Commit, 0, len(mrCommits))
+	for _, commit := range mrCommits {
+		commits = append(commits, dco.Commit{
+			SHA:         commit.ID,
+			AuthorName:  commit.AuthorName,
+			AuthorEmail: commit.AuthorEmail,
+			Message:     commit.Message,
+			IsMerge:     len(commit.ParentIDs) > 1,
+		})
+	}
+	passed, failed := dco.CheckCommits(commits)
+	log.WithFields(f).Debugf("DCO check - %d commits passed, %d commits failed", len(passed), len(failed))
+
+	state := gitlab.Success
+	if len(failed) > 0 {
+		state = gitlab.Failed
+	}
+	if statusErr := gitlab_api.SetCommitStatus(gitlabClient, projectID, lastCommitSha, state, dco.StatusDescription(failed), dco.InfoURL); statusErr != nil {
+		log.WithFields(f).WithError(statusErr).Warnf("problem setting the commit status for merge request ID: %d, sha: %s", mergeID, lastCommitSha)
+		return fmt.Errorf("setting commit status failed : %v", statusErr)
+	}
+
+	if mrCommentErr := gitlab_api.SetMrComment(gitlabClient, projectID, mergeID, dco.CommentBody(passed, failed, targetBranch)); mrCommentErr != nil {
+		log.WithFields(f).WithError(mrCommentErr).Warnf("problem setting the commit merge request comment for merge request ID: %d", mergeID)
+		return fmt.Errorf("setting comment failed : %v", mrCommentErr)
+	}
+
+	return nil
+}
+
+// processMergeTrivialChange evaluates the repository trivial change rules against the merge request files - when a rule
+// applies the commit status passes with the rule description and the CLA coverage of the participants is not checked
+func (s *service) processMergeTrivialChange(ctx context.Context, client mergeRequestClient, gitlabRepo *models.GithubRepository, projectID, mergeID int, lastCommitSha string) (bool, error) {
+	f := logrus.Fields{
+		"functionName":    "processMergeTrivialChange",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"gitlabProjectID": projectID,
+		"mergeID":         mergeID,
+		"lastCommitSha":   lastCommitSha,
+		"repositoryName":  gitlabRepo.RepositoryName,
+	}
+
+	files, err := client.FetchMrFiles(projectID, mergeID)
+	if err != nil {
+		// fall back to the CLA check when the files cannot be loaded
+		log.WithFields(f).WithError(err).Warn("unable to load merge request files - checking the CLA coverage")
+		return false, nil
+	}
+
+	rule := trivialchange.Match(trivialchange.FromModels(gitlabRepo.TrivialChangeRules), files)
+	if rule == nil {
+		log.WithFields(f).Debugf("no trivial change rule applies to the %d changed files", len(files))
+		return false, nil
+	}
+
+	description := trivialchange.StatusDescription(rule, trivialchange.ChangedLines(files))
+	log.WithFields(f).Infof("trivial change rule applies to the %d changed files - %s", len(files), description)
+	if statusErr := client.SetCommitStatus(projectID, lastCommitSha, gitlab.Success, description, ""); statusErr != nil {
+		log.WithFields(f).WithError(statusErr).Warnf("problem setting the commit status for merge request ID: %d, sha: %s", mergeID, lastCommitSha)
+		return true, fmt.Errorf("setting commit status failed : %v", statusErr)
+	}
+
+	return true, nil
+}
+
+func PrepareMrCommentContent(missingUsers []*gatedGitlabUser, signedUsers []*gitlab.User, coAuthors []*gatedGitlabUser, exemptUsers []*gitlab.User, signURL string) string {
 	landingPage := config.GetConfig().CLALandingPage
 	landingPage += "/#/?version=2"
 
 	var badgeHyperlink string
 	if len(missingUsers) > 0 {
-		badgeHyperlink = signURL
+		badgeHyperlink = missingCLAGroupSignURL(signURL, missingUsers)
 	} else {
 		badgeHyperlink = landingPage
 	}
@@ -330,13 +676,30 @@ func PrepareMrCommentContent(missingUsers []*gatedGitlabUser, signedUsers []*git
 	var result string
 	failed := ":x:"
 	success := ":white_check_mark:"
+	warning := ":warning:"
 
-	if len(signedUsers) > 0 {
+	if len(signedUsers) > 0 || len(coAuthors) > 0 || len(exemptUsers) > 0 {
 		result = "<ul>"
 		for _, signed := range signedUsers {
 			authorInfo := getAuthorInfo(signed)
 			result += fmt.Sprintf("<li>%s %s</li>", success, authorInfo)
 		}
+		for _, exempt := range exemptUsers {
+			authorInfo := getAuthorInfo(exempt)
+			result += fmt.Sprintf("<li>%s %s - exempt</li>", success, authorInfo)
+		}
+		// the co-authors which are not covered by a signed CLA are reported without blocking the merge request
+		for _, coAuthor := range coAuthors {
+			authorInfo := getGatedAuthorInfo(coAuthor)
+			if coAuthor.exempt {
+				result += fmt.Sprintf("<li>%s %s - exempt</li>", success, authorInfo)
+			} else if coAuthor.err == nil {
+				result += fmt.Sprintf("<li>%s %s</li>", success, authorInfo)
+			} else {
+				result += fmt.Sprintf(`<li>%s %s. The co-author is %s and is reported only.
+									<a href='%s' target='_blank'>Please click here to be authorized</a>.</li>`, warning, authorInfo, coAuthor.err, signURL)
+			}
+		}
 		result += "</ul>"
 		body = coveredBadge
 	}
@@ -348,8 +711,18 @@ func PrepareMrCommentContent(missingUsers []*gatedGitlabUser, signedUsers []*git
 	if len(missingUsers) > 0 {
 		result += "<ul>"
 		for _, missingUser := range missingUsers {
-			authorInfo := getAuthorInfo(missingUser.User)
-			if errors.Is(missingUser.err, missingCompanyAffiliation) {
+			authorInfo := getGatedAuthorInfo(missingUser)
+			if len(missingUser.missingCLAGroups) > 0 {
+				// the changes are bound to several CLA groups - one link per agreement which is still missing
+				msg := fmt.Sprintf(`<li><a href='%s' target='_blank'>%s</a> - %s. The commit is not authorized under a signed CLA of every CLA group of the changed files.
+									Please sign the missing agreements, one at a time: %s.
+									For further assistance with EasyCLA,
+									<a href='%s' target='_blank'>please submit a support request ticket</a>.
+									</li>`, clagroupbinding.SignURL(signURL, missingUser.missingCLAGroups[0].ID), failed, authorInfo,
+					clagroupbinding.SignLinks(signURL, missingUser.missingCLAGroups), easyCLASupportURL)
+				result += msg
+				body = failedBadge
+			} else if errors.Is(missingUser.err, missingCompanyAffiliation) {
 				msg := fmt.Sprintf(`<li> %s %s. This user is authorized, but they must confirm their affiliation with their company. 
 								  Start the authorization process <a href='%s'> by clicking here</a>, click "Corporate", 
 								  select the appropriate company from the list, then confirm your affiliation on the page that appears.
@@ -377,6 +750,17 @@ func PrepareMrCommentContent(missingUsers []*gatedGitlabUser, signedUsers []*git
 	return body
 }
 
+// missingCLAGroupSignURL returns the sign URL of the first CLA group missing for the users, or the sign URL of the CLA
+// group of the project when the changes are not bound to other CLA groups
+func missingCLAGroupSignURL(signURL string, missingUsers []*gatedGitlabUser) string {
+	for _, missingUser := range missingUsers {
+		if len(missingUser.missingCLAGroups) > 0 {
+			return clagroupbinding.SignURL(signURL, missingUser.missingCLAGroups[0].ID)
+		}
+	}
+	return signURL
+}
+
 func GetFullSignURL(gitlabOrganizationID string, gitlabRepositoryID string, mrID string) string {
 	return fmt.Sprintf("%s/v4/repository-provider/%s/sign/%s/%s/%s/#/",
 		config.GetConfig().ClaAPIV4Base,
@@ -403,6 +787,14 @@ func getAuthorInfo(gitlabUser *gitlab.User) string {
 	return fmt.Sprintf("name:%s", gitlabUser.Name)
 }
 
+// getGatedAuthorInfo returns the author info of the user, with the co-authors marked as such
+func getGatedAuthorInfo(gatedUser *gatedGitlabUser) string {
+	if gatedUser.coAuthor {
+		return getAuthorInfo(gatedUser.User) + " (co-author)"
+	}
+	return getAuthorInfo(gatedUser.User)
+}
+
 func (s *service) getGitlabOrganizationFromProjectPath(ctx context.Context, projectPath, projectNameSpace string) (*v2Models.GitlabOrganization, error) {
 	parts := strings.Split(projectPath, "/")
 	organizationName := parts[0]
@@ -499,6 +891,16 @@ func (s *service) isSigned(ctx context.Context, userModel *models.User, claGroup
 		return false, err
 	}
 
+	if icla != nil && signatures.IsResignRequired(icla) {
+		log.WithFields(f).Infof("user signature (ICLA): %s was superseded by a new CLA document version and must be re-signed", icla.SignatureID)
+		icla = nil
+	}
+
+	if icla != nil && signatures.IsSignatureExpired(icla) {
+		log.WithFields(f).Infof("user signature (ICLA): %s expired on %s and must be renewed", icla.SignatureID, icla.SignatureExpiresOn)
+		icla = nil
+	}
+
 	if icla != nil {
 		log.WithFields(f).Infof("user has signed the following signature (ICLA): %s, passing", icla.SignatureID)
 		return true, nil
@@ -532,6 +934,18 @@ func (s *service) isSigned(ctx context.Context, userModel *models.User, claGroup
 
 	log.WithFields(f).Debugf("loaded corporate signature id: %s for claGroupID: %s and companyID: %s", corporateSignature.SignatureID, claGroupID, companyID)
 
+	if signatures.IsResignRequired(corporateSignature) {
+		msg := fmt.Sprintf("corporate signature (CCLA): %s for company : %s was superseded by a new CLA document version and must be re-signed", corporateSignature.SignatureID, companyID)
+		log.WithFields(f).Debugf(msg)
+		return false, fmt.Errorf(msg)
+	}
+
+	if signatures.IsSignatureExpired(corporateSignature) {
+		msg := fmt.Sprintf("corporate signature (CCLA): %s for company : %s expired on %s and must be renewed", corporateSignature.SignatureID, companyID, corporateSignature.SignatureExpiresOn)
+		log.WithFields(f).Debugf(msg)
+		return false, fmt.Errorf(msg)
+	}
+
 	approvalCriteria := &signatures.ApprovalCriteria{}
 	if gitlabUser.Email != "" {
 		approvalCriteria.UserEmail = gitlabUser.Email
@@ -10,6 +10,9 @@ import (
 	"testing"
 
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	mock_project "github.com/communitybridge/easycla/cla-backend-go/project/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
+	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/xanzy/go-gitlab"
@@ -171,7 +174,7 @@ func TestPrepareMrCommentContent(t *testing.T) {
 
 		for _, tc := range testCases {
 			t.Run(tc.name, func(tt *testing.T) {
-				result := PrepareMrCommentContent(tc.missing, tc.signed, "https://sign.com")
+				result := PrepareMrCommentContent(tc.missing, tc.signed, nil, nil, "https://sign.com")
 				tt.Logf("the result is : %s", result)
 				parts := strings.Split(result, "<li>")
 				assert.Len(tt, parts, len(tc.expectedMsgs)+1)
@@ -201,3 +204,159 @@ func TestPrepareMrCommentContent(t *testing.T) {
 	}
 
 }
+
+// fakeMergeRequestClient records the commit status instead of calling the GitLab API
+type fakeMergeRequestClient struct {
+	files    []trivialchange.File
+	filesErr error
+
+	state   gitlab.BuildStateValue
+	message string
+}
+
+func (c *fakeMergeRequestClient) FetchMrFiles(projectID, mergeID int) ([]trivialchange.File, error) {
+	return c.files, c.filesErr
+}
+
+func (c *fakeMergeRequestClient) SetCommitStatus(projectID int, commitSha string, state gitlab.BuildStateValue, message, targetURL string) error {
+	c.state = state
+	c.message = message
+	return nil
+}
+
+func TestProcessMergeTrivialChange(t *testing.T) {
+	docsRule := &models.TrivialChangeRule{Paths: []string{"docs/**", "*.md"}, MaxChangedLines: 50}
+	smallRule := &models.TrivialChangeRule{MaxChangedLines: 3}
+
+	testCases := []struct {
+		name     string
+		rules    []*models.TrivialChangeRule
+		files    []trivialchange.File
+		filesErr error
+		trivial  bool
+		message  string
+	}{
+		{
+			name:    "documentation typo",
+			rules:   []*models.TrivialChangeRule{docsRule},
+			files:   []trivialchange.File{{Path: "docs/guide.md", Additions: 1, Deletions: 1}},
+			trivial: true,
+			message: "EasyCLA check skipped - trivial change of 2/50 lines in docs/**, *.md",
+		},
+		{
+			name:  "documentation over the threshold",
+			rules: []*models.TrivialChangeRule{docsRule},
+			files: []trivialchange.File{{Path: "docs/guide.md", Additions: 51}},
+		},
+		{
+			name:  "renamed into the documentation",
+			rules: []*models.TrivialChangeRule{docsRule},
+			files: []trivialchange.File{{Path: "docs/main.go", PreviousPath: "main.go"}},
+		},
+		{
+			name:    "small code change",
+			rules:   []*models.TrivialChangeRule{docsRule, smallRule},
+			files:   []trivialchange.File{{Path: "main.go", Additions: 2}},
+			trivial: true,
+			message: "EasyCLA check skipped - trivial change of 2/3 lines",
+		},
+		{
+			name:     "files cannot be loaded",
+			rules:    []*models.TrivialChangeRule{smallRule},
+			filesErr: fmt.Errorf("over the diff limits"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeMergeRequestClient{files: tc.files, filesErr: tc.filesErr}
+			gitlabRepo := &models.GithubRepository{
+				RepositoryName:     "easycla-test-group/easycla-test-repo",
+				TrivialChangeRules: tc.rules,
+			}
+
+			trivial, err := (&service{}).processMergeTrivialChange(context.Background(), client, gitlabRepo, 7, 12, "b7a1e0f3")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.trivial, trivial)
+			if tc.trivial {
+				assert.Equal(t, gitlab.Success, client.state)
+				assert.Equal(t, tc.message, client.message)
+			} else {
+				assert.Empty(t, client.message)
+			}
+		})
+	}
+}
+
+func TestRequiredMrCLAGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claGroupRepo := mock_project.NewMockProjectRepository(ctrl)
+	claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), "docs-cla-group", false).Return(&models.ClaGroup{ProjectName: "Docs"}, nil).AnyTimes()
+	claGroupRepo.EXPECT().GetCLAGroupByID(gomock.Any(), "default-cla-group", false).Return(&models.ClaGroup{ProjectName: "Default"}, nil).AnyTimes()
+	s := &service{claGroupRepository: claGroupRepo}
+
+	docsCLAGroupID := "docs-cla-group"
+	bindings := []*models.ClaGroupBinding{{ClaGroupID: &docsCLAGroupID, Paths: []string{"docs"}}}
+
+	testCases := []struct {
+		name        string
+		bindings    []*models.ClaGroupBinding
+		files       []trivialchange.File
+		filesErr    error
+		claGroups   []string
+		perCLAGroup bool
+	}{
+		{
+			name:      "no bindings",
+			files:     []trivialchange.File{{Path: "docs/guide.md"}},
+			claGroups: []string{""},
+		},
+		{
+			name:      "code change",
+			bindings:  bindings,
+			files:     []trivialchange.File{{Path: "main.go"}},
+			claGroups: []string{""},
+		},
+		{
+			name:        "documentation change",
+			bindings:    bindings,
+			files:       []trivialchange.File{{Path: "docs/guide.md"}},
+			claGroups:   []string{"Docs"},
+			perCLAGroup: true,
+		},
+		{
+			name:        "documentation and code change",
+			bindings:    bindings,
+			files:       []trivialchange.File{{Path: "docs/guide.md"}, {Path: "main.go"}},
+			claGroups:   []string{"Docs", "Default"},
+			perCLAGroup: true,
+		},
+		{
+			name:        "files cannot be loaded",
+			bindings:    bindings,
+			filesErr:    fmt.Errorf("over the diff limits"),
+			claGroups:   []string{"Docs", "Default"},
+			perCLAGroup: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeMergeRequestClient{files: tc.files, filesErr: tc.filesErr}
+			gitlabRepo := &models.GithubRepository{
+				RepositoryName:   "easycla-test-group/easycla-test-repo",
+				ClaGroupBindings: tc.bindings,
+			}
+
+			claGroups, perCLAGroup := s.requiredMrCLAGroups(context.Background(), client, gitlabRepo, "default-cla-group", "main", 7, 12)
+			assert.Equal(t, tc.perCLAGroup, perCLAGroup)
+			var names []string
+			for _, claGroup := range claGroups {
+				names = append(names, claGroup.Name)
+			}
+			assert.Equal(t, tc.claGroups, names)
+		})
+	}
+}
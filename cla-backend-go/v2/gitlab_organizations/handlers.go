@@ -485,6 +485,9 @@ func Configure(api *operations.EasyclaAPI, service ServiceInterface, eventServic
 						return
 					}
 
+					// optional - only set by the sign links of the changes bound to several CLA groups
+					claGroupID, _ := session.Values["gitlab_cla_group_id"].(string)
+
 					mergeRequestID, ok := session.Values["gitlab_merge_request_id"].(string)
 					if !ok {
 						msg := "Error getting gitlab_merge_request_id - missing from session object"
@@ -522,7 +525,7 @@ func Configure(api *operations.EasyclaAPI, service ServiceInterface, eventServic
 						return
 					}
 
-					consoleURL, err := service.InitiateSignRequest(ctx, params.HTTPRequest, gitlabClient, repositoryID, mergeRequestID, gitlabOriginURL, contributorConsoleV2Base, eventService)
+					consoleURL, err := service.InitiateSignRequest(ctx, params.HTTPRequest, gitlabClient, repositoryID, mergeRequestID, claGroupID, gitlabOriginURL, contributorConsoleV2Base, eventService)
 					log.WithFields(f).Debugf("redirecting to :%s ", *consoleURL)
 					http.Redirect(rw, params.HTTPRequest, *consoleURL, http.StatusSeeOther)
 				})
@@ -61,7 +61,7 @@ type ServiceInterface interface {
 	UpdateGitLabOrganization(ctx context.Context, input *common.GitLabAddOrganization) error
 	UpdateGitLabOrganizationAuth(ctx context.Context, gitLabOrganizationID string, oauthResp *gitlabApi.OauthSuccessResponse, authExpiryTime int64) error
 	DeleteGitLabOrganizationByFullPath(ctx context.Context, projectSFID string, gitlabOrgFullPath string) error
-	InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *goGitLab.Client, repositoryID, mergeRequestID, originURL, contributorBaseURL string, eventService events.Service) (*string, error)
+	InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *goGitLab.Client, repositoryID, mergeRequestID, claGroupID, originURL, contributorBaseURL string, eventService events.Service) (*string, error)
 	RefreshGitLabOrganizationAuth(ctx context.Context, gitLabOrg *common.GitLabOrganization) (*string, error)
 }
 
@@ -766,11 +766,12 @@ func (s *Service) DeleteGitLabOrganizationByFullPath(ctx context.Context, projec
 }
 
 // InitiateSignRequest initiates sign request and returns easy cla redirect url
-func (s *Service) InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *goGitLab.Client, repositoryID, mergeRequestID, originURL, contributorBaseURL string, eventService events.Service) (*string, error) {
+func (s *Service) InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *goGitLab.Client, repositoryID, mergeRequestID, claGroupID, originURL, contributorBaseURL string, eventService events.Service) (*string, error) {
 	f := logrus.Fields{
 		"functionName":   "v2.gitlab_organizations.service.InitiateSignRequest",
 		"repositoryID":   repositoryID,
 		"mergeRequestID": mergeRequestID,
+		"claGroupID":     claGroupID,
 		"originURL":      originURL,
 	}
 
@@ -809,7 +810,7 @@ func (s *Service) InitiateSignRequest(ctx context.Context, req *http.Request, gi
 	key := fmt.Sprintf("active_signature:%s", claUser.UserID)
 	storeValue := StoreValue{
 		UserID:         claUser.UserID,
-		ProjectID:      gitlabRepo.RepositoryClaGroupID,
+		ProjectID:      signCLAGroupID(gitlabRepo, claGroupID),
 		RepositoryID:   repositoryID,
 		MergeRequestID: mergeRequestID,
 		ReturnURL:      originURL,
@@ -834,7 +835,7 @@ func (s *Service) InitiateSignRequest(ctx context.Context, req *http.Request, gi
 	}
 
 	params := "redirect=" + url.QueryEscape(originURL)
-	consoleURL := fmt.Sprintf("https://%s/#/cla/project/%s/user/%s?%s", contributorBaseURL, gitlabRepo.RepositoryClaGroupID, claUser.UserID, params)
+	consoleURL := fmt.Sprintf("https://%s/#/cla/project/%s/user/%s?%s", contributorBaseURL, storeValue.ProjectID, claUser.UserID, params)
 	_, err = http.Get(consoleURL)
 
 	if err != nil {
@@ -1058,3 +1059,14 @@ func (s *Service) updateRepositoryStatus(glClient *goGitLab.Client, gitLabProjec
 
 	return gitLabProjectRepos
 }
+
+// signCLAGroupID returns the CLA group selected by the sign link when it is bound to the repository, otherwise the CLA
+// group of the repository
+func signCLAGroupID(gitlabRepo *v2Models.GitlabRepository, claGroupID string) string {
+	for _, binding := range gitlabRepo.ClaGroupBindings {
+		if binding != nil && claGroupID != "" && utils.StringValue(binding.ClaGroupID) == claGroupID {
+			return claGroupID
+		}
+	}
+	return gitlabRepo.RepositoryClaGroupID
+}
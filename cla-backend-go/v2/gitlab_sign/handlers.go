@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
 	"github.com/communitybridge/easycla/cla-backend-go/config"
 	"github.com/communitybridge/easycla/cla-backend-go/events"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
@@ -60,6 +61,9 @@ func Configure(api *operations.EasyclaAPI, service Service, eventService events.
 				session.Values["gitlab_installation_id"] = srp.OrganizationID
 				session.Values["gitlab_repository_id"] = srp.GitlabRepositoryID
 				session.Values["gitlab_merge_request_id"] = srp.MergeRequestID
+				// the CLA group to sign when the changes are bound to several CLA groups
+				claGroupID := srp.HTTPRequest.URL.Query().Get(clagroupbinding.SignURLParameter)
+				session.Values["gitlab_cla_group_id"] = claGroupID
 
 				originURL, err := service.GetOriginURL(ctx, srp.OrganizationID, srp.GitlabRepositoryID, srp.MergeRequestID)
 				if err != nil {
@@ -84,7 +88,7 @@ func Configure(api *operations.EasyclaAPI, service Service, eventService events.
 
 					log.WithFields(f).Debugf("Initiating Gitlab sign request for : %+v ", srp)
 
-					consoleURL, err := service.InitiateSignRequest(ctx, srp.HTTPRequest, gitlabClient, srp.GitlabRepositoryID, srp.MergeRequestID, *originURL, contributorConsoleV2Base, eventService)
+					consoleURL, err := service.InitiateSignRequest(ctx, srp.HTTPRequest, gitlabClient, srp.GitlabRepositoryID, srp.MergeRequestID, claGroupID, *originURL, contributorConsoleV2Base, eventService)
 
 					if err != nil {
 						msg := fmt.Sprintf("problem initiating sign request for :%+v", srp)
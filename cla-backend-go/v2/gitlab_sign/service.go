@@ -23,6 +23,7 @@ import (
 
 	"github.com/communitybridge/easycla/cla-backend-go/events"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v2Models "github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
 	gitlab_api "github.com/communitybridge/easycla/cla-backend-go/gitlab_api"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/users"
@@ -42,7 +43,7 @@ type service struct {
 }
 
 type Service interface {
-	InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *gitlab.Client, repositoryID, mergeRequestID, originURL, contributorBaseURL string, eventService events.Service) (*string, error)
+	InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *gitlab.Client, repositoryID, mergeRequestID, claGroupID, originURL, contributorBaseURL string, eventService events.Service) (*string, error)
 	GetOriginURL(ctx context.Context, organizationID, repositoryID, mergeRequestID string) (*string, error)
 }
 
@@ -106,11 +107,12 @@ func (s service) GetOriginURL(ctx context.Context, organizationID, repositoryID,
 }
 
 // InitiateSignRequest initiates sign request and returns easy cla redirect url
-func (s service) InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *gitlab.Client, repositoryID, mergeRequestID, originURL, contributorBaseURL string, eventService events.Service) (*string, error) {
+func (s service) InitiateSignRequest(ctx context.Context, req *http.Request, gitlabClient *gitlab.Client, repositoryID, mergeRequestID, claGroupID, originURL, contributorBaseURL string, eventService events.Service) (*string, error) {
 	f := logrus.Fields{
 		"functionName":   "v2.gitlab_sign.service.redirectToConsole",
 		"repositoryID":   repositoryID,
 		"mergeRequestID": mergeRequestID,
+		"claGroupID":     claGroupID,
 		"originURL":      originURL,
 	}
 
@@ -149,7 +151,7 @@ func (s service) InitiateSignRequest(ctx context.Context, req *http.Request, git
 	key := fmt.Sprintf("active_signature:%s", claUser.UserID)
 	storeValue := StoreValue{
 		UserID:         claUser.UserID,
-		ProjectID:      gitlabRepo.RepositoryClaGroupID,
+		ProjectID:      signCLAGroupID(gitlabRepo, claGroupID),
 		RepositoryID:   repositoryID,
 		MergeRequestID: mergeRequestID,
 		ReturnURL:      originURL,
@@ -169,7 +171,7 @@ func (s service) InitiateSignRequest(ctx context.Context, req *http.Request, git
 	}
 
 	params := "redirect=" + url.QueryEscape(originURL)
-	consoleURL := fmt.Sprintf("https://%s/#/cla/project/%s/user/%s?%s", contributorBaseURL, gitlabRepo.RepositoryClaGroupID, claUser.UserID, params)
+	consoleURL := fmt.Sprintf("https://%s/#/cla/project/%s/user/%s?%s", contributorBaseURL, storeValue.ProjectID, claUser.UserID, params)
 	_, err = http.Get(consoleURL)
 
 	if err != nil {
@@ -240,3 +242,14 @@ func (s service) getOrCreateUser(ctx context.Context, gitlabClient *gitlab.Clien
 
 	return claUser, nil
 }
+
+// signCLAGroupID returns the CLA group selected by the sign link when it is bound to the repository, otherwise the CLA
+// group of the repository
+func signCLAGroupID(gitlabRepo *v2Models.GitlabRepository, claGroupID string) string {
+	for _, binding := range gitlabRepo.ClaGroupBindings {
+		if binding != nil && claGroupID != "" && utils.StringValue(binding.ClaGroupID) == claGroupID {
+			return claGroupID
+		}
+	}
+	return gitlabRepo.RepositoryClaGroupID
+}
@@ -0,0 +1,125 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	repoModels "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// giteaFilter returns the repository type filter - the repository URL prefix is included as the repository IDs and
+// organization names are only unique within a Gitea instance
+func giteaFilter(giteaURL string) expression.ConditionBuilder {
+	return expression.Name(repoModels.RepositoryTypeColumn).Equal(expression.Value(utils.GiteaLower)).
+		And(expression.Name(repoModels.RepositoryURLColumn).BeginsWith(giteaURL + "/"))
+}
+
+// GiteaGetRepositoryByExternalID returns the database model for the specified Gitea instance repository ID
+func (r *Repository) GiteaGetRepositoryByExternalID(ctx context.Context, giteaURL string, repositoryExternalID int64) (*repoModels.RepositoryDBModel, error) {
+	condition := expression.Key(repoModels.RepositoryExternalIDColumn).Equal(expression.Value(strconv.FormatInt(repositoryExternalID, 10)))
+	record, err := r.getRepositoryWithConditionFilter(ctx, condition, giteaFilter(giteaURL), repoModels.RepositoryExternalIDIndex)
+	if err != nil {
+		if _, ok := err.(*utils.GitLabRepositoryNotFound); ok {
+			return nil, &utils.GiteaRepositoryNotFound{
+				RepositoryExternalID: repositoryExternalID,
+			}
+		}
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// GiteaGetRepositoriesByOrganizationName returns the repositories registered under the Gitea instance organization
+func (r *Repository) GiteaGetRepositoriesByOrganizationName(ctx context.Context, giteaURL, orgName string) ([]*repoModels.RepositoryDBModel, error) {
+	condition := expression.Key(repoModels.RepositoryOrganizationNameColumn).Equal(expression.Value(orgName))
+	records, err := r.getRepositoriesWithConditionFilter(ctx, condition, giteaFilter(giteaURL), repoModels.RepositoryOrganizationNameIndex)
+	if err != nil {
+		if _, ok := err.(*utils.GitLabRepositoryNotFound); ok {
+			return nil, &utils.GiteaRepositoryNotFound{
+				OrganizationName: orgName,
+			}
+		}
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// GiteaAddRepository creates a new entry in the repositories table using the specified input parameters
+func (r *Repository) GiteaAddRepository(ctx context.Context, input *repoModels.RepositoryDBModel) (*repoModels.RepositoryDBModel, error) {
+	f := logrus.Fields{
+		"functionName":               "v2.repositories.repository.GiteaAddRepository",
+		utils.XREQUESTID:             ctx.Value(utils.XREQUESTID),
+		"projectSFID":                input.ProjectSFID,
+		"repositoryExternalID":       input.RepositoryExternalID,
+		"repositoryURL":              input.RepositoryURL,
+		"repositoryName":             input.RepositoryName,
+		"repositoryCLAGroupID":       input.RepositoryCLAGroupID,
+		"repositoryOrganizationName": input.RepositoryOrganizationName,
+	}
+
+	_, currentTime := utils.CurrentTime()
+	repoID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	input.RepositoryID = repoID.String()
+	input.RepositoryType = utils.GiteaLower
+	input.DateCreated = currentTime
+	input.DateModified = currentTime
+	input.Note = fmt.Sprintf("created on %s", currentTime)
+	input.Version = "v1"
+
+	av, err := dynamodbattribute.MarshalMap(input)
+	if err != nil {
+		log.WithFields(f).Warnf("problem marshalling the input, error: %+v", err)
+		return nil, err
+	}
+
+	_, err = r.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		Item:      av,
+		TableName: aws.String(r.repositoryTableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to add gitea repository")
+		return nil, err
+	}
+
+	return input, nil
+}
+
+// GiteaDeleteRepository deletes the repository record by the internal repository ID
+func (r *Repository) GiteaDeleteRepository(ctx context.Context, repositoryID string) error {
+	f := logrus.Fields{
+		"functionName":   "v2.repositories.repository.GiteaDeleteRepository",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"repositoryID":   repositoryID,
+	}
+
+	_, err := r.dynamoDBClient.DeleteItem(&dynamodb.DeleteItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			repoModels.RepositoryIDColumn: {S: aws.String(repositoryID)},
+		},
+		TableName: aws.String(r.repositoryTableName),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to delete gitea repository")
+		return err
+	}
+
+	return nil
+}
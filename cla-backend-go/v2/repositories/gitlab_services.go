@@ -461,6 +461,11 @@ func dbModelToGitLabRepository(dbModel *repoModels.RepositoryDBModel) (*v2Models
 		DateModified:               dbModel.DateModified,               // date updated
 		Note:                       dbModel.Note,                       // Optional note
 		Version:                    dbModel.Version,                    // record version
+		EnforcementMode:            dbModel.EnforcementMode,            // cla, dco or not set for the CLA group mode
+		TrivialChangeRules:         toV2TrivialChangeRules(dbModel.TrivialChangeRules),
+		IncludeBranches:            dbModel.IncludeBranches,
+		ExcludeBranches:            dbModel.ExcludeBranches,
+		ClaGroupBindings:           toV2CLAGroupBindings(dbModel.ClaGroupBindings),
 	}
 
 	return &response, nil
@@ -21,11 +21,14 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/github"
 
 	"github.com/LF-Engineering/lfx-kit/auth"
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
 	"github.com/communitybridge/easycla/cla-backend-go/events"
 	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/github_repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/repository_enforcement"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/jinzhu/copier"
@@ -404,6 +407,377 @@ func Configure(api *operations.EasyclaAPI, service ServiceInterface, eventServic
 			return github_repositories.NewGetProjectGithubRepositoryBranchProtectionOK().WithPayload(protectedBranch)
 		})
 
+	api.GithubRepositoriesUpdateProjectGithubRepositoryCheckRunHandler = github_repositories.UpdateProjectGithubRepositoryCheckRunHandlerFunc(
+		func(params github_repositories.UpdateProjectGithubRepositoryCheckRunParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":    "v2.repositories.handlers.GithubRepositoriesUpdateProjectGithubRepositoryCheckRunHandler",
+				utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+				"authUser":        authUser.UserName,
+				"authEmail":       authUser.Email,
+				"projectSFID":     params.ProjectSFID,
+				"repositoryID":    params.RepositoryID,
+				"checkRunEnabled": utils.BoolValue(params.Body.CheckRunEnabled),
+			}
+
+			// Load the project
+			psc := project_service.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return github_repositories.NewUpdateProjectGithubRepositoryCheckRunNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Update GitHub Repository Check Run for Project %s with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return github_repositories.NewUpdateProjectGithubRepositoryCheckRunForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			repoModel, err := service.GitHubUpdateRepositoryCheckRun(ctx, params.ProjectSFID, params.RepositoryID, utils.BoolValue(params.Body.CheckRunEnabled))
+			if err != nil {
+				if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+					msg := fmt.Sprintf("repository not found for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+					log.WithFields(f).WithError(err).Warn(msg)
+					return github_repositories.NewUpdateProjectGithubRepositoryCheckRunNotFound().WithPayload(
+						utils.ErrorResponseNotFound(reqID, msg))
+				}
+				if errors.Is(err, ErrCheckRunNotSupported) {
+					return github_repositories.NewUpdateProjectGithubRepositoryCheckRunBadRequest().WithPayload(
+						utils.ErrorResponseBadRequestWithError(reqID, "check runs are only supported for GitHub repositories", err))
+				}
+
+				msg := fmt.Sprintf("problem updating the check run option for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return github_repositories.NewUpdateProjectGithubRepositoryCheckRunInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				EventType:   events.RepositoryCheckRunUpdated,
+				ProjectSFID: params.ProjectSFID,
+				CLAGroupID:  repoModel.RepositoryClaGroupID,
+				LfUsername:  authUser.UserName,
+				EventData: &events.RepositoryCheckRunUpdatedEventData{
+					RepositoryName:  repoModel.RepositoryName,
+					CheckRunEnabled: repoModel.CheckRunEnabled,
+				},
+			})
+
+			response := &models.GithubRepository{}
+			err = copier.Copy(response, repoModel)
+			if err != nil {
+				msg := fmt.Sprintf("problem converting response for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return github_repositories.NewUpdateProjectGithubRepositoryCheckRunInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			return github_repositories.NewUpdateProjectGithubRepositoryCheckRunOK().WithPayload(response)
+		})
+
+	api.RepositoryEnforcementUpdateRepositoryEnforcementModeHandler = repository_enforcement.UpdateRepositoryEnforcementModeHandlerFunc(
+		func(params repository_enforcement.UpdateRepositoryEnforcementModeParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":    "v2.repositories.handlers.RepositoryEnforcementUpdateRepositoryEnforcementModeHandler",
+				utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+				"authUser":        authUser.UserName,
+				"authEmail":       authUser.Email,
+				"projectSFID":     params.ProjectSFID,
+				"repositoryID":    params.RepositoryID,
+				"enforcementMode": utils.StringValue(params.Body.EnforcementMode),
+			}
+
+			// Load the project
+			psc := project_service.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return repository_enforcement.NewUpdateRepositoryEnforcementModeNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Update Repository Enforcement Mode for Project %s with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return repository_enforcement.NewUpdateRepositoryEnforcementModeForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			repoModel, err := service.UpdateRepositoryEnforcementMode(ctx, params.ProjectSFID, params.RepositoryID, utils.StringValue(params.Body.EnforcementMode))
+			if err != nil {
+				if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+					msg := fmt.Sprintf("repository not found for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+					log.WithFields(f).WithError(err).Warn(msg)
+					return repository_enforcement.NewUpdateRepositoryEnforcementModeNotFound().WithPayload(
+						utils.ErrorResponseNotFound(reqID, msg))
+				}
+				if errors.Is(err, ErrInvalidEnforcementMode) {
+					return repository_enforcement.NewUpdateRepositoryEnforcementModeBadRequest().WithPayload(
+						utils.ErrorResponseBadRequestWithError(reqID, "enforcement mode must be one of: cla, dco", err))
+				}
+
+				msg := fmt.Sprintf("problem updating the enforcement mode for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryEnforcementModeInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				EventType:   events.RepositoryEnforcementModeUpdated,
+				ProjectSFID: params.ProjectSFID,
+				CLAGroupID:  repoModel.RepositoryClaGroupID,
+				LfUsername:  authUser.UserName,
+				EventData: &events.RepositoryEnforcementModeUpdatedEventData{
+					RepositoryName:  repoModel.RepositoryName,
+					EnforcementMode: utils.StringValue(params.Body.EnforcementMode),
+				},
+			})
+
+			response := &models.GithubRepository{}
+			err = copier.Copy(response, repoModel)
+			if err != nil {
+				msg := fmt.Sprintf("problem converting response for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryEnforcementModeInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			return repository_enforcement.NewUpdateRepositoryEnforcementModeOK().WithPayload(response)
+		})
+
+	api.RepositoryEnforcementUpdateRepositoryTrivialChangeRulesHandler = repository_enforcement.UpdateRepositoryTrivialChangeRulesHandlerFunc(
+		func(params repository_enforcement.UpdateRepositoryTrivialChangeRulesParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":   "v2.repositories.handlers.RepositoryEnforcementUpdateRepositoryTrivialChangeRulesHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"authUser":       authUser.UserName,
+				"authEmail":      authUser.Email,
+				"projectSFID":    params.ProjectSFID,
+				"repositoryID":   params.RepositoryID,
+				"ruleCount":      len(params.Body.TrivialChangeRules),
+			}
+
+			// Load the project
+			psc := project_service.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Update Repository Trivial Change Rules for Project %s with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			repoModel, err := service.UpdateRepositoryTrivialChangeRules(ctx, params.ProjectSFID, params.RepositoryID, params.Body.TrivialChangeRules)
+			if err != nil {
+				if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+					msg := fmt.Sprintf("repository not found for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+					log.WithFields(f).WithError(err).Warn(msg)
+					return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesNotFound().WithPayload(
+						utils.ErrorResponseNotFound(reqID, msg))
+				}
+				if errors.Is(err, trivialchange.ErrInvalidRule) {
+					return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesBadRequest().WithPayload(
+						utils.ErrorResponseBadRequestWithError(reqID, err.Error(), err))
+				}
+
+				msg := fmt.Sprintf("problem updating the trivial change rules for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				EventType:   events.RepositoryTrivialChangesUpdated,
+				ProjectSFID: params.ProjectSFID,
+				CLAGroupID:  repoModel.RepositoryClaGroupID,
+				LfUsername:  authUser.UserName,
+				EventData: &events.RepositoryTrivialChangeRulesUpdatedEventData{
+					RepositoryName: repoModel.RepositoryName,
+					RuleCount:      len(repoModel.TrivialChangeRules),
+				},
+			})
+
+			response := &models.GithubRepository{}
+			err = copier.Copy(response, repoModel)
+			if err != nil {
+				msg := fmt.Sprintf("problem converting response for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			return repository_enforcement.NewUpdateRepositoryTrivialChangeRulesOK().WithPayload(response)
+		})
+
+	api.RepositoryEnforcementUpdateRepositoryBranchesHandler = repository_enforcement.UpdateRepositoryBranchesHandlerFunc(
+		func(params repository_enforcement.UpdateRepositoryBranchesParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":    "v2.repositories.handlers.RepositoryEnforcementUpdateRepositoryBranchesHandler",
+				utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+				"authUser":        authUser.UserName,
+				"authEmail":       authUser.Email,
+				"projectSFID":     params.ProjectSFID,
+				"repositoryID":    params.RepositoryID,
+				"includeBranches": strings.Join(params.Body.IncludeBranches, ","),
+				"excludeBranches": strings.Join(params.Body.ExcludeBranches, ","),
+			}
+
+			// Load the project
+			psc := project_service.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return repository_enforcement.NewUpdateRepositoryBranchesNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Update Repository Branches for Project %s with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return repository_enforcement.NewUpdateRepositoryBranchesForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			repoModel, err := service.UpdateRepositoryBranches(ctx, params.ProjectSFID, params.RepositoryID, params.Body.IncludeBranches, params.Body.ExcludeBranches)
+			if err != nil {
+				if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+					msg := fmt.Sprintf("repository not found for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+					log.WithFields(f).WithError(err).Warn(msg)
+					return repository_enforcement.NewUpdateRepositoryBranchesNotFound().WithPayload(
+						utils.ErrorResponseNotFound(reqID, msg))
+				}
+				if errors.Is(err, ErrInvalidBranchPattern) {
+					return repository_enforcement.NewUpdateRepositoryBranchesBadRequest().WithPayload(
+						utils.ErrorResponseBadRequestWithError(reqID, "branch patterns must not be empty", err))
+				}
+
+				msg := fmt.Sprintf("problem updating the branches for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryBranchesInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				EventType:   events.RepositoryBranchesUpdated,
+				ProjectSFID: params.ProjectSFID,
+				CLAGroupID:  repoModel.RepositoryClaGroupID,
+				LfUsername:  authUser.UserName,
+				EventData: &events.RepositoryBranchesUpdatedEventData{
+					RepositoryName:  repoModel.RepositoryName,
+					IncludeBranches: repoModel.IncludeBranches,
+					ExcludeBranches: repoModel.ExcludeBranches,
+				},
+			})
+
+			response := &models.GithubRepository{}
+			err = copier.Copy(response, repoModel)
+			if err != nil {
+				msg := fmt.Sprintf("problem converting response for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryBranchesInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			return repository_enforcement.NewUpdateRepositoryBranchesOK().WithPayload(response)
+		})
+
+	api.RepositoryEnforcementUpdateRepositoryClaGroupBindingsHandler = repository_enforcement.UpdateRepositoryClaGroupBindingsHandlerFunc(
+		func(params repository_enforcement.UpdateRepositoryClaGroupBindingsParams, authUser *auth.User) middleware.Responder {
+			reqID := utils.GetRequestID(params.XREQUESTID)
+			utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+			ctx := utils.ContextWithRequestAndUser(params.HTTPRequest.Context(), reqID, authUser) // nolint
+			f := logrus.Fields{
+				"functionName":   "v2.repositories.handlers.RepositoryEnforcementUpdateRepositoryClaGroupBindingsHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"authUser":       authUser.UserName,
+				"authEmail":      authUser.Email,
+				"projectSFID":    params.ProjectSFID,
+				"repositoryID":   params.RepositoryID,
+				"bindingCount":   len(params.Body.ClaGroupBindings),
+			}
+
+			// Load the project
+			psc := project_service.GetClient()
+			projectModel, err := psc.GetProject(params.ProjectSFID)
+			if err != nil || projectModel == nil {
+				return repository_enforcement.NewUpdateRepositoryClaGroupBindingsNotFound().WithPayload(
+					utils.ErrorResponseNotFound(reqID, fmt.Sprintf("unable to locate project with ID: %s", params.ProjectSFID)))
+			}
+
+			if !utils.IsUserAuthorizedForProjectTree(ctx, authUser, params.ProjectSFID, utils.ALLOW_ADMIN_SCOPE) {
+				msg := fmt.Sprintf("user %s does not have access to Update Repository CLA Group Bindings for Project %s with scope of %s",
+					authUser.UserName, projectModel.Name, params.ProjectSFID)
+				log.WithFields(f).Debug(msg)
+				return repository_enforcement.NewUpdateRepositoryClaGroupBindingsForbidden().WithPayload(
+					utils.ErrorResponseForbidden(reqID, msg))
+			}
+
+			repoModel, err := service.UpdateRepositoryCLAGroupBindings(ctx, params.ProjectSFID, params.RepositoryID, params.Body.ClaGroupBindings)
+			if err != nil {
+				if _, ok := err.(*utils.GitHubRepositoryNotFound); ok {
+					msg := fmt.Sprintf("repository not found for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+					log.WithFields(f).WithError(err).Warn(msg)
+					return repository_enforcement.NewUpdateRepositoryClaGroupBindingsNotFound().WithPayload(
+						utils.ErrorResponseNotFound(reqID, msg))
+				}
+				if errors.Is(err, clagroupbinding.ErrInvalidBinding) || errors.Is(err, ErrCLAGroupNotLinked) {
+					return repository_enforcement.NewUpdateRepositoryClaGroupBindingsBadRequest().WithPayload(
+						utils.ErrorResponseBadRequestWithError(reqID, "invalid CLA group bindings", err))
+				}
+
+				msg := fmt.Sprintf("problem updating the CLA group bindings for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryClaGroupBindingsInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			var claGroupIDs []string
+			for _, binding := range repoModel.ClaGroupBindings {
+				claGroupIDs = append(claGroupIDs, utils.StringValue(binding.ClaGroupID))
+			}
+			eventService.LogEventWithContext(ctx, &events.LogEventArgs{
+				EventType:   events.RepositoryCLAGroupBindingsUpdated,
+				ProjectSFID: params.ProjectSFID,
+				CLAGroupID:  repoModel.RepositoryClaGroupID,
+				LfUsername:  authUser.UserName,
+				EventData: &events.RepositoryCLAGroupBindingsUpdatedEventData{
+					RepositoryName: repoModel.RepositoryName,
+					CLAGroupIDs:    claGroupIDs,
+				},
+			})
+
+			response := &models.GithubRepository{}
+			err = copier.Copy(response, repoModel)
+			if err != nil {
+				msg := fmt.Sprintf("problem converting response for projectSFID: %s, repository: %s", params.ProjectSFID, params.RepositoryID)
+				log.WithFields(f).WithError(err).Warn(msg)
+				return repository_enforcement.NewUpdateRepositoryClaGroupBindingsInternalServerError().WithPayload(
+					utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+			}
+
+			return repository_enforcement.NewUpdateRepositoryClaGroupBindingsOK().WithPayload(response)
+		})
+
 	api.GitlabRepositoriesGetProjectGitLabRepositoriesHandler = gitlab_repositories.GetProjectGitLabRepositoriesHandlerFunc(
 		func(params gitlab_repositories.GetProjectGitLabRepositoriesParams, authUser *auth.User) middleware.Responder {
 			reqID := utils.GetRequestID(params.XREQUESTID)
@@ -14,8 +14,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	repoModels "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 	"github.com/gofrs/uuid"
 	"github.com/sirupsen/logrus"
@@ -39,6 +41,17 @@ type RepositoryInterface interface {
 	GitLabEnableCLAGroupRepositories(ctx context.Context, claGroupID string, enrollValue bool) error
 	GitLabDeleteRepositories(ctx context.Context, gitLabGroupPath string) error
 	GitLabDeleteRepositoryByExternalID(ctx context.Context, gitLabExternalID int64) error
+
+	UpdateRepositoryEnforcementMode(ctx context.Context, repositoryID, enforcementMode string) error
+	GitHubUpdateRepositoryCheckRun(ctx context.Context, repositoryID string, checkRunEnabled bool) error
+	UpdateRepositoryTrivialChangeRules(ctx context.Context, repositoryID string, rules []trivialchange.Rule) error
+	UpdateRepositoryBranches(ctx context.Context, repositoryID string, includeBranches, excludeBranches []string) error
+	UpdateRepositoryCLAGroupBindings(ctx context.Context, repositoryID string, bindings []clagroupbinding.Binding) error
+
+	GiteaGetRepositoryByExternalID(ctx context.Context, giteaURL string, repositoryExternalID int64) (*repoModels.RepositoryDBModel, error)
+	GiteaGetRepositoriesByOrganizationName(ctx context.Context, giteaURL, orgName string) ([]*repoModels.RepositoryDBModel, error)
+	GiteaAddRepository(ctx context.Context, input *repoModels.RepositoryDBModel) (*repoModels.RepositoryDBModel, error)
+	GiteaDeleteRepository(ctx context.Context, repositoryID string) error
 }
 
 // Repository object/struct
@@ -668,3 +681,347 @@ func (r *Repository) setRepositoryEnabledValue(ctx context.Context, claGroupID s
 
 	return err
 }
+
+// UpdateRepositoryEnforcementMode sets the enforcement mode, CLA or DCO, of the specified repository - an empty enforcement
+// mode removes it, the repository then uses the enforcement mode of its CLA group
+func (r *Repository) UpdateRepositoryEnforcementMode(ctx context.Context, repositoryID, enforcementMode string) error {
+	f := logrus.Fields{
+		"functionName":    "v2.repositories.repository.UpdateRepositoryEnforcementMode",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"repositoryID":    repositoryID,
+		"enforcementMode": enforcementMode,
+	}
+
+	existingModel, getErr := r.GitLabGetRepository(ctx, repositoryID)
+	if getErr != nil {
+		return getErr
+	}
+
+	var existingNote = ""
+	if existingModel.Note != "" {
+		if !strings.HasSuffix(strings.TrimSpace(existingModel.Note), ".") {
+			existingNote = strings.TrimSpace(existingModel.Note) + ". "
+		} else {
+			existingNote = strings.TrimSpace(existingModel.Note) + " "
+		}
+	}
+	userNameFromCtx := utils.GetUserNameFromContext(ctx)
+	byUserStr := ""
+	if userNameFromCtx != "" {
+		byUserStr = fmt.Sprintf("by user: %s", userNameFromCtx)
+	}
+
+	_, now := utils.CurrentTime()
+	updateInput := &dynamodb.UpdateItemInput{
+		ExpressionAttributeNames: map[string]*string{
+			"#enforcementMode": aws.String(repoModels.RepositoryEnforcementModeColumn),
+			"#note":            aws.String(repoModels.RepositoryNoteColumn),
+			"#dateModified":    aws.String(repoModels.RepositoryDateModifiedColumn),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":enforcementModeValue": {
+				S: aws.String(enforcementMode),
+			},
+			":noteValue": {
+				S: aws.String(fmt.Sprintf("%s Updated enforcement mode to %s on %s %s.", existingNote, enforcementMode, now, byUserStr)),
+			},
+			":dateModifiedValue": {
+				S: aws.String(now),
+			},
+		},
+		Key: map[string]*dynamodb.AttributeValue{
+			repoModels.RepositoryIDColumn: {S: aws.String(repositoryID)},
+		},
+		TableName:        aws.String(r.repositoryTableName),
+		UpdateExpression: aws.String("SET #enforcementMode = :enforcementModeValue, #note = :noteValue, #dateModified = :dateModifiedValue"),
+	}
+	if enforcementMode == "" {
+		delete(updateInput.ExpressionAttributeValues, ":enforcementModeValue")
+		updateInput.ExpressionAttributeValues[":noteValue"] = &dynamodb.AttributeValue{
+			S: aws.String(fmt.Sprintf("%s Updated enforcement mode to the CLA group enforcement mode on %s %s.", existingNote, now, byUserStr)),
+		}
+		updateInput.UpdateExpression = aws.String("SET #note = :noteValue, #dateModified = :dateModifiedValue REMOVE #enforcementMode")
+	}
+
+	_, err := r.dynamoDBClient.UpdateItem(updateInput)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem with update, error: %+v", err.Error())
+	}
+
+	return err
+}
+
+// GitHubUpdateRepositoryCheckRun sets whether the EasyCLA result of the specified GitHub repository is published as a check run
+func (r *Repository) GitHubUpdateRepositoryCheckRun(ctx context.Context, repositoryID string, checkRunEnabled bool) error {
+	f := logrus.Fields{
+		"functionName":    "v2.repositories.repository.GitHubUpdateRepositoryCheckRun",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"repositoryID":    repositoryID,
+		"checkRunEnabled": checkRunEnabled,
+	}
+
+	existingModel, getErr := r.GitLabGetRepository(ctx, repositoryID)
+	if getErr != nil {
+		return getErr
+	}
+
+	var existingNote = ""
+	if existingModel.Note != "" {
+		if !strings.HasSuffix(strings.TrimSpace(existingModel.Note), ".") {
+			existingNote = strings.TrimSpace(existingModel.Note) + ". "
+		} else {
+			existingNote = strings.TrimSpace(existingModel.Note) + " "
+		}
+	}
+	userNameFromCtx := utils.GetUserNameFromContext(ctx)
+	byUserStr := ""
+	if userNameFromCtx != "" {
+		byUserStr = fmt.Sprintf("by user: %s", userNameFromCtx)
+	}
+
+	_, now := utils.CurrentTime()
+	_, err := r.dynamoDBClient.UpdateItem(&dynamodb.UpdateItemInput{
+		ExpressionAttributeNames: map[string]*string{
+			"#checkRunEnabled": aws.String(repoModels.RepositoryCheckRunEnabledColumn),
+			"#note":            aws.String(repoModels.RepositoryNoteColumn),
+			"#dateModified":    aws.String(repoModels.RepositoryDateModifiedColumn),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":checkRunEnabledValue": {
+				BOOL: aws.Bool(checkRunEnabled),
+			},
+			":noteValue": {
+				S: aws.String(fmt.Sprintf("%s Updated check run enabled to %t on %s %s.", existingNote, checkRunEnabled, now, byUserStr)),
+			},
+			":dateModifiedValue": {
+				S: aws.String(now),
+			},
+		},
+		Key: map[string]*dynamodb.AttributeValue{
+			repoModels.RepositoryIDColumn: {S: aws.String(repositoryID)},
+		},
+		TableName:        aws.String(r.repositoryTableName),
+		UpdateExpression: aws.String("SET #checkRunEnabled = :checkRunEnabledValue, #note = :noteValue, #dateModified = :dateModifiedValue"),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem with update, error: %+v", err.Error())
+	}
+
+	return err
+}
+
+// UpdateRepositoryTrivialChangeRules sets the trivial change rules of the specified repository, no rules removes the attribute
+func (r *Repository) UpdateRepositoryTrivialChangeRules(ctx context.Context, repositoryID string, rules []trivialchange.Rule) error {
+	f := logrus.Fields{
+		"functionName":   "v2.repositories.repository.UpdateRepositoryTrivialChangeRules",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"repositoryID":   repositoryID,
+		"ruleCount":      len(rules),
+	}
+
+	existingModel, getErr := r.GitLabGetRepository(ctx, repositoryID)
+	if getErr != nil {
+		return getErr
+	}
+
+	var existingNote = ""
+	if existingModel.Note != "" {
+		if !strings.HasSuffix(strings.TrimSpace(existingModel.Note), ".") {
+			existingNote = strings.TrimSpace(existingModel.Note) + ". "
+		} else {
+			existingNote = strings.TrimSpace(existingModel.Note) + " "
+		}
+	}
+	userNameFromCtx := utils.GetUserNameFromContext(ctx)
+	byUserStr := ""
+	if userNameFromCtx != "" {
+		byUserStr = fmt.Sprintf("by user: %s", userNameFromCtx)
+	}
+
+	_, now := utils.CurrentTime()
+	expressionAttributeValues := map[string]*dynamodb.AttributeValue{
+		":noteValue": {
+			S: aws.String(fmt.Sprintf("%s Updated trivial change rules to %d rules on %s %s.", existingNote, len(rules), now, byUserStr)),
+		},
+		":dateModifiedValue": {
+			S: aws.String(now),
+		},
+	}
+	updateExpression := "SET #note = :noteValue, #dateModified = :dateModifiedValue REMOVE #trivialChangeRules"
+	if len(rules) > 0 {
+		rulesValue, marshalErr := dynamodbattribute.Marshal(rules)
+		if marshalErr != nil {
+			log.WithFields(f).WithError(marshalErr).Warn("unable to marshal the trivial change rules")
+			return marshalErr
+		}
+		expressionAttributeValues[":trivialChangeRulesValue"] = rulesValue
+		updateExpression = "SET #trivialChangeRules = :trivialChangeRulesValue, #note = :noteValue, #dateModified = :dateModifiedValue"
+	}
+
+	_, err := r.dynamoDBClient.UpdateItem(&dynamodb.UpdateItemInput{
+		ExpressionAttributeNames: map[string]*string{
+			"#trivialChangeRules": aws.String(repoModels.RepositoryTrivialChangeRulesColumn),
+			"#note":               aws.String(repoModels.RepositoryNoteColumn),
+			"#dateModified":       aws.String(repoModels.RepositoryDateModifiedColumn),
+		},
+		ExpressionAttributeValues: expressionAttributeValues,
+		Key: map[string]*dynamodb.AttributeValue{
+			repoModels.RepositoryIDColumn: {S: aws.String(repositoryID)},
+		},
+		TableName:        aws.String(r.repositoryTableName),
+		UpdateExpression: aws.String(updateExpression),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem with update, error: %+v", err.Error())
+	}
+
+	return err
+}
+
+// UpdateRepositoryBranches sets the include and exclude base branch globs of the specified repository, an empty list
+// removes the attribute
+func (r *Repository) UpdateRepositoryBranches(ctx context.Context, repositoryID string, includeBranches, excludeBranches []string) error {
+	f := logrus.Fields{
+		"functionName":    "v2.repositories.repository.UpdateRepositoryBranches",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"repositoryID":    repositoryID,
+		"includeBranches": strings.Join(includeBranches, ","),
+		"excludeBranches": strings.Join(excludeBranches, ","),
+	}
+
+	existingModel, getErr := r.GitLabGetRepository(ctx, repositoryID)
+	if getErr != nil {
+		return getErr
+	}
+
+	var existingNote = ""
+	if existingModel.Note != "" {
+		if !strings.HasSuffix(strings.TrimSpace(existingModel.Note), ".") {
+			existingNote = strings.TrimSpace(existingModel.Note) + ". "
+		} else {
+			existingNote = strings.TrimSpace(existingModel.Note) + " "
+		}
+	}
+	userNameFromCtx := utils.GetUserNameFromContext(ctx)
+	byUserStr := ""
+	if userNameFromCtx != "" {
+		byUserStr = fmt.Sprintf("by user: %s", userNameFromCtx)
+	}
+
+	_, now := utils.CurrentTime()
+	expressionAttributeValues := map[string]*dynamodb.AttributeValue{
+		":noteValue": {
+			S: aws.String(fmt.Sprintf("%s Updated branches to include: [%s] exclude: [%s] on %s %s.", existingNote,
+				strings.Join(includeBranches, ", "), strings.Join(excludeBranches, ", "), now, byUserStr)),
+		},
+		":dateModifiedValue": {
+			S: aws.String(now),
+		},
+	}
+	setExpressions := []string{"#note = :noteValue", "#dateModified = :dateModifiedValue"}
+	var removeExpressions []string
+	for _, column := range []struct {
+		name     string
+		branches []string
+	}{{"includeBranches", includeBranches}, {"excludeBranches", excludeBranches}} {
+		name, branches := column.name, column.branches
+		if len(branches) == 0 {
+			removeExpressions = append(removeExpressions, "#"+name)
+			continue
+		}
+		expressionAttributeValues[":"+name+"Value"] = &dynamodb.AttributeValue{SS: aws.StringSlice(branches)}
+		setExpressions = append(setExpressions, fmt.Sprintf("#%s = :%sValue", name, name))
+	}
+	updateExpression := "SET " + strings.Join(setExpressions, ", ")
+	if len(removeExpressions) > 0 {
+		updateExpression += " REMOVE " + strings.Join(removeExpressions, ", ")
+	}
+
+	_, err := r.dynamoDBClient.UpdateItem(&dynamodb.UpdateItemInput{
+		ExpressionAttributeNames: map[string]*string{
+			"#includeBranches": aws.String(repoModels.RepositoryIncludeBranchesColumn),
+			"#excludeBranches": aws.String(repoModels.RepositoryExcludeBranchesColumn),
+			"#note":            aws.String(repoModels.RepositoryNoteColumn),
+			"#dateModified":    aws.String(repoModels.RepositoryDateModifiedColumn),
+		},
+		ExpressionAttributeValues: expressionAttributeValues,
+		Key: map[string]*dynamodb.AttributeValue{
+			repoModels.RepositoryIDColumn: {S: aws.String(repositoryID)},
+		},
+		TableName:        aws.String(r.repositoryTableName),
+		UpdateExpression: aws.String(updateExpression),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem with update, error: %+v", err.Error())
+	}
+
+	return err
+}
+
+// UpdateRepositoryCLAGroupBindings sets the CLA group bindings of the specified repository, no bindings removes the attribute
+func (r *Repository) UpdateRepositoryCLAGroupBindings(ctx context.Context, repositoryID string, bindings []clagroupbinding.Binding) error {
+	f := logrus.Fields{
+		"functionName":   "v2.repositories.repository.UpdateRepositoryCLAGroupBindings",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"repositoryID":   repositoryID,
+		"bindingCount":   len(bindings),
+	}
+
+	existingModel, getErr := r.GitLabGetRepository(ctx, repositoryID)
+	if getErr != nil {
+		return getErr
+	}
+
+	var existingNote = ""
+	if existingModel.Note != "" {
+		if !strings.HasSuffix(strings.TrimSpace(existingModel.Note), ".") {
+			existingNote = strings.TrimSpace(existingModel.Note) + ". "
+		} else {
+			existingNote = strings.TrimSpace(existingModel.Note) + " "
+		}
+	}
+	userNameFromCtx := utils.GetUserNameFromContext(ctx)
+	byUserStr := ""
+	if userNameFromCtx != "" {
+		byUserStr = fmt.Sprintf("by user: %s", userNameFromCtx)
+	}
+
+	_, now := utils.CurrentTime()
+	expressionAttributeValues := map[string]*dynamodb.AttributeValue{
+		":noteValue": {
+			S: aws.String(fmt.Sprintf("%s Updated CLA group bindings to %d bindings on %s %s.", existingNote, len(bindings), now, byUserStr)),
+		},
+		":dateModifiedValue": {
+			S: aws.String(now),
+		},
+	}
+	updateExpression := "SET #note = :noteValue, #dateModified = :dateModifiedValue REMOVE #claGroupBindings"
+	if len(bindings) > 0 {
+		bindingsValue, marshalErr := dynamodbattribute.Marshal(bindings)
+		if marshalErr != nil {
+			log.WithFields(f).WithError(marshalErr).Warn("unable to marshal the CLA group bindings")
+			return marshalErr
+		}
+		expressionAttributeValues[":claGroupBindingsValue"] = bindingsValue
+		updateExpression = "SET #claGroupBindings = :claGroupBindingsValue, #note = :noteValue, #dateModified = :dateModifiedValue"
+	}
+
+	_, err := r.dynamoDBClient.UpdateItem(&dynamodb.UpdateItemInput{
+		ExpressionAttributeNames: map[string]*string{
+			"#claGroupBindings": aws.String(repoModels.RepositoryCLAGroupBindingsColumn),
+			"#note":             aws.String(repoModels.RepositoryNoteColumn),
+			"#dateModified":     aws.String(repoModels.RepositoryDateModifiedColumn),
+		},
+		ExpressionAttributeValues: expressionAttributeValues,
+		Key: map[string]*dynamodb.AttributeValue{
+			repoModels.RepositoryIDColumn: {S: aws.String(repositoryID)},
+		},
+		TableName:        aws.String(r.repositoryTableName),
+		UpdateExpression: aws.String(updateExpression),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("problem with update, error: %+v", err.Error())
+	}
+
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/communitybridge/easycla/cla-backend-go/events"
 	"github.com/communitybridge/easycla/cla-backend-go/github_organizations"
@@ -29,11 +30,13 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/github"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/communitybridge/easycla/cla-backend-go/clagroupbinding"
 	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
 	v2Models "github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
 	v1Repositories "github.com/communitybridge/easycla/cla-backend-go/repositories"
+	"github.com/communitybridge/easycla/cla-backend-go/trivialchange"
 	v2ProjectService "github.com/communitybridge/easycla/cla-backend-go/v2/project-service"
 )
 
@@ -51,6 +54,14 @@ type ServiceInterface interface {
 	GitHubDisableCLAGroupRepositories(ctx context.Context, claGroupID string) error
 	GitHubGetProtectedBranch(ctx context.Context, projectSFID, repositoryID, branchName string) (*v2Models.GithubRepositoryBranchProtection, error)
 	GitHubUpdateProtectedBranch(ctx context.Context, projectSFID, repositoryID string, input *v2Models.GithubRepositoryBranchProtectionInput) (*v2Models.GithubRepositoryBranchProtection, error)
+	GitHubUpdateRepositoryCheckRun(ctx context.Context, projectSFID, repositoryID string, checkRunEnabled bool) (*v1Models.GithubRepository, error)
+
+	// GitHub and GitLab
+
+	UpdateRepositoryEnforcementMode(ctx context.Context, projectSFID, repositoryID, enforcementMode string) (*v1Models.GithubRepository, error)
+	UpdateRepositoryTrivialChangeRules(ctx context.Context, projectSFID, repositoryID string, input []*v2Models.TrivialChangeRule) (*v1Models.GithubRepository, error)
+	UpdateRepositoryBranches(ctx context.Context, projectSFID, repositoryID string, includeBranches, excludeBranches []string) (*v1Models.GithubRepository, error)
+	UpdateRepositoryCLAGroupBindings(ctx context.Context, projectSFID, repositoryID string, input []*v2Models.ClaGroupBinding) (*v1Models.GithubRepository, error)
 
 	// GitLab
 
@@ -99,6 +110,14 @@ var (
 	requiredBranchProtectionChecks = []string{"EasyCLA"}
 	// ErrInvalidBranchProtectionName is returned when invalid protection option is supplied
 	ErrInvalidBranchProtectionName = errors.New("invalid protection option")
+	// ErrInvalidEnforcementMode is returned when the enforcement mode is neither cla, dco nor cla-group
+	ErrInvalidEnforcementMode = errors.New("invalid enforcement mode")
+	// ErrCheckRunNotSupported is returned when the check run option is set on a repository which is not a GitHub repository
+	ErrCheckRunNotSupported = errors.New("check runs are only supported for github repositories")
+	// ErrInvalidBranchPattern is returned when a branch glob is empty
+	ErrInvalidBranchPattern = errors.New("invalid branch pattern")
+	// ErrCLAGroupNotLinked is returned when a CLA group binding refers to a CLA group which is not linked to the project
+	ErrCLAGroupNotLinked = errors.New("CLA group is not linked to the project")
 )
 
 // NewService creates a new githubOrganizations service
@@ -437,6 +456,21 @@ func (s *Service) GitHubGetProtectedBranch(ctx context.Context, projectSFID, rep
 		BranchName: &branchName,
 	}
 
+	// the rulesets are reported whether the branch has a classic protection rule or not, they may be unavailable
+	// for the plan of the organization, so the classic protection is still reported when they can't be loaded
+	rulesets, err := branchProtectionRepository.GetBranchRulesets(ctx, owner, githubRepoName, branchName)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("getting the github rulesets for owner : %s, gitV1Repository : %s and branch : %s failed", owner, githubRepoName, branchName)
+	} else {
+		result.Rulesets = toBranchRulesetModels(rulesets)
+		result.RequiredByRuleset = len(rulesets) > 0
+		for _, check := range requiredBranchProtectionChecks {
+			if !branch_protection.RequiresStatusCheck(rulesets, check) {
+				result.RequiredByRuleset = false
+			}
+		}
+	}
+
 	branchProtection, err := branchProtectionRepository.GetProtectedBranch(ctx, owner, githubRepoName, branchName)
 	if err != nil {
 		if errors.Is(err, branch_protection.ErrBranchNotProtected) {
@@ -524,6 +558,16 @@ func (s *Service) GitHubUpdateProtectedBranch(ctx context.Context, projectSFID,
 		}
 	}
 
+	if aws.BoolValue(input.UseRuleset) {
+		log.WithFields(f).Debugf("enabling the %s ruleset on repository...", branch_protection.EasyCLARulesetName)
+		err = branchProtectionRepository.EnableRulesetProtection(ctx, owner, githubRepoName, branchName, aws.BoolValue(input.EnforceAdmin), requiredChecks, disabledChecks)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("problem enabling github ruleset")
+			return nil, err
+		}
+		return s.GitHubGetProtectedBranch(ctx, projectSFID, repositoryID, branchName)
+	}
+
 	log.WithFields(f).Debugf("enabling branch protection on repository...")
 	err = branchProtectionRepository.EnableBranchProtection(ctx, owner, githubRepoName, branchName, *input.EnforceAdmin, requiredChecks, disabledChecks)
 	if err != nil {
@@ -534,6 +578,322 @@ func (s *Service) GitHubUpdateProtectedBranch(ctx context.Context, projectSFID,
 	return s.GitHubGetProtectedBranch(ctx, projectSFID, repositoryID, branchName)
 }
 
+// UpdateRepositoryEnforcementMode sets whether the repository pull/merge requests are checked for CLA coverage or DCO sign-offs,
+// the cla-group enforcement mode removes the repository enforcement mode so the enforcement mode of the CLA group applies
+func (s *Service) UpdateRepositoryEnforcementMode(ctx context.Context, projectSFID, repositoryID, enforcementMode string) (*v1Models.GithubRepository, error) {
+	f := logrus.Fields{
+		"functionName":    "v2.repositories.service.UpdateRepositoryEnforcementMode",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"projectSFID":     projectSFID,
+		"repositoryID":    repositoryID,
+		"enforcementMode": enforcementMode,
+	}
+
+	if enforcementMode != utils.EnforcementModeCLA && enforcementMode != utils.EnforcementModeDCO && enforcementMode != utils.EnforcementModeCLAGroup {
+		return nil, ErrInvalidEnforcementMode
+	}
+
+	repoModel, err := s.gitV2Repository.GitLabGetRepository(ctx, repositoryID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("fetching repository %s, failed", repositoryID)
+		return nil, err
+	}
+	if repoModel.ProjectSFID != projectSFID {
+		return nil, &utils.GitHubRepositoryNotFound{
+			Message: fmt.Sprintf("repository %s doesn't belong to project : %s", repositoryID, projectSFID),
+		}
+	}
+
+	// the repositories without an enforcement mode use the enforcement mode of their CLA group
+	repositoryEnforcementMode := enforcementMode
+	if enforcementMode == utils.EnforcementModeCLAGroup {
+		repositoryEnforcementMode = ""
+	}
+	log.WithFields(f).Debugf("updating repository %s enforcement mode from %q to %q", repoModel.RepositoryName, repoModel.EnforcementMode, repositoryEnforcementMode)
+	if err = s.gitV2Repository.UpdateRepositoryEnforcementMode(ctx, repositoryID, repositoryEnforcementMode); err != nil {
+		return nil, err
+	}
+
+	repoModel.EnforcementMode = repositoryEnforcementMode
+	response := repoModel.ToGitHubModel()
+	if response == nil {
+		return nil, fmt.Errorf("unable to convert repository %s with external ID: %s", repositoryID, repoModel.RepositoryExternalID)
+	}
+
+	return response, nil
+}
+
+// GitHubUpdateRepositoryCheckRun sets whether the GitHub repository pull requests get a check run or a commit status
+func (s *Service) GitHubUpdateRepositoryCheckRun(ctx context.Context, projectSFID, repositoryID string, checkRunEnabled bool) (*v1Models.GithubRepository, error) {
+	f := logrus.Fields{
+		"functionName":    "v2.repositories.service.GitHubUpdateRepositoryCheckRun",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"projectSFID":     projectSFID,
+		"repositoryID":    repositoryID,
+		"checkRunEnabled": checkRunEnabled,
+	}
+
+	repoModel, err := s.gitV2Repository.GitLabGetRepository(ctx, repositoryID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("fetching repository %s, failed", repositoryID)
+		return nil, err
+	}
+	if repoModel.ProjectSFID != projectSFID {
+		return nil, &utils.GitHubRepositoryNotFound{
+			Message: fmt.Sprintf("repository %s doesn't belong to project : %s", repositoryID, projectSFID),
+		}
+	}
+	if repoModel.RepositoryType != utils.GitHubType {
+		return nil, ErrCheckRunNotSupported
+	}
+
+	log.WithFields(f).Debugf("updating repository %s check run enabled from %t to %t", repoModel.RepositoryName, repoModel.CheckRunEnabled, checkRunEnabled)
+	if err = s.gitV2Repository.GitHubUpdateRepositoryCheckRun(ctx, repositoryID, checkRunEnabled); err != nil {
+		return nil, err
+	}
+
+	repoModel.CheckRunEnabled = checkRunEnabled
+	response := repoModel.ToGitHubModel()
+	if response == nil {
+		return nil, fmt.Errorf("unable to convert repository %s with external ID: %s", repositoryID, repoModel.RepositoryExternalID)
+	}
+
+	return response, nil
+}
+
+// UpdateRepositoryTrivialChangeRules sets the rules which exempt the trivial repository pull/merge requests from the CLA check
+func (s *Service) UpdateRepositoryTrivialChangeRules(ctx context.Context, projectSFID, repositoryID string, input []*v2Models.TrivialChangeRule) (*v1Models.GithubRepository, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.repositories.service.UpdateRepositoryTrivialChangeRules",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"projectSFID":    projectSFID,
+		"repositoryID":   repositoryID,
+		"ruleCount":      len(input),
+	}
+
+	rules := toTrivialChangeRules(input)
+	for _, rule := range rules {
+		if err := trivialchange.ValidateRule(rule); err != nil {
+			return nil, err
+		}
+	}
+
+	repoModel, err := s.gitV2Repository.GitLabGetRepository(ctx, repositoryID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("fetching repository %s, failed", repositoryID)
+		return nil, err
+	}
+	if repoModel.ProjectSFID != projectSFID {
+		return nil, &utils.GitHubRepositoryNotFound{
+			Message: fmt.Sprintf("repository %s doesn't belong to project : %s", repositoryID, projectSFID),
+		}
+	}
+
+	log.WithFields(f).Debugf("updating repository %s trivial change rules from %d to %d rules", repoModel.RepositoryName, len(repoModel.TrivialChangeRules), len(rules))
+	if err = s.gitV2Repository.UpdateRepositoryTrivialChangeRules(ctx, repositoryID, rules); err != nil {
+		return nil, err
+	}
+
+	repoModel.TrivialChangeRules = rules
+	response := repoModel.ToGitHubModel()
+	if response == nil {
+		return nil, fmt.Errorf("unable to convert repository %s with external ID: %s", repositoryID, repoModel.RepositoryExternalID)
+	}
+
+	return response, nil
+}
+
+// UpdateRepositoryBranches sets the base branch globs of the repository pull/merge requests which are checked by EasyCLA
+func (s *Service) UpdateRepositoryBranches(ctx context.Context, projectSFID, repositoryID string, includeBranches, excludeBranches []string) (*v1Models.GithubRepository, error) {
+	f := logrus.Fields{
+		"functionName":    "v2.repositories.service.UpdateRepositoryBranches",
+		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
+		"projectSFID":     projectSFID,
+		"repositoryID":    repositoryID,
+		"includeBranches": strings.Join(includeBranches, ","),
+		"excludeBranches": strings.Join(excludeBranches, ","),
+	}
+
+	includeBranches, err := normalizeBranchPatterns(includeBranches)
+	if err != nil {
+		return nil, err
+	}
+	excludeBranches, err = normalizeBranchPatterns(excludeBranches)
+	if err != nil {
+		return nil, err
+	}
+
+	repoModel, err := s.gitV2Repository.GitLabGetRepository(ctx, repositoryID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("fetching repository %s, failed", repositoryID)
+		return nil, err
+	}
+	if repoModel.ProjectSFID != projectSFID {
+		return nil, &utils.GitHubRepositoryNotFound{
+			Message: fmt.Sprintf("repository %s doesn't belong to project : %s", repositoryID, projectSFID),
+		}
+	}
+
+	log.WithFields(f).Debugf("updating repository %s branches", repoModel.RepositoryName)
+	if err = s.gitV2Repository.UpdateRepositoryBranches(ctx, repositoryID, includeBranches, excludeBranches); err != nil {
+		return nil, err
+	}
+
+	repoModel.IncludeBranches = includeBranches
+	repoModel.ExcludeBranches = excludeBranches
+	response := repoModel.ToGitHubModel()
+	if response == nil {
+		return nil, fmt.Errorf("unable to convert repository %s with external ID: %s", repositoryID, repoModel.RepositoryExternalID)
+	}
+
+	return response, nil
+}
+
+// normalizeBranchPatterns trims and de-duplicates the branch globs, the globs are stored as a string set
+// UpdateRepositoryCLAGroupBindings sets the CLA group bindings of the repository, which select the CLA groups covering
+// the pull/merge requests of a monorepo by the changed paths and the base branch
+func (s *Service) UpdateRepositoryCLAGroupBindings(ctx context.Context, projectSFID, repositoryID string, input []*v2Models.ClaGroupBinding) (*v1Models.GithubRepository, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.repositories.service.UpdateRepositoryCLAGroupBindings",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"projectSFID":    projectSFID,
+		"repositoryID":   repositoryID,
+		"bindingCount":   len(input),
+	}
+
+	bindings, err := toCLAGroupBindings(input)
+	if err != nil {
+		return nil, err
+	}
+
+	repoModel, err := s.gitV2Repository.GitLabGetRepository(ctx, repositoryID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("fetching repository %s, failed", repositoryID)
+		return nil, err
+	}
+	if repoModel.ProjectSFID != projectSFID {
+		return nil, &utils.GitHubRepositoryNotFound{
+			Message: fmt.Sprintf("repository %s doesn't belong to project : %s", repositoryID, projectSFID),
+		}
+	}
+
+	linked := map[string]bool{}
+	for _, binding := range bindings {
+		if linked[binding.CLAGroupID] {
+			continue
+		}
+		mappings, mappingErr := s.projectsClaGroupsRepo.GetProjectsIdsForClaGroup(ctx, binding.CLAGroupID)
+		if mappingErr != nil {
+			log.WithFields(f).WithError(mappingErr).Warnf("unable to get project IDs for CLA Group: %s", binding.CLAGroupID)
+			return nil, mappingErr
+		}
+		for _, cgm := range mappings {
+			if cgm.ProjectSFID == projectSFID || cgm.FoundationSFID == projectSFID {
+				linked[binding.CLAGroupID] = true
+				break
+			}
+		}
+		if !linked[binding.CLAGroupID] {
+			return nil, fmt.Errorf("%w: CLA group %s, project %s", ErrCLAGroupNotLinked, binding.CLAGroupID, projectSFID)
+		}
+	}
+
+	log.WithFields(f).Debugf("updating repository %s CLA group bindings from %d to %d bindings", repoModel.RepositoryName, len(repoModel.ClaGroupBindings), len(bindings))
+	if err = s.gitV2Repository.UpdateRepositoryCLAGroupBindings(ctx, repositoryID, bindings); err != nil {
+		return nil, err
+	}
+
+	repoModel.ClaGroupBindings = bindings
+	response := repoModel.ToGitHubModel()
+	if response == nil {
+		return nil, fmt.Errorf("unable to convert repository %s with external ID: %s", repositoryID, repoModel.RepositoryExternalID)
+	}
+
+	return response, nil
+}
+
+func normalizeBranchPatterns(patterns []string) ([]string, error) {
+	var response []string
+	seen := map[string]bool{}
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return nil, ErrInvalidBranchPattern
+		}
+		if seen[pattern] {
+			continue
+		}
+		seen[pattern] = true
+		response = append(response, pattern)
+	}
+	return response, nil
+}
+
+// toTrivialChangeRules converts the v2 trivial change rule models
+func toTrivialChangeRules(input []*v2Models.TrivialChangeRule) []trivialchange.Rule {
+	var rules []trivialchange.Rule
+	for _, rule := range input {
+		if rule == nil {
+			continue
+		}
+		rules = append(rules, trivialchange.Rule{
+			Paths:           rule.Paths,
+			MaxChangedLines: rule.MaxChangedLines,
+		})
+	}
+	return rules
+}
+
+// toV2TrivialChangeRules converts the trivial change rules to the v2 models
+func toV2TrivialChangeRules(rules []trivialchange.Rule) []*v2Models.TrivialChangeRule {
+	var response []*v2Models.TrivialChangeRule
+	for _, rule := range rules {
+		response = append(response, &v2Models.TrivialChangeRule{
+			Paths:           rule.Paths,
+			MaxChangedLines: rule.MaxChangedLines,
+		})
+	}
+	return response
+}
+
+// toCLAGroupBindings converts and validates the v2 CLA group binding models, the paths and branches are trimmed
+func toCLAGroupBindings(input []*v2Models.ClaGroupBinding) ([]clagroupbinding.Binding, error) {
+	var bindings []clagroupbinding.Binding
+	for _, binding := range input {
+		if binding == nil {
+			continue
+		}
+		response := clagroupbinding.Binding{
+			CLAGroupID: strings.TrimSpace(utils.StringValue(binding.ClaGroupID)),
+		}
+		for _, prefix := range binding.Paths {
+			response.Paths = append(response.Paths, strings.TrimSpace(prefix))
+		}
+		for _, pattern := range binding.Branches {
+			response.Branches = append(response.Branches, strings.TrimSpace(pattern))
+		}
+		if err := clagroupbinding.ValidateBinding(response); err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, response)
+	}
+	return bindings, nil
+}
+
+// toV2CLAGroupBindings converts the CLA group bindings to the v2 models
+func toV2CLAGroupBindings(bindings []clagroupbinding.Binding) []*v2Models.ClaGroupBinding {
+	var response []*v2Models.ClaGroupBinding
+	for _, binding := range bindings {
+		claGroupID := binding.CLAGroupID
+		response = append(response, &v2Models.ClaGroupBinding{
+			ClaGroupID: &claGroupID,
+			Paths:      binding.Paths,
+			Branches:   binding.Branches,
+		})
+	}
+	return response
+}
+
 // getGithubRepo service function
 func (s *Service) getGithubRepo(ctx context.Context, projectSFID, repositoryID string) (*v1Models.GithubRepository, error) {
 	f := logrus.Fields{
@@ -581,7 +941,8 @@ func (s *Service) getBranchProtectionRepositoryForOrgName(ctx context.Context, g
 		return nil, errors.New("github app not installed on github organization")
 	}
 
-	branchProtectionRepo, err := branch_protection.NewBranchProtectionRepository(githubOrg.List[0].OrganizationInstallationID, branch_protection.EnableNonBlockingLimiter())
+	branchProtectionRepo, err := branch_protection.NewBranchProtectionRepository(githubOrg.List[0].OrganizationInstallationID, branch_protection.EnableNonBlockingLimiter(),
+		branch_protection.WithGitHubBaseURL(githubOrg.List[0].GithubBaseURL))
 	if err != nil {
 		return nil, err
 	}
@@ -638,6 +999,21 @@ func (s *Service) getRequiredProtectedBranchCheckStatus(branchProtectionRule *br
 	return result
 }
 
+// toBranchRulesetModels converts the rulesets applying to the branch to the response models
+func toBranchRulesetModels(rulesets []*branch_protection.BranchRuleset) []*v2Models.GithubRepositoryBranchRuleset {
+	result := []*v2Models.GithubRepositoryBranchRuleset{}
+	for _, ruleset := range rulesets {
+		result = append(result, &v2Models.GithubRepositoryBranchRuleset{
+			ID:                   ruleset.ID,
+			Name:                 ruleset.Name,
+			SourceType:           ruleset.SourceType,
+			Source:               ruleset.Source,
+			RequiredStatusChecks: ruleset.RequiredStatusChecks,
+		})
+	}
+	return result
+}
+
 // GitHubDisableCLAGroupRepositories service function to disable CLA group repositories
 func (s *Service) GitHubDisableCLAGroupRepositories(ctx context.Context, claGroupID string) error {
 	f := logrus.Fields{
@@ -0,0 +1,61 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package resign_campaigns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/LF-Engineering/lfx-kit/auth"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/resign_campaigns"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+// Configure setup the re-sign campaign API handlers
+func Configure(api *operations.EasyclaAPI, service Service, projectClaGroupsRepo projects_cla_groups.Repository) {
+	api.ResignCampaignsListResignCampaignsHandler = resign_campaigns.ListResignCampaignsHandlerFunc(func(params resign_campaigns.ListResignCampaignsParams, authUser *auth.User) middleware.Responder {
+		reqID := utils.GetRequestID(params.XREQUESTID)
+		ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTID, reqID) // nolint
+		utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+		f := logrus.Fields{
+			"functionName":   "v2.resign_campaigns.handlers.ResignCampaignsListResignCampaignsHandler",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			"claGroupID":     params.ClaGroupID,
+			"authUser":       authUser.UserName,
+		}
+
+		projectCLAGroups, lookupErr := projectClaGroupsRepo.GetProjectsIdsForClaGroup(ctx, params.ClaGroupID)
+		if lookupErr != nil || len(projectCLAGroups) == 0 {
+			msg := fmt.Sprintf("unable to lookup CLA Group mapping using CLA Group ID: %s", params.ClaGroupID)
+			log.WithFields(f).WithError(lookupErr).Warn(msg)
+			return resign_campaigns.NewListResignCampaignsNotFound().WithXRequestID(reqID).WithPayload(utils.ErrorResponseNotFoundWithError(reqID, msg, lookupErr))
+		}
+
+		var projectSFIDs []string
+		for _, projectCLAGroup := range projectCLAGroups {
+			projectSFIDs = append(projectSFIDs, projectCLAGroup.ProjectSFID)
+		}
+		if !utils.IsUserAuthorizedForAnyProjects(ctx, authUser, projectSFIDs, utils.ALLOW_ADMIN_SCOPE) {
+			msg := fmt.Sprintf("authUser '%s' does not have access to view the re-sign campaigns with Project scope of any %s",
+				authUser.UserName, strings.Join(projectSFIDs, ","))
+			log.WithFields(f).Debug(msg)
+			return resign_campaigns.NewListResignCampaignsForbidden().WithXRequestID(reqID).WithPayload(utils.ErrorResponseForbidden(reqID, msg))
+		}
+
+		result, err := service.GetCampaigns(ctx, params.ClaGroupID)
+		if err != nil {
+			msg := fmt.Sprintf("unable to load the re-sign campaigns for CLA Group ID: %s", params.ClaGroupID)
+			log.WithFields(f).WithError(err).Warn(msg)
+			return resign_campaigns.NewListResignCampaignsInternalServerError().WithXRequestID(reqID).WithPayload(utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+		}
+
+		return resign_campaigns.NewListResignCampaignsOK().WithXRequestID(reqID).WithPayload(result)
+	})
+}
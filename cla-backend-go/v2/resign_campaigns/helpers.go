@@ -0,0 +1,77 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package resign_campaigns
+
+import (
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+)
+
+// isClaTypeSignature returns true if the signature is an ICLA or CCLA signature of the specified CLA type
+func isClaTypeSignature(signature *signatures.ItemSignature, claType string) bool {
+	switch claType {
+	case utils.ClaTypeICLA:
+		return signature.SignatureType == utils.SignatureTypeCLA && signature.SignatureReferenceType == utils.SignatureReferenceTypeUser
+	case utils.ClaTypeCCLA:
+		return signature.SignatureType == utils.SignatureTypeCCLA && signature.SignatureReferenceType == utils.SignatureReferenceTypeCompany
+	}
+	return false
+}
+
+// supersededSignatures returns the signatures of the CLA type signed with a previous major version by signers who have
+// not signed the new major version yet - signatures already part of a re-sign campaign are skipped
+func supersededSignatures(claGroupSignatures []*signatures.ItemSignature, claType string, majorVersion int) []*signatures.ItemSignature {
+	var response []*signatures.ItemSignature
+	for _, signature := range claGroupSignatures {
+		if !isClaTypeSignature(signature, claType) || signature.SignatureDocumentMajorVersion >= majorVersion || signature.SignatureResignCampaignID != "" {
+			continue
+		}
+		if hasResigned(claGroupSignatures, claType, signature.SignatureReferenceID, majorVersion) {
+			continue
+		}
+		response = append(response, signature)
+	}
+	return response
+}
+
+// hasResigned returns true if the signer has a signature of the CLA type with the major version or later
+func hasResigned(claGroupSignatures []*signatures.ItemSignature, claType, referenceID string, majorVersion int) bool {
+	for _, signature := range claGroupSignatures {
+		if isClaTypeSignature(signature, claType) && signature.SignatureReferenceID == referenceID && signature.SignatureDocumentMajorVersion >= majorVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// isResignRequired returns true when the re-sign deadline of the superseded signature has passed
+func isResignRequired(signature *signatures.ItemSignature) bool {
+	return signatures.IsResignRequired(&v1Models.Signature{
+		SignatureID:               signature.SignatureID,
+		SignatureResignRequiredOn: signature.SignatureResignRequiredOn,
+	})
+}
+
+// signatureEmail returns the email address of the signer recorded on the signature
+func signatureEmail(signature *signatures.ItemSignature) string {
+	if signature.SignatureType == utils.SignatureTypeCCLA {
+		return signature.SignatoryEmail
+	}
+	return signature.UserEmail
+}
+
+// signerName returns the name used to greet the signer
+func signerName(signature *signatures.ItemSignature) string {
+	if signature.SignatureType == utils.SignatureTypeCCLA {
+		if signature.SignatoryName != "" {
+			return signature.SignatoryName
+		}
+		return signature.SignatureReferenceName
+	}
+	if signature.UserName != "" {
+		return signature.UserName
+	}
+	return signature.SignatureReferenceName
+}
@@ -0,0 +1,56 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package resign_campaigns
+
+import (
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupersededSignatures(t *testing.T) {
+	iclaSignature := func(signatureID, userID string, majorVersion int) *signatures.ItemSignature {
+		return &signatures.ItemSignature{
+			SignatureID:                   signatureID,
+			SignatureType:                 utils.SignatureTypeCLA,
+			SignatureReferenceType:        utils.SignatureReferenceTypeUser,
+			SignatureReferenceID:          userID,
+			SignatureDocumentMajorVersion: majorVersion,
+		}
+	}
+
+	stampedSignature := iclaSignature("sig-4", "user-4", 1)
+	stampedSignature.SignatureResignCampaignID = "campaign-1"
+	cclaSignature := &signatures.ItemSignature{
+		SignatureID:                   "sig-5",
+		SignatureType:                 utils.SignatureTypeCCLA,
+		SignatureReferenceType:        utils.SignatureReferenceTypeCompany,
+		SignatureReferenceID:          "company-1",
+		SignatureDocumentMajorVersion: 1,
+	}
+
+	claGroupSignatures := []*signatures.ItemSignature{
+		iclaSignature("sig-1", "user-1", 1),
+		iclaSignature("sig-2", "user-2", 1),
+		iclaSignature("sig-3", "user-2", 2),
+		stampedSignature,
+		cclaSignature,
+		iclaSignature("sig-6", "user-6", 2),
+	}
+
+	superseded := supersededSignatures(claGroupSignatures, utils.ClaTypeICLA, 2)
+	if assert.Len(t, superseded, 1) {
+		assert.Equal(t, "sig-1", superseded[0].SignatureID)
+	}
+
+	superseded = supersededSignatures(claGroupSignatures, utils.ClaTypeCCLA, 2)
+	if assert.Len(t, superseded, 1) {
+		assert.Equal(t, "sig-5", superseded[0].SignatureID)
+	}
+
+	assert.True(t, hasResigned(claGroupSignatures, utils.ClaTypeICLA, "user-2", 2))
+	assert.False(t, hasResigned(claGroupSignatures, utils.ClaTypeCCLA, "company-1", 2))
+}
@@ -0,0 +1,25 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package resign_campaigns
+
+// Campaign is the re-sign campaign record saved in the store table
+type Campaign struct {
+	CampaignID           string `json:"campaign_id"`
+	ClaGroupID           string `json:"cla_group_id"`
+	ClaType              string `json:"cla_type"`
+	DocumentMajorVersion int    `json:"document_major_version"`
+	Policy               string `json:"policy"`
+	ResignDeadline       string `json:"resign_deadline"`
+	SignerCount          int    `json:"signer_count"`
+	StartedBy            string `json:"started_by"`
+	DateCreated          string `json:"date_created"`
+}
+
+// EnforcementSummary is the outcome of a re-sign deadline enforcement run
+type EnforcementSummary struct {
+	Checked  int
+	Enforced int
+	Resigned int
+	Failed   int
+}
@@ -0,0 +1,590 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package resign_campaigns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/LF-Engineering/lfx-kit/auth"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	v1SignatureParams "github.com/communitybridge/easycla/cla-backend-go/gen/v1/restapi/operations/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/models"
+	"github.com/communitybridge/easycla/cla-backend-go/gerrits"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/project/common"
+	v1ProjectService "github.com/communitybridge/easycla/cla-backend-go/project/service"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// campaignRecordTTL is how long we keep the re-sign campaign records - the superseded signatures keep their
+	// re-sign stamp regardless
+	campaignRecordTTL = 2 * 365 * 24 * time.Hour
+)
+
+// Service interface defines the re-sign campaign service methods
+type Service interface {
+	StartCampaigns(ctx context.Context, claGroupID, startedBy string) ([]*Campaign, error)
+	GetCampaigns(ctx context.Context, claGroupID string) (*models.ResignCampaignList, error)
+	EnforceResignDeadlines(ctx context.Context) (*EnforcementSummary, error)
+}
+
+// GerritGroups removes the signers from the Gerrit LDAP groups, implemented by gerrits.LFGroup
+type GerritGroups interface {
+	RemoveUserFromGroup(ctx context.Context, authUser *auth.User, claGroupID, groupName, userName string) error
+}
+
+type service struct {
+	v1ProjectService v1ProjectService.Service
+	signatureRepo    signatures.SignatureRepository
+	usersService     users.Service
+	storeRepository  store.Repository
+	eventsService    events.Service
+	gerritService    gerrits.Service
+	gerritGroups     GerritGroups
+	claLandingPage   string
+}
+
+// NewService creates a new re-sign campaign service
+func NewService(v1ProjectService v1ProjectService.Service, signatureRepo signatures.SignatureRepository, usersService users.Service, storeRepository store.Repository, eventsService events.Service, gerritService gerrits.Service, gerritGroups GerritGroups, claLandingPage string) Service {
+	return &service{
+		v1ProjectService: v1ProjectService,
+		signatureRepo:    signatureRepo,
+		usersService:     usersService,
+		storeRepository:  storeRepository,
+		eventsService:    eventsService,
+		gerritService:    gerritService,
+		gerritGroups:     gerritGroups,
+		claLandingPage:   claLandingPage,
+	}
+}
+
+// StartCampaigns starts a re-sign campaign for each CLA type of the CLA Group where the current document has a newer
+// major version than the signed signatures. The signers of the superseded signatures are emailed a signing link and
+// the signatures are stamped with the re-sign deadline from the CLA Group re-sign policy. Campaigns are only started
+// once per CLA type and document major version.
+func (s *service) StartCampaigns(ctx context.Context, claGroupID, startedBy string) ([]*Campaign, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.resign_campaigns.service.StartCampaigns",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"claGroupID":     claGroupID,
+		"startedBy":      startedBy,
+	}
+
+	claGroup, err := s.v1ProjectService.GetCLAGroupByID(ctx, claGroupID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", claGroupID)
+		return nil, err
+	}
+	if claGroup == nil {
+		return nil, fmt.Errorf("unable to locate CLA Group by ID: %s", claGroupID)
+	}
+
+	policy := claGroup.ProjectResignPolicy
+	if policy == "" || policy == utils.ResignPolicyNone {
+		log.WithFields(f).Debugf("CLA Group re-sign policy is '%s' - previous signatures remain valid", policy)
+		return nil, nil
+	}
+
+	claGroupSignatures, err := s.signatureRepo.GetClaGroupSignedSignatures(ctx, claGroupID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query the CLA Group signatures")
+		return nil, err
+	}
+
+	var campaigns []*Campaign
+	claTypeDocuments := map[string][]v1Models.ClaGroupDocument{}
+	if claGroup.ProjectICLAEnabled {
+		claTypeDocuments[utils.ClaTypeICLA] = claGroup.ProjectIndividualDocuments
+	}
+	if claGroup.ProjectCCLAEnabled {
+		claTypeDocuments[utils.ClaTypeCCLA] = claGroup.ProjectCorporateDocuments
+	}
+	for _, claType := range []string{utils.ClaTypeICLA, utils.ClaTypeCCLA} {
+		documents, ok := claTypeDocuments[claType]
+		if !ok {
+			continue
+		}
+		currentDocument, docErr := common.GetCurrentDocument(ctx, documents)
+		if docErr != nil {
+			log.WithFields(f).WithError(docErr).Warnf("unable to determine the current %s document", claType)
+			continue
+		}
+		majorVersion, convErr := strconv.Atoi(currentDocument.DocumentMajorVersion)
+		if convErr != nil || majorVersion == 0 {
+			log.WithFields(f).Debugf("no current %s document", claType)
+			continue
+		}
+
+		campaign, campaignErr := s.startCampaign(ctx, claGroup, claType, majorVersion, currentDocument.DocumentCreationDate, startedBy, claGroupSignatures)
+		if campaignErr != nil {
+			log.WithFields(f).WithError(campaignErr).Warnf("unable to start the %s re-sign campaign for major version: %d", claType, majorVersion)
+			return campaigns, campaignErr
+		}
+		if campaign != nil {
+			campaigns = append(campaigns, campaign)
+		}
+	}
+
+	return campaigns, nil
+}
+
+// startCampaign starts the re-sign campaign for the CLA type, returns nil if there are no superseded signatures or if
+// the campaign for the major version was already started
+func (s *service) startCampaign(ctx context.Context, claGroup *v1Models.ClaGroup, claType string, majorVersion int, publishedOn, startedBy string, claGroupSignatures []*signatures.ItemSignature) (*Campaign, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.resign_campaigns.service.startCampaign",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"claGroupID":     claGroup.ProjectID,
+		"claType":        claType,
+		"majorVersion":   majorVersion,
+	}
+
+	affectedSignatures := supersededSignatures(claGroupSignatures, claType, majorVersion)
+	if len(affectedSignatures) == 0 {
+		log.WithFields(f).Debug("no signatures of a previous major version - nothing to re-sign")
+		return nil, nil
+	}
+
+	// Only one campaign per CLA type and major version - the CLA Group documents are published once per CLA type
+	claimKey := campaignClaimKey(claGroup.ProjectID, claType, majorVersion)
+	claimed, err := s.storeRepository.SetValueIfNotExists(ctx, claimKey, time.Now().Add(campaignRecordTTL).Unix(), startedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		log.WithFields(f).Debug("re-sign campaign was already started for the major version")
+		return nil, nil
+	}
+
+	now, currentTime := utils.CurrentTime()
+	deadline := now
+	if claGroup.ProjectResignPolicy == utils.ResignPolicyGracePeriod {
+		if publishedTime, parseErr := utils.ParseDateTime(publishedOn); parseErr == nil {
+			deadline = publishedTime
+		}
+		deadline = deadline.Add(time.Duration(claGroup.ProjectResignGraceDays) * 24 * time.Hour)
+	}
+
+	campaign := &Campaign{
+		CampaignID:           uuid.Must(uuid.NewV4()).String(),
+		ClaGroupID:           claGroup.ProjectID,
+		ClaType:              claType,
+		DocumentMajorVersion: majorVersion,
+		Policy:               claGroup.ProjectResignPolicy,
+		ResignDeadline:       utils.TimeToString(deadline),
+		SignerCount:          len(affectedSignatures),
+		StartedBy:            startedBy,
+		DateCreated:          currentTime,
+	}
+	if err = s.saveCampaign(ctx, campaign); err != nil {
+		// Release the claim so that the campaign is started again on the next CLA Group update
+		if releaseErr := s.storeRepository.DeleteValue(ctx, claimKey); releaseErr != nil {
+			log.WithFields(f).WithError(releaseErr).Warnf("unable to release the re-sign campaign claim: %s", claimKey)
+		}
+		return nil, err
+	}
+
+	for _, signature := range affectedSignatures {
+		updates := map[string]interface{}{
+			"signature_resign_campaign_id": campaign.CampaignID,
+			"signature_resign_required_on": campaign.ResignDeadline,
+		}
+		if notifyErr := s.notifySigner(ctx, claGroup, campaign, signature); notifyErr != nil {
+			// The signature is stamped regardless - the signer will be asked to re-sign by the CLA check
+			log.WithFields(f).WithError(notifyErr).Warnf("unable to send the re-sign notification for signature: %s", signature.SignatureID)
+		} else {
+			_, notifiedOn := utils.CurrentTime()
+			updates["signature_resign_notified_on"] = notifiedOn
+		}
+
+		if updateErr := s.signatureRepo.UpdateSignature(ctx, signature.SignatureID, updates); updateErr != nil {
+			log.WithFields(f).WithError(updateErr).Warnf("unable to stamp signature: %s with the re-sign campaign", signature.SignatureID)
+			continue
+		}
+
+		// The immediate policy revokes the Gerrit access right away, the deadlines of the grace period policy are
+		// enforced by EnforceResignDeadlines
+		signature.SignatureResignCampaignID = campaign.CampaignID
+		signature.SignatureResignRequiredOn = campaign.ResignDeadline
+		if isResignRequired(signature) {
+			if enforceErr := s.enforceResignDeadline(ctx, signature); enforceErr != nil {
+				log.WithFields(f).WithError(enforceErr).Warnf("unable to revoke the Gerrit access of signature: %s", signature.SignatureID)
+			}
+		}
+	}
+
+	s.eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   events.ResignCampaignStarted,
+		LfUsername:  startedBy,
+		UserID:      startedBy,
+		CLAGroupID:  claGroup.ProjectID,
+		ProjectID:   claGroup.ProjectID,
+		ProjectName: claGroup.ProjectName,
+		EventData: &events.ResignCampaignStartedEventData{
+			CampaignID:           campaign.CampaignID,
+			ClaType:              claType,
+			DocumentMajorVersion: majorVersion,
+			Policy:               campaign.Policy,
+			ResignDeadline:       campaign.ResignDeadline,
+			SignerCount:          campaign.SignerCount,
+		},
+	})
+
+	log.WithFields(f).Infof("started re-sign campaign: %s for %d signers with deadline: %s", campaign.CampaignID, campaign.SignerCount, campaign.ResignDeadline)
+	return campaign, nil
+}
+
+// GetCampaigns returns the re-sign campaigns of the CLA Group with the progress of the affected signers
+func (s *service) GetCampaigns(ctx context.Context, claGroupID string) (*models.ResignCampaignList, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.resign_campaigns.service.GetCampaigns",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"claGroupID":     claGroupID,
+	}
+
+	campaigns, err := s.loadCampaigns(ctx, claGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.ResignCampaignList{List: []*models.ResignCampaign{}}
+	if len(campaigns) == 0 {
+		return response, nil
+	}
+
+	claGroupSignatures, err := s.signatureRepo.GetClaGroupSignedSignatures(ctx, claGroupID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query the CLA Group signatures")
+		return nil, err
+	}
+
+	for _, campaign := range campaigns {
+		resignCampaign := &models.ResignCampaign{
+			CampaignID:           campaign.CampaignID,
+			ClaGroupID:           campaign.ClaGroupID,
+			ClaType:              campaign.ClaType,
+			DocumentMajorVersion: int64(campaign.DocumentMajorVersion),
+			Policy:               campaign.Policy,
+			ResignDeadline:       campaign.ResignDeadline,
+			DateCreated:          campaign.DateCreated,
+			SignerCount:          int64(campaign.SignerCount),
+			Signers:              []*models.ResignCampaignSigner{},
+		}
+		for _, signature := range claGroupSignatures {
+			if signature.SignatureResignCampaignID != campaign.CampaignID {
+				continue
+			}
+			signer := &models.ResignCampaignSigner{
+				SignatureID:   signature.SignatureID,
+				ReferenceID:   signature.SignatureReferenceID,
+				ReferenceName: signature.SignatureReferenceName,
+				Email:         signatureEmail(signature),
+				NotifiedOn:    signature.SignatureResignNotifiedOn,
+				Resigned:      hasResigned(claGroupSignatures, campaign.ClaType, signature.SignatureReferenceID, campaign.DocumentMajorVersion),
+			}
+			if signer.Resigned {
+				resignCampaign.ResignedCount++
+			}
+			resignCampaign.Signers = append(resignCampaign.Signers, signer)
+		}
+		response.List = append(response.List, resignCampaign)
+	}
+
+	return response, nil
+}
+
+// EnforceResignDeadlines revokes the Gerrit access of the signers whose superseded signatures passed the re-sign
+// deadline - the GitHub, GitLab and Gitea checks and the Gerrit authorization check already treat these signatures
+// as unsigned through signatures.IsResignRequired, the Gerrit LDAP group membership has to be removed
+func (s *service) EnforceResignDeadlines(ctx context.Context) (*EnforcementSummary, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.resign_campaigns.service.EnforceResignDeadlines",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	stampedSignatures, err := s.signatureRepo.GetSignaturesWithResignDeadline(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query the signatures with a re-sign deadline")
+		return nil, err
+	}
+
+	summary := &EnforcementSummary{}
+	claGroupSignatures := map[string][]*signatures.ItemSignature{}
+	for _, signature := range stampedSignatures {
+		if !isResignRequired(signature) {
+			continue
+		}
+		summary.Checked++
+
+		claType := utils.ClaTypeICLA
+		if signature.SignatureType == utils.SignatureTypeCCLA {
+			claType = utils.ClaTypeCCLA
+		}
+		signedSignatures, ok := claGroupSignatures[signature.SignatureProjectID]
+		if !ok {
+			signedSignatures, err = s.signatureRepo.GetClaGroupSignedSignatures(ctx, signature.SignatureProjectID)
+			if err != nil {
+				log.WithFields(f).WithError(err).Warnf("unable to query the signatures of CLA Group: %s", signature.SignatureProjectID)
+				summary.Failed++
+				continue
+			}
+			claGroupSignatures[signature.SignatureProjectID] = signedSignatures
+		}
+
+		// The signers who signed the new major version keep their access
+		if hasResigned(signedSignatures, claType, signature.SignatureReferenceID, signature.SignatureDocumentMajorVersion+1) {
+			summary.Resigned++
+			if markErr := s.markResignEnforced(ctx, signature); markErr != nil {
+				summary.Failed++
+			}
+			continue
+		}
+
+		if enforceErr := s.enforceResignDeadline(ctx, signature); enforceErr != nil {
+			log.WithFields(f).WithError(enforceErr).Warnf("unable to revoke the Gerrit access of signature: %s", signature.SignatureID)
+			summary.Failed++
+			continue
+		}
+		summary.Enforced++
+	}
+
+	return summary, nil
+}
+
+// enforceResignDeadline removes the signers of the superseded signature from the Gerrit LDAP groups of the CLA Group
+// and records the enforcement on the signature - the ICLA signer from the ICLA groups, the acknowledged employees of
+// the company from the CCLA groups
+func (s *service) enforceResignDeadline(ctx context.Context, signature *signatures.ItemSignature) error {
+	f := logrus.Fields{
+		"functionName":   "v2.resign_campaigns.service.enforceResignDeadline",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"claGroupID":     signature.SignatureProjectID,
+		"signatureID":    signature.SignatureID,
+	}
+
+	gerritList, err := s.gerritService.GetClaGroupGerrits(ctx, signature.SignatureProjectID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query the CLA Group Gerrit instances")
+		return err
+	}
+
+	var groupIDs []string
+	for _, gerrit := range gerritList.List {
+		groupID := gerrit.GroupIDIcla
+		if signature.SignatureType == utils.SignatureTypeCCLA {
+			groupID = gerrit.GroupIDCcla
+		}
+		if groupID != "" {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+
+	if len(groupIDs) > 0 {
+		lfUsernames, lookupErr := s.signerLFUsernames(ctx, signature)
+		if lookupErr != nil {
+			return lookupErr
+		}
+
+		authUser := &auth.User{UserName: "easycla system", ACL: auth.ACL{}}
+		for _, groupID := range groupIDs {
+			for _, lfUsername := range lfUsernames {
+				log.WithFields(f).Debugf("removing user: %s from Gerrit group: %s", lfUsername, groupID)
+				if removeErr := s.gerritGroups.RemoveUserFromGroup(ctx, authUser, signature.SignatureProjectID, groupID, lfUsername); removeErr != nil {
+					log.WithFields(f).WithError(removeErr).Warnf("unable to remove user: %s from Gerrit group: %s", lfUsername, groupID)
+					return removeErr
+				}
+			}
+		}
+	}
+
+	return s.markResignEnforced(ctx, signature)
+}
+
+// signerLFUsernames returns the LF usernames with Gerrit access through the signature - the ICLA signer or the
+// acknowledged employees of the CCLA company
+func (s *service) signerLFUsernames(ctx context.Context, signature *signatures.ItemSignature) ([]string, error) {
+	if signature.SignatureType != utils.SignatureTypeCCLA {
+		if signature.UserLFUsername != "" {
+			return []string{signature.UserLFUsername}, nil
+		}
+		user, err := s.usersService.GetUser(signature.SignatureReferenceID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || user.LfUsername == "" {
+			return nil, nil
+		}
+		return []string{user.LfUsername}, nil
+	}
+
+	var lfUsernames []string
+	params := v1SignatureParams.GetProjectCompanyEmployeeSignaturesParams{
+		CompanyID: signature.SignatureReferenceID,
+		ProjectID: signature.SignatureProjectID,
+	}
+	for {
+		employeeSignatures, err := s.signatureRepo.GetProjectCompanyEmployeeSignatures(ctx, params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, employeeSignature := range employeeSignatures.Signatures {
+			if employeeSignature.UserLFID != "" && !utils.StringInSlice(employeeSignature.UserLFID, lfUsernames) {
+				lfUsernames = append(lfUsernames, employeeSignature.UserLFID)
+			}
+		}
+		if employeeSignatures.LastKeyScanned == "" {
+			return lfUsernames, nil
+		}
+		params.NextKey = utils.StringRef(employeeSignatures.LastKeyScanned)
+	}
+}
+
+// markResignEnforced records that the re-sign deadline of the signature was enforced
+func (s *service) markResignEnforced(ctx context.Context, signature *signatures.ItemSignature) error {
+	_, enforcedOn := utils.CurrentTime()
+	return s.signatureRepo.UpdateSignature(ctx, signature.SignatureID, map[string]interface{}{
+		"signature_resign_enforced_on": enforcedOn,
+	})
+}
+
+// notifySigner emails the signer of the superseded signature a link to sign the new major version
+func (s *service) notifySigner(ctx context.Context, claGroup *v1Models.ClaGroup, campaign *Campaign, signature *signatures.ItemSignature) error {
+	recipients := s.signerRecipients(ctx, signature)
+	if len(recipients) == 0 {
+		return fmt.Errorf("no email address for signature: %s", signature.SignatureID)
+	}
+
+	subject, body := s.resignEmailContent(claGroup, campaign, signature)
+	return utils.SendEmail(subject, body, recipients)
+}
+
+// signerRecipients returns the email addresses of the signer - the user for an ICLA, the CLA signatory and CLA
+// managers for a CCLA
+func (s *service) signerRecipients(ctx context.Context, signature *signatures.ItemSignature) []string {
+	f := logrus.Fields{
+		"functionName":   "v2.resign_campaigns.service.signerRecipients",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+	}
+
+	var recipients []string
+	addRecipient := func(email string) {
+		if email != "" && !utils.StringInSlice(email, recipients) {
+			recipients = append(recipients, email)
+		}
+	}
+
+	if signature.SignatureType == utils.SignatureTypeCCLA {
+		addRecipient(signature.SignatoryEmail)
+		for _, lfUsername := range signature.SignatureACL {
+			claManager, err := s.usersService.GetUserByLFUserName(lfUsername)
+			if err != nil || claManager == nil {
+				log.WithFields(f).Debugf("unable to lookup CLA manager by LF username: %s", lfUsername)
+				continue
+			}
+			addRecipient(claManager.LfEmail.String())
+		}
+		return recipients
+	}
+
+	if signature.UserEmail != "" {
+		addRecipient(signature.UserEmail)
+		return recipients
+	}
+	user, err := s.usersService.GetUser(signature.SignatureReferenceID)
+	if err != nil || user == nil {
+		log.WithFields(f).Debugf("unable to lookup user by ID: %s", signature.SignatureReferenceID)
+		return recipients
+	}
+	addRecipient(user.LfEmail.String())
+	if len(recipients) == 0 && len(user.Emails) > 0 {
+		addRecipient(user.Emails[0])
+	}
+	return recipients
+}
+
+// resignEmailContent returns the re-sign notification email subject and body
+func (s *service) resignEmailContent(claGroup *v1Models.ClaGroup, campaign *Campaign, signature *signatures.ItemSignature) (string, string) {
+	agreement := "Individual Contributor License Agreement"
+	signingURL := s.claLandingPage
+	if campaign.ClaType == utils.ClaTypeCCLA {
+		agreement = "Corporate Contributor License Agreement"
+		signingURL = utils.GetCorporateURL(true)
+	}
+
+	emailSubject := fmt.Sprintf("EasyCLA: New Version of the CLA for %s Requires Your Signature", claGroup.ProjectName)
+	emailBody := fmt.Sprintf("<p>Hello %s,</p>", signerName(signature))
+	emailBody += fmt.Sprintf("<p>This is a notification email from EasyCLA regarding the CLA Group %s. A new version (%d) of the %s was published and your signature of the previous version needs to be renewed.</p>",
+		claGroup.ProjectName, campaign.DocumentMajorVersion, agreement)
+	if campaign.Policy == utils.ResignPolicyImmediate {
+		emailBody += "<p>Your previous signature no longer covers contributions. Please sign the new version to continue contributing.</p>"
+	} else {
+		deadline := campaign.ResignDeadline
+		if deadlineTime, err := utils.ParseDateTime(campaign.ResignDeadline); err == nil {
+			deadline = deadlineTime.Format("January 2, 2006")
+		}
+		emailBody += fmt.Sprintf("<p>Your previous signature remains valid until %s. Please sign the new version before then to continue contributing.</p>", deadline)
+	}
+	emailBody += fmt.Sprintf("<p>You can sign the new version from <a href=\"%s\" target=\"_blank\">EasyCLA</a>.</p>", signingURL)
+	emailBody += utils.GetEmailHelpContent(true)
+	emailBody += utils.GetEmailSignOffContent()
+	return emailSubject, emailBody
+}
+
+// loadCampaigns returns the re-sign campaigns of the CLA Group ordered by creation date
+func (s *service) loadCampaigns(ctx context.Context, claGroupID string) ([]*Campaign, error) {
+	value, err := s.storeRepository.GetValue(ctx, campaignsKey(claGroupID))
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	var campaigns []*Campaign
+	if err = json.Unmarshal([]byte(value), &campaigns); err != nil {
+		return nil, err
+	}
+	sort.Slice(campaigns, func(i, j int) bool {
+		return campaigns[i].DateCreated < campaigns[j].DateCreated
+	})
+	return campaigns, nil
+}
+
+// saveCampaign adds the campaign to the re-sign campaigns of the CLA Group
+func (s *service) saveCampaign(ctx context.Context, campaign *Campaign) error {
+	campaigns, err := s.loadCampaigns(ctx, campaign.ClaGroupID)
+	if err != nil {
+		return err
+	}
+	campaigns = append(campaigns, campaign)
+
+	value, err := json.Marshal(campaigns)
+	if err != nil {
+		return err
+	}
+	return s.storeRepository.SetValue(ctx, campaignsKey(campaign.ClaGroupID), time.Now().Add(campaignRecordTTL).Unix(), string(value))
+}
+
+// campaignsKey returns the store key of the re-sign campaigns of the CLA Group
+func campaignsKey(claGroupID string) string {
+	return fmt.Sprintf("resign_campaigns:%s", claGroupID)
+}
+
+// campaignClaimKey returns the store key claimed when the re-sign campaign of the major version is started
+func campaignClaimKey(claGroupID, claType string, majorVersion int) string {
+	return fmt.Sprintf("resign_campaign:%s:%s:%d", claGroupID, claType, majorVersion)
+}
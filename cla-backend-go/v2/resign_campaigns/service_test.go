@@ -0,0 +1,319 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package resign_campaigns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/LF-Engineering/lfx-kit/auth"
+	mock_events "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	mock_gerrits "github.com/communitybridge/easycla/cla-backend-go/gerrits/mocks"
+	mock_project "github.com/communitybridge/easycla/cla-backend-go/project/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeStore is an in memory store repository, the values are saved with setValueErr
+type fakeStore struct {
+	mu          sync.Mutex
+	values      map[string]string
+	setValueErr error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{values: map[string]string{}}
+}
+
+func (s *fakeStore) SetActiveSignatureMetaData(ctx context.Context, key string, expire int64, value string) error {
+	return s.SetValue(ctx, key, expire, value)
+}
+
+func (s *fakeStore) GetActiveSignatureMetaData(ctx context.Context, userID string) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) DeleteActiveSignatureMetaData(ctx context.Context, key string) error {
+	return s.DeleteValue(ctx, key)
+}
+
+func (s *fakeStore) SetValue(ctx context.Context, key string, expire int64, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.setValueErr != nil {
+		return s.setValueErr
+	}
+	s.values[key] = value
+	return nil
+}
+
+func (s *fakeStore) GetValue(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.values[key], nil
+}
+
+func (s *fakeStore) SetValueIfNotExists(ctx context.Context, key string, expire int64, value string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *fakeStore) SetValueIfEquals(ctx context.Context, key string, expire int64, value, expectedValue string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.values[key]; !ok || current != expectedValue {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *fakeStore) DeleteValue(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+// fakeGerritGroups records the users removed from the Gerrit groups as group:username
+type fakeGerritGroups struct {
+	removed []string
+}
+
+func (g *fakeGerritGroups) RemoveUserFromGroup(ctx context.Context, authUser *auth.User, claGroupID, groupName, userName string) error {
+	g.removed = append(g.removed, fmt.Sprintf("%s:%s", groupName, userName))
+	return nil
+}
+
+// recordingEmailSender records the recipients of the emails sent
+type recordingEmailSender struct {
+	recipients []string
+}
+
+func (e *recordingEmailSender) SendEmail(subject string, body string, recipients []string) error {
+	e.recipients = append(e.recipients, recipients...)
+	return nil
+}
+
+// recordUpdates records the signature updates by signature ID
+func recordUpdates(signatureRepo *mock_signatures.MockSignatureRepository) map[string]map[string]interface{} {
+	updates := map[string]map[string]interface{}{}
+	signatureRepo.EXPECT().UpdateSignature(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, signatureID string, signatureUpdates map[string]interface{}) error {
+		if updates[signatureID] == nil {
+			updates[signatureID] = map[string]interface{}{}
+		}
+		for key, value := range signatureUpdates {
+			updates[signatureID][key] = value
+		}
+		return nil
+	}).AnyTimes()
+	return updates
+}
+
+func iclaSignature(signatureID, userID string, majorVersion int) *signatures.ItemSignature {
+	return &signatures.ItemSignature{
+		SignatureID:                   signatureID,
+		SignatureProjectID:            "cla-group-1",
+		SignatureType:                 utils.SignatureTypeCLA,
+		SignatureReferenceType:        utils.SignatureReferenceTypeUser,
+		SignatureReferenceID:          userID,
+		SignatureDocumentMajorVersion: majorVersion,
+		UserEmail:                     userID + "@example.com",
+		UserLFUsername:                userID,
+	}
+}
+
+func TestStartCampaigns(t *testing.T) {
+	publishedOn := utils.TimeToString(time.Now().UTC())
+	claimKey := campaignClaimKey("cla-group-1", utils.ClaTypeICLA, 2)
+	saveErr := errors.New("unable to save the campaign")
+
+	testCases := []struct {
+		Name             string
+		Policy           string
+		Claimed          bool
+		SaveErr          error
+		ExpectedErr      error
+		ExpectedCampaign bool
+		ExpectedRemoved  []string
+		ExpectedClaimed  bool
+	}{
+		{
+			Name:             "immediate policy revokes the Gerrit access",
+			Policy:           utils.ResignPolicyImmediate,
+			ExpectedCampaign: true,
+			ExpectedRemoved:  []string{"1901:user-1"},
+			ExpectedClaimed:  true,
+		},
+		{
+			Name:             "grace period policy keeps the Gerrit access until the deadline",
+			Policy:           utils.ResignPolicyGracePeriod,
+			ExpectedCampaign: true,
+			ExpectedClaimed:  true,
+		},
+		{
+			Name:            "campaign already started for the major version",
+			Policy:          utils.ResignPolicyImmediate,
+			Claimed:         true,
+			ExpectedClaimed: true,
+		},
+		{
+			Name:        "failed campaign save releases the claim",
+			Policy:      utils.ResignPolicyImmediate,
+			SaveErr:     saveErr,
+			ExpectedErr: saveErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			emailSender := &recordingEmailSender{}
+			previousEmailSender := utils.GetEmailSender()
+			utils.SetEmailSender(emailSender)
+			tt.Cleanup(func() { utils.SetEmailSender(previousEmailSender) })
+
+			projectService := mock_project.NewMockService(ctrl)
+			projectService.EXPECT().GetCLAGroupByID(gomock.Any(), "cla-group-1").Return(&v1Models.ClaGroup{
+				ProjectID:              "cla-group-1",
+				ProjectName:            "Project",
+				ProjectICLAEnabled:     true,
+				ProjectResignPolicy:    tc.Policy,
+				ProjectResignGraceDays: 30,
+				ProjectIndividualDocuments: []v1Models.ClaGroupDocument{
+					{DocumentMajorVersion: "2", DocumentMinorVersion: "0", DocumentCreationDate: publishedOn},
+				},
+			}, nil)
+
+			signatureRepo := mock_signatures.NewMockSignatureRepository(ctrl)
+			signatureRepo.EXPECT().GetClaGroupSignedSignatures(gomock.Any(), "cla-group-1").Return([]*signatures.ItemSignature{
+				iclaSignature("sig-1", "user-1", 1),
+				iclaSignature("sig-2", "user-2", 2),
+			}, nil)
+			updates := recordUpdates(signatureRepo)
+
+			gerritService := mock_gerrits.NewMockService(ctrl)
+			gerritService.EXPECT().GetClaGroupGerrits(gomock.Any(), "cla-group-1").Return(&v1Models.GerritList{
+				List: []*v1Models.Gerrit{{GroupIDIcla: "1901", GroupIDCcla: "1902"}},
+			}, nil).AnyTimes()
+
+			eventsService := mock_events.NewMockService(ctrl)
+			eventsService.EXPECT().LogEventWithContext(gomock.Any(), gomock.Any()).AnyTimes()
+
+			storeRepository := newFakeStore()
+			if tc.Claimed {
+				storeRepository.values[claimKey] = "admin"
+			}
+			storeRepository.setValueErr = tc.SaveErr
+			gerritGroups := &fakeGerritGroups{}
+
+			s := NewService(projectService, signatureRepo, users.NewService(mock_users.NewMockUserRepository(ctrl), eventsService),
+				storeRepository, eventsService, gerritService, gerritGroups, "https://cla.test")
+
+			campaigns, err := s.StartCampaigns(ctx, "cla-group-1", "admin")
+			assert.Equal(tt, tc.ExpectedErr, err)
+			assert.Equal(tt, tc.ExpectedRemoved, gerritGroups.removed)
+			_, claimed := storeRepository.values[claimKey]
+			assert.Equal(tt, tc.ExpectedClaimed, claimed)
+
+			if !tc.ExpectedCampaign {
+				assert.Empty(tt, campaigns)
+				assert.Empty(tt, updates)
+				return
+			}
+
+			// only the signature of the previous major version is stamped and its signer notified
+			if assert.Len(tt, campaigns, 1) {
+				assert.Equal(tt, 1, campaigns[0].SignerCount)
+				assert.Equal(tt, campaigns[0].CampaignID, updates["sig-1"]["signature_resign_campaign_id"])
+				assert.Equal(tt, campaigns[0].ResignDeadline, updates["sig-1"]["signature_resign_required_on"])
+			}
+			assert.NotContains(tt, updates, "sig-2")
+			assert.Equal(tt, []string{"user-1@example.com"}, emailSender.recipients)
+			_, enforced := updates["sig-1"]["signature_resign_enforced_on"]
+			assert.Equal(tt, len(tc.ExpectedRemoved) > 0, enforced)
+
+			saved, loadErr := s.(*service).loadCampaigns(ctx, "cla-group-1")
+			assert.NoError(tt, loadErr)
+			assert.Len(tt, saved, 1)
+		})
+	}
+}
+
+func TestEnforceResignDeadlines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	passed := utils.TimeToString(time.Now().UTC().Add(-time.Hour))
+	upcoming := utils.TimeToString(time.Now().UTC().Add(24 * time.Hour))
+	stamp := func(signature *signatures.ItemSignature, requiredOn string) *signatures.ItemSignature {
+		signature.SignatureResignCampaignID = "campaign-1"
+		signature.SignatureResignRequiredOn = requiredOn
+		return signature
+	}
+
+	overdue := stamp(iclaSignature("sig-1", "user-1", 1), passed)
+	notDue := stamp(iclaSignature("sig-2", "user-2", 1), upcoming)
+	resigned := stamp(iclaSignature("sig-3", "user-3", 1), passed)
+	corporate := stamp(&signatures.ItemSignature{
+		SignatureID:                   "sig-4",
+		SignatureProjectID:            "cla-group-1",
+		SignatureType:                 utils.SignatureTypeCCLA,
+		SignatureReferenceType:        utils.SignatureReferenceTypeCompany,
+		SignatureReferenceID:          "company-1",
+		SignatureDocumentMajorVersion: 1,
+	}, passed)
+
+	signatureRepo := mock_signatures.NewMockSignatureRepository(ctrl)
+	signatureRepo.EXPECT().GetSignaturesWithResignDeadline(gomock.Any()).Return([]*signatures.ItemSignature{overdue, notDue, resigned, corporate}, nil)
+	signatureRepo.EXPECT().GetClaGroupSignedSignatures(gomock.Any(), "cla-group-1").Return([]*signatures.ItemSignature{
+		overdue, notDue, resigned, corporate, iclaSignature("sig-5", "user-3", 2),
+	}, nil)
+	signatureRepo.EXPECT().GetProjectCompanyEmployeeSignatures(gomock.Any(), gomock.Any(), gomock.Any()).Return(&v1Models.Signatures{
+		Signatures: []*v1Models.Signature{{UserLFID: "employee-1"}, {UserLFID: "employee-2"}},
+	}, nil)
+	updates := recordUpdates(signatureRepo)
+
+	gerritService := mock_gerrits.NewMockService(ctrl)
+	gerritService.EXPECT().GetClaGroupGerrits(gomock.Any(), "cla-group-1").Return(&v1Models.GerritList{
+		List: []*v1Models.Gerrit{{GroupIDIcla: "1901", GroupIDCcla: "1902"}},
+	}, nil).AnyTimes()
+	gerritGroups := &fakeGerritGroups{}
+
+	s := &service{
+		signatureRepo: signatureRepo,
+		gerritService: gerritService,
+		gerritGroups:  gerritGroups,
+	}
+
+	summary, err := s.EnforceResignDeadlines(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &EnforcementSummary{Checked: 3, Enforced: 2, Resigned: 1}, summary)
+
+	// the ICLA signer leaves the ICLA group and the acknowledged employees of the company the CCLA group
+	assert.Equal(t, []string{"1901:user-1", "1902:employee-1", "1902:employee-2"}, gerritGroups.removed)
+	for _, signatureID := range []string{"sig-1", "sig-3", "sig-4"} {
+		assert.Contains(t, updates[signatureID], "signature_resign_enforced_on", signatureID)
+	}
+	assert.NotContains(t, updates, "sig-2")
+}
@@ -0,0 +1,240 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// signed callback ledger outcomes
+const (
+	CallbackOutcomeProcessing = "processing"
+	CallbackOutcomeProcessed  = "processed"
+)
+
+const (
+	// callbackLedgerTTL is how long we keep the processed callback ledger records
+	callbackLedgerTTL = 90 * 24 * time.Hour
+	// callbackProcessingTimeout is how long a processing claim is honored before a delivery may take it over - covers
+	// the case where the process handling the callback died before recording the outcome
+	callbackProcessingTimeout = 15 * time.Minute
+)
+
+// ErrCallbackInProgress is returned when the same envelope status is currently being processed by another delivery
+var ErrCallbackInProgress = errors.New("signed callback for the envelope status is already being processed")
+
+// callbackLedgerRecord is the processed callback ledger record saved in the store table
+type callbackLedgerRecord struct {
+	EnvelopeID  string `json:"envelope_id"`
+	Status      string `json:"status"`
+	Callback    string `json:"callback"`
+	Outcome     string `json:"outcome"`
+	ClaimedOn   string `json:"claimed_on"`
+	ProcessedOn string `json:"processed_on,omitempty"`
+}
+
+// callbackLedgerKey returns the ledger store key for the envelope and envelope status
+func callbackLedgerKey(envelopeID, status string) string {
+	return fmt.Sprintf("signed_callback:%s:%s", envelopeID, strings.ToLower(status))
+}
+
+// callbackEnvelopeStatus returns the envelope ID and status from the DocuSign Connect payload. Multi-party envelopes
+// are delivered with the same envelope status as each routed signer completes, so the status of an envelope which is
+// not completed includes the number of completed recipients.
+func callbackEnvelopeStatus(payload []byte) (string, string, error) {
+	var info DocuSignEnvelopeInformation
+	if err := xml.Unmarshal(payload, &info); err != nil {
+		return "", "", err
+	}
+
+	status := info.EnvelopeStatus.Status
+	if status == "" && len(info.EnvelopeStatus.RecipientStatuses) > 0 {
+		status = info.EnvelopeStatus.RecipientStatuses[0].Status
+	}
+	if status != "" && !strings.EqualFold(status, DocusignCompleted) && len(info.EnvelopeStatus.RecipientStatuses) > 1 {
+		status = fmt.Sprintf("%s-%d", status, completedRecipients(info.EnvelopeStatus.RecipientStatuses))
+	}
+	return info.EnvelopeStatus.EnvelopeID, status, nil
+}
+
+// getCallbackLedgerRecord returns the ledger record for the key and the stored value of the record, nil if not found
+func (s *service) getCallbackLedgerRecord(ctx context.Context, key string) (*callbackLedgerRecord, string, error) {
+	value, err := s.storeRepository.GetValue(ctx, key)
+	if err != nil || value == "" {
+		return nil, "", err
+	}
+
+	var record callbackLedgerRecord
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return nil, "", err
+	}
+	return &record, value, nil
+}
+
+// saveCallbackLedgerRecord saves the ledger record, overwriting any existing record
+func (s *service) saveCallbackLedgerRecord(ctx context.Context, key string, record *callbackLedgerRecord) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.storeRepository.SetValue(ctx, key, time.Now().Add(callbackLedgerTTL).Unix(), string(value))
+}
+
+// processCallbackOnce runs the signed callback once per envelope ID and envelope status. DocuSign retries Connect
+// deliveries, repeated deliveries of an envelope status which was already processed - or deliveries of an earlier
+// status after the envelope was completed - are no-ops. Only the processed outcome is recorded, a delivery which fails
+// releases its claim so that the next delivery retries the callback.
+func (s *service) processCallbackOnce(ctx context.Context, callbackName string, payload []byte, process func() error) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.processCallbackOnce",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"callback":       callbackName,
+	}
+
+	envelopeID, status, err := callbackEnvelopeStatus(payload)
+	if err != nil || envelopeID == "" || status == "" {
+		// Let the callback report the invalid payload
+		log.WithFields(f).Debug("unable to determine the envelope ID and status from the payload - skipping the callback ledger")
+		return process()
+	}
+	f["envelopeID"] = envelopeID
+	f["status"] = status
+
+	// Out of order delivery - the envelope was already completed, nothing to do for an earlier status
+	if !strings.EqualFold(status, DocusignCompleted) {
+		completed, _, completedErr := s.getCallbackLedgerRecord(ctx, callbackLedgerKey(envelopeID, DocusignCompleted))
+		if completedErr != nil {
+			log.WithFields(f).WithError(completedErr).Warn("problem loading the completed callback ledger record")
+		} else if completed != nil && completed.Outcome == CallbackOutcomeProcessed {
+			log.WithFields(f).Debug("envelope already completed - ignoring out of order delivery")
+			return nil
+		}
+	}
+
+	key := callbackLedgerKey(envelopeID, status)
+	_, now := utils.CurrentTime()
+	record := &callbackLedgerRecord{
+		EnvelopeID: envelopeID,
+		Status:     status,
+		Callback:   callbackName,
+		Outcome:    CallbackOutcomeProcessing,
+		ClaimedOn:  now,
+	}
+	claimValue, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	expire := time.Now().Add(callbackLedgerTTL).Unix()
+	claimed, err := s.storeRepository.SetValueIfNotExists(ctx, key, expire, string(claimValue))
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem claiming the callback ledger record")
+		return err
+	}
+
+	if !claimed {
+		existing, existingValue, existingErr := s.getCallbackLedgerRecord(ctx, key)
+		if existingErr != nil {
+			log.WithFields(f).WithError(existingErr).Warn("problem loading the callback ledger record")
+			return existingErr
+		}
+
+		switch {
+		case existing == nil:
+			// Released after a failure between our claim and read - claim it again
+			claimed, err = s.storeRepository.SetValueIfNotExists(ctx, key, expire, string(claimValue))
+		case existing.Outcome == CallbackOutcomeProcessed:
+			log.WithFields(f).Debugf("callback already processed on %s - ignoring the repeated delivery", existing.ProcessedOn)
+			return nil
+		case !callbackClaimExpired(existing.ClaimedOn):
+			log.WithFields(f).Debugf("callback claimed on %s is still being processed", existing.ClaimedOn)
+			return ErrCallbackInProgress
+		default:
+			// Only take over the expired claim we loaded - another delivery may have taken it over in the meantime
+			log.WithFields(f).Debugf("callback claimed on %s has expired - taking over the claim", existing.ClaimedOn)
+			claimed, err = s.storeRepository.SetValueIfEquals(ctx, key, expire, string(claimValue), existingValue)
+		}
+
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("problem claiming the callback ledger record")
+			return err
+		}
+		if !claimed {
+			log.WithFields(f).Debug("callback was claimed by another delivery")
+			return ErrCallbackInProgress
+		}
+	}
+
+	err = process()
+	if err != nil {
+		// Release the claim so that the next delivery can retry
+		if deleteErr := s.storeRepository.DeleteValue(ctx, key); deleteErr != nil {
+			log.WithFields(f).WithError(deleteErr).Warn("problem releasing the callback ledger record")
+		}
+		return err
+	}
+
+	_, record.ProcessedOn = utils.CurrentTime()
+	record.Outcome = CallbackOutcomeProcessed
+	if saveErr := s.saveCallbackLedgerRecord(ctx, key, record); saveErr != nil {
+		// The callback was processed - a repeated delivery will re-run the callback
+		log.WithFields(f).WithError(saveErr).Warn("problem saving the processed callback ledger record")
+	}
+
+	return nil
+}
+
+// callbackClaimExpired returns true if the processing claim is older than the processing timeout
+func callbackClaimExpired(claimedOn string) bool {
+	claimed, err := utils.ParseDateTime(claimedOn)
+	if err != nil {
+		return true
+	}
+	return time.Since(claimed) > callbackProcessingTimeout
+}
+
+// SignedIndividualCallbackGithub processes the GitHub individual signed callback once per envelope status
+func (s *service) SignedIndividualCallbackGithub(ctx context.Context, payload []byte, installationID, changeRequestID, repositoryID string) error {
+	return s.processCallbackOnce(ctx, "github_individual", payload, func() error {
+		return s.signedIndividualCallback(ctx, payload, s.newGitHubChangeRequestProvider(installationID, changeRequestID, repositoryID))
+	})
+}
+
+// SignedIndividualCallbackGitlab processes the GitLab individual signed callback once per envelope status
+func (s *service) SignedIndividualCallbackGitlab(ctx context.Context, payload []byte, userID, organizationID, repositoryID, mergeRequestID string) error {
+	return s.processCallbackOnce(ctx, "gitlab_individual", payload, func() error {
+		return s.signedIndividualCallback(ctx, payload, s.newGitLabChangeRequestProvider(userID, organizationID, repositoryID, mergeRequestID))
+	})
+}
+
+// SignedIndividualCallbackGitea processes the Gitea individual signed callback once per envelope status
+func (s *service) SignedIndividualCallbackGitea(ctx context.Context, payload []byte, userID, organizationID, repositoryID, pullRequestID string) error {
+	return s.processCallbackOnce(ctx, "gitea_individual", payload, func() error {
+		return s.signedIndividualCallback(ctx, payload, s.newGiteaChangeRequestProvider(userID, organizationID, repositoryID, pullRequestID))
+	})
+}
+
+// SignedIndividualCallbackGerrit processes the Gerrit individual signed callback once per envelope status
+func (s *service) SignedIndividualCallbackGerrit(ctx context.Context, payload []byte, userID string) error {
+	return s.processCallbackOnce(ctx, "gerrit_individual", payload, func() error {
+		return s.signedIndividualCallback(ctx, payload, s.newGerritChangeRequestProvider(userID))
+	})
+}
+
+// SignedCorporateCallback processes the corporate signed callback once per envelope status
+func (s *service) SignedCorporateCallback(ctx context.Context, payload []byte, companyID, projectID string) error {
+	return s.processCallbackOnce(ctx, "corporate", payload, func() error {
+		return s.signedCorporateCallback(ctx, payload, companyID, projectID)
+	})
+}
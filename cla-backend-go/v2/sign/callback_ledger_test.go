@@ -0,0 +1,137 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const callbackPayload = `<DocuSignEnvelopeInformation><EnvelopeStatus><EnvelopeID>envelope-1</EnvelopeID><Status>Completed</Status></EnvelopeStatus></DocuSignEnvelopeInformation>`
+
+// racingStore takes over the claim on behalf of another delivery right before the conditional write
+type racingStore struct {
+	*fakeStore
+}
+
+func (s *racingStore) SetValueIfEquals(ctx context.Context, key string, expire int64, value, expectedValue string) (bool, error) {
+	if err := s.fakeStore.SetValue(ctx, key, expire, ledgerValue(CallbackOutcomeProcessing, time.Now())); err != nil {
+		return false, err
+	}
+	return s.fakeStore.SetValueIfEquals(ctx, key, expire, value, expectedValue)
+}
+
+func ledgerValue(outcome string, claimedOn time.Time) string {
+	value, _ := json.Marshal(&callbackLedgerRecord{ // nolint
+		EnvelopeID: "envelope-1",
+		Status:     DocusignCompleted,
+		Callback:   "github_individual",
+		Outcome:    outcome,
+		ClaimedOn:  utils.TimeToString(claimedOn),
+	})
+	return string(value)
+}
+
+func TestProcessCallbackOnce(t *testing.T) {
+	key := callbackLedgerKey("envelope-1", DocusignCompleted)
+	processErr := errors.New("unable to update the change request")
+
+	testCases := []struct {
+		Name            string
+		Existing        string
+		Racing          bool
+		ProcessErr      error
+		ExpectedErr     error
+		ExpectedCalls   int
+		ExpectedOutcome string
+	}{
+		{
+			Name:            "first delivery",
+			ExpectedCalls:   1,
+			ExpectedOutcome: CallbackOutcomeProcessed,
+		},
+		{
+			Name:            "duplicate delivery",
+			Existing:        ledgerValue(CallbackOutcomeProcessed, time.Now().Add(-time.Hour)),
+			ExpectedOutcome: CallbackOutcomeProcessed,
+		},
+		{
+			Name:            "claimed by another delivery",
+			Existing:        ledgerValue(CallbackOutcomeProcessing, time.Now().Add(-time.Minute)),
+			ExpectedErr:     ErrCallbackInProgress,
+			ExpectedOutcome: CallbackOutcomeProcessing,
+		},
+		{
+			Name:            "expired claim",
+			Existing:        ledgerValue(CallbackOutcomeProcessing, time.Now().Add(-2*callbackProcessingTimeout)),
+			ExpectedCalls:   1,
+			ExpectedOutcome: CallbackOutcomeProcessed,
+		},
+		{
+			Name:            "expired claim taken over by another delivery",
+			Existing:        ledgerValue(CallbackOutcomeProcessing, time.Now().Add(-2*callbackProcessingTimeout)),
+			Racing:          true,
+			ExpectedErr:     ErrCallbackInProgress,
+			ExpectedOutcome: CallbackOutcomeProcessing,
+		},
+		{
+			Name:          "failure releases the claim",
+			ProcessErr:    processErr,
+			ExpectedErr:   processErr,
+			ExpectedCalls: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			ctx := context.Background()
+			fake := newFakeStore()
+			if tc.Existing != "" {
+				fake.values[key] = tc.Existing
+			}
+			s := &service{storeRepository: fake}
+			if tc.Racing {
+				s.storeRepository = &racingStore{fakeStore: fake}
+			}
+
+			calls := 0
+			err := s.processCallbackOnce(ctx, "github_individual", []byte(callbackPayload), func() error {
+				calls++
+				return tc.ProcessErr
+			})
+			assert.Equal(tt, tc.ExpectedErr, err)
+			assert.Equal(tt, tc.ExpectedCalls, calls)
+
+			record, _, err := s.getCallbackLedgerRecord(ctx, key)
+			assert.NoError(tt, err)
+			if tc.ExpectedOutcome == "" {
+				assert.Nil(tt, record)
+				return
+			}
+			assert.Equal(tt, tc.ExpectedOutcome, record.Outcome)
+		})
+	}
+}
+
+func TestProcessCallbackOnce_OutOfOrderDelivery(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStore()
+	fake.values[callbackLedgerKey("envelope-1", DocusignCompleted)] = ledgerValue(CallbackOutcomeProcessed, time.Now())
+	s := &service{storeRepository: fake}
+
+	sent := `<DocuSignEnvelopeInformation><EnvelopeStatus><EnvelopeID>envelope-1</EnvelopeID><Status>Sent</Status></EnvelopeStatus></DocuSignEnvelopeInformation>`
+	calls := 0
+	err := s.processCallbackOnce(ctx, "github_individual", []byte(sent), func() error {
+		calls++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, calls)
+}
@@ -0,0 +1,540 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/github"
+	gitlab_api "github.com/communitybridge/easycla/cla-backend-go/gitlab_api"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	gitlab_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitlab-activity"
+	"github.com/sirupsen/logrus"
+	goGitLab "github.com/xanzy/go-gitlab"
+)
+
+// ChangeRequestProvider defines the code hosting platform specific steps of the signed individual callback - the
+// rest of the callback (envelope parsing, signature update, signed document storage, event and email) is shared
+type ChangeRequestProvider interface {
+	// Name returns the code hosting platform name, e.g. github
+	Name() string
+	// ResolveUser returns the CLA user who signed the signature
+	ResolveUser(ctx context.Context, signature *v1Models.Signature) (*v1Models.User, error)
+	// ResolveReturnURL returns the change request URL the signer is redirected back to after signing
+	ResolveReturnURL(ctx context.Context, signature *v1Models.Signature) (string, error)
+	// RefreshChangeRequest updates the CLA status of the change request which triggered the signature
+	RefreshChangeRequest(ctx context.Context, signature *v1Models.Signature) error
+	// ActiveSignatureKey returns the store key of the active signature metadata saved when the sign request was
+	// initiated, the metadata is removed once the signature is signed - empty if the platform does not save one
+	ActiveSignatureKey(signature *v1Models.Signature) string
+}
+
+// activeSignatureKey returns the store key of the active signature metadata of the user
+func activeSignatureKey(userID string) string {
+	return fmt.Sprintf("active_signature:%s", userID)
+}
+
+// resolveSignatureUser is the common user lookup for the change request providers - the user is the signature
+// reference, the callback user ID (when the callback URL carries one) is only checked against it
+func (s *service) resolveSignatureUser(ctx context.Context, signature *v1Models.Signature, callbackUserID string) (*v1Models.User, error) {
+	if callbackUserID != "" && callbackUserID != signature.SignatureReferenceID {
+		log.WithFields(logrus.Fields{
+			"functionName":   "v2.sign.resolveSignatureUser",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			"signatureID":    signature.SignatureID,
+		}).Warnf("callback user ID: %s does not match the signature user ID: %s", callbackUserID, signature.SignatureReferenceID)
+	}
+	claUser, err := s.userService.GetUser(signature.SignatureReferenceID)
+	if err != nil {
+		return nil, err
+	}
+	if claUser == nil {
+		return nil, fmt.Errorf("unable to lookup user by ID: %s - user not found", signature.SignatureReferenceID)
+	}
+	return claUser, nil
+}
+
+// gitHubChangeRequestProvider handles the signed callbacks of GitHub pull requests
+type gitHubChangeRequestProvider struct {
+	s               *service
+	installationID  string
+	repositoryID    string
+	changeRequestID string
+}
+
+func (s *service) newGitHubChangeRequestProvider(installationID, changeRequestID, repositoryID string) *gitHubChangeRequestProvider {
+	return &gitHubChangeRequestProvider{
+		s:               s,
+		installationID:  installationID,
+		repositoryID:    repositoryID,
+		changeRequestID: changeRequestID,
+	}
+}
+
+// Name returns the provider name
+func (p *gitHubChangeRequestProvider) Name() string {
+	return utils.GitHubType
+}
+
+// ResolveUser returns the CLA user who signed the signature
+func (p *gitHubChangeRequestProvider) ResolveUser(ctx context.Context, signature *v1Models.Signature) (*v1Models.User, error) {
+	return p.s.resolveSignatureUser(ctx, signature, "")
+}
+
+// ResolveReturnURL returns the pull request URL
+func (p *gitHubChangeRequestProvider) ResolveReturnURL(ctx context.Context, signature *v1Models.Signature) (string, error) {
+	if signature.SignatureReturnURL != "" {
+		return signature.SignatureReturnURL, nil
+	}
+	installationID, repositoryID, pullRequestID, err := p.ids()
+	if err != nil {
+		return "", err
+	}
+	return github.GetReturnURL(ctx, installationID, repositoryID, int(pullRequestID))
+}
+
+// RefreshChangeRequest re-runs the pull request check which updates the pull request status and comment
+func (p *gitHubChangeRequestProvider) RefreshChangeRequest(ctx context.Context, signature *v1Models.Signature) error {
+	installationID, repositoryID, pullRequestID, err := p.ids()
+	if err != nil {
+		return err
+	}
+	return p.s.githubActivityService.RefreshPullRequest(ctx, installationID, repositoryID, int(pullRequestID))
+}
+
+// ActiveSignatureKey returns the active signature key saved when the pull request sign request was initiated
+func (p *gitHubChangeRequestProvider) ActiveSignatureKey(signature *v1Models.Signature) string {
+	return activeSignatureKey(signature.SignatureReferenceID)
+}
+
+// ids converts the callback path parameters to the GitHub installation, repository and pull request IDs
+func (p *gitHubChangeRequestProvider) ids() (int64, int64, int64, error) {
+	installationID, err := strconv.ParseInt(p.installationID, 10, 64)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("unable to convert installation ID to int: %s - %w", p.installationID, err)
+	}
+	repositoryID, err := strconv.ParseInt(p.repositoryID, 10, 64)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("unable to convert repository ID to int: %s - %w", p.repositoryID, err)
+	}
+	pullRequestID, err := strconv.ParseInt(p.changeRequestID, 10, 64)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("unable to convert change request ID to int: %s - %w", p.changeRequestID, err)
+	}
+	return installationID, repositoryID, pullRequestID, nil
+}
+
+// gitLabChangeRequestProvider handles the signed callbacks of GitLab merge requests
+type gitLabChangeRequestProvider struct {
+	s              *service
+	userID         string
+	organizationID string
+	repositoryID   string
+	mergeRequestID string
+	client         *goGitLab.Client
+}
+
+func (s *service) newGitLabChangeRequestProvider(userID, organizationID, repositoryID, mergeRequestID string) *gitLabChangeRequestProvider {
+	return &gitLabChangeRequestProvider{
+		s:              s,
+		userID:         userID,
+		organizationID: organizationID,
+		repositoryID:   repositoryID,
+		mergeRequestID: mergeRequestID,
+	}
+}
+
+// Name returns the provider name
+func (p *gitLabChangeRequestProvider) Name() string {
+	return utils.GitLabLower
+}
+
+// ResolveUser returns the CLA user who signed the signature
+func (p *gitLabChangeRequestProvider) ResolveUser(ctx context.Context, signature *v1Models.Signature) (*v1Models.User, error) {
+	return p.s.resolveSignatureUser(ctx, signature, p.userID)
+}
+
+// ResolveReturnURL returns the merge request URL
+func (p *gitLabChangeRequestProvider) ResolveReturnURL(ctx context.Context, signature *v1Models.Signature) (string, error) {
+	if signature.SignatureReturnURL != "" {
+		return signature.SignatureReturnURL, nil
+	}
+	_, mergeRequest, err := p.mergeRequest(ctx)
+	if err != nil {
+		return "", err
+	}
+	return mergeRequest.WebURL, nil
+}
+
+// RefreshChangeRequest re-runs the merge request activity which updates the merge request status
+func (p *gitLabChangeRequestProvider) RefreshChangeRequest(ctx context.Context, signature *v1Models.Signature) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.gitLabChangeRequestProvider.RefreshChangeRequest",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"organizationID": p.organizationID,
+		"repositoryID":   p.repositoryID,
+		"mergeRequestID": p.mergeRequestID,
+	}
+
+	repositoryID, mergeRequest, err := p.mergeRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(f).Debugf("fetching repository info for repository ID: %d", repositoryID)
+	gitlabProject, err := gitlab_api.GetProjectByID(ctx, p.client, repositoryID)
+	if err != nil {
+		return err
+	}
+
+	tokenPlaceHolder := "token"
+	input := gitlab_activity.ProcessMergeActivityInput{
+		ProjectName:      gitlabProject.Name,
+		ProjectID:        gitlabProject.ID,
+		ProjectPath:      gitlabProject.PathWithNamespace,
+		ProjectNamespace: gitlabProject.Namespace.Name,
+		MergeID:          mergeRequest.IID,
+		RepositoryPath:   gitlabProject.PathWithNamespace,
+		LastCommitSha:    mergeRequest.SHA,
+	}
+
+	log.WithFields(f).Debugf("processing merge activity for input: %+v", input)
+	return p.s.gitlabActivityService.ProcessMergeActivity(ctx, tokenPlaceHolder, &input)
+}
+
+// ActiveSignatureKey returns the active signature key saved when the merge request sign request was initiated
+func (p *gitLabChangeRequestProvider) ActiveSignatureKey(signature *v1Models.Signature) string {
+	return activeSignatureKey(signature.SignatureReferenceID)
+}
+
+// mergeRequest returns the GitLab project ID and the merge request of the callback - the GitLab client is created once
+func (p *gitLabChangeRequestProvider) mergeRequest(ctx context.Context) (int, *goGitLab.MergeRequest, error) {
+	repositoryID, err := strconv.Atoi(p.repositoryID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to convert repository ID to int: %s - %w", p.repositoryID, err)
+	}
+	mergeRequestID, err := strconv.Atoi(p.mergeRequestID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to convert merge request ID to int: %s - %w", p.mergeRequestID, err)
+	}
+
+	if p.client == nil {
+		gitlabOrg, orgErr := p.s.gitlabOrgService.GetGitLabOrganizationByID(ctx, p.organizationID)
+		if orgErr != nil {
+			return 0, nil, orgErr
+		}
+		encryptedOauthResponse, authErr := p.s.gitlabOrgService.RefreshGitLabOrganizationAuth(ctx, gitlabOrg)
+		if authErr != nil {
+			return 0, nil, authErr
+		}
+		p.client, err = gitlab_api.NewGitlabOauthClient(*encryptedOauthResponse, p.s.gitlabApp)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	mergeRequest, err := gitlab_api.FetchMrInfo(p.client, repositoryID, mergeRequestID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return repositoryID, mergeRequest, nil
+}
+
+// giteaChangeRequestProvider handles the signed callbacks of Gitea/Forgejo pull requests
+type giteaChangeRequestProvider struct {
+	s              *service
+	userID         string
+	organizationID string
+	repositoryID   string
+	pullRequestID  string
+}
+
+func (s *service) newGiteaChangeRequestProvider(userID, organizationID, repositoryID, pullRequestID string) *giteaChangeRequestProvider {
+	return &giteaChangeRequestProvider{
+		s:              s,
+		userID:         userID,
+		organizationID: organizationID,
+		repositoryID:   repositoryID,
+		pullRequestID:  pullRequestID,
+	}
+}
+
+// Name returns the provider name
+func (p *giteaChangeRequestProvider) Name() string {
+	return utils.GiteaLower
+}
+
+// ResolveUser returns the CLA user who signed the signature
+func (p *giteaChangeRequestProvider) ResolveUser(ctx context.Context, signature *v1Models.Signature) (*v1Models.User, error) {
+	return p.s.resolveSignatureUser(ctx, signature, p.userID)
+}
+
+// ResolveReturnURL returns the return URL stored when the sign request was initiated - the pull request URL
+func (p *giteaChangeRequestProvider) ResolveReturnURL(ctx context.Context, signature *v1Models.Signature) (string, error) {
+	return signature.SignatureReturnURL, nil
+}
+
+// RefreshChangeRequest re-runs the pull request activity which updates the pull request status
+func (p *giteaChangeRequestProvider) RefreshChangeRequest(ctx context.Context, signature *v1Models.Signature) error {
+	repositoryID, err := strconv.ParseInt(p.repositoryID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to convert repository ID to int: %s - %w", p.repositoryID, err)
+	}
+	pullRequestID, err := strconv.ParseInt(p.pullRequestID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to convert pull request ID to int: %s - %w", p.pullRequestID, err)
+	}
+
+	giteaOrg, err := p.s.giteaOrgService.GetGiteaOrganization(ctx, p.organizationID)
+	if err != nil {
+		return err
+	}
+	return p.s.giteaActivityService.ProcessPullRequestActivity(ctx, giteaOrg, repositoryID, pullRequestID)
+}
+
+// ActiveSignatureKey returns the active signature key saved when the pull request sign request was initiated
+func (p *giteaChangeRequestProvider) ActiveSignatureKey(signature *v1Models.Signature) string {
+	return activeSignatureKey(signature.SignatureReferenceID)
+}
+
+// gerritChangeRequestProvider handles the signed callbacks of Gerrit changes - Gerrit checks the CLA status when the
+// change is pushed again, so there is no change request to refresh
+type gerritChangeRequestProvider struct {
+	s      *service
+	userID string
+}
+
+func (s *service) newGerritChangeRequestProvider(userID string) *gerritChangeRequestProvider {
+	return &gerritChangeRequestProvider{
+		s:      s,
+		userID: userID,
+	}
+}
+
+// Name returns the provider name
+func (p *gerritChangeRequestProvider) Name() string {
+	return "gerrit"
+}
+
+// ResolveUser returns the CLA user who signed the signature
+func (p *gerritChangeRequestProvider) ResolveUser(ctx context.Context, signature *v1Models.Signature) (*v1Models.User, error) {
+	return p.s.resolveSignatureUser(ctx, signature, p.userID)
+}
+
+// ResolveReturnURL returns the return URL provided when the signature was requested
+func (p *gerritChangeRequestProvider) ResolveReturnURL(ctx context.Context, signature *v1Models.Signature) (string, error) {
+	return signature.SignatureReturnURL, nil
+}
+
+// RefreshChangeRequest is a no-op for Gerrit
+func (p *gerritChangeRequestProvider) RefreshChangeRequest(ctx context.Context, signature *v1Models.Signature) error {
+	return nil
+}
+
+// ActiveSignatureKey returns an empty key - the Gerrit sign requests do not save the active signature metadata, the
+// active signature of the user belongs to a pending GitHub or GitLab sign request
+func (p *gerritChangeRequestProvider) ActiveSignatureKey(signature *v1Models.Signature) string {
+	return ""
+}
+
+// signedIndividualCallback is the signed individual callback pipeline shared by the code hosting platforms
+func (s *service) signedIndividualCallback(ctx context.Context, payload []byte, provider ChangeRequestProvider) error { // nolint:gocyclo
+	f := logrus.Fields{
+		"functionName":   "v2.sign.signedIndividualCallback",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"provider":       provider.Name(),
+	}
+
+	log.WithFields(f).Debug("processing signed individual callback...")
+	var info DocuSignEnvelopeInformation
+	err := xml.Unmarshal(payload, &info)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to unmarshal xml payload")
+		return err
+	}
+	if len(info.EnvelopeStatus.RecipientStatuses) == 0 || len(info.EnvelopeStatus.DocumentStatuses) == 0 {
+		log.WithFields(f).Warn("envelope payload is missing the recipient or document status")
+		return errors.New("envelope payload is missing the recipient or document status")
+	}
+
+	envelopeID := info.EnvelopeStatus.EnvelopeID
+	signatureID := info.EnvelopeStatus.RecipientStatuses[0].ClientUserId
+	status := info.EnvelopeStatus.RecipientStatuses[0].Status
+	signedDate := info.EnvelopeStatus.RecipientStatuses[0].Signed
+	documentID := info.EnvelopeStatus.DocumentStatuses[0].ID
+	fullName := fetchFullName(info)
+	f["envelopeID"] = envelopeID
+	f["signatureID"] = signatureID
+
+	log.WithFields(f).Debugf("envelopeID: %s, signatureID: %s, status: %s, signedDate: %s, fullName: %s", envelopeID, signatureID, status, signedDate, fullName)
+
+	signature, err := s.signatureService.GetSignature(ctx, signatureID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID")
+		return err
+	}
+	if signature == nil {
+		log.WithFields(f).Warn("unable to lookup signature by ID - signature not found")
+		return errors.New("unable to lookup signature by ID - signature not found")
+	}
+
+	if status != DocusignCompleted {
+		log.WithFields(f).Debugf("envelope not signed - status: %s", status)
+		return nil
+	}
+
+	log.WithFields(f).Debugf("envelope signed - status: %s", status)
+	_, currentTime := utils.CurrentTime()
+	updates := map[string]interface{}{
+		"signature_signed":          true,
+		"signature_embargo_acked":   true,
+		"date_modified":             currentTime,
+		"signed_on":                 currentTime,
+		"user_docusign_raw_xml":     string(payload),
+		"user_docusign_name":        fullName,
+		"user_docusign_date_signed": signedDate,
+	}
+	// Record the change request URL on signatures which were requested without a return URL (GitHub and GitLab) - the
+	// providers which only know the stored return URL (Gerrit and Gitea) resolve an empty URL and nothing is recorded
+	if signature.SignatureReturnURL == "" {
+		returnURL, returnURLErr := provider.ResolveReturnURL(ctx, signature)
+		if returnURLErr != nil {
+			log.WithFields(f).WithError(returnURLErr).Warn("unable to resolve the change request return URL")
+		} else if returnURL != "" {
+			updates["signature_return_url"] = returnURL
+		}
+	}
+	err = s.signatureService.UpdateSignature(ctx, signatureID, updates)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to update signature record with envelope ID: %s", envelopeID)
+		return err
+	}
+	log.WithFields(f).Debugf("updated signature record: %s", signatureID)
+
+	// Update the change request - do this early in the flow as the user will be immediately redirected back
+	log.WithFields(f).Debug("refreshing change request...")
+	err = provider.RefreshChangeRequest(ctx, signature)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to update change request")
+		return err
+	}
+
+	claUser, err := provider.ResolveUser(ctx, signature)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to lookup user by ID: %s", signature.SignatureReferenceID)
+		return err
+	}
+
+	if claUser.Username == "" && fullName != "" {
+		log.WithFields(f).Debugf("updating user with username: %s", fullName)
+		_, err = s.userService.UpdateUser(signature.SignatureReferenceID, map[string]interface{}{
+			"user_name": fullName,
+		})
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("unable to update user with username: %s", fullName)
+			return err
+		}
+	}
+
+	// Remove the active signature
+	if key := provider.ActiveSignatureKey(signature); key != "" {
+		log.WithFields(f).Debugf("removing active signature metadata for user: %s", signature.SignatureReferenceID)
+		err = s.storeRepository.DeleteActiveSignatureMetaData(ctx, key)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("unable to remove active signature metadata for user: %s", signature.SignatureReferenceID)
+			return err
+		}
+	}
+
+	log.WithFields(f).Debugf("getting signed document for envelope ID: %s", envelopeID)
+	signedDocument, err := s.GetSignedDocument(ctx, envelopeID, documentID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to get signed document for envelope ID: %s", envelopeID)
+		return err
+	}
+
+	log.WithFields(f).Debugf("getting claGroupID: %s", signature.ProjectID)
+	claGroup, err := s.claGroupService.GetCLAGroup(ctx, signature.ProjectID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", signature.ProjectID)
+		return err
+	}
+
+	// The project CLA group name is only a fallback for the email and event, a failed lookup does not fail the callback
+	projectName := claGroup.ProjectName
+	if projectName == "" {
+		pcg, pcgErr := s.projectClaGroupsRepo.GetCLAGroup(ctx, signature.ProjectID)
+		if pcgErr != nil {
+			log.WithFields(f).WithError(pcgErr).Warnf("unable to lookup project cla group by project ID: %s", signature.ProjectID)
+		} else if pcg != nil {
+			projectName = pcg.ProjectName
+			log.WithFields(f).Debugf("project name not found in cla_group, using project cla group name: %s", projectName)
+		}
+	}
+
+	if claUser.UserID == "" {
+		return fmt.Errorf("user id is empty for user: %s", claUser.Username)
+	}
+
+	log.WithFields(f).Debugf("storing signed document on S3...")
+	err = s.storeSignedDocument(ctx, signedDocument, signature.ProjectID, utils.ClaTypeICLA, claUser.UserID, signature.SignatureID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to store signed document on S3")
+		return err
+	}
+
+	log.WithFields(f).Debugf("logging event...")
+	s.eventsService.LogEvent(&events.LogEventArgs{
+		EventType:  events.IndividualSignatureSigned,
+		ProjectID:  signature.ProjectID,
+		UserID:     claUser.UserID,
+		LfUsername: fullName,
+		EventData: &events.IndividualSignatureSignedEventData{
+			ProjectName: projectName,
+			Username:    fullName,
+			ProjectID:   signature.ProjectID,
+		},
+		CLAGroupID: signature.ProjectID,
+	})
+
+	// The email is the last side effect - the user is only told the CLA is signed once the signed document is stored
+	email := utils.GetBestEmail(claUser)
+	if email == "" {
+		log.WithFields(f).Warnf("unable to find email for user: %+v", claUser)
+		return errors.New("unable to find email for user")
+	}
+
+	subject := fmt.Sprintf("EasyCLA: Individual CLA Signed for %s", projectName)
+	pdfLink := fmt.Sprintf("%s/v3/signatures/%s/%s/icla/pdf", s.ClaV1ApiURL, signature.ProjectID, signature.SignatureReferenceID)
+	emailParams := emails.DocumentSignedTemplateParams{
+		CommonEmailParams: emails.CommonEmailParams{
+			RecipientName: fullName,
+		},
+		PdfLink: pdfLink,
+		ICLA:    true,
+	}
+	body, err := emails.RenderDocumentSignedTemplate(s.emailTemplateService, claGroup.Version, claGroup.ProjectExternalID, emailParams)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to render document signed template for project version: %s, project ID: %s", claGroup.Version, claGroup.ProjectID)
+		return err
+	}
+
+	log.WithFields(f).Debugf("sending email to user... ")
+	err = utils.SendEmail(subject, body, []string{email})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to send email to user: %s", claUser.Username)
+		return err
+	}
+	log.WithFields(f).Debugf("email sent to user: %s", claUser.Username)
+
+	return nil
+}
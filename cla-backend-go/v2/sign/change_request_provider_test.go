@@ -0,0 +1,361 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/emails"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	mock_events "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	mock_projects_cla_groups "github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups/mocks"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/users"
+	mock_users "github.com/communitybridge/easycla/cla-backend-go/users/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/cla_groups"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const signedIndividualPayload = `<DocuSignEnvelopeInformation>
+  <EnvelopeStatus>
+    <EnvelopeID>envelope-1</EnvelopeID>
+    <Status>Completed</Status>
+    <RecipientStatuses>
+      <RecipientStatus>
+        <ClientUserId>signature-1</ClientUserId>
+        <Status>Completed</Status>
+        <Signed>2026-10-16T10:00:00</Signed>
+        <TabStatuses>
+          <TabStatus><TabLabel>full_name</TabLabel><TabValue>John Doe</TabValue></TabStatus>
+        </TabStatuses>
+      </RecipientStatus>
+    </RecipientStatuses>
+    <DocumentStatuses>
+      <DocumentStatus><ID>1</ID></DocumentStatus>
+    </DocumentStatuses>
+  </EnvelopeStatus>
+</DocuSignEnvelopeInformation>`
+
+// fakeChangeRequestProvider records the change request refreshes of the signed callback pipeline
+type fakeChangeRequestProvider struct {
+	s                  *service
+	returnURL          string
+	activeSignatureKey string
+	refreshErr         error
+	refreshed          int
+}
+
+func (p *fakeChangeRequestProvider) Name() string {
+	return "fake"
+}
+
+func (p *fakeChangeRequestProvider) ResolveUser(ctx context.Context, signature *v1Models.Signature) (*v1Models.User, error) {
+	return p.s.resolveSignatureUser(ctx, signature, "")
+}
+
+func (p *fakeChangeRequestProvider) ResolveReturnURL(ctx context.Context, signature *v1Models.Signature) (string, error) {
+	return p.returnURL, nil
+}
+
+func (p *fakeChangeRequestProvider) RefreshChangeRequest(ctx context.Context, signature *v1Models.Signature) error {
+	p.refreshed++
+	return p.refreshErr
+}
+
+func (p *fakeChangeRequestProvider) ActiveSignatureKey(signature *v1Models.Signature) string {
+	return p.activeSignatureKey
+}
+
+// fakeCLAGroupService returns the CLA group of the signature
+type fakeCLAGroupService struct {
+	cla_groups.Service
+	claGroup *v1Models.ClaGroup
+}
+
+func (s *fakeCLAGroupService) GetCLAGroup(ctx context.Context, claGroupID string) (*v1Models.ClaGroup, error) {
+	return s.claGroup, nil
+}
+
+// fakeEmailTemplateService returns the CLA group template parameters of a single project
+type fakeEmailTemplateService struct{}
+
+func (s *fakeEmailTemplateService) PrefillV2CLAProjectParams(projectSFIDs []string) ([]emails.CLAProjectParams, error) {
+	return nil, nil
+}
+
+func (s *fakeEmailTemplateService) GetCLAGroupTemplateParamsFromProjectSFID(claGroupVersion, projectSFID string) (emails.CLAGroupTemplateParams, error) {
+	return s.GetCLAGroupTemplateParamsFromCLAGroup("")
+}
+
+func (s *fakeEmailTemplateService) GetCLAGroupTemplateParamsFromCLAGroup(claGroupID string) (emails.CLAGroupTemplateParams, error) {
+	return emails.CLAGroupTemplateParams{
+		Projects: []emails.CLAProjectParams{{ExternalProjectName: "Project"}},
+	}, nil
+}
+
+// recordingEmailSender records the subjects of the emails sent
+type recordingEmailSender struct {
+	subjects []string
+}
+
+func (e *recordingEmailSender) SendEmail(subject string, body string, recipients []string) error {
+	e.subjects = append(e.subjects, subject)
+	return nil
+}
+
+// fakeS3Storage records the uploaded documents, the uploads fail with uploadErr
+type fakeS3Storage struct {
+	uploads   int
+	uploadErr error
+}
+
+func (s *fakeS3Storage) Upload(fileContent []byte, projectID string, claType string, identifier string, signatureID string) error {
+	if s.uploadErr != nil {
+		return s.uploadErr
+	}
+	s.uploads++
+	return nil
+}
+
+func (s *fakeS3Storage) UploadFile(file *os.File, projectID string, claType string, identifier string, signatureID string) error {
+	return nil
+}
+
+func (s *fakeS3Storage) UploadKey(fileContent []byte, key string) error {
+	return nil
+}
+
+func (s *fakeS3Storage) Download(filename string) ([]byte, error) {
+	return nil, nil
+}
+
+func (s *fakeS3Storage) Delete(filename string) error {
+	return nil
+}
+
+func (s *fakeS3Storage) GetPresignedURL(filename string) (string, error) {
+	return "", nil
+}
+
+func (s *fakeS3Storage) KeyExists(key string) (bool, error) {
+	return false, nil
+}
+
+func TestChangeRequestProviders(t *testing.T) {
+	s := &service{}
+	signature := &v1Models.Signature{SignatureReferenceID: "user-1", SignatureReturnURL: "https://github.com/org/repo/pull/1"}
+
+	testCases := []struct {
+		Name                       string
+		Provider                   ChangeRequestProvider
+		ExpectedName               string
+		ExpectedActiveSignatureKey string
+	}{
+		{Name: "github", Provider: s.newGitHubChangeRequestProvider("1", "2", "3"), ExpectedName: utils.GitHubType, ExpectedActiveSignatureKey: "active_signature:user-1"},
+		{Name: "gitlab", Provider: s.newGitLabChangeRequestProvider("user-1", "org-1", "2", "3"), ExpectedName: utils.GitLabLower, ExpectedActiveSignatureKey: "active_signature:user-1"},
+		{Name: "gitea", Provider: s.newGiteaChangeRequestProvider("user-1", "org-1", "2", "3"), ExpectedName: utils.GiteaLower, ExpectedActiveSignatureKey: "active_signature:user-1"},
+		{Name: "gerrit", Provider: s.newGerritChangeRequestProvider("user-1"), ExpectedName: "gerrit"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			assert.Equal(tt, tc.ExpectedName, tc.Provider.Name())
+			assert.Equal(tt, tc.ExpectedActiveSignatureKey, tc.Provider.ActiveSignatureKey(signature))
+
+			// the stored return URL is used without a change request lookup
+			returnURL, err := tc.Provider.ResolveReturnURL(context.Background(), signature)
+			assert.NoError(tt, err)
+			assert.Equal(tt, signature.SignatureReturnURL, returnURL)
+		})
+	}
+}
+
+func TestSignedIndividualCallback(t *testing.T) {
+	refreshErr := errors.New("unable to update the pull request")
+	uploadErr := errors.New("unable to upload the signed document")
+
+	testCases := []struct {
+		Name                   string
+		Gerrit                 bool
+		ReturnURL              string
+		ClaGroupName           string
+		ProjectCLAGroupErr     error
+		RefreshErr             error
+		UploadErr              error
+		ExpectedErr            error
+		ExpectedReturnURL      interface{}
+		ExpectedRefreshes      int
+		ExpectedActiveRemoved  bool
+		ExpectedSubject        string
+		ExpectedDocumentStored bool
+		ExpectedEventLogged    bool
+	}{
+		{
+			Name:                   "github signed",
+			ReturnURL:              "https://github.com/org/repo/pull/1",
+			ClaGroupName:           "Project",
+			ExpectedReturnURL:      "https://github.com/org/repo/pull/1",
+			ExpectedRefreshes:      1,
+			ExpectedActiveRemoved:  true,
+			ExpectedSubject:        "EasyCLA: Individual CLA Signed for Project",
+			ExpectedDocumentStored: true,
+			ExpectedEventLogged:    true,
+		},
+		{
+			Name:                   "gerrit signed keeps the active signature and records no return URL",
+			Gerrit:                 true,
+			ClaGroupName:           "Project",
+			ExpectedSubject:        "EasyCLA: Individual CLA Signed for Project",
+			ExpectedDocumentStored: true,
+			ExpectedEventLogged:    true,
+		},
+		{
+			Name:                   "project name from the project CLA group",
+			ExpectedRefreshes:      1,
+			ExpectedActiveRemoved:  true,
+			ExpectedSubject:        "EasyCLA: Individual CLA Signed for Project CLA Group",
+			ExpectedDocumentStored: true,
+			ExpectedEventLogged:    true,
+		},
+		{
+			Name:                   "project CLA group lookup failure does not fail the callback",
+			ProjectCLAGroupErr:     errors.New("project cla group not found"),
+			ExpectedRefreshes:      1,
+			ExpectedActiveRemoved:  true,
+			ExpectedSubject:        "EasyCLA: Individual CLA Signed for ",
+			ExpectedDocumentStored: true,
+			ExpectedEventLogged:    true,
+		},
+		{
+			Name:              "change request refresh failure",
+			RefreshErr:        refreshErr,
+			ExpectedErr:       refreshErr,
+			ExpectedRefreshes: 1,
+		},
+		{
+			Name:                  "signed document storage failure sends no email",
+			ClaGroupName:          "Project",
+			UploadErr:             uploadErr,
+			ExpectedErr:           uploadErr,
+			ExpectedRefreshes:     1,
+			ExpectedActiveRemoved: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			emailSender := &recordingEmailSender{}
+			previousEmailSender := utils.GetEmailSender()
+			utils.SetEmailSender(emailSender)
+			tt.Cleanup(func() { utils.SetEmailSender(previousEmailSender) })
+			s3Storage := &fakeS3Storage{uploadErr: tc.UploadErr}
+			utils.SetS3StorageClient(s3Storage)
+			tt.Cleanup(func() { utils.SetS3StorageClient(nil) })
+
+			var updates map[string]interface{}
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			signatureService.EXPECT().GetSignature(gomock.Any(), "signature-1").Return(&v1Models.Signature{
+				SignatureID:          "signature-1",
+				SignatureReferenceID: "user-1",
+				ProjectID:            "cla-group-1",
+			}, nil)
+			signatureService.EXPECT().UpdateSignature(gomock.Any(), "signature-1", gomock.Any()).DoAndReturn(func(ctx context.Context, signatureID string, signatureUpdates map[string]interface{}) error {
+				if updates == nil {
+					updates = signatureUpdates
+				}
+				return nil
+			}).AnyTimes()
+
+			userRepo := mock_users.NewMockUserRepository(ctrl)
+			userRepo.EXPECT().GetUser("user-1").Return(&v1Models.User{
+				UserID:   "user-1",
+				Username: "John Doe",
+				Emails:   []string{"john@example.com"},
+			}, nil).AnyTimes()
+
+			pcgRepo := mock_projects_cla_groups.NewMockRepository(ctrl)
+			pcgRepo.EXPECT().GetCLAGroup(gomock.Any(), "cla-group-1").Return(&projects_cla_groups.ProjectClaGroup{ProjectName: "Project CLA Group"}, tc.ProjectCLAGroupErr).AnyTimes()
+
+			// the event is logged once the signed document is stored and before the email is sent
+			var eventLogged bool
+			eventsService := mock_events.NewMockService(ctrl)
+			eventsService.EXPECT().LogEvent(gomock.Any()).Do(func(args *events.LogEventArgs) {
+				if args.EventType != events.IndividualSignatureSigned {
+					return
+				}
+				eventLogged = true
+				assert.Equal(tt, 1, s3Storage.uploads)
+				assert.Empty(tt, emailSender.subjects)
+			}).AnyTimes()
+
+			storeRepository := newFakeStore()
+			storeRepository.values["active_signature:user-1"] = `{"user_id":"user-1"}`
+
+			s := &service{
+				docusign:             &fakeSignatureProvider{},
+				signatureService:     signatureService,
+				userService:          users.NewService(userRepo, eventsService),
+				projectClaGroupsRepo: pcgRepo,
+				claGroupService:      &fakeCLAGroupService{claGroup: &v1Models.ClaGroup{ProjectID: "cla-group-1", ProjectName: tc.ClaGroupName, Version: utils.V2}},
+				storeRepository:      storeRepository,
+				emailTemplateService: &fakeEmailTemplateService{},
+				eventsService:        eventsService,
+			}
+
+			fake := &fakeChangeRequestProvider{s: s, returnURL: tc.ReturnURL, activeSignatureKey: "active_signature:user-1", refreshErr: tc.RefreshErr}
+			var provider ChangeRequestProvider = fake
+			if tc.Gerrit {
+				provider = s.newGerritChangeRequestProvider("user-1")
+			}
+
+			err := s.signedIndividualCallback(ctx, []byte(signedIndividualPayload), provider)
+			assert.Equal(tt, tc.ExpectedErr, err)
+			assert.Equal(tt, tc.ExpectedRefreshes, fake.refreshed)
+
+			// the signature is updated before the change request is refreshed
+			assert.Equal(tt, true, updates["signature_signed"])
+			assert.Equal(tt, "John Doe", updates["user_docusign_name"])
+			assert.Equal(tt, tc.ExpectedReturnURL, updates["signature_return_url"])
+
+			_, activeSignature := storeRepository.values["active_signature:user-1"]
+			assert.Equal(tt, !tc.ExpectedActiveRemoved, activeSignature)
+
+			if tc.ExpectedSubject == "" {
+				assert.Empty(tt, emailSender.subjects)
+			} else {
+				assert.Equal(tt, []string{tc.ExpectedSubject}, emailSender.subjects)
+			}
+			assert.Equal(tt, tc.ExpectedDocumentStored, s3Storage.uploads == 1)
+			assert.Equal(tt, tc.ExpectedEventLogged, eventLogged)
+		})
+	}
+}
+
+func TestSignedIndividualCallback_NotCompleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	signatureService := mock_signatures.NewMockSignatureService(ctrl)
+	signatureService.EXPECT().GetSignature(gomock.Any(), "signature-1").Return(&v1Models.Signature{SignatureID: "signature-1"}, nil)
+
+	s := &service{signatureService: signatureService}
+	provider := &fakeChangeRequestProvider{s: s}
+	payload := []byte(`<DocuSignEnvelopeInformation><EnvelopeStatus><EnvelopeID>envelope-1</EnvelopeID><RecipientStatuses><RecipientStatus><ClientUserId>signature-1</ClientUserId><Status>Delivered</Status></RecipientStatus></RecipientStatuses><DocumentStatuses><DocumentStatus><ID>1</ID></DocumentStatus></DocumentStatuses></EnvelopeStatus></DocuSignEnvelopeInformation>`)
+
+	assert.NoError(t, s.signedIndividualCallback(context.Background(), payload, provider))
+	assert.Equal(t, 0, provider.refreshed)
+
+	assert.Error(t, s.signedIndividualCallback(context.Background(), []byte(`<DocuSignEnvelopeInformation/>`), provider))
+}
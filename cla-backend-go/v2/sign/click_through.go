@@ -0,0 +1,778 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// click-through constants
+const (
+	clickThroughEnvelopePrefix  = "click-through-"
+	clickThroughStoreKeyPrefix  = "click_through_envelope:"
+	clickThroughEnvelopeTTLDays = 90
+
+	// the callback is notified up to clickThroughNotifyAttempts times, the delay doubles after each attempt
+	clickThroughNotifyAttempts   = 3
+	clickThroughNotifyRetryDelay = time.Second
+
+	ClickThroughStatusSent      = "sent"
+	ClickThroughStatusCompleted = DocusignCompleted
+	ClickThroughStatusVoided    = "voided"
+)
+
+// click-through errors
+var (
+	ErrClickThroughEnvelopeNotFound = errors.New("click-through envelope not found")
+	ErrClickThroughInvalidToken     = errors.New("click-through signing token is invalid")
+	ErrClickThroughNotSignable      = errors.New("click-through envelope is not available for signing")
+	ErrClickThroughConsentRequired  = errors.New("click-through consent requires the signer name and agreement")
+	ErrClickThroughEnvelopeChanged  = errors.New("click-through envelope was changed by another request")
+)
+
+// clickThroughEnvelope is the click-through envelope record persisted in the store table
+type clickThroughEnvelope struct {
+	EnvelopeID   string                `json:"envelope_id"`
+	Status       string                `json:"status"`
+	CallbackURL  string                `json:"callback_url"`
+	EmailSubject string                `json:"email_subject"`
+	EmailBlurb   string                `json:"email_blurb,omitempty"`
+	DocumentID   string                `json:"document_id"`
+	DocumentName string                `json:"document_name"`
+	Signers      []*clickThroughSigner `json:"signers"`
+	VoidedReason string                `json:"voided_reason,omitempty"`
+	CreatedOn    string                `json:"created_on"`
+	CompletedOn  string                `json:"completed_on,omitempty"`
+	// SignedDocumentKey is the S3 key of the document stamped with the consents recorded so far
+	SignedDocumentKey string `json:"signed_document_key,omitempty"`
+	// Version is incremented on every save, the envelope is only saved if it was not changed since it was loaded
+	Version int `json:"version"`
+
+	// stored is the envelope value loaded from the store, the expected value of the conditional save
+	stored string
+}
+
+// clickThroughSigner is a single signer of a click-through envelope along with the recorded consent details
+type clickThroughSigner struct {
+	RecipientID      string `json:"recipient_id"`
+	ClientUserID     string `json:"client_user_id,omitempty"`
+	RoutingOrder     string `json:"routing_order,omitempty"`
+	RoleName         string `json:"role_name,omitempty"`
+	Name             string `json:"name"`
+	Email            string `json:"email"`
+	Status           string `json:"status"`
+	Token            string `json:"token,omitempty"`
+	ReturnURL        string `json:"return_url,omitempty"`
+	LinkSent         bool   `json:"link_sent,omitempty"`
+	SignedName       string `json:"signed_name,omitempty"`
+	SignedOn         string `json:"signed_on,omitempty"`
+	ConsentIPAddress string `json:"consent_ip_address,omitempty"`
+	ConsentUserAgent string `json:"consent_user_agent,omitempty"`
+}
+
+// clickThroughDocuments stores and stamps the click-through documents
+type clickThroughDocuments interface {
+	Upload(document []byte, key string) error
+	Download(key string) ([]byte, error)
+	DownloadLink(key string) (string, error)
+	Stamp(document []byte, text string) ([]byte, error)
+}
+
+// s3ClickThroughDocuments stores the click-through documents in the S3 bucket
+type s3ClickThroughDocuments struct{}
+
+func (s3ClickThroughDocuments) Upload(document []byte, key string) error {
+	return utils.UploadKeyToS3(document, key)
+}
+
+func (s3ClickThroughDocuments) Download(key string) ([]byte, error) {
+	return utils.DownloadFromS3(key)
+}
+
+func (s3ClickThroughDocuments) DownloadLink(key string) (string, error) {
+	return utils.GetDownloadLink(key)
+}
+
+func (s3ClickThroughDocuments) Stamp(document []byte, text string) ([]byte, error) {
+	return utils.StampPdf(document, text)
+}
+
+// clickThroughProvider is a local signature provider - the signer reviews the rendered CLA document and records
+// their consent, the consent is stamped on the PDF and the signed PDF is stored in S3
+type clickThroughProvider struct {
+	apiURL           string
+	storeRepository  store.Repository
+	documents        clickThroughDocuments
+	httpClient       *http.Client
+	notifyRetryDelay time.Duration
+}
+
+// newClickThroughProvider returns a new click-through signature provider
+func newClickThroughProvider(apiURL string, storeRepository store.Repository) *clickThroughProvider {
+	return &clickThroughProvider{
+		apiURL:           apiURL,
+		storeRepository:  storeRepository,
+		documents:        s3ClickThroughDocuments{},
+		httpClient:       &http.Client{Timeout: 30 * time.Second},
+		notifyRetryDelay: clickThroughNotifyRetryDelay,
+	}
+}
+
+// isClickThroughEnvelope returns true if the envelope was created by the click-through provider
+func isClickThroughEnvelope(envelopeID string) bool {
+	return strings.HasPrefix(envelopeID, clickThroughEnvelopePrefix)
+}
+
+// Name returns the provider name
+func (p *clickThroughProvider) Name() string {
+	return SignatureProviderClickThrough
+}
+
+// PrepareSignRequest creates a new click-through envelope, signers without a client user ID are sent the signing link by email
+func (p *clickThroughProvider) PrepareSignRequest(ctx context.Context, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.clickThroughProvider.PrepareSignRequest",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if len(signRequest.Documents) == 0 || len(signRequest.Recipients.Signers) == 0 {
+		msg := "click-through sign request requires a document and at least one signer"
+		log.WithFields(f).Warn(msg)
+		return nil, errors.New(msg)
+	}
+
+	envelopeID := clickThroughEnvelopePrefix + uuid.Must(uuid.NewV4()).String()
+	f["envelopeID"] = envelopeID
+	_, currentTime := utils.CurrentTime()
+
+	document := signRequest.Documents[0]
+	pdf, err := base64.StdEncoding.DecodeString(document.DocumentBase64)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to decode the envelope document")
+		return nil, err
+	}
+
+	log.WithFields(f).Debugf("uploading click-through document: %s to s3", document.Name)
+	err = p.documents.Upload(pdf, utils.ClickThroughDocumentFilename(envelopeID, document.DocumentId))
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to upload the click-through document to s3")
+		return nil, err
+	}
+
+	envelope := &clickThroughEnvelope{
+		EnvelopeID:   envelopeID,
+		Status:       ClickThroughStatusSent,
+		CallbackURL:  signRequest.EventNotification.URL,
+		EmailSubject: signRequest.EmailSubject,
+		EmailBlurb:   signRequest.EmailBlurb,
+		DocumentID:   document.DocumentId,
+		DocumentName: document.Name,
+		CreatedOn:    currentTime,
+	}
+	for _, recipient := range signRequest.Recipients.Signers {
+		envelope.Signers = append(envelope.Signers, &clickThroughSigner{
+			RecipientID:  recipient.RecipientId,
+			ClientUserID: recipient.ClientUserId,
+			RoutingOrder: recipient.RoutingOrder,
+			RoleName:     recipient.RoleName,
+			Name:         recipient.Name,
+			Email:        recipient.Email,
+			Status:       ClickThroughStatusSent,
+		})
+	}
+
+	err = p.saveEnvelope(ctx, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.sendSigningLinks(ctx, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DocusignEnvelopeResponse{
+		EnvelopeId:     envelopeID,
+		Status:         ClickThroughStatusSent,
+		StatusDateTime: currentTime,
+		Uri:            fmt.Sprintf("/envelopes/%s", envelopeID),
+	}, nil
+}
+
+// sendSigningLinks emails the signing link to the signers at the current routing order. Signers without a client user
+// ID are not redirected to the signing page by the caller, signers at a later routing order are emailed once every
+// signer before them has signed.
+func (p *clickThroughProvider) sendSigningLinks(ctx context.Context, envelope *clickThroughEnvelope) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.clickThroughProvider.sendSigningLinks",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"envelopeID":     envelope.EnvelopeID,
+	}
+
+	currentOrder := envelope.currentRoutingOrder()
+	sent := 0
+	for _, signer := range envelope.Signers {
+		if signer.ClientUserID != "" || signer.LinkSent || signer.Status != ClickThroughStatusSent || routingOrder(signer.RoutingOrder) != currentOrder {
+			continue
+		}
+		signURL, signURLErr := p.newSigningLink(envelope, signer, "")
+		if signURLErr != nil {
+			log.WithFields(f).WithError(signURLErr).Warnf("unable to create the click-through signing link for: %s", signer.Email)
+			return signURLErr
+		}
+		body := fmt.Sprintf("%s<p>Please review and sign the document using the following link: <a href=\"%s\" target=\"_blank\">%s</a></p>",
+			envelope.EmailBlurb, signURL, signURL)
+		log.WithFields(f).Debugf("sending click-through signing link to: %s", signer.Email)
+		emailErr := utils.SendEmail(envelope.EmailSubject, body, []string{signer.Email})
+		if emailErr != nil {
+			log.WithFields(f).WithError(emailErr).Warnf("unable to send the click-through signing link to: %s", signer.Email)
+			return emailErr
+		}
+		signer.LinkSent = true
+		sent++
+	}
+
+	if sent == 0 {
+		return nil
+	}
+	return p.saveEnvelope(ctx, envelope)
+}
+
+// CreateEnvelope creates a new click-through envelope and returns the envelope ID
+func (p *clickThroughProvider) CreateEnvelope(ctx context.Context, payload *DocuSignEnvelopeRequest) (string, error) {
+	response, err := p.PrepareSignRequest(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+	return response.EnvelopeId, nil
+}
+
+// AddDocumentToEnvelope replaces the click-through envelope document
+func (p *clickThroughProvider) AddDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error {
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return err
+	}
+
+	err = p.documents.Upload(document, utils.ClickThroughDocumentFilename(envelopeID, envelope.DocumentID))
+	if err != nil {
+		return err
+	}
+
+	envelope.DocumentName = documentName
+	return p.saveEnvelope(ctx, envelope)
+}
+
+// GetEnvelopeRecipients returns the list of signers for the click-through envelope
+func (p *clickThroughProvider) GetEnvelopeRecipients(ctx context.Context, envelopeID string) ([]Signer, error) {
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]Signer, 0, len(envelope.Signers))
+	for _, signer := range envelope.Signers {
+		signers = append(signers, Signer{
+			Name:           signer.Name,
+			Email:          signer.Email,
+			RecipientId:    signer.RecipientID,
+			ClientUserId:   signer.ClientUserID,
+			RoutingOrder:   signer.RoutingOrder,
+			RoleName:       signer.RoleName,
+			Status:         signer.Status,
+			SignedDateTime: signer.SignedOn,
+		})
+	}
+
+	return signers, nil
+}
+
+// GetSignURL creates a new single use signing token for the recipient and returns the click-through signing page URL
+func (p *clickThroughProvider) GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error) {
+	f := logrus.Fields{
+		"functionName": "v2.sign.clickThroughProvider.GetSignURL",
+		"envelopeID":   envelopeID,
+		"recipientID":  recipientID,
+	}
+	ctx := context.Background()
+
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return "", err
+	}
+
+	signer := envelope.signer(recipientID)
+	if signer == nil {
+		log.WithFields(f).Warnf("recipient: %s not found in envelope", recipientID)
+		return "", ErrClickThroughEnvelopeNotFound
+	}
+
+	signURL, err := p.newSigningLink(envelope, signer, returnURL)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to generate the signing token")
+		return "", err
+	}
+
+	err = p.saveEnvelope(ctx, envelope)
+	if err != nil {
+		return "", err
+	}
+
+	return signURL, nil
+}
+
+// newSigningLink assigns a new single use signing token to the signer and returns the click-through signing page URL,
+// the caller saves the envelope
+func (p *clickThroughProvider) newSigningLink(envelope *clickThroughEnvelope, signer *clickThroughSigner, returnURL string) (string, error) {
+	token, err := newClickThroughToken()
+	if err != nil {
+		return "", err
+	}
+
+	signer.Token = token
+	if returnURL != "" {
+		signer.ReturnURL = returnURL
+	}
+
+	return fmt.Sprintf("%s/v4/sign/click-through/%s?recipient_id=%s&token=%s",
+		p.apiURL, envelope.EnvelopeID, url.QueryEscape(signer.RecipientID), url.QueryEscape(token)), nil
+}
+
+// GetSignedDocument returns the click-through document stamped with the consents of the signers
+func (p *clickThroughProvider) GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error) {
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return nil, err
+	}
+	return p.documents.Download(envelope.signedDocumentKey())
+}
+
+// GetEnvelopeDocuments returns the list of documents for the click-through envelope
+func (p *clickThroughProvider) GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error) {
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []DocuSignDocument{
+		{
+			Name:           envelope.DocumentName,
+			DocumentId:     envelope.DocumentID,
+			FileExtension:  "pdf",
+			FileFormatHint: "pdf",
+			Order:          "1",
+		},
+	}, nil
+}
+
+// VoidEnvelope voids the click-through envelope - voided envelopes can no longer be signed
+func (p *clickThroughProvider) VoidEnvelope(ctx context.Context, envelopeID, message string) error {
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return err
+	}
+
+	if envelope.Status == ClickThroughStatusCompleted {
+		return errors.New("unable to void a completed click-through envelope")
+	}
+
+	envelope.Status = ClickThroughStatusVoided
+	envelope.VoidedReason = message
+	return p.saveEnvelope(ctx, envelope)
+}
+
+// GetSigningDocumentURL validates the signing token and returns the envelope, the signer and a pre-signed URL of the document to review
+func (p *clickThroughProvider) GetSigningDocumentURL(ctx context.Context, envelopeID, recipientID, token string) (*clickThroughEnvelope, *clickThroughSigner, string, error) {
+	envelope, signer, err := p.getSignableEnvelope(ctx, envelopeID, recipientID, token)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	documentURL, err := p.documents.DownloadLink(utils.ClickThroughDocumentFilename(envelopeID, envelope.DocumentID))
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return envelope, signer, documentURL, nil
+}
+
+// Consent records the signer consent, stamps and stores the signed document and notifies the envelope callback URL
+// using the same payload format as DocuSign Connect. Returns the URL the signer should be redirected to - the consent
+// succeeds once the envelope is saved, the routing emails and the callback notification are not rolled back.
+func (p *clickThroughProvider) Consent(ctx context.Context, envelopeID, recipientID, token, signedName, remoteAddr, userAgent string) (string, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.clickThroughProvider.Consent",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"envelopeID":     envelopeID,
+		"recipientID":    recipientID,
+		"remoteAddr":     remoteAddr,
+	}
+
+	signedName = strings.TrimSpace(signedName)
+	if signedName == "" {
+		return "", ErrClickThroughConsentRequired
+	}
+
+	envelope, signer, err := p.getSignableEnvelope(ctx, envelopeID, recipientID, token)
+	if err != nil {
+		return "", err
+	}
+
+	signedOn, signedOnStr := utils.CurrentTime()
+
+	// Stamp the consent on the previously signed document (if any) so that every signer is recorded
+	sourceKey := utils.ClickThroughDocumentFilename(envelopeID, envelope.DocumentID)
+	if envelope.completedSigners() > 0 {
+		sourceKey = envelope.signedDocumentKey()
+	}
+	pdf, err := p.documents.Download(sourceKey)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to download the click-through document: %s", sourceKey)
+		return "", err
+	}
+
+	stamp := fmt.Sprintf("Electronically signed by %s <%s> on %s UTC via EasyCLA click-through, envelope %s",
+		signedName, signer.Email, signedOn.Format("2006-01-02 15:04:05"), envelopeID)
+	signedPdf, err := p.documents.Stamp(pdf, stamp)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to stamp the click-through document")
+		return "", err
+	}
+
+	// Each signer stamps its own copy, the envelope only refers to it once the consent is saved - a consent losing the
+	// conditional save to a concurrent one leaves the document of the saved envelope unchanged
+	signedKey := utils.ClickThroughSignedDocumentFilename(envelopeID, envelope.DocumentID+"-"+signer.RecipientID)
+	err = p.documents.Upload(signedPdf, signedKey)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to upload the signed click-through document")
+		return "", err
+	}
+
+	envelope.SignedDocumentKey = signedKey
+	signer.Status = ClickThroughStatusCompleted
+	signer.Token = ""
+	signer.SignedName = signedName
+	signer.SignedOn = signedOnStr
+	signer.ConsentIPAddress = remoteAddr
+	signer.ConsentUserAgent = userAgent
+	if envelope.completedSigners() == len(envelope.Signers) {
+		envelope.Status = ClickThroughStatusCompleted
+		envelope.CompletedOn = signedOnStr
+	}
+
+	err = p.saveEnvelope(ctx, envelope)
+	if err != nil {
+		return "", err
+	}
+
+	if envelope.Status != ClickThroughStatusCompleted {
+		// Route the envelope to the next signers
+		routeErr := p.sendSigningLinks(ctx, envelope)
+		if routeErr != nil {
+			log.WithFields(f).WithError(routeErr).Warn("unable to send the signing links to the next signers")
+		}
+	}
+
+	// Multi-party envelopes also notify the callback as each signer completes - same as the DocuSign recipient events
+	if envelope.CallbackURL != "" && (envelope.Status == ClickThroughStatusCompleted || len(envelope.Signers) > 1) {
+		notifyErr := p.notifyWithRetry(ctx, envelope)
+		if notifyErr != nil {
+			// the envelope reconciliation replays the signed callback of the completed envelopes
+			log.WithFields(f).WithError(notifyErr).Warnf("unable to notify the click-through callback: %s", envelope.CallbackURL)
+		}
+	}
+
+	return signer.ReturnURL, nil
+}
+
+// notifyWithRetry notifies the envelope callback URL, retrying the failed notifications
+func (p *clickThroughProvider) notifyWithRetry(ctx context.Context, envelope *clickThroughEnvelope) error {
+	delay := p.notifyRetryDelay
+	var err error
+	for attempt := 1; attempt <= clickThroughNotifyAttempts; attempt++ {
+		err = p.notify(ctx, envelope)
+		if err == nil || attempt == clickThroughNotifyAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return err
+}
+
+// notify posts the DocuSign Connect style envelope information payload to the envelope callback URL
+func (p *clickThroughProvider) notify(ctx context.Context, envelope *clickThroughEnvelope) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.clickThroughProvider.notify",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"envelopeID":     envelope.EnvelopeID,
+		"callbackURL":    envelope.CallbackURL,
+	}
+
+	payload, err := xml.Marshal(envelope.envelopeInformation())
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to marshal the envelope information payload")
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, envelope.CallbackURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("User-Agent", "easycla-click-through")
+	signConnectRequest(req, payload)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.WithFields(f).WithError(closeErr).Warn("problem closing the response body")
+		}
+	}()
+
+	responsePayload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		msg := fmt.Sprintf("click-through callback returned status code: %d - response: %s", resp.StatusCode, string(responsePayload))
+		log.WithFields(f).Warn(msg)
+		return errors.New(msg)
+	}
+
+	log.WithFields(f).Debug("click-through callback notified")
+	return nil
+}
+
+// getSignableEnvelope loads the envelope and validates that the recipient can sign it using the provided token
+func (p *clickThroughProvider) getSignableEnvelope(ctx context.Context, envelopeID, recipientID, token string) (*clickThroughEnvelope, *clickThroughSigner, error) {
+	envelope, err := p.getEnvelope(ctx, envelopeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer := envelope.signer(recipientID)
+	if signer == nil {
+		return nil, nil, ErrClickThroughEnvelopeNotFound
+	}
+
+	if signer.Token == "" || subtle.ConstantTimeCompare([]byte(signer.Token), []byte(token)) != 1 {
+		return nil, nil, ErrClickThroughInvalidToken
+	}
+
+	if envelope.Status != ClickThroughStatusSent || signer.Status != ClickThroughStatusSent {
+		return nil, nil, ErrClickThroughNotSignable
+	}
+
+	// Routed signers can only sign once every signer before them has signed
+	if routingOrder(signer.RoutingOrder) != envelope.currentRoutingOrder() {
+		return nil, nil, ErrClickThroughNotSignable
+	}
+
+	return envelope, signer, nil
+}
+
+func (p *clickThroughProvider) getEnvelope(ctx context.Context, envelopeID string) (*clickThroughEnvelope, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.clickThroughProvider.getEnvelope",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"envelopeID":     envelopeID,
+	}
+
+	value, err := p.storeRepository.GetValue(ctx, clickThroughStoreKeyPrefix+envelopeID)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to load click-through envelope")
+		return nil, err
+	}
+
+	if value == "" {
+		return nil, ErrClickThroughEnvelopeNotFound
+	}
+
+	var envelope clickThroughEnvelope
+	err = json.Unmarshal([]byte(value), &envelope)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to unmarshal click-through envelope")
+		return nil, err
+	}
+	envelope.stored = value
+
+	return &envelope, nil
+}
+
+func (p *clickThroughProvider) saveEnvelope(ctx context.Context, envelope *clickThroughEnvelope) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.clickThroughProvider.saveEnvelope",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"envelopeID":     envelope.EnvelopeID,
+	}
+
+	envelope.Version++
+	value, err := json.Marshal(envelope)
+	if err != nil {
+		envelope.Version--
+		log.WithFields(f).WithError(err).Warn("unable to marshal click-through envelope")
+		return err
+	}
+
+	// the envelope is only saved if it was not changed since it was loaded, a new envelope only if it does not exist
+	key := clickThroughStoreKeyPrefix + envelope.EnvelopeID
+	expire := time.Now().AddDate(0, 0, clickThroughEnvelopeTTLDays).Unix()
+	var saved bool
+	if envelope.stored == "" {
+		saved, err = p.storeRepository.SetValueIfNotExists(ctx, key, expire, string(value))
+	} else {
+		saved, err = p.storeRepository.SetValueIfEquals(ctx, key, expire, string(value), envelope.stored)
+	}
+	if err != nil || !saved {
+		envelope.Version--
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("unable to save click-through envelope")
+			return err
+		}
+		log.WithFields(f).Warn("click-through envelope was changed by another request")
+		return ErrClickThroughEnvelopeChanged
+	}
+
+	envelope.stored = string(value)
+	return nil
+}
+
+func (e *clickThroughEnvelope) signer(recipientID string) *clickThroughSigner {
+	for _, signer := range e.Signers {
+		if signer.RecipientID == recipientID {
+			return signer
+		}
+	}
+	return nil
+}
+
+// currentRoutingOrder returns the lowest routing order of the signers which have not signed yet
+func (e *clickThroughEnvelope) currentRoutingOrder() int {
+	current := 0
+	for _, signer := range e.Signers {
+		if signer.Status == ClickThroughStatusCompleted {
+			continue
+		}
+		if order := routingOrder(signer.RoutingOrder); current == 0 || order < current {
+			current = order
+		}
+	}
+	return current
+}
+
+// signedDocumentKey returns the S3 key of the document stamped with the consents recorded so far - the envelopes
+// signed before the key was recorded use the shared signed document key
+func (e *clickThroughEnvelope) signedDocumentKey() string {
+	if e.SignedDocumentKey != "" {
+		return e.SignedDocumentKey
+	}
+	return utils.ClickThroughSignedDocumentFilename(e.EnvelopeID, e.DocumentID)
+}
+
+func (e *clickThroughEnvelope) completedSigners() int {
+	count := 0
+	for _, signer := range e.Signers {
+		if signer.Status == ClickThroughStatusCompleted {
+			count++
+		}
+	}
+	return count
+}
+
+// envelopeInformation converts the envelope to the DocuSign Connect payload model consumed by the signed callbacks
+func (e *clickThroughEnvelope) envelopeInformation() *DocuSignEnvelopeInformation {
+	recipientStatuses := make([]RecipientStatus, 0, len(e.Signers))
+	for _, signer := range e.Signers {
+		recipientStatuses = append(recipientStatuses, RecipientStatus{
+			Type:               "Signer",
+			Email:              signer.Email,
+			UserName:           signer.SignedName,
+			RoutingOrder:       routingOrder(signer.RoutingOrder),
+			Signed:             signer.SignedOn,
+			Status:             signer.Status,
+			RecipientIPAddress: signer.ConsentIPAddress,
+			ClientUserId:       signer.ClientUserID,
+			RecipientId:        signer.RecipientID,
+			TabStatuses: []TabStatus{
+				{TabLabel: "full_name", TabValue: signer.SignedName},
+			},
+		})
+	}
+
+	return &DocuSignEnvelopeInformation{
+		EnvelopeStatus: EnvelopeStatus{
+			RecipientStatuses: recipientStatuses,
+			TimeGenerated:     e.CompletedOn,
+			EnvelopeID:        e.EnvelopeID,
+			Subject:           e.EmailSubject,
+			Status:            e.Status,
+			Created:           e.CreatedOn,
+			Completed:         e.CompletedOn,
+			DocumentStatuses: []DocumentStatus{
+				{ID: e.DocumentID, Name: e.DocumentName, Sequence: 1},
+			},
+		},
+	}
+}
+
+// GetClickThroughSigningPage returns the click-through signing page details for the recipient
+func (s *service) GetClickThroughSigningPage(ctx context.Context, envelopeID, recipientID, token string) (*ClickThroughSigningPage, error) {
+	envelope, signer, documentURL, err := s.clickThrough.GetSigningDocumentURL(ctx, envelopeID, recipientID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClickThroughSigningPage{
+		EnvelopeID:   envelope.EnvelopeID,
+		RecipientID:  signer.RecipientID,
+		Token:        token,
+		Title:        envelope.EmailSubject,
+		DocumentName: envelope.DocumentName,
+		DocumentURL:  documentURL,
+		SignerName:   signer.Name,
+		SignerEmail:  signer.Email,
+	}, nil
+}
+
+// ClickThroughConsent records the click-through consent of the recipient and returns the URL to redirect the signer to
+func (s *service) ClickThroughConsent(ctx context.Context, envelopeID, recipientID, token, signedName, remoteAddr, userAgent string) (string, error) {
+	return s.clickThrough.Consent(ctx, envelopeID, recipientID, token, signedName, remoteAddr, userAgent)
+}
+
+func newClickThroughToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
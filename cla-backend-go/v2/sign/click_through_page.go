@@ -0,0 +1,40 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import "html/template"
+
+// clickThroughPageTemplate is the click-through signing page - the signer reviews the document and records their consent
+var clickThroughPageTemplate = template.Must(template.New("click-through").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>EasyCLA - {{.Title}}</title>
+  <style>
+    body { font-family: Helvetica, Arial, sans-serif; margin: 0; background: #f5f5f5; color: #333; }
+    main { max-width: 960px; margin: 24px auto; padding: 24px; background: #fff; }
+    iframe { width: 100%; height: 640px; border: 1px solid #ddd; }
+    label { display: block; margin: 16px 0 8px; }
+    input[type=text] { width: 100%; padding: 8px; box-sizing: border-box; }
+    button { margin-top: 16px; padding: 10px 24px; background: #0068fa; color: #fff; border: 0; cursor: pointer; }
+  </style>
+</head>
+<body>
+<main>
+  <h1>{{.Title}}</h1>
+  <p>Please review the document <a href="{{.DocumentURL}}" target="_blank" rel="noopener">{{.DocumentName}}</a> before signing.</p>
+  <iframe src="{{.DocumentURL}}" title="{{.DocumentName}}"></iframe>
+  <form method="post" action="{{.EnvelopeID}}/consent">
+    <input type="hidden" name="recipient_id" value="{{.RecipientID}}">
+    <input type="hidden" name="token" value="{{.Token}}">
+    <label for="signer_name">Full name</label>
+    <input type="text" id="signer_name" name="signer_name" value="{{.SignerName}}" required>
+    <label><input type="checkbox" name="agree" value="true" required> I, {{.SignerEmail}}, have read and agree to the terms of this agreement and adopt the name above as my electronic signature.</label>
+    <button type="submit">Sign</button>
+  </form>
+</main>
+</body>
+</html>
+`))
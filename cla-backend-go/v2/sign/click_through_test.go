@@ -0,0 +1,349 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeStore is an in memory store repository
+type fakeStore struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{values: map[string]string{}}
+}
+
+func (s *fakeStore) SetActiveSignatureMetaData(ctx context.Context, key string, expire int64, value string) error {
+	return s.SetValue(ctx, key, expire, value)
+}
+
+func (s *fakeStore) GetActiveSignatureMetaData(ctx context.Context, userID string) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) DeleteActiveSignatureMetaData(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+func (s *fakeStore) SetValue(ctx context.Context, key string, expire int64, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+	return nil
+}
+
+func (s *fakeStore) GetValue(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.values[key], nil
+}
+
+func (s *fakeStore) SetValueIfNotExists(ctx context.Context, key string, expire int64, value string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *fakeStore) SetValueIfEquals(ctx context.Context, key string, expire int64, value, expectedValue string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.values[key]; !ok || current != expectedValue {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *fakeStore) DeleteValue(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+// fakeDocuments keeps the click-through documents in memory, the stamp is appended to the document
+type fakeDocuments struct {
+	documents map[string][]byte
+}
+
+func (d *fakeDocuments) Upload(document []byte, key string) error {
+	d.documents[key] = document
+	return nil
+}
+
+func (d *fakeDocuments) Download(key string) ([]byte, error) {
+	return d.documents[key], nil
+}
+
+func (d *fakeDocuments) DownloadLink(key string) (string, error) {
+	return "https://s3.test/" + key, nil
+}
+
+func (d *fakeDocuments) Stamp(document []byte, text string) ([]byte, error) {
+	return append(append([]byte{}, document...), []byte("\n"+text)...), nil
+}
+
+// callbackRecorder records the payloads posted to the envelope callback URL, the first failures requests fail
+type callbackRecorder struct {
+	mu       sync.Mutex
+	payloads []string
+	failures int
+	attempts int
+}
+
+func (c *callbackRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, _ := io.ReadAll(r.Body)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attempts++
+	if c.failures > 0 {
+		c.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	c.payloads = append(c.payloads, string(payload))
+}
+
+func newTestClickThroughProvider(t *testing.T) (*clickThroughProvider, *fakeDocuments, *callbackRecorder, string) {
+	callbacks := &callbackRecorder{}
+	server := httptest.NewServer(callbacks)
+	t.Cleanup(server.Close)
+
+	documents := &fakeDocuments{documents: map[string][]byte{}}
+	return &clickThroughProvider{
+		apiURL:          "https://api.test",
+		storeRepository: newFakeStore(),
+		documents:       documents,
+		httpClient:      server.Client(),
+	}, documents, callbacks, server.URL
+}
+
+func clickThroughSignRequest(callbackURL string, signers ...DocuSignRecipient) *DocuSignEnvelopeRequest {
+	return &DocuSignEnvelopeRequest{
+		EmailSubject: "EasyCLA: CLA Signature Request",
+		Documents: []DocuSignDocument{
+			{
+				DocumentId:     "1",
+				Name:           "Individual Contributor License Agreement",
+				DocumentBase64: base64.StdEncoding.EncodeToString([]byte("%PDF-1.4 cla")),
+			},
+		},
+		Recipients:        DocuSignRecipientType{Signers: signers},
+		EventNotification: DocuSignEventNotification{URL: callbackURL},
+	}
+}
+
+// signingToken returns the token of the click-through signing page URL
+func signingToken(t *testing.T, signURL string) string {
+	parsed, err := url.Parse(signURL)
+	assert.NoError(t, err)
+	return parsed.Query().Get("token")
+}
+
+func TestClickThroughProvider_Consent(t *testing.T) {
+	ctx := context.Background()
+	provider, documents, callbacks, callbackURL := newTestClickThroughProvider(t)
+
+	response, err := provider.PrepareSignRequest(ctx, clickThroughSignRequest(callbackURL, DocuSignRecipient{
+		RecipientId:  "1",
+		ClientUserId: "user-1",
+		RoutingOrder: "1",
+		Name:         "John Doe",
+		Email:        "john@example.com",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, isClickThroughEnvelope(response.EnvelopeId))
+	assert.Equal(t, ClickThroughStatusSent, response.Status)
+	envelopeID := response.EnvelopeId
+
+	signURL, err := provider.GetSignURL("john@example.com", "1", "John Doe", "user-1", envelopeID, "https://return.test")
+	assert.NoError(t, err)
+	token := signingToken(t, signURL)
+	assert.NotEmpty(t, token)
+
+	_, err = provider.Consent(ctx, envelopeID, "1", "invalid", "John Doe", "10.0.0.1", "test-agent")
+	assert.Equal(t, ErrClickThroughInvalidToken, err)
+	_, err = provider.Consent(ctx, envelopeID, "1", token, " ", "10.0.0.1", "test-agent")
+	assert.Equal(t, ErrClickThroughConsentRequired, err)
+
+	returnURL, err := provider.Consent(ctx, envelopeID, "1", token, "John Doe", "10.0.0.1", "test-agent")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://return.test", returnURL)
+
+	// the consent is recorded on the envelope and stamped on the signed document
+	envelope, err := provider.getEnvelope(ctx, envelopeID)
+	assert.NoError(t, err)
+	assert.Equal(t, ClickThroughStatusCompleted, envelope.Status)
+	signer := envelope.signer("1")
+	assert.Equal(t, ClickThroughStatusCompleted, signer.Status)
+	assert.Equal(t, "John Doe", signer.SignedName)
+	assert.Equal(t, "10.0.0.1", signer.ConsentIPAddress)
+	assert.Equal(t, "test-agent", signer.ConsentUserAgent)
+	assert.Empty(t, signer.Token)
+	assert.Equal(t, utils.ClickThroughSignedDocumentFilename(envelopeID, "1-1"), envelope.SignedDocumentKey)
+	signed, err := provider.GetSignedDocument(ctx, envelopeID, "1")
+	assert.NoError(t, err)
+	assert.Contains(t, string(signed), "Electronically signed by John Doe <john@example.com>")
+	assert.Equal(t, signed, documents.documents[envelope.SignedDocumentKey])
+
+	// the callback receives the completed envelope in the DocuSign Connect format
+	assert.Len(t, callbacks.payloads, 1)
+	assert.Contains(t, callbacks.payloads[0], envelopeID)
+	assert.Contains(t, callbacks.payloads[0], "<Status>"+ClickThroughStatusCompleted+"</Status>")
+
+	// the token is single use
+	_, err = provider.Consent(ctx, envelopeID, "1", token, "John Doe", "10.0.0.1", "test-agent")
+	assert.Equal(t, ErrClickThroughInvalidToken, err)
+}
+
+func TestClickThroughProvider_ConsentRoutingOrder(t *testing.T) {
+	ctx := context.Background()
+	provider, _, callbacks, callbackURL := newTestClickThroughProvider(t)
+
+	response, err := provider.PrepareSignRequest(ctx, clickThroughSignRequest(callbackURL,
+		DocuSignRecipient{RecipientId: "1", ClientUserId: "signatory", RoutingOrder: "1", Name: "Signatory", Email: "signatory@example.com"},
+		DocuSignRecipient{RecipientId: "2", ClientUserId: "counter-signer", RoutingOrder: "2", Name: "Counter Signer", Email: "legal@example.com"},
+	))
+	assert.NoError(t, err)
+	envelopeID := response.EnvelopeId
+
+	signatoryURL, err := provider.GetSignURL("signatory@example.com", "1", "Signatory", "signatory", envelopeID, "")
+	assert.NoError(t, err)
+	counterSignerURL, err := provider.GetSignURL("legal@example.com", "2", "Counter Signer", "counter-signer", envelopeID, "")
+	assert.NoError(t, err)
+
+	// the counter-signer signs once the signatory has signed
+	_, err = provider.Consent(ctx, envelopeID, "2", signingToken(t, counterSignerURL), "Counter Signer", "10.0.0.2", "test-agent")
+	assert.Equal(t, ErrClickThroughNotSignable, err)
+
+	_, err = provider.Consent(ctx, envelopeID, "1", signingToken(t, signatoryURL), "Signatory", "10.0.0.1", "test-agent")
+	assert.NoError(t, err)
+	envelope, err := provider.getEnvelope(ctx, envelopeID)
+	assert.NoError(t, err)
+	assert.Equal(t, ClickThroughStatusSent, envelope.Status)
+
+	_, err = provider.Consent(ctx, envelopeID, "2", signingToken(t, counterSignerURL), "Counter Signer", "10.0.0.2", "test-agent")
+	assert.NoError(t, err)
+	envelope, err = provider.getEnvelope(ctx, envelopeID)
+	assert.NoError(t, err)
+	assert.Equal(t, ClickThroughStatusCompleted, envelope.Status)
+
+	// multi-party envelopes notify the callback as each signer completes
+	assert.Len(t, callbacks.payloads, 2)
+}
+
+func TestClickThroughProvider_VoidedEnvelope(t *testing.T) {
+	ctx := context.Background()
+	provider, _, _, callbackURL := newTestClickThroughProvider(t)
+
+	response, err := provider.PrepareSignRequest(ctx, clickThroughSignRequest(callbackURL, DocuSignRecipient{
+		RecipientId: "1", ClientUserId: "user-1", RoutingOrder: "1", Name: "John Doe", Email: "john@example.com",
+	}))
+	assert.NoError(t, err)
+	signURL, err := provider.GetSignURL("john@example.com", "1", "John Doe", "user-1", response.EnvelopeId, "")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.VoidEnvelope(ctx, response.EnvelopeId, "signature request expired"))
+	_, err = provider.Consent(ctx, response.EnvelopeId, "1", signingToken(t, signURL), "John Doe", "10.0.0.1", "test-agent")
+	assert.Equal(t, ErrClickThroughNotSignable, err)
+
+	_, err = provider.getEnvelope(ctx, "click-through-unknown")
+	assert.Equal(t, ErrClickThroughEnvelopeNotFound, err)
+}
+
+func TestClickThroughProvider_ConsentNotifyRetry(t *testing.T) {
+	testCases := []struct {
+		name     string
+		failures int
+		attempts int
+		notified bool
+	}{
+		{name: "notified after a retry", failures: 1, attempts: 2, notified: true},
+		{name: "callback unavailable", failures: clickThroughNotifyAttempts, attempts: clickThroughNotifyAttempts},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			provider, _, callbacks, callbackURL := newTestClickThroughProvider(t)
+			callbacks.failures = tc.failures
+
+			response, err := provider.PrepareSignRequest(ctx, clickThroughSignRequest(callbackURL, DocuSignRecipient{
+				RecipientId: "1", ClientUserId: "user-1", RoutingOrder: "1", Name: "John Doe", Email: "john@example.com",
+			}))
+			assert.NoError(t, err)
+			signURL, err := provider.GetSignURL("john@example.com", "1", "John Doe", "user-1", response.EnvelopeId, "https://return.test")
+			assert.NoError(t, err)
+
+			// the consent succeeds once the envelope is saved, whether the callback was notified or not
+			returnURL, err := provider.Consent(ctx, response.EnvelopeId, "1", signingToken(t, signURL), "John Doe", "10.0.0.1", "test-agent")
+			assert.NoError(t, err)
+			assert.Equal(t, "https://return.test", returnURL)
+			envelope, err := provider.getEnvelope(ctx, response.EnvelopeId)
+			assert.NoError(t, err)
+			assert.Equal(t, ClickThroughStatusCompleted, envelope.Status)
+
+			assert.Equal(t, tc.attempts, callbacks.attempts)
+			assert.Equal(t, tc.notified, len(callbacks.payloads) == 1)
+		})
+	}
+}
+
+func TestClickThroughProvider_ConditionalSave(t *testing.T) {
+	ctx := context.Background()
+	provider, documents, _, callbackURL := newTestClickThroughProvider(t)
+
+	response, err := provider.PrepareSignRequest(ctx, clickThroughSignRequest(callbackURL,
+		DocuSignRecipient{RecipientId: "1", ClientUserId: "user-1", Name: "John Doe", Email: "john@example.com"},
+		DocuSignRecipient{RecipientId: "2", ClientUserId: "user-2", Name: "Jane Doe", Email: "jane@example.com"},
+	))
+	assert.NoError(t, err)
+	envelopeID := response.EnvelopeId
+	johnURL, err := provider.GetSignURL("john@example.com", "1", "John Doe", "user-1", envelopeID, "")
+	assert.NoError(t, err)
+	janeURL, err := provider.GetSignURL("jane@example.com", "2", "Jane Doe", "user-2", envelopeID, "")
+	assert.NoError(t, err)
+
+	// a stale copy of the envelope is not saved over the newer envelope
+	stale, err := provider.getEnvelope(ctx, envelopeID)
+	assert.NoError(t, err)
+	_, err = provider.Consent(ctx, envelopeID, "1", signingToken(t, johnURL), "John Doe", "10.0.0.1", "test-agent")
+	assert.NoError(t, err)
+	stale.Status = ClickThroughStatusVoided
+	assert.Equal(t, ErrClickThroughEnvelopeChanged, provider.saveEnvelope(ctx, stale))
+
+	// the consents of the parallel signers are both stamped on the signed document
+	_, err = provider.Consent(ctx, envelopeID, "2", signingToken(t, janeURL), "Jane Doe", "10.0.0.2", "test-agent")
+	assert.NoError(t, err)
+	envelope, err := provider.getEnvelope(ctx, envelopeID)
+	assert.NoError(t, err)
+	assert.Equal(t, ClickThroughStatusCompleted, envelope.Status)
+	signed := string(documents.documents[envelope.SignedDocumentKey])
+	assert.Contains(t, signed, "Electronically signed by John Doe <john@example.com>")
+	assert.Contains(t, signed, "Electronically signed by Jane Doe <jane@example.com>")
+
+	// a new envelope is not saved over an existing one
+	duplicate := &clickThroughEnvelope{EnvelopeID: envelopeID}
+	assert.Equal(t, ErrClickThroughEnvelopeChanged, provider.saveEnvelope(ctx, duplicate))
+}
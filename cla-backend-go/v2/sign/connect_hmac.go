@@ -0,0 +1,129 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// DocuSignConnectSignatureHeaderPrefix is the prefix of the DocuSign Connect HMAC signature headers - DocuSign sends one
+// header per active HMAC key: X-DocuSign-Signature-1, X-DocuSign-Signature-2, ...
+const DocuSignConnectSignatureHeaderPrefix = "X-Docusign-Signature-"
+
+// DocuSign Connect HMAC validation errors
+var (
+	ErrConnectHMACKeysNotConfigured = errors.New("no DocuSign Connect HMAC keys configured")
+	ErrConnectSignatureMissing      = errors.New("missing DocuSign Connect HMAC signature header")
+	ErrConnectSignatureInvalid      = errors.New("invalid DocuSign Connect HMAC signature")
+)
+
+// computeConnectSignature returns the base64 encoded HMAC-SHA256 signature of the payload using the key
+func computeConnectSignature(key string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(payload) // nolint - hash writes never return an error
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// connectSignatures returns the DocuSign Connect HMAC signature header values from the request
+func connectSignatures(header http.Header) []string {
+	var signatures []string
+	for name, values := range header {
+		if strings.HasPrefix(http.CanonicalHeaderKey(name), DocuSignConnectSignatureHeaderPrefix) {
+			signatures = append(signatures, values...)
+		}
+	}
+	return signatures
+}
+
+// validateConnectSignature verifies that at least one of the request signatures matches the payload signed with one
+// of the active keys - multiple keys are supported so the keys can be rotated
+func validateConnectSignature(keys []string, header http.Header, payload []byte) error {
+	if len(keys) == 0 {
+		return ErrConnectHMACKeysNotConfigured
+	}
+
+	signatures := connectSignatures(header)
+	if len(signatures) == 0 {
+		return ErrConnectSignatureMissing
+	}
+
+	for _, key := range keys {
+		expected := computeConnectSignature(key, payload)
+		for _, signature := range signatures {
+			if hmac.Equal([]byte(expected), []byte(strings.TrimSpace(signature))) {
+				return nil
+			}
+		}
+	}
+
+	return ErrConnectSignatureInvalid
+}
+
+// signConnectRequest adds a DocuSign Connect HMAC signature header to the request using the primary (first) key
+func signConnectRequest(req *http.Request, payload []byte) {
+	keys := config.GetConfig().DocuSignConnectHMACKeys
+	if len(keys) == 0 {
+		return
+	}
+	req.Header.Set(DocuSignConnectSignatureHeaderPrefix+"1", computeConnectSignature(keys[0], payload))
+}
+
+// connectHMACEnforced reports whether the callbacks failing the HMAC validation are rejected, which is the case whenever
+// an HMAC key is configured unless the validation was disabled with the rollout switch
+func connectHMACEnforced(easyCLAConfig config.Config) bool {
+	return len(easyCLAConfig.DocuSignConnectHMACKeys) > 0 && !easyCLAConfig.DocuSignConnectHMACDisabled
+}
+
+// verifyConnectRequest validates the DocuSign Connect HMAC signature of the request payload and logs a security event
+// if the signature can not be verified - returns false if the request should be rejected, which is the case whenever
+// the HMAC validation is enforced
+func verifyConnectRequest(ctx context.Context, eventsService events.Service, r *http.Request, payload []byte) bool {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.verifyConnectRequest",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"path":           r.URL.Path,
+		"remoteAddress":  r.RemoteAddr,
+	}
+
+	easyCLAConfig := config.GetConfig()
+	err := validateConnectSignature(easyCLAConfig.DocuSignConnectHMACKeys, r.Header, payload)
+	if err == nil {
+		return true
+	}
+
+	enforced := connectHMACEnforced(easyCLAConfig)
+	if enforced {
+		log.WithFields(f).WithError(err).Warn("security - rejecting DocuSign Connect callback - unable to verify the HMAC signature")
+	} else {
+		log.WithFields(f).WithError(err).Warn("security - DocuSign Connect HMAC validation is not enforced - allowing the callback through - unable to verify the HMAC signature")
+	}
+
+	if eventsService != nil {
+		eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+			EventType:  events.DocuSignConnectValidationFailed,
+			LfUsername: "easycla system",
+			UserID:     "easycla system",
+			EventData: &events.DocuSignConnectValidationFailedEventData{
+				Path:          r.URL.Path,
+				RemoteAddress: r.RemoteAddr,
+				Reason:        err.Error(),
+				Enforced:      enforced,
+			},
+		})
+	}
+
+	return !enforced
+}
@@ -0,0 +1,176 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/communitybridge/easycla/cla-backend-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const connectPayload = `<DocuSignEnvelopeInformation><EnvelopeStatus><EnvelopeID>envelope-1</EnvelopeID><Status>Completed</Status></EnvelopeStatus></DocuSignEnvelopeInformation>`
+
+func connectHeader(signatures ...string) http.Header {
+	header := http.Header{}
+	for i, signature := range signatures {
+		header.Set(DocuSignConnectSignatureHeaderPrefix+string(rune('1'+i)), signature)
+	}
+	return header
+}
+
+func TestValidateConnectSignature(t *testing.T) {
+	payload := []byte(connectPayload)
+
+	testCases := []struct {
+		Name          string
+		Keys          []string
+		Header        http.Header
+		Payload       []byte
+		ExpectedError error
+	}{
+		{
+			Name:    "valid signature",
+			Keys:    []string{"key-1"},
+			Header:  connectHeader(computeConnectSignature("key-1", payload)),
+			Payload: payload,
+		},
+		{
+			Name:    "rotation - signed with the new key in the second header",
+			Keys:    []string{"key-2"},
+			Header:  connectHeader(computeConnectSignature("key-1", payload), computeConnectSignature("key-2", payload)),
+			Payload: payload,
+		},
+		{
+			Name:    "rotation - signed with the previous key which is still active",
+			Keys:    []string{"key-2", "key-1"},
+			Header:  connectHeader(computeConnectSignature("key-1", payload)),
+			Payload: payload,
+		},
+		{
+			Name:    "lower case header name",
+			Keys:    []string{"key-1"},
+			Header:  http.Header{"x-docusign-signature-1": []string{computeConnectSignature("key-1", payload)}},
+			Payload: payload,
+		},
+		{
+			Name:          "missing signature header",
+			Keys:          []string{"key-1"},
+			Header:        http.Header{},
+			Payload:       payload,
+			ExpectedError: ErrConnectSignatureMissing,
+		},
+		{
+			Name:          "tampered body",
+			Keys:          []string{"key-1"},
+			Header:        connectHeader(computeConnectSignature("key-1", payload)),
+			Payload:       []byte(strings.Replace(connectPayload, "envelope-1", "envelope-2", 1)),
+			ExpectedError: ErrConnectSignatureInvalid,
+		},
+		{
+			Name:          "retired key",
+			Keys:          []string{"key-2"},
+			Header:        connectHeader(computeConnectSignature("key-1", payload)),
+			Payload:       payload,
+			ExpectedError: ErrConnectSignatureInvalid,
+		},
+		{
+			Name:          "no keys configured",
+			Header:        connectHeader(computeConnectSignature("key-1", payload)),
+			Payload:       payload,
+			ExpectedError: ErrConnectHMACKeysNotConfigured,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			assert.Equal(tt, tc.ExpectedError, validateConnectSignature(tc.Keys, tc.Header, tc.Payload))
+		})
+	}
+}
+
+// loadConnectConfig loads the DocuSign Connect HMAC configuration used by the middlewares
+func loadConnectConfig(t *testing.T, keys string, disabled bool) {
+	content, err := json.Marshal(map[string]interface{}{
+		"docuSignConnectHMACKeysCommaSeparated": keys,
+		"docuSignConnectHMACDisabled":           disabled,
+	})
+	assert.NoError(t, err)
+	configDir := t.TempDir()
+	configFile := filepath.Join(configDir, "config.json")
+	assert.NoError(t, os.WriteFile(configFile, content, 0600))
+	_, err = config.LoadConfig(configFile, nil, "test")
+	assert.NoError(t, err)
+
+	// reset the configuration once the test completes
+	t.Cleanup(func() {
+		emptyConfigFile := filepath.Join(configDir, "empty.json")
+		assert.NoError(t, os.WriteFile(emptyConfigFile, []byte("{}"), 0600))
+		_, err := config.LoadConfig(emptyConfigFile, nil, "test")
+		assert.NoError(t, err)
+	})
+}
+
+func TestDocusignMiddlewares(t *testing.T) {
+	payload := []byte(connectPayload)
+	validHeader := connectHeader(computeConnectSignature("key-1", payload))
+	invalidHeader := connectHeader(computeConnectSignature("key-1", []byte("tampered")))
+
+	testCases := []struct {
+		Name           string
+		Keys           string
+		Disabled       bool
+		Header         http.Header
+		ExpectedStatus int
+	}{
+		{Name: "valid signature", Keys: "key-1", Header: validHeader, ExpectedStatus: http.StatusOK},
+		{Name: "rotated keys", Keys: "key-2, key-1", Header: validHeader, ExpectedStatus: http.StatusOK},
+		{Name: "invalid signature", Keys: "key-1", Header: invalidHeader, ExpectedStatus: http.StatusUnauthorized},
+		{Name: "forged signature", Keys: "key-1", Header: connectHeader(computeConnectSignature("guessed-key", payload)), ExpectedStatus: http.StatusUnauthorized},
+		{Name: "missing signature", Keys: "key-1", Header: http.Header{}, ExpectedStatus: http.StatusUnauthorized},
+		{Name: "no keys configured", Header: http.Header{}, ExpectedStatus: http.StatusOK},
+		{Name: "disabled - invalid signature", Keys: "key-1", Disabled: true, Header: invalidHeader, ExpectedStatus: http.StatusOK},
+		{Name: "disabled - valid signature", Keys: "key-1", Disabled: true, Header: validHeader, ExpectedStatus: http.StatusOK},
+	}
+
+	middlewares := map[string]func(http.Handler) http.Handler{
+		"individual": DocusignMiddleware(nil),
+		"corporate":  CCLADocusignMiddleware(nil),
+	}
+
+	for _, tc := range testCases {
+		for name, middleware := range middlewares {
+			t.Run(name+" - "+tc.Name, func(tt *testing.T) {
+				loadConnectConfig(tt, tc.Keys, tc.Disabled)
+
+				var received []byte
+				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					received, _ = io.ReadAll(r.Body)
+				}))
+
+				req := httptest.NewRequest(http.MethodPost, "/v4/signed/corporate/project-1/company-1", strings.NewReader(connectPayload))
+				for key, values := range tc.Header {
+					req.Header[key] = values
+				}
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, req)
+
+				assert.Equal(tt, tc.ExpectedStatus, recorder.Code)
+				if tc.ExpectedStatus == http.StatusOK {
+					// the payload is passed on to the handler
+					assert.Equal(tt, connectPayload, string(received))
+				} else {
+					assert.Nil(tt, received)
+				}
+			})
+		}
+	}
+}
@@ -12,4 +12,7 @@ const (
 
 	// Gitlab is a constant for gitlab
 	Gitlab = "gitlab"
+
+	// Gitea is a constant for gitea
+	Gitea = "gitea"
 )
@@ -0,0 +1,206 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// The anchor strings which place the tabs of the nth counter signer in the CLA group document, usually written in white
+// text next to the counter signature block, e.g. \cs1\ for the signature of the first counter signer
+const (
+	counterSignerSignAnchor = `\cs%d\`
+	counterSignerDateAnchor = `\cd%d\`
+	counterSignerNameAnchor = `\cn%d\`
+)
+
+// routedSigners returns the envelope signers for a multi-party corporate CLA - the company signatory signs first,
+// followed by the CLA group counter signers in the configured order
+func routedSigners(signatory DocuSignRecipient, counterSigners []*v1Models.ClaGroupCounterSigner, documentID string) []DocuSignRecipient {
+	signatory.RoutingOrder = "1"
+	signers := []DocuSignRecipient{signatory}
+	for _, counterSigner := range counterSigners {
+		if counterSigner == nil || counterSigner.Email.String() == "" {
+			continue
+		}
+		order := strconv.Itoa(len(signers) + 1)
+		signers = append(signers, DocuSignRecipient{
+			Email:        counterSigner.Email.String(),
+			Name:         counterSigner.Name,
+			RecipientId:  order,
+			RoutingOrder: order,
+			RoleName:     utils.SignatureSignerRoleCounterSigner,
+			Tabs:         counterSignerTabs(documentID, len(signers)),
+		})
+	}
+	return signers
+}
+
+// counterSignerTabs returns the signature, date and name tabs of the nth counter signer - the signature anchor must be
+// present in the document, DocuSign rejects the envelope otherwise instead of sending a document without a place for the
+// counter signature
+func counterSignerTabs(documentID string, position int) DocuSignTab {
+	anchorTab := func(anchor, label, ignoreIfNotPresent string) DocuSignTabDetails {
+		return DocuSignTabDetails{
+			DocumentId:               documentID,
+			TabLabel:                 fmt.Sprintf("counter_signer_%d_%s", position, label),
+			AnchorString:             fmt.Sprintf(anchor, position),
+			AnchorIgnoreIfNotPresent: ignoreIfNotPresent,
+			AnchorUnits:              "pixels",
+			AnchorXOffset:            "0",
+			AnchorYOffset:            "0",
+		}
+	}
+
+	return DocuSignTab{
+		SignHereTabs:   []DocuSignTabDetails{anchorTab(counterSignerSignAnchor, "signature", DocSignFalse)},
+		DateSignedTabs: []DocuSignTabDetails{anchorTab(counterSignerDateAnchor, "date", "true")},
+		FullNameTabs:   []DocuSignTabDetails{anchorTab(counterSignerNameAnchor, "name", "true")},
+	}
+}
+
+// initialSignatureSigners returns the signature signers for the routed envelope signers - nobody has signed yet
+func initialSignatureSigners(signers []DocuSignRecipient) []signatures.ItemSignatureSigner {
+	var signatureSigners []signatures.ItemSignatureSigner
+	for i, signer := range signers {
+		role := utils.SignatureSignerRoleCounterSigner
+		if i == 0 {
+			role = utils.SignatureSignerRoleSignatory
+		}
+		signatureSigners = append(signatureSigners, signatures.ItemSignatureSigner{
+			Name:         signer.Name,
+			Email:        signer.Email,
+			Role:         role,
+			RoutingOrder: i + 1,
+			Status:       DocusignSent,
+		})
+	}
+	return signatureSigners
+}
+
+// routedSignatureSigners returns the signature signers updated with the recipient statuses from the envelope payload
+func routedSignatureSigners(signers []*v1Models.SignatureSigner, recipientStatuses []RecipientStatus) []signatures.ItemSignatureSigner {
+	updated := make([]signatures.ItemSignatureSigner, 0, len(signers))
+	for _, signer := range signers {
+		if signer == nil {
+			continue
+		}
+		item := signatures.ItemSignatureSigner{
+			Name:         signer.Name,
+			Email:        signer.Email,
+			Role:         signer.Role,
+			RoutingOrder: int(signer.RoutingOrder),
+			Status:       signer.Status,
+			SignedOn:     signer.SignedOn,
+		}
+		if recipient := findRecipientStatus(recipientStatuses, item); recipient != nil && recipient.Status != "" {
+			item.Status = strings.ToLower(recipient.Status)
+			if recipient.Signed != "" {
+				item.SignedOn = recipient.Signed
+			}
+		}
+		updated = append(updated, item)
+	}
+	return updated
+}
+
+// findRecipientStatus returns the recipient status for the signer, matched by routing order (or recipient ID) and email
+func findRecipientStatus(recipientStatuses []RecipientStatus, signer signatures.ItemSignatureSigner) *RecipientStatus {
+	order := strconv.Itoa(signer.RoutingOrder)
+	for i := range recipientStatuses {
+		recipient := &recipientStatuses[i]
+		if !strings.EqualFold(recipient.Email, signer.Email) {
+			continue
+		}
+		if recipient.RoutingOrder == signer.RoutingOrder || recipient.RecipientId == order || (recipient.RoutingOrder == 0 && recipient.RecipientId == "") {
+			return recipient
+		}
+	}
+	return nil
+}
+
+// routedSignatureStatus returns the signature status for the signature signers progress
+func routedSignatureStatus(signers []signatures.ItemSignatureSigner) string {
+	if len(signers) == 0 || !strings.EqualFold(signers[0].Status, DocusignCompleted) {
+		return utils.SignatureStatusPending
+	}
+	for _, signer := range signers[1:] {
+		if !strings.EqualFold(signer.Status, DocusignCompleted) {
+			return utils.SignatureStatusAwaitingCounterSignature
+		}
+	}
+	return utils.SignatureStatusSigned
+}
+
+// updateRoutedSignatureProgress records the intermediate signing progress of a multi-party corporate CLA - the
+// signature is only marked as signed once the envelope is completed, e.g. every routed party has signed
+func (s *service) updateRoutedSignatureProgress(ctx context.Context, signature *v1Models.Signature, info *DocuSignEnvelopeInformation) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.updateRoutedSignatureProgress",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+		"envelopeID":     info.EnvelopeStatus.EnvelopeID,
+		"envelopeStatus": info.EnvelopeStatus.Status,
+	}
+
+	signers := routedSignatureSigners(signature.SignatureSigners, info.EnvelopeStatus.RecipientStatuses)
+	status := routedSignatureStatus(signers)
+	if status == utils.SignatureStatusSigned {
+		// Every party has signed but the envelope has not been completed yet - wait for the completed event
+		status = utils.SignatureStatusAwaitingCounterSignature
+	}
+
+	_, currentTime := utils.CurrentTime()
+	log.WithFields(f).Debugf("updating multi-party signature progress - status: %s", status)
+	err := s.signatureService.UpdateSignature(ctx, signature.SignatureID, map[string]interface{}{
+		"signature_signers": signers,
+		"signature_status":  status,
+		"date_modified":     currentTime,
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to update the multi-party signature progress")
+		return err
+	}
+
+	return nil
+}
+
+// completedRecipients returns the number of recipients in the envelope payload which have completed signing
+func completedRecipients(recipientStatuses []RecipientStatus) int {
+	count := 0
+	for _, recipient := range recipientStatuses {
+		if strings.EqualFold(recipient.Status, DocusignCompleted) {
+			count++
+		}
+	}
+	return count
+}
+
+// allRecipientsCompleted returns true if every envelope recipient has completed signing
+func allRecipientsCompleted(recipients []Signer) bool {
+	for _, recipient := range recipients {
+		if !strings.EqualFold(recipient.Status, DocusignCompleted) {
+			return false
+		}
+	}
+	return len(recipients) > 0
+}
+
+// routingOrder returns the numeric routing order, recipients without a routing order are routed first
+func routingOrder(order string) int {
+	value, err := strconv.Atoi(order)
+	if err != nil || value < 1 {
+		return 1
+	}
+	return value
+}
@@ -0,0 +1,173 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"testing"
+
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutedSigners(t *testing.T) {
+	signatory := DocuSignRecipient{Email: "signatory@example.org", Name: "Signatory", RecipientId: "1", RoleName: "signer"}
+	counterSigners := []*v1Models.ClaGroupCounterSigner{
+		{Name: "Legal", Email: strfmt.Email("legal@linuxfoundation.org")},
+		nil,
+		{Name: "No Email"},
+		{Name: "Executive Director", Email: strfmt.Email("ed@linuxfoundation.org")},
+	}
+
+	signers := routedSigners(signatory, counterSigners, "42")
+
+	assert.Len(t, signers, 3)
+	assert.Equal(t, "signatory@example.org", signers[0].Email)
+	assert.Equal(t, "1", signers[0].RoutingOrder)
+	for i, expected := range []string{"legal@linuxfoundation.org", "ed@linuxfoundation.org"} {
+		counterSigner := signers[i+1]
+		assert.Equal(t, expected, counterSigner.Email)
+		assert.Equal(t, utils.SignatureSignerRoleCounterSigner, counterSigner.RoleName)
+		// the counter signers are routed in the configured order after the signatory
+		assert.Equal(t, counterSigner.RecipientId, counterSigner.RoutingOrder)
+		assert.Equal(t, []string{"2", "3"}[i], counterSigner.RoutingOrder)
+
+		// every counter signer gets the signature, date and name tabs of its position
+		assert.Len(t, counterSigner.Tabs.SignHereTabs, 1)
+		assert.Len(t, counterSigner.Tabs.DateSignedTabs, 1)
+		assert.Len(t, counterSigner.Tabs.FullNameTabs, 1)
+		signHere := counterSigner.Tabs.SignHereTabs[0]
+		assert.Equal(t, "42", signHere.DocumentId)
+		assert.Equal(t, []string{`\cs1\`, `\cs2\`}[i], signHere.AnchorString)
+		assert.Equal(t, DocSignFalse, signHere.AnchorIgnoreIfNotPresent)
+		assert.Equal(t, []string{`\cd1\`, `\cd2\`}[i], counterSigner.Tabs.DateSignedTabs[0].AnchorString)
+		assert.Equal(t, []string{`\cn1\`, `\cn2\`}[i], counterSigner.Tabs.FullNameTabs[0].AnchorString)
+	}
+
+	signatureSigners := initialSignatureSigners(signers)
+	assert.Len(t, signatureSigners, 3)
+	assert.Equal(t, utils.SignatureSignerRoleSignatory, signatureSigners[0].Role)
+	assert.Equal(t, utils.SignatureSignerRoleCounterSigner, signatureSigners[2].Role)
+	assert.Equal(t, 3, signatureSigners[2].RoutingOrder)
+	assert.Equal(t, DocusignSent, signatureSigners[2].Status)
+}
+
+func TestRoutedSignatureStatus(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Statuses       []string
+		ExpectedStatus string
+	}{
+		{Name: "nobody signed", Statuses: []string{DocusignSent, DocusignSent}, ExpectedStatus: utils.SignatureStatusPending},
+		{Name: "no signers", ExpectedStatus: utils.SignatureStatusPending},
+		{Name: "counter signer signed before the signatory", Statuses: []string{DocusignSent, "completed"}, ExpectedStatus: utils.SignatureStatusPending},
+		{Name: "signatory signed", Statuses: []string{"completed", DocusignSent, DocusignSent}, ExpectedStatus: utils.SignatureStatusAwaitingCounterSignature},
+		{Name: "first counter signer signed", Statuses: []string{"completed", "completed", "delivered"}, ExpectedStatus: utils.SignatureStatusAwaitingCounterSignature},
+		{Name: "every party signed", Statuses: []string{"completed", DocusignCompleted, "completed"}, ExpectedStatus: utils.SignatureStatusSigned},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var signers []signatures.ItemSignatureSigner
+			for i, status := range tc.Statuses {
+				signers = append(signers, signatures.ItemSignatureSigner{RoutingOrder: i + 1, Status: status})
+			}
+			assert.Equal(t, tc.ExpectedStatus, routedSignatureStatus(signers))
+		})
+	}
+}
+
+func TestUpdateRoutedSignatureProgress(t *testing.T) {
+	signatureSigners := []*v1Models.SignatureSigner{
+		{Name: "Signatory", Email: "signatory@example.org", Role: utils.SignatureSignerRoleSignatory, RoutingOrder: 1, Status: DocusignSent},
+		{Name: "Legal", Email: "legal@linuxfoundation.org", Role: utils.SignatureSignerRoleCounterSigner, RoutingOrder: 2, Status: DocusignSent},
+		{Name: "Executive Director", Email: "ed@linuxfoundation.org", Role: utils.SignatureSignerRoleCounterSigner, RoutingOrder: 3, Status: DocusignSent},
+	}
+
+	testCases := []struct {
+		Name              string
+		RecipientStatuses []RecipientStatus
+		ExpectedStatus    string
+		ExpectedSigners   []string
+	}{
+		{
+			Name: "signatory signed",
+			RecipientStatuses: []RecipientStatus{
+				{Email: "Signatory@example.org", RoutingOrder: 1, Status: "Completed", Signed: "2024-05-01T10:00:00Z"},
+				{Email: "legal@linuxfoundation.org", RoutingOrder: 2, Status: "Delivered"},
+			},
+			ExpectedStatus:  utils.SignatureStatusAwaitingCounterSignature,
+			ExpectedSigners: []string{"completed", "delivered", DocusignSent},
+		},
+		{
+			Name: "partial counter signature",
+			RecipientStatuses: []RecipientStatus{
+				{Email: "signatory@example.org", RoutingOrder: 1, Status: "Completed", Signed: "2024-05-01T10:00:00Z"},
+				{Email: "legal@linuxfoundation.org", RoutingOrder: 2, Status: "Completed", Signed: "2024-05-02T10:00:00Z"},
+				{Email: "ed@linuxfoundation.org", RoutingOrder: 3, Status: "Sent"},
+			},
+			ExpectedStatus:  utils.SignatureStatusAwaitingCounterSignature,
+			ExpectedSigners: []string{"completed", "completed", "sent"},
+		},
+		{
+			// the last recipient event can arrive before the envelope completed event - the signature is only marked
+			// as signed by the completed envelope
+			Name: "final counter signature",
+			RecipientStatuses: []RecipientStatus{
+				{Email: "signatory@example.org", RoutingOrder: 1, Status: "Completed", Signed: "2024-05-01T10:00:00Z"},
+				{Email: "legal@linuxfoundation.org", RoutingOrder: 2, Status: "Completed", Signed: "2024-05-02T10:00:00Z"},
+				{Email: "ed@linuxfoundation.org", RoutingOrder: 3, Status: "Completed", Signed: "2024-05-03T10:00:00Z"},
+			},
+			ExpectedStatus:  utils.SignatureStatusAwaitingCounterSignature,
+			ExpectedSigners: []string{"completed", "completed", "completed"},
+		},
+		{
+			Name: "recipient with another email is not matched",
+			RecipientStatuses: []RecipientStatus{
+				{Email: "someone-else@example.org", RoutingOrder: 1, Status: "Completed"},
+			},
+			ExpectedStatus:  utils.SignatureStatusPending,
+			ExpectedSigners: []string{DocusignSent, DocusignSent, DocusignSent},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			var updates map[string]interface{}
+			signatureService.EXPECT().UpdateSignature(gomock.Any(), "signature-1", gomock.Any()).DoAndReturn(
+				func(ctx context.Context, signatureID string, values map[string]interface{}) error {
+					updates = values
+					return nil
+				})
+
+			s := &service{signatureService: signatureService}
+			info := &DocuSignEnvelopeInformation{EnvelopeStatus: EnvelopeStatus{EnvelopeID: "envelope-1", Status: "Sent", RecipientStatuses: tc.RecipientStatuses}}
+			err := s.updateRoutedSignatureProgress(context.Background(), &v1Models.Signature{SignatureID: "signature-1", SignatureSigners: signatureSigners}, info)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.ExpectedStatus, updates["signature_status"])
+			signers, ok := updates["signature_signers"].([]signatures.ItemSignatureSigner)
+			assert.True(t, ok)
+			assert.Len(t, signers, len(tc.ExpectedSigners))
+			for i, expected := range tc.ExpectedSigners {
+				assert.Equal(t, expected, signers[i].Status)
+				assert.Equal(t, i+1, signers[i].RoutingOrder)
+			}
+			for _, recipient := range tc.RecipientStatuses {
+				if recipient.Signed != "" {
+					assert.Equal(t, recipient.Signed, signers[recipient.RoutingOrder-1].SignedOn)
+				}
+			}
+		})
+	}
+}
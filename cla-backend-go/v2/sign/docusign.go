@@ -19,14 +19,31 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// docusignProvider is the DocuSign implementation of the SignatureProvider interface
+type docusignProvider struct {
+	privateKey string
+}
+
+// newDocuSignProvider returns a new DocuSign signature provider using the provided integration private key
+func newDocuSignProvider(privateKey string) SignatureProvider {
+	return &docusignProvider{
+		privateKey: privateKey,
+	}
+}
+
+// Name returns the provider name
+func (p *docusignProvider) Name() string {
+	return SignatureProviderDocuSign
+}
+
 // getAccessToken retrieves an access token for the DocuSign API using a JWT assertion.
-func (s *service) getAccessToken(ctx context.Context) (string, error) {
+func (p *docusignProvider) getAccessToken(ctx context.Context) (string, error) {
 	f := logrus.Fields{
 		"functionName":   "v2.getAccessToken",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
 	}
 
-	jwtAssertion, err := jwtToken(s.docsignPrivateKey)
+	jwtAssertion, err := jwtToken(p.privateKey)
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("problem generating the JWT token")
 		return "", err
@@ -92,8 +109,8 @@ func (s *service) getAccessToken(ctx context.Context) (string, error) {
 
 }
 
-// Void envelope
-func (s *service) VoidEnvelope(ctx context.Context, envelopeID, message string) error {
+// VoidEnvelope voids the specified envelope
+func (p *docusignProvider) VoidEnvelope(ctx context.Context, envelopeID, message string) error {
 	f := logrus.Fields{
 		"functionName":   "v2.VoidEnvelope",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
@@ -101,7 +118,7 @@ func (s *service) VoidEnvelope(ctx context.Context, envelopeID, message string)
 		"message":        message,
 	}
 
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("problem getting the access token")
 		return err
@@ -160,7 +177,8 @@ func (s *service) VoidEnvelope(ctx context.Context, envelopeID, message string)
 
 }
 
-func (s *service) createEnvelope(ctx context.Context, payload *DocuSignEnvelopeRequest) (string, error) {
+// CreateEnvelope creates a new envelope and returns the envelope ID
+func (p *docusignProvider) CreateEnvelope(ctx context.Context, payload *DocuSignEnvelopeRequest) (string, error) {
 	f := logrus.Fields{
 		"functionName":   "v2.createEnvelope",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
@@ -175,7 +193,7 @@ func (s *service) createEnvelope(ctx context.Context, payload *DocuSignEnvelopeR
 	log.WithFields(f).Debugf("sign request: %+v", string(requestJSON))
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 
 	if err != nil {
 		return "", err
@@ -235,7 +253,8 @@ func (s *service) createEnvelope(ctx context.Context, payload *DocuSignEnvelopeR
 
 }
 
-func (s *service) addDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error {
+// AddDocumentToEnvelope adds/replaces the document on the specified envelope
+func (p *docusignProvider) AddDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error {
 	f := logrus.Fields{
 		"functionName": "v2.addDocumentToEnvelope",
 	}
@@ -243,7 +262,7 @@ func (s *service) addDocumentToEnvelope(ctx context.Context, envelopeID, documen
 	const method = "PUT"
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 
 	if err != nil {
 		return err
@@ -329,14 +348,15 @@ func (s *service) addDocumentToEnvelope(ctx context.Context, envelopeID, documen
 
 }
 
-func (s *service) getEnvelopeRecipients(ctx context.Context, envelopeID string) ([]Signer, error) {
+// GetEnvelopeRecipients returns the list of signers for the specified envelope
+func (p *docusignProvider) GetEnvelopeRecipients(ctx context.Context, envelopeID string) ([]Signer, error) {
 	f := logrus.Fields{
 		"functionName": "v2.getEnvelopeRecipients",
 		"envelopeID":   envelopeID,
 	}
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 
 	if err != nil {
 		return nil, err
@@ -398,8 +418,8 @@ func (s *service) getEnvelopeRecipients(ctx context.Context, envelopeID string)
 	return response.Signers, nil
 }
 
-// Function to create a DocuSign envelope
-func (s *service) PrepareSignRequest(ctx context.Context, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error) {
+// PrepareSignRequest creates a DocuSign envelope
+func (p *docusignProvider) PrepareSignRequest(ctx context.Context, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error) {
 	f := logrus.Fields{
 		"functionName":   "v2.PrepareSignRequest",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
@@ -412,7 +432,7 @@ func (s *service) PrepareSignRequest(ctx context.Context, signRequest *DocuSignE
 	}
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 
 	if err != nil {
 		return nil, err
@@ -479,8 +499,7 @@ type RecipientViewResponse struct {
 }
 
 // GetSignURL fetches the signing URL for the specified envelope and recipient
-
-func (s *service) GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error) {
+func (p *docusignProvider) GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error) {
 
 	f := logrus.Fields{
 		"functionName": "v2.GetSignURL",
@@ -489,7 +508,7 @@ func (s *service) GetSignURL(email, recipientID, userName, clientUserId, envelop
 	}
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(context.Background())
+	accessToken, err := p.getAccessToken(context.Background())
 
 	if err != nil {
 		return "", err
@@ -566,14 +585,15 @@ func (s *service) GetSignURL(email, recipientID, userName, clientUserId, envelop
 	return viewResponse.URL, nil
 }
 
-func (s service) GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error) {
+// GetSignedDocument returns the signed document for the specified envelope
+func (p *docusignProvider) GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error) {
 	f := logrus.Fields{
 		"functionName": "v2.getSignedDocument",
 		"envelopeID":   envelopeID,
 	}
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("problem getting the access token")
@@ -624,14 +644,15 @@ func (s service) GetSignedDocument(ctx context.Context, envelopeID, documentID s
 
 }
 
-func (s *service) GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error) {
+// GetEnvelopeDocuments returns the list of documents for the specified envelope
+func (p *docusignProvider) GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error) {
 	f := logrus.Fields{
 		"functionName": "v2.GetEnvelopeDocuments",
 		"envelopeID":   envelopeID,
 	}
 
 	// Get the access token
-	accessToken, err := s.getAccessToken(ctx)
+	accessToken, err := p.getAccessToken(ctx)
 
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("problem getting the access token")
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/communitybridge/easycla/cla-backend-go/events"
 	log "github.com/communitybridge/easycla/cla-backend-go/logging"
 	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
 	"github.com/communitybridge/easycla/cla-backend-go/users"
@@ -23,6 +24,7 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/gen/v2/restapi/operations/sign"
 	"github.com/communitybridge/easycla/cla-backend-go/utils"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/organization-service/client/organizations"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 )
 
@@ -32,46 +34,60 @@ var (
 	cclaDocusignPayload []byte
 )
 
-// DocusignMiddleware is used to get access to xml request body
-func DocusignMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		f := logrus.Fields{
-			"functionName": "v2.sign.handlers.docusignMiddleware",
-		}
-		var err error
-		log.WithFields(f).Debug("docusign middleware...")
-		iclaGitHubPayload, err = io.ReadAll(r.Body)
-		if err != nil {
-			log.Warnf("unable to read request body")
-			return
-		}
-		r.Body.Close()
-		r.Body = io.NopCloser(bytes.NewBuffer(iclaGitHubPayload))
-		log.WithFields(f).Debugf("docusign middleware...payload: %s", string(iclaGitHubPayload))
-		// call the next middleware
-		next.ServeHTTP(w, r)
-	})
+// DocusignMiddleware is used to get access to xml request body - the DocuSign Connect HMAC signature is verified
+// before the request is passed on
+func DocusignMiddleware(eventsService events.Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			f := logrus.Fields{
+				"functionName": "v2.sign.handlers.docusignMiddleware",
+			}
+			log.WithFields(f).Debug("docusign middleware...")
+			payload, err := io.ReadAll(r.Body)
+			if err != nil {
+				log.Warnf("unable to read request body")
+				return
+			}
+			r.Body.Close()
+			if !verifyConnectRequest(utils.NewContext(), eventsService, r, payload) {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+			iclaGitHubPayload = payload
+			r.Body = io.NopCloser(bytes.NewBuffer(iclaGitHubPayload))
+			log.WithFields(f).Debugf("docusign middleware...payload: %s", string(iclaGitHubPayload))
+			// call the next middleware
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
-// CCLADocusignMiddleware used to set CCLA middleware
-func CCLADocusignMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		f := logrus.Fields{
-			"functionName": "v2.sign.handlers.cclaDocusignMiddleware",
-		}
-		var err error
-		log.WithFields(f).Debug("docusign middleware...")
-		cclaDocusignPayload, err = io.ReadAll(r.Body)
-		if err != nil {
-			log.Warnf("unable to read request body")
-			return
-		}
-		r.Body.Close()
-		r.Body = io.NopCloser(bytes.NewBuffer(cclaDocusignPayload))
-		log.WithFields(f).Debugf("docusign middleware...payload: %s", string(cclaDocusignPayload))
-		// call the next middleware
-		next.ServeHTTP(w, r)
-	})
+// CCLADocusignMiddleware used to set CCLA middleware - the DocuSign Connect HMAC signature is verified before the
+// request is passed on
+func CCLADocusignMiddleware(eventsService events.Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			f := logrus.Fields{
+				"functionName": "v2.sign.handlers.cclaDocusignMiddleware",
+			}
+			log.WithFields(f).Debug("docusign middleware...")
+			payload, err := io.ReadAll(r.Body)
+			if err != nil {
+				log.Warnf("unable to read request body")
+				return
+			}
+			r.Body.Close()
+			if !verifyConnectRequest(utils.NewContext(), eventsService, r, payload) {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+			cclaDocusignPayload = payload
+			r.Body = io.NopCloser(bytes.NewBuffer(cclaDocusignPayload))
+			log.WithFields(f).Debugf("docusign middleware...payload: %s", string(cclaDocusignPayload))
+			// call the next middleware
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 // Configure API call
@@ -144,7 +160,7 @@ func Configure(api *operations.EasyclaAPI, service Service, userService users.Se
 			var err error
 			var preferredEmail string
 
-			if strings.ToLower(params.Input.ReturnURLType) == Github || strings.ToLower(params.Input.ReturnURLType) == Gitlab {
+			if strings.ToLower(params.Input.ReturnURLType) == Github || strings.ToLower(params.Input.ReturnURLType) == Gitlab || strings.ToLower(params.Input.ReturnURLType) == Gitea {
 				log.WithFields(f).Debug("fetching user emails")
 				user, userErr := userService.GetUser(*params.Input.UserID)
 				if userErr != nil {
@@ -156,7 +172,7 @@ func Configure(api *operations.EasyclaAPI, service Service, userService users.Se
 					return sign.NewRequestIndividualSignatureBadRequest().WithPayload(errorResponse(reqId, errors.New(msg)))
 				}
 				preferredEmail = user.Emails[0]
-				log.WithFields(f).Debug("requesting individual signature for github/gitlab")
+				log.WithFields(f).Debug("requesting individual signature for github/gitlab/gitea")
 				resp, err = service.RequestIndividualSignature(ctx, params.Input, preferredEmail)
 			} else if strings.ToLower(params.Input.ReturnURLType) == "gerrit" {
 				log.WithFields(f).Debug("requesting individual signature for gerrit")
@@ -209,6 +225,23 @@ func Configure(api *operations.EasyclaAPI, service Service, userService users.Se
 			return sign.NewCclaCallbackOK()
 		})
 
+	api.SignIclaCallbackGiteaHandler = sign.IclaCallbackGiteaHandlerFunc(
+		func(params sign.IclaCallbackGiteaParams) middleware.Responder {
+			reqId := utils.GetRequestID(params.XREQUESTID)
+			ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTIDKey, reqId)
+			f := logrus.Fields{
+				"functionName":   "v2.sign.handlers.SignIclaCallbackGiteaHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			}
+			log.WithFields(f).Debug("gitea callback")
+
+			err := service.SignedIndividualCallbackGitea(ctx, iclaGitHubPayload, params.UserID, params.OrganizationID, params.GiteaRepositoryID, params.PullRequestID)
+			if err != nil {
+				return sign.NewIclaCallbackGiteaBadRequest()
+			}
+			return sign.NewCclaCallbackOK()
+		})
+
 	api.SignIclaCallbackGerritHandler = sign.IclaCallbackGerritHandlerFunc(
 		func(params sign.IclaCallbackGerritParams) middleware.Responder {
 			reqId := utils.GetRequestID(params.XREQUESTID)
@@ -244,6 +277,82 @@ func Configure(api *operations.EasyclaAPI, service Service, userService users.Se
 			return sign.NewCclaCallbackOK()
 		})
 
+	api.SignClickThroughSigningPageHandler = sign.ClickThroughSigningPageHandlerFunc(
+		func(params sign.ClickThroughSigningPageParams) middleware.Responder {
+			reqId := utils.GetRequestID(params.XREQUESTID)
+			ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTIDKey, reqId)
+			f := logrus.Fields{
+				"functionName":   "v2.sign.handlers.SignClickThroughSigningPageHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"envelopeID":     params.EnvelopeID,
+				"recipientID":    params.RecipientID,
+			}
+
+			page, err := service.GetClickThroughSigningPage(ctx, params.EnvelopeID, params.RecipientID, params.Token)
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, pr runtime.Producer) {
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("unable to load click-through signing page")
+					http.Error(rw, err.Error(), clickThroughErrorStatus(err))
+					return
+				}
+
+				rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+				rw.WriteHeader(http.StatusOK)
+				if tmplErr := clickThroughPageTemplate.Execute(rw, page); tmplErr != nil {
+					log.WithFields(f).WithError(tmplErr).Warn("unable to render click-through signing page")
+				}
+			})
+		})
+
+	api.SignClickThroughConsentHandler = sign.ClickThroughConsentHandlerFunc(
+		func(params sign.ClickThroughConsentParams) middleware.Responder {
+			reqId := utils.GetRequestID(params.XREQUESTID)
+			ctx := context.WithValue(params.HTTPRequest.Context(), utils.XREQUESTIDKey, reqId)
+			f := logrus.Fields{
+				"functionName":   "v2.sign.handlers.SignClickThroughConsentHandler",
+				utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+				"envelopeID":     params.EnvelopeID,
+				"recipientID":    params.RecipientID,
+			}
+
+			var returnURL string
+			var err error
+			if !params.Agree {
+				err = ErrClickThroughConsentRequired
+			} else {
+				returnURL, err = service.ClickThroughConsent(ctx, params.EnvelopeID, params.RecipientID, params.Token, params.SignerName,
+					params.HTTPRequest.RemoteAddr, params.HTTPRequest.UserAgent())
+			}
+
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, pr runtime.Producer) {
+				if err != nil {
+					log.WithFields(f).WithError(err).Warn("unable to record click-through consent")
+					http.Error(rw, err.Error(), clickThroughErrorStatus(err))
+					return
+				}
+
+				if returnURL == "" {
+					rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+					rw.WriteHeader(http.StatusOK)
+					_, _ = rw.Write([]byte("<p>Thank you, the document has been signed. You may close this window.</p>"))
+					return
+				}
+
+				http.Redirect(rw, params.HTTPRequest, returnURL, http.StatusSeeOther)
+			})
+		})
+}
+
+// clickThroughErrorStatus maps the click-through errors to a HTTP status code
+func clickThroughErrorStatus(err error) int {
+	switch err {
+	case ErrClickThroughEnvelopeNotFound:
+		return http.StatusNotFound
+	case ErrClickThroughInvalidToken, ErrClickThroughNotSignable, ErrClickThroughConsentRequired:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 type codedResponse interface {
@@ -329,10 +329,16 @@ type DocuSignRecipientEvent struct {
 	EnvelopeEventStatusCode string `json:"envelopeEventStatusCode"`
 }
 
+// DocuSignRecipientStatusEvent is a recipient event we want to be notified of, e.g. Completed
+type DocuSignRecipientStatusEvent struct {
+	RecipientEventStatusCode string `json:"recipientEventStatusCode"`
+}
+
 type DocuSignEventNotification struct {
-	URL            string                   `json:"url"`
-	LoggingEnabled bool                     `json:"loggingEnabled"`
-	EnvelopeEvents []DocuSignRecipientEvent `json:"envelopeEvents"`
+	URL             string                         `json:"url"`
+	LoggingEnabled  bool                           `json:"loggingEnabled"`
+	EnvelopeEvents  []DocuSignRecipientEvent       `json:"envelopeEvents"`
+	RecipientEvents []DocuSignRecipientStatusEvent `json:"recipientEvents,omitempty"`
 	// EventData             EventData                `json:"eventData"`
 	// RequireAcknowledgment string                   `json:"requireAcknowledgment"`
 }
@@ -390,6 +396,7 @@ type Signer struct {
 	RoutingOrder    string `json:"routingOrder"`
 	RoleName        string `json:"roleName"`
 	Status          string `json:"status"`
+	SignedDateTime  string `json:"signedDateTime,omitempty"`
 }
 
 type DocusignRecipientResponse struct {
@@ -632,3 +639,15 @@ type DocumentStatus struct {
 	Sequence     int    `xml:"Sequence"`
 	// Additional fields can be added here if needed
 }
+
+// ClickThroughSigningPage is the model used to render the click-through signing page
+type ClickThroughSigningPage struct {
+	EnvelopeID   string
+	RecipientID  string
+	Token        string
+	Title        string
+	DocumentName string
+	DocumentURL  string
+	SignerName   string
+	SignerEmail  string
+}
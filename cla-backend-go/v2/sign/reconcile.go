@@ -0,0 +1,242 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// ReconcileSummary is the summary of an envelope reconciliation run
+type ReconcileSummary struct {
+	Checked    int
+	Skipped    int
+	Pending    int
+	Reconciled int
+	Failed     int
+}
+
+// ErrUnknownCallbackURL is returned when the signed callback can not be determined from the signature callback URL
+var ErrUnknownCallbackURL = errors.New("unable to determine the signed callback from the signature callback URL")
+
+// ReconcileEnvelopes checks the unsigned signatures which have an envelope and replays the signed callback for the
+// envelopes which have been completed, e.g. when the DocuSign Connect callback never arrived. Only signatures last
+// modified between minAge and maxAge ago are checked.
+func (s *service) ReconcileEnvelopes(ctx context.Context, minAge, maxAge time.Duration) (*ReconcileSummary, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.ReconcileEnvelopes",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"minAge":         minAge.String(),
+		"maxAge":         maxAge.String(),
+	}
+
+	unsignedSignatures, err := s.signatureService.GetUnsignedSignaturesWithEnvelope(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query unsigned signatures with an envelope")
+		return nil, err
+	}
+
+	summary := &ReconcileSummary{}
+	now := time.Now().UTC()
+	for _, signature := range unsignedSignatures {
+		modified, parseErr := utils.ParseDateTime(signature.DateModified)
+		if parseErr != nil || now.Sub(modified) < minAge || now.Sub(modified) > maxAge {
+			summary.Skipped++
+			continue
+		}
+
+		summary.Checked++
+		reconciled, reconcileErr := s.reconcileEnvelope(ctx, signature)
+		switch {
+		case reconcileErr != nil:
+			log.WithFields(f).WithError(reconcileErr).Warnf("unable to reconcile signature: %s with envelope: %s",
+				signature.SignatureID, signature.SignatureEnvelopeID)
+			summary.Failed++
+		case reconciled:
+			summary.Reconciled++
+		default:
+			summary.Pending++
+		}
+	}
+
+	log.WithFields(f).Infof("envelope reconciliation complete - checked: %d, reconciled: %d, pending: %d, failed: %d, skipped: %d",
+		summary.Checked, summary.Reconciled, summary.Pending, summary.Failed, summary.Skipped)
+	return summary, nil
+}
+
+// reconcileEnvelope replays the signed callback for the signature if the envelope recipient has completed signing,
+// returns true if the callback was replayed
+func (s *service) reconcileEnvelope(ctx context.Context, signature *signatures.ItemSignature) (bool, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.reconcileEnvelope",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+		"envelopeID":     signature.SignatureEnvelopeID,
+		"signatureType":  signature.SignatureType,
+	}
+
+	recipients, err := s.getEnvelopeRecipients(ctx, signature.SignatureEnvelopeID)
+	if err != nil {
+		return false, err
+	}
+
+	recipient := findEnvelopeRecipient(recipients, signature.SignatureID)
+	if recipient == nil {
+		log.WithFields(f).Debug("no envelope recipients found")
+		return false, nil
+	}
+
+	if !strings.EqualFold(recipient.Status, DocusignCompleted) {
+		log.WithFields(f).Debugf("envelope recipient status is: %s - nothing to reconcile", recipient.Status)
+		return false, nil
+	}
+
+	// Multi-party envelopes are only completed once every routed party has signed
+	if len(signature.SignatureSigners) > 0 && !allRecipientsCompleted(recipients) {
+		log.WithFields(f).Debug("envelope is waiting for the counter signers - nothing to reconcile")
+		return false, nil
+	}
+
+	documents, err := s.GetEnvelopeDocuments(ctx, signature.SignatureEnvelopeID)
+	if err != nil {
+		return false, err
+	}
+
+	payload, err := buildReconcilePayload(signature, recipient, recipients, documents)
+	if err != nil {
+		return false, err
+	}
+
+	log.WithFields(f).Debugf("envelope completed by: %s - replaying the signed callback", recipient.Email)
+	err = s.replaySignedCallback(ctx, signature, payload)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// findEnvelopeRecipient returns the recipient for the signature - the embedded signer when present, otherwise the first signer
+func findEnvelopeRecipient(recipients []Signer, signatureID string) *Signer {
+	if len(recipients) == 0 {
+		return nil
+	}
+	for i := range recipients {
+		if recipients[i].ClientUserId == signatureID {
+			return &recipients[i]
+		}
+	}
+	return &recipients[0]
+}
+
+// buildReconcilePayload builds a DocuSign Connect style payload so the existing signed callbacks can be replayed - the
+// signature recipient is listed first, followed by any other (counter signing) recipients
+func buildReconcilePayload(signature *signatures.ItemSignature, recipient *Signer, recipients []Signer, documents []DocuSignDocument) ([]byte, error) {
+	var documentID string
+	for _, document := range documents {
+		if document.DocumentId != "" && document.DocumentId != "certificate" {
+			documentID = document.DocumentId
+			break
+		}
+	}
+	if documentID == "" {
+		return nil, fmt.Errorf("no signed document found for envelope: %s", signature.SignatureEnvelopeID)
+	}
+
+	clientUserID := recipient.ClientUserId
+	if clientUserID == "" {
+		clientUserID = signature.SignatureID
+	}
+
+	recipientStatuses := []RecipientStatus{
+		{
+			Type:         "Signer",
+			Email:        recipient.Email,
+			UserName:     recipient.Name,
+			RoutingOrder: routingOrder(recipient.RoutingOrder),
+			Signed:       recipient.SignedDateTime,
+			Status:       DocusignCompleted,
+			ClientUserId: clientUserID,
+			RecipientId:  recipient.RecipientId,
+			TabStatuses: []TabStatus{
+				{TabLabel: "full_name", TabValue: recipient.Name},
+			},
+		},
+	}
+	for _, other := range recipients {
+		if other.RecipientId == recipient.RecipientId {
+			continue
+		}
+		recipientStatuses = append(recipientStatuses, RecipientStatus{
+			Type:         "Signer",
+			Email:        other.Email,
+			UserName:     other.Name,
+			RoutingOrder: routingOrder(other.RoutingOrder),
+			Signed:       other.SignedDateTime,
+			Status:       other.Status,
+			RecipientId:  other.RecipientId,
+		})
+	}
+
+	_, currentTime := utils.CurrentTime()
+	info := DocuSignEnvelopeInformation{
+		EnvelopeStatus: EnvelopeStatus{
+			RecipientStatuses: recipientStatuses,
+			TimeGenerated:     currentTime,
+			EnvelopeID:        signature.SignatureEnvelopeID,
+			Status:            DocusignCompleted,
+			Completed:         recipient.SignedDateTime,
+			DocumentStatuses: []DocumentStatus{
+				{ID: documentID, Sequence: 1},
+			},
+		},
+	}
+
+	return xml.Marshal(info)
+}
+
+// replaySignedCallback invokes the signed callback handler matching the signature callback URL
+func (s *service) replaySignedCallback(ctx context.Context, signature *signatures.ItemSignature, payload []byte) error {
+	segments := callbackURLSegments(signature.SignatureCallbackURL)
+
+	switch {
+	case len(segments) == 4 && segments[0] == "individual":
+		// signed/individual/{installation_id}/{github_repository_id}/{change_request_id}
+		return s.SignedIndividualCallbackGithub(ctx, payload, segments[1], segments[3], segments[2])
+	case len(segments) == 6 && segments[0] == "gitlab" && segments[1] == "individual":
+		// signed/gitlab/individual/{user_id}/{organization_id}/{gitlab_repository_id}/{merge_request_id}
+		return s.SignedIndividualCallbackGitlab(ctx, payload, segments[2], segments[3], segments[4], segments[5])
+	case len(segments) == 6 && segments[0] == "gitea" && segments[1] == "individual":
+		// signed/gitea/individual/{user_id}/{organization_id}/{gitea_repository_id}/{pull_request_id}
+		return s.SignedIndividualCallbackGitea(ctx, payload, segments[2], segments[3], segments[4], segments[5])
+	case len(segments) == 3 && segments[0] == "gerrit" && segments[1] == "individual":
+		// signed/gerrit/individual/{user_id}
+		return s.SignedIndividualCallbackGerrit(ctx, payload, segments[2])
+	case len(segments) == 3 && segments[0] == "corporate":
+		// signed/corporate/{project_id}/{company_id}
+		return s.SignedCorporateCallback(ctx, payload, segments[2], segments[1])
+	case signature.SignatureType == utils.SignatureTypeCCLA:
+		return s.SignedCorporateCallback(ctx, payload, signature.SignatureReferenceID, signature.SignatureProjectID)
+	default:
+		return ErrUnknownCallbackURL
+	}
+}
+
+// callbackURLSegments returns the callback URL path segments following the "signed" path segment
+func callbackURLSegments(callbackURL string) []string {
+	idx := strings.Index(callbackURL, "/signed/")
+	if idx < 0 {
+		return nil
+	}
+	return strings.Split(strings.Trim(callbackURL[idx+len("/signed/"):], "/"), "/")
+}
@@ -28,6 +28,9 @@ import (
 	"github.com/communitybridge/easycla/cla-backend-go/signatures"
 	"github.com/communitybridge/easycla/cla-backend-go/users"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/cla_groups"
+	gitea_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitea-activity"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/gitea_organizations"
+	"github.com/communitybridge/easycla/cla-backend-go/v2/github_activity"
 	gitlab_activity "github.com/communitybridge/easycla/cla-backend-go/v2/gitlab-activity"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/gitlab_organizations"
 	"github.com/communitybridge/easycla/cla-backend-go/v2/store"
@@ -59,6 +62,7 @@ const (
 	DontLoadRepoDetails = false
 	DocSignFalse        = "false"
 	DocusignCompleted   = "Completed"
+	DocusignSent        = "sent"
 )
 
 // errors
@@ -76,9 +80,9 @@ type ProjectRepo interface {
 // Service interface defines the sign service methods
 type Service interface {
 	VoidEnvelope(ctx context.Context, envelopeID, message string) error
-	PrepareSignRequest(ctx context.Context, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error)
+	PrepareSignRequest(ctx context.Context, claGroup *v1Models.ClaGroup, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error)
 	GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error)
-	createEnvelope(ctx context.Context, payload *DocuSignEnvelopeRequest) (string, error)
+	createEnvelope(ctx context.Context, claGroup *v1Models.ClaGroup, payload *DocuSignEnvelopeRequest) (string, error)
 	addDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error
 	GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error)
 	GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error)
@@ -88,8 +92,15 @@ type Service interface {
 	RequestIndividualSignatureGerrit(ctx context.Context, input *models.IndividualSignatureInput) (*models.IndividualSignatureOutput, error)
 	SignedIndividualCallbackGithub(ctx context.Context, payload []byte, installationID, changeRequestID, repositoryID string) error
 	SignedIndividualCallbackGitlab(ctx context.Context, payload []byte, userID, organizationID, repositoryID, mergeRequestID string) error
+	SignedIndividualCallbackGitea(ctx context.Context, payload []byte, userID, organizationID, repositoryID, pullRequestID string) error
 	SignedIndividualCallbackGerrit(ctx context.Context, payload []byte, userID string) error
 	SignedCorporateCallback(ctx context.Context, payload []byte, companyID, projectID string) error
+
+	GetClickThroughSigningPage(ctx context.Context, envelopeID, recipientID, token string) (*ClickThroughSigningPage, error)
+	ClickThroughConsent(ctx context.Context, envelopeID, recipientID, token, signedName, remoteAddr, userAgent string) (string, error)
+	ReconcileEnvelopes(ctx context.Context, minAge, maxAge time.Duration) (*ReconcileSummary, error)
+	ProcessPendingCorporateSignatures(ctx context.Context) (*SignatoryReminderSummary, error)
+	ProcessExpiringSignatures(ctx context.Context) (*SignatureExpirySummary, error)
 }
 
 // service
@@ -101,7 +112,8 @@ type service struct {
 	projectClaGroupsRepo  projects_cla_groups.Repository
 	companyService        company.IService
 	claGroupService       cla_groups.Service
-	docsignPrivateKey     string
+	docusign              SignatureProvider
+	clickThrough          *clickThroughProvider
 	userService           users.Service
 	signatureService      signatures.SignatureService
 	storeRepository       store.Repository
@@ -115,12 +127,15 @@ type service struct {
 	gitlabActivityService gitlab_activity.Service
 	gitlabApp             *gitlab_api.App
 	gerritService         gerrits.Service
+	giteaOrgService       gitea_organizations.ServiceInterface
+	giteaActivityService  gitea_activity.Service
+	githubActivityService github_activity.Service
 }
 
 // NewService returns an instance of v2 project service
 func NewService(apiURL, v1API string, compRepo company.IRepository, projectRepo ProjectRepo, pcgRepo projects_cla_groups.Repository, compService company.IService, claGroupService cla_groups.Service, docsignPrivateKey string, userService users.Service, signatureService signatures.SignatureService, storeRepository store.Repository,
 	repositoryService repositories.Service, githubOrgService github_organizations.Service, gitlabOrgService gitlab_organizations.ServiceInterface, claLandingPage string, claLogoURL string, emailTemplateService emails.EmailTemplateService, eventsService events.Service, gitlabActivityService gitlab_activity.Service, gitlabApp *gitlab_api.App,
-	gerritService gerrits.Service) Service {
+	gerritService gerrits.Service, giteaOrgService gitea_organizations.ServiceInterface, giteaActivityService gitea_activity.Service, githubActivityService github_activity.Service) Service {
 	return &service{
 		ClaV4ApiURL:           apiURL,
 		ClaV1ApiURL:           v1API,
@@ -129,7 +144,8 @@ func NewService(apiURL, v1API string, compRepo company.IRepository, projectRepo
 		projectClaGroupsRepo:  pcgRepo,
 		companyService:        compService,
 		claGroupService:       claGroupService,
-		docsignPrivateKey:     docsignPrivateKey,
+		docusign:              newDocuSignProvider(docsignPrivateKey),
+		clickThrough:          newClickThroughProvider(apiURL, storeRepository),
 		userService:           userService,
 		signatureService:      signatureService,
 		storeRepository:       storeRepository,
@@ -142,7 +158,10 @@ func NewService(apiURL, v1API string, compRepo company.IRepository, projectRepo
 		gitlabActivityService: gitlabActivityService,
 		gitlabApp:             gitlabApp,
 		gerritService:         gerritService,
+		giteaOrgService:       giteaOrgService,
+		giteaActivityService:  giteaActivityService,
 		eventsService:         eventsService,
+		githubActivityService: githubActivityService,
 	}
 }
 
@@ -377,226 +396,6 @@ func (s *service) getCorporateSignatureCallbackUrl(companyId, projectId string)
 	return fmt.Sprintf("%s/v4/signed/corporate/%s/%s", s.ClaV4ApiURL, companyId, projectId)
 }
 
-func (s *service) SignedIndividualCallbackGithub(ctx context.Context, payload []byte, installationID, changeRequestID, repositoryID string) error {
-	f := logrus.Fields{
-		"functionName":    "sign.SignedIndividualCallbackGithub",
-		utils.XREQUESTID:  ctx.Value(utils.XREQUESTID),
-		"installationID":  installationID,
-		"changeRequestID": changeRequestID,
-		"repositoryID":    repositoryID,
-	}
-
-	log.WithFields(f).Debug("processing signed individual callback...")
-
-	var info DocuSignEnvelopeInformation
-
-	err := xml.Unmarshal(payload, &info)
-	if err != nil {
-		log.WithFields(f).WithError(err).Warn("unable to unmarshal xml payload")
-		return err
-	}
-
-	envelopeID := info.EnvelopeStatus.EnvelopeID
-	signatureID := info.EnvelopeStatus.RecipientStatuses[0].ClientUserId
-	status := info.EnvelopeStatus.RecipientStatuses[0].Status
-	signedDate := info.EnvelopeStatus.RecipientStatuses[0].Signed
-	documentID := info.EnvelopeStatus.DocumentStatuses[0].ID
-	fullName := fetchFullName(info)
-
-	log.WithFields(f).Debugf("envelopeID: %s, signatureID: %s, status: %s, signedDate: %s, fullName: %s", envelopeID, signatureID, status, signedDate, fullName)
-
-	_, currentTime := utils.CurrentTime()
-
-	signature, err := s.signatureService.GetSignature(ctx, signatureID)
-	if err != nil {
-		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID")
-		return err
-	}
-
-	if signature == nil {
-		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID - signature not found")
-		return errors.New("unable to lookup signature by ID - signature not found")
-	}
-
-	if status == DocusignCompleted {
-		log.WithFields(f).Debugf("envelope signed - status: %s", status)
-		updates := map[string]interface{}{
-			"signature_signed":          true,
-			"signature_embargo_acked":   true,
-			"date_modified":             currentTime,
-			"signed_on":                 currentTime,
-			"user_docusign_raw_xml":     string(payload),
-			"user_docusign_name":        fullName,
-			"user_docusign_date_signed": signedDate,
-		}
-		err = s.signatureService.UpdateSignature(ctx, signatureID, updates)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to update signature record with envelope ID: %s", envelopeID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("updated signature record: %s", signatureID)
-
-		// Update the repository provider with this change - this will update the comment (if necessary)
-		// and the status - do this early in the flow as the user will be immediately redirected back
-		installtionIDInt, err := strconv.Atoi(installationID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to convert installation ID to int: %s", installationID)
-			return err
-		}
-
-		repositoryIDInt, err := strconv.Atoi(repositoryID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to convert repository ID to int: %s", repositoryID)
-			return err
-		}
-
-		changeRequestIDInt, err := strconv.Atoi(changeRequestID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to convert change request ID to int: %s", changeRequestID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("updating change request for installation ID: %d, repository ID: %d, change request ID: %d", installtionIDInt, repositoryIDInt, changeRequestIDInt)
-		err = s.updateChangeRequest(ctx, int64(installtionIDInt), int64(repositoryIDInt), int64(changeRequestIDInt), signature.ProjectID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to update change request: %s", changeRequestID)
-			return err
-		}
-
-		claUser, userErr := s.userService.GetUser(signature.SignatureReferenceID)
-		if userErr != nil {
-			log.WithFields(f).WithError(userErr).Warnf("unable to lookup user by ID: %s", signature.SignatureReferenceID)
-			return userErr
-		}
-
-		if claUser.Username == "" {
-			if fullName != "" {
-				log.WithFields(f).Debugf("setting username for user with :%s", fullName)
-				updates := map[string]interface{}{
-					"user_name": fullName,
-				}
-				log.WithFields(f).Debugf("updating user with username: %s", fullName)
-				_, err = s.userService.UpdateUser(signature.SignatureReferenceID, updates)
-				if err != nil {
-					log.WithFields(f).WithError(err).Warnf("unable to update user with username: %s", fullName)
-					return err
-				}
-			}
-		}
-
-		// Remove the active signature
-		log.WithFields(f).Debugf("removing active signature metadata for user: %s", signature.SignatureReferenceID)
-		key := fmt.Sprintf("active_signature:%s", signature.SignatureReferenceID)
-		err = s.storeRepository.DeleteActiveSignatureMetaData(ctx, key)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to remove active signature metadata for user: %s", signature.SignatureReferenceID)
-			return err
-		}
-
-		//Get signed document
-		log.WithFields(f).Debugf("getting signed document for envelope ID: %s", envelopeID)
-		signedDocument, err := s.GetSignedDocument(ctx, envelopeID, documentID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to get signed document for envelope ID: %s", envelopeID)
-			return err
-		}
-
-		// send email to user
-		log.WithFields(f).Debugf("sending email to user... ")
-		claGroup, err := s.claGroupService.GetCLAGroup(ctx, signature.ProjectID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", signature.ProjectID)
-			return err
-		}
-
-		subject := fmt.Sprintf("EasyCLA: Individual CLA Signed for %s", claGroup.ProjectName)
-		pdfLink := fmt.Sprintf("%s/v3/signatures/%s/%s/icla/pdf", s.ClaV1ApiURL, signature.ProjectID, signature.SignatureReferenceID)
-		emailParams := emails.DocumentSignedTemplateParams{
-			CommonEmailParams: emails.CommonEmailParams{
-				RecipientName: fullName,
-			},
-			PdfLink: pdfLink,
-			ICLA:    true,
-		}
-		email := utils.GetBestEmail(claUser)
-		if email == "" {
-			log.WithFields(f).Warnf("unable to find email for user: %+v", claUser)
-			return errors.New("unable to find email for user")
-		}
-
-		recipients := []string{utils.GetBestEmail(claUser)}
-
-		body, err := emails.RenderDocumentSignedTemplate(s.emailTemplateService, claGroup.Version, claGroup.ProjectExternalID, emailParams)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to render document signed template for project version: %s, project ID: %s", claGroup.Version, claGroup.ProjectID)
-			return err
-		}
-
-		// send email to user
-		log.WithFields(f).Debugf("sending email to user... ")
-		err = utils.SendEmail(subject, body, recipients)
-
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to send email to user: %s", claUser.Username)
-			return err
-		}
-
-		log.WithFields(f).Debugf("email sent to user: %s", claUser.Username)
-
-		if claUser.UserID == "" {
-			return fmt.Errorf("user id is empty for user: %s", claUser.Username)
-		}
-
-		// store document on S3
-		log.WithFields(f).Debugf("storing signed document on S3...")
-		err = utils.UploadToS3(signedDocument, signature.ProjectID, utils.ClaTypeICLA, claUser.UserID, signature.SignatureID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to store signed document on S3")
-			return err
-		}
-
-		log.WithFields(f).Debugf("cla_group : %+v", claGroup)
-
-		pcg, err := s.projectClaGroupsRepo.GetCLAGroup(ctx, signature.ProjectID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to lookup project cla group by project ID: %s", signature.ProjectID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("project cla group: %+v", pcg)
-		projectName := claGroup.ProjectName
-		if projectName == "" {
-			projectName = pcg.ProjectName
-			log.WithFields(f).Debugf("project name not found in cla_group, using project cla group name: %s", projectName)
-		}
-		log.WithFields(f).Debugf("project name: %s", projectName)
-
-		// Log the event
-		eventData := events.IndividualSignatureSignedEventData{
-			ProjectName: projectName,
-			ProjectID:   signature.ProjectID,
-		}
-		log.WithFields(f).Debugf("logging event: %+v", eventData)
-		eventArgs := &events.LogEventArgs{
-			EventType:  events.IndividualSignatureSigned,
-			ProjectID:  signature.ProjectID,
-			UserID:     claUser.UserID,
-			LfUsername: fullName,
-			EventData:  &eventData,
-			CLAGroupID: signature.ProjectID,
-		}
-		log.WithFields(f).Debugf("logging event: %+v", eventArgs)
-		s.eventsService.LogEvent(eventArgs)
-
-	} else {
-		log.WithFields(f).Debugf("envelope not signed - status: %s", status)
-	}
-
-	return nil
-
-}
-
 func fetchFullName(info DocuSignEnvelopeInformation) string {
 	var fullName string
 	for _, tabStatus := range info.EnvelopeStatus.RecipientStatuses[0].TabStatuses {
@@ -613,423 +412,7 @@ func fetchFullName(info DocuSignEnvelopeInformation) string {
 	return fullName
 }
 
-func (s *service) SignedIndividualCallbackGitlab(ctx context.Context, payload []byte, userID, organizationID, repositoryID, mergeRequestID string) error {
-	f := logrus.Fields{
-		"functionName":   "sign.SignedIndividualCallbackGitlab",
-		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
-		"userID":         userID,
-		"organizationID": organizationID,
-		"mergeRequestID": mergeRequestID,
-		"repositoryID":   repositoryID,
-	}
-
-	log.WithFields(f).Debug("processing signed individual callback...")
-	var info DocuSignEnvelopeInformation
-
-	err := xml.Unmarshal(payload, &info)
-	if err != nil {
-		log.WithFields(f).WithError(err).Warn("unable to unmarshal xml payload")
-		return err
-	}
-
-	envelopeID := info.EnvelopeStatus.EnvelopeID
-	signatureID := info.EnvelopeStatus.RecipientStatuses[0].ClientUserId
-	status := info.EnvelopeStatus.RecipientStatuses[0].Status
-	signedDate := info.EnvelopeStatus.RecipientStatuses[0].Signed
-	documentID := info.EnvelopeStatus.DocumentStatuses[0].ID
-	fullName := fetchFullName(info)
-
-	log.WithFields(f).Debugf("envelopeID: %s, signatureID: %s, status: %s, signedDate: %s, fullName: %s", envelopeID, signatureID, status, signedDate, fullName)
-
-	_, currentTime := utils.CurrentTime()
-
-	signature, err := s.signatureService.GetSignature(ctx, signatureID)
-	if err != nil {
-		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID")
-		return err
-	}
-
-	if signature == nil {
-		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID - signature not found")
-		return errors.New("unable to lookup signature by ID - signature not found")
-	}
-
-	if status == DocusignCompleted {
-		log.WithFields(f).Debugf("envelope signed - status: %s", status)
-		updates := map[string]interface{}{
-			"signature_signed":          true,
-			"signature_embargo_acked":   true,
-			"date_modified":             currentTime,
-			"signed_on":                 currentTime,
-			"user_docusign_raw_xml":     string(payload),
-			"user_docusign_name":        fullName,
-			"user_docusign_date_signed": signedDate,
-		}
-		err = s.signatureService.UpdateSignature(ctx, signatureID, updates)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to update signature record with envelope ID: %s", envelopeID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("updated signature record: %s", signatureID)
-
-		gitlabOrg, err := s.gitlabOrgService.GetGitLabOrganizationByID(ctx, organizationID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to lookup gitlab organization by ID: %s", organizationID)
-			return err
-		}
-
-		repositoryIDInt, err := strconv.Atoi(repositoryID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to convert repository ID to int: %s", repositoryID)
-			return err
-		}
-
-		mergeRequestIDInt, err := strconv.Atoi(mergeRequestID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to convert merge request ID to int: %s", mergeRequestID)
-			return err
-		}
-
-		encryptedOauthResponse, err := s.gitlabOrgService.RefreshGitLabOrganizationAuth(ctx, gitlabOrg)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to refresh gitlab organization auth for organization ID: %s", organizationID)
-			return err
-		}
-
-		gitlabClient, err := gitlab_api.NewGitlabOauthClient(*encryptedOauthResponse, s.gitlabApp)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to create gitlab client for organization ID: %s", organizationID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("fetching repository info for repository ID: %d", repositoryIDInt)
-		gitlabProject, err := gitlab_api.GetProjectByID(ctx, gitlabClient, repositoryIDInt)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to lookup gitlab project by ID: %s", repositoryID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("fetching merge request info for merge request ID: %d", mergeRequestIDInt)
-		gitlabMr, err := gitlab_api.FetchMrInfo(gitlabClient, repositoryIDInt, mergeRequestIDInt)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to fetch merge request info for merge request ID: %s", mergeRequestID)
-			return err
-		}
-
-		tokenPlaceHolder := "token"
-		input := gitlab_activity.ProcessMergeActivityInput{
-			ProjectName:      gitlabProject.Name,
-			ProjectID:        gitlabProject.ID,
-			ProjectPath:      gitlabProject.PathWithNamespace,
-			ProjectNamespace: gitlabProject.Namespace.Name,
-			MergeID:          mergeRequestIDInt,
-			RepositoryPath:   gitlabProject.PathWithNamespace,
-			LastCommitSha:    gitlabMr.SHA,
-		}
-
-		log.WithFields(f).Debugf("processing merge activity for input: %+v", input)
-
-		err = s.gitlabActivityService.ProcessMergeActivity(ctx, tokenPlaceHolder, &input)
-
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to update change request: %s", mergeRequestID)
-			return err
-		}
-
-		claUser, userErr := s.userService.GetUser(signature.SignatureReferenceID)
-		if userErr != nil {
-			log.WithFields(f).WithError(userErr).Warnf("unable to lookup user by ID: %s", signature.SignatureReferenceID)
-			return userErr
-		}
-
-		if claUser.Username == "" {
-			if fullName != "" {
-				log.WithFields(f).Debugf("setting username for user with :%s", fullName)
-				updates := map[string]interface{}{
-					"user_name": fullName,
-				}
-				log.WithFields(f).Debugf("updating user with username: %s", fullName)
-				_, err = s.userService.UpdateUser(signature.SignatureReferenceID, updates)
-				if err != nil {
-					log.WithFields(f).WithError(err).Warnf("unable to update user with username: %s", fullName)
-					return err
-				}
-			}
-		}
-
-		// Remove the active signature
-		log.WithFields(f).Debugf("removing active signature metadata for user: %s", signature.SignatureReferenceID)
-		key := fmt.Sprintf("active_signature:%s", signature.SignatureReferenceID)
-		err = s.storeRepository.DeleteActiveSignatureMetaData(ctx, key)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to remove active signature metadata for user: %s", signature.SignatureReferenceID)
-			return err
-		}
-
-		//Get signed document
-		log.WithFields(f).Debugf("getting signed document for envelope ID: %s", envelopeID)
-		signedDocument, err := s.GetSignedDocument(ctx, envelopeID, documentID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to get signed document for envelope ID: %s", envelopeID)
-			return err
-		}
-
-		// send email to user
-		log.WithFields(f).Debugf("sending email to user... ")
-		log.WithFields(f).Debugf("getting claGroupID: %s", signature.ProjectID)
-		claGroup, err := s.claGroupService.GetCLAGroup(ctx, signature.ProjectID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", signature.ProjectID)
-			return err
-		}
-
-		subject := fmt.Sprintf("EasyCLA: Individual CLA Signed for %s", claGroup.ProjectName)
-		pdfLink := fmt.Sprintf("%s/v3/signatures/%s/%s/icla/pdf", s.ClaV1ApiURL, signature.ProjectID, signature.SignatureReferenceID)
-		emailParams := emails.DocumentSignedTemplateParams{
-			CommonEmailParams: emails.CommonEmailParams{
-				RecipientName: fullName,
-			},
-			PdfLink: pdfLink,
-			ICLA:    true,
-		}
-		email := utils.GetBestEmail(claUser)
-		if email == "" {
-			log.WithFields(f).Warnf("unable to find email for user: %+v", claUser)
-			return errors.New("unable to find email for user")
-		}
-
-		recipients := []string{utils.GetBestEmail(claUser)}
-
-		body, err := emails.RenderDocumentSignedTemplate(s.emailTemplateService, claGroup.Version, claGroup.ProjectExternalID, emailParams)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to render document signed template for project version: %s, project ID: %s", claGroup.Version, claGroup.ProjectID)
-			return err
-		}
-
-		// send email to user
-		log.WithFields(f).Debugf("sending email to user... ")
-		err = utils.SendEmail(subject, body, recipients)
-
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to send email to user: %s", claUser.Username)
-			return err
-		}
-
-		log.WithFields(f).Debugf("email sent to user: %s", claUser.Username)
-
-		if claUser.UserID == "" {
-			return fmt.Errorf("user id is empty for user: %s", claUser.Username)
-		}
-
-		// store document on S3
-		log.WithFields(f).Debugf("storing signed document on S3...")
-		err = utils.UploadToS3(signedDocument, signature.ProjectID, utils.ClaTypeICLA, claUser.UserID, signature.SignatureID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to store signed document on S3")
-			return err
-		}
-
-		// Log the event
-		log.WithFields(f).Debugf("logging event...")
-		s.eventsService.LogEvent(&events.LogEventArgs{
-			EventType: events.IndividualSignatureSigned,
-			ProjectID: signature.ProjectID,
-			UserID:    claUser.UserID,
-			EventData: &events.IndividualSignatureSignedEventData{
-				ProjectName: claGroup.ProjectName,
-				Username:    fullName,
-				ProjectID:   signature.ProjectID,
-			},
-			CLAGroupID: signature.ProjectID,
-		})
-	} else {
-		log.WithFields(f).Debugf("envelope not signed - status: %s", status)
-	}
-
-	return nil
-}
-
-func (s *service) SignedIndividualCallbackGerrit(ctx context.Context, payload []byte, userID string) error {
-	f := logrus.Fields{
-		"functionName":   "sign.SignedIndividualCallbackGerrit",
-		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
-		"userID":         userID,
-	}
-
-	log.WithFields(f).Debug("processing signed individual callback...")
-	var info DocuSignEnvelopeInformation
-
-	err := xml.Unmarshal(payload, &info)
-	if err != nil {
-		log.WithFields(f).WithError(err).Warn("unable to unmarshal xml payload")
-		return err
-	}
-
-	envelopeID := info.EnvelopeStatus.EnvelopeID
-	signatureID := info.EnvelopeStatus.RecipientStatuses[0].ClientUserId
-	status := info.EnvelopeStatus.RecipientStatuses[0].Status
-	signedDate := info.EnvelopeStatus.RecipientStatuses[0].Signed
-	documentID := info.EnvelopeStatus.DocumentStatuses[0].ID
-	fullName := fetchFullName(info)
-
-	log.WithFields(f).Debugf("envelopeID: %s, signatureID: %s, status: %s, signedDate: %s, fullName: %s", envelopeID, signatureID, status, signedDate, fullName)
-
-	_, currentTime := utils.CurrentTime()
-
-	signature, err := s.signatureService.GetSignature(ctx, signatureID)
-	if err != nil {
-		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID")
-		return err
-	}
-
-	if signature == nil {
-		log.WithFields(f).WithError(err).Warn("unable to lookup signature by ID - signature not found")
-		return errors.New("unable to lookup signature by ID - signature not found")
-	}
-
-	if status == DocusignCompleted {
-		log.WithFields(f).Debugf("envelope signed - status: %s", status)
-		updates := map[string]interface{}{
-			"signature_signed":          true,
-			"signature_embargo_acked":   true,
-			"date_modified":             currentTime,
-			"signed_on":                 currentTime,
-			"user_docusign_raw_xml":     string(payload),
-			"user_docusign_name":        fullName,
-			"user_docusign_date_signed": signedDate,
-		}
-		err = s.signatureService.UpdateSignature(ctx, signatureID, updates)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to update signature record with envelope ID: %s", envelopeID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("updated signature record: %s", signatureID)
-
-		claUser, userErr := s.userService.GetUser(signature.SignatureReferenceID)
-		if userErr != nil {
-			log.WithFields(f).WithError(userErr).Warnf("unable to lookup user by ID: %s", signature.SignatureReferenceID)
-			return userErr
-		}
-
-		if claUser.Username == "" {
-			if fullName != "" {
-				log.WithFields(f).Debugf("setting username for user with :%s", fullName)
-				updates := map[string]interface{}{
-					"user_name": fullName,
-				}
-				log.WithFields(f).Debugf("updating user with username: %s", fullName)
-				_, err = s.userService.UpdateUser(signature.SignatureReferenceID, updates)
-				if err != nil {
-					log.WithFields(f).WithError(err).Warnf("unable to update user with username: %s", fullName)
-					return err
-				}
-			}
-		}
-
-		//Get signed document
-		log.WithFields(f).Debugf("getting signed document for envelope ID: %s", envelopeID)
-		signedDocument, err := s.GetSignedDocument(ctx, envelopeID, documentID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to get signed document for envelope ID: %s", envelopeID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("getting claGroupID: %s", signature.ProjectID)
-
-		claGroup, err := s.claGroupService.GetCLAGroup(ctx, signature.ProjectID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", signature.ProjectID)
-			return err
-		}
-
-		log.WithFields(f).Debugf("claGroup: %+s found", claGroup.ProjectID)
-
-		subject := fmt.Sprintf("EasyCLA: Individual CLA Signed for %s", claGroup.ProjectName)
-		pdfLink := fmt.Sprintf("%s/v3/signatures/%s/%s/icla/pdf", s.ClaV1ApiURL, signature.ProjectID, signature.SignatureReferenceID)
-		emailParams := emails.DocumentSignedTemplateParams{
-			CommonEmailParams: emails.CommonEmailParams{
-				RecipientName: fullName,
-			},
-			PdfLink: pdfLink,
-			ICLA:    true,
-		}
-
-		email := utils.GetBestEmail(claUser)
-		if email == "" {
-			log.WithFields(f).Warnf("unable to find email for user: %+v", claUser)
-			return errors.New("unable to find email for user")
-		}
-
-		recipients := []string{utils.GetBestEmail(claUser)}
-
-		body, err := emails.RenderDocumentSignedTemplate(s.emailTemplateService, claGroup.Version, claGroup.ProjectExternalID, emailParams)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to render document signed template for project version: %s, project ID: %s", claGroup.Version, claGroup.ProjectID)
-			return err
-		}
-
-		// send email to user
-		log.WithFields(f).Debugf("sending email to user... ")
-		err = utils.SendEmail(subject, body, recipients)
-
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to send email to user: %s", claUser.Username)
-			return err
-		}
-
-		log.WithFields(f).Debugf("email sent to user: %s", claUser.Username)
-
-		if claUser.UserID == "" {
-			return fmt.Errorf("user id is empty for user: %s", claUser.Username)
-		}
-
-		// store document on S3
-		log.WithFields(f).Debugf("storing signed document on S3...")
-		err = utils.UploadToS3(signedDocument, signature.ProjectID, utils.ClaTypeICLA, claUser.UserID, signature.SignatureID)
-		if err != nil {
-			log.WithFields(f).WithError(err).Warnf("unable to store signed document on S3")
-			return err
-		}
-
-		// event data
-		eventData := &events.IndividualSignatureSignedEventData{
-			Username:    fullName,
-			ProjectID:   signature.ProjectID,
-			ProjectName: claGroup.ProjectName,
-		}
-
-		// Log the event
-		log.WithFields(f).Debugf("logging event...")
-		s.eventsService.LogEvent(&events.LogEventArgs{
-			EventType:  events.IndividualSignatureSigned,
-			ProjectID:  signature.ProjectID,
-			UserID:     claUser.UserID,
-			EventData:  eventData,
-			CLAGroupID: signature.ProjectID,
-		})
-
-		// Add User to Gerrit Group
-		//		if claUser.LfUsername != "" {
-		//			log.WithFields(f).Debugf("adding user to gerrit group: %s", claUser.LfUsername)
-		//			err = s.gerritService.AddUserToGroup(ctx, nil, signature.ProjectID, claUser.LfUsername, utils.ClaTypeICLA)
-		//			if err != nil {
-		//				log.WithFields(f).WithError(err).Warnf("unable to add user to gerrit group")
-		//				return err
-		//			}
-		//		} else {
-		//			log.WithFields(f).Warnf("user LF username is empty")
-		//		}
-
-	} else {
-		log.WithFields(f).Debugf("envelope not signed - status: %s", status)
-	}
-
-	return nil
-}
-
-func (s *service) SignedCorporateCallback(ctx context.Context, payload []byte, companyID, projectID string) error {
+func (s *service) signedCorporateCallback(ctx context.Context, payload []byte, companyID, projectID string) error {
 	f := logrus.Fields{
 		"functionName":   "sign.SignedCorporateCallback",
 		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
@@ -1106,6 +489,12 @@ func (s *service) SignedCorporateCallback(ctx context.Context, payload []byte, c
 	}
 
 	log.WithFields(f).Debugf("signatureID: %s", signatureID)
+
+	// Multi-party corporate CLA - record the progress until every routed party has signed
+	if info.EnvelopeStatus.Status != DocusignCompleted && len(signature.SignatureSigners) > 0 {
+		return s.updateRoutedSignatureProgress(ctx, signature, &info)
+	}
+
 	var user *v1Models.User
 	if signature.SignatureReferenceType == utils.SignatureReferenceTypeUser {
 		log.WithFields(f).Debugf("looking up user by ID: %s", signature.SignatureReferenceID)
@@ -1154,6 +543,14 @@ func (s *service) SignedCorporateCallback(ctx context.Context, payload []byte, c
 
 		updates["user_docusign_raw_xml"] = string(payload)
 
+		// Close out the pending signatory request
+		if signature.SignatureStatus != "" {
+			updates["signature_status"] = utils.SignatureStatusSigned
+		}
+		if len(signature.SignatureSigners) > 0 {
+			updates["signature_signers"] = routedSignatureSigners(signature.SignatureSigners, info.EnvelopeStatus.RecipientStatuses)
+		}
+
 		// Update the signature record
 		log.WithFields(f).Debugf("updating signature record: %s", signatureID)
 		err = s.signatureService.UpdateSignature(ctx, signatureID, updates)
@@ -1174,7 +571,7 @@ func (s *service) SignedCorporateCallback(ctx context.Context, payload []byte, c
 		return err
 	}
 
-	err = utils.UploadToS3(signedDocument, projectID, utils.ClaTypeCCLA, companyID, signatureID)
+	err = s.storeSignedDocument(ctx, signedDocument, projectID, utils.ClaTypeCCLA, companyID, signatureID)
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("unable to store signed document on S3")
 		return err
@@ -1329,6 +726,12 @@ func (s *service) RequestIndividualSignature(ctx context.Context, input *models.
 			log.WithFields(f).WithError(err).Warnf("unable to get signature callback url for user: %s", *input.UserID)
 			return nil, err
 		}
+	} else if strings.ToLower(input.ReturnURLType) == utils.GiteaLower {
+		callBackURL, err = s.getIndividualSignatureCallbackURLGitea(ctx, *input.UserID, activeSignatureMetadata)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("unable to get signature callback url for user: %s", *input.UserID)
+			return nil, err
+		}
 	}
 
 	log.WithFields(f).Debugf("signature callback url: %s", callBackURL)
@@ -1356,6 +759,12 @@ func (s *service) RequestIndividualSignature(ctx context.Context, input *models.
 		return nil, err
 	}
 
+	// A signed signature which expired or is about to expire is renewed with a new signature record
+	if latestSignature != nil && latestSignature.SignatureSigned && signatures.IsRenewable(latestSignature, claGroup.ProjectSignatureExpiryNoticeDays) {
+		log.WithFields(f).Debugf("renewing signature: %s which expires on: %s", latestSignature.SignatureID, latestSignature.SignatureExpiresOn)
+		latestSignature = nil
+	}
+
 	if latestSignature != nil {
 		log.WithFields(f).Debugf("comparing latest signature document version: %s to latest document version: %s", latestSignature.SignatureDocumentMajorVersion, latestDocument.DocumentMajorVersion)
 		if latestDocument.DocumentMajorVersion == latestSignature.SignatureDocumentMajorVersion {
@@ -1557,6 +966,36 @@ func (s *service) getIndividualSignatureCallbackURLGitlab(ctx context.Context, u
 
 }
 
+// getIndividualSignatureCallbackURLGitea returns the Gitea callback URL - the organization, repository and pull
+// request are stored in the active signature metadata when the sign request is initiated
+func (s *service) getIndividualSignatureCallbackURLGitea(ctx context.Context, userID string, metadata map[string]interface{}) (string, error) {
+	f := logrus.Fields{
+		"functionName":   "sign.getIndividualSignatureCallbackURLGitea",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"userID":         userID,
+	}
+
+	var err error
+	if metadata == nil {
+		metadata, err = s.storeRepository.GetActiveSignatureMetaData(ctx, userID)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warnf("unable to get active signature meta data for user: %s", userID)
+			return "", err
+		}
+	}
+
+	organizationID, _ := metadata["organization_id"].(string)
+	repositoryID, _ := metadata["repository_id"].(string)
+	pullRequestID, _ := metadata["pull_request_id"].(string)
+	if organizationID == "" || repositoryID == "" || pullRequestID == "" {
+		msg := fmt.Sprintf("active signature metadata for user: %s is missing the gitea organization, repository or pull request", userID)
+		log.WithFields(f).Warn(msg)
+		return "", errors.New(msg)
+	}
+
+	return fmt.Sprintf("%s/v4/signed/gitea/individual/%s/%s/%s/%s", s.ClaV4ApiURL, userID, organizationID, repositoryID, pullRequestID), nil
+}
+
 func (s *service) getIndividualSignatureCallbackURL(ctx context.Context, userID string, metadata map[string]interface{}) (string, error) {
 	f := logrus.Fields{
 		"functionName": "sign.getIndividualSignatureCallbackURL",
@@ -1679,6 +1118,14 @@ func (s *service) populateSignURL(ctx context.Context,
 		return errors.New("no project lookup error")
 	}
 
+	// Select the e-signature provider configured for the CLA Group
+	provider, err := s.signatureProviderForCLAGroup(ctx, project)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warnf("unable to determine the signature provider for project: %s", latestSignature.SignatureProjectID)
+		return err
+	}
+	log.WithFields(f).Debugf("using signature provider: %s", provider.Name())
+
 	if signatureReferenceType == utils.SignatureReferenceTypeCompany {
 		log.WithFields(f).Debugf("loading project corporate document...")
 		document, err = common.GetCurrentDocument(ctx, project.ProjectCorporateDocuments)
@@ -1819,6 +1266,16 @@ func (s *service) populateSignURL(ctx context.Context,
 		}
 	}
 
+	// Route the corporate CLA to the CLA group counter signers after the company signatory
+	signers := []DocuSignRecipient{signer}
+	latestSignature.SignatureSigners = nil
+	if signatureReferenceType == utils.SignatureReferenceTypeCompany && len(project.ProjectCCLACounterSigners) > 0 {
+		signers = routedSigners(signer, project.ProjectCCLACounterSigners, documentID)
+		latestSignature.SignatureSigners = initialSignatureSigners(signers)
+		latestSignature.SignatureStatus = utils.SignatureStatusPending
+		log.WithFields(f).Debugf("routing the corporate CLA to %d signers", len(signers))
+	}
+
 	contentType := document.DocumentContentType
 	var pdf []byte
 
@@ -1875,6 +1332,15 @@ func (s *service) populateSignURL(ctx context.Context,
 			EnvelopeEvents: recipientEvents,
 		}
 
+		// Multi-party envelopes also notify us as each routed signer completes so we can track the progress
+		if len(signers) > 1 {
+			eventNotification.RecipientEvents = []DocuSignRecipientStatusEvent{
+				{
+					RecipientEventStatusCode: "Completed",
+				},
+			}
+		}
+
 		envelopeRequest = DocuSignEnvelopeRequest{
 			Documents: []DocuSignDocument{
 				docusignDocument,
@@ -1884,9 +1350,7 @@ func (s *service) populateSignURL(ctx context.Context,
 			EventNotification: eventNotification,
 			Status:            "sent",
 			Recipients: DocuSignRecipientType{
-				Signers: []DocuSignRecipient{
-					signer,
-				},
+				Signers: signers,
 			},
 		}
 
@@ -1900,15 +1364,13 @@ func (s *service) populateSignURL(ctx context.Context,
 			EmailBlurb:   emailBody,
 			Status:       "sent",
 			Recipients: DocuSignRecipientType{
-				Signers: []DocuSignRecipient{
-					signer,
-				},
+				Signers: signers,
 			},
 		}
 
 	}
 
-	envelopeResponse, err := s.PrepareSignRequest(ctx, &envelopeRequest)
+	envelopeResponse, err := provider.PrepareSignRequest(ctx, &envelopeRequest)
 
 	if err != nil {
 		log.WithFields(f).WithError(err).Warnf("unable to create envelope for user: %s", latestSignature.SignatureReferenceID)
@@ -1920,7 +1382,7 @@ func (s *service) populateSignURL(ctx context.Context,
 	if !sendAsEmail {
 		// The URL the user will be redirected to after signing.
 		// This route will be in charge of extracting the signature's return_url and redirecting.
-		recipients, recipientErr := s.getEnvelopeRecipients(ctx, envelopeResponse.EnvelopeId)
+		recipients, recipientErr := provider.GetEnvelopeRecipients(ctx, envelopeResponse.EnvelopeId)
 		if recipientErr != nil {
 			log.WithFields(f).Debugf("unable to fetch recipients for envelope: %s", envelopeResponse.EnvelopeId)
 			return recipientErr
@@ -1934,7 +1396,7 @@ func (s *service) populateSignURL(ctx context.Context,
 		returnURL := fmt.Sprintf("%s/v2/return-url/%s", s.ClaV1ApiURL, recipient.ClientUserId)
 
 		log.WithFields(f).Debugf("generating signature sign_url, using return-url as: %s", returnURL)
-		signURL, signErr := s.GetSignURL(signer.Email, signer.RecipientId, signer.Name, signer.ClientUserId, envelopeResponse.EnvelopeId, returnURL)
+		signURL, signErr := provider.GetSignURL(signer.Email, signer.RecipientId, signer.Name, signer.ClientUserId, envelopeResponse.EnvelopeId, returnURL)
 
 		if signErr != nil {
 			log.WithFields(f).WithError(err).Warnf("unable to get sign url for user: %s", latestSignature.SignatureReferenceID)
@@ -2247,6 +1709,9 @@ func getLatestSignature(signatures []*v1Models.Signature) *v1Models.Signature {
 			} else if signature.SignatureMajorVersion == latestSignature.SignatureMajorVersion {
 				if signature.SignatureMinorVersion > latestSignature.SignatureMinorVersion {
 					latestSignature = signature
+				} else if signature.SignatureMinorVersion == latestSignature.SignatureMinorVersion && signature.Created > latestSignature.Created {
+					// a renewed signature is created after the signature it renews
+					latestSignature = signature
 				}
 			}
 		}
@@ -2346,6 +1811,12 @@ func (s *service) RequestIndividualSignatureGerrit(ctx context.Context, input *m
 	returnURL := input.ReturnURL
 	log.WithFields(f).Debugf("returnURL: %s", returnURL)
 
+	// A signed signature which expired or is about to expire is renewed with a new signature record
+	if latestSignature != nil && latestSignature.SignatureSigned && signatures.IsRenewable(latestSignature, project.ProjectSignatureExpiryNoticeDays) {
+		log.WithFields(f).Debugf("renewing signature: %s which expires on: %s", latestSignature.SignatureID, latestSignature.SignatureExpiresOn)
+		latestSignature = nil
+	}
+
 	if latestSignature != nil {
 		log.WithFields(f).Debugf("comparing latest signature document version: %s to latest document version: %s", latestSignature.SignatureDocumentMajorVersion, latestDocument.DocumentMajorVersion)
 		if latestDocument.DocumentMajorVersion == latestSignature.SignatureDocumentMajorVersion {
@@ -2529,12 +2000,21 @@ func (s *service) requestCorporateSignature(ctx context.Context, apiURL string,
 
 	log.WithFields(f).Debugf("found %d corporate signatures", len(companySignatures))
 
+	// A signed signature which expired or is about to expire does not block a new signature - the company renews the
+	// CLA with a new signature record which keeps the approval lists of the signature it renews
 	haveSigned := false
-	for _, s := range companySignatures {
-		if s.SignatureSigned {
+	var renewedSignature *v1Models.Signature
+	var pendingSignatures []*v1Models.Signature
+	for _, sig := range companySignatures {
+		if !sig.SignatureSigned {
+			pendingSignatures = append(pendingSignatures, sig)
+			continue
+		}
+		if !signatures.IsRenewable(sig, proj.ProjectSignatureExpiryNoticeDays) {
 			haveSigned = true
 			break
 		}
+		renewedSignature = sig
 	}
 	if haveSigned {
 		haveSignedErr := fmt.Errorf("one or more corporate valid signature exists for Company ID: %s, Project ID: %s", input.CompanyID, input.ProjectID)
@@ -2545,9 +2025,9 @@ func (s *service) requestCorporateSignature(ctx context.Context, apiURL string,
 	var companySignature *v1Models.Signature
 	var itemSignature *signatures.ItemSignature
 	var signed bool
-	if len(companySignatures) > 0 {
-		companySignature = companySignatures[0]
-		log.WithFields(f).Debugf("found %d corporate signatures - using first one with signatureID: %s", len(companySignatures), companySignature.SignatureID)
+	if len(pendingSignatures) > 0 {
+		companySignature = pendingSignatures[0]
+		log.WithFields(f).Debugf("found %d pending corporate signatures - using first one with signatureID: %s", len(pendingSignatures), companySignature.SignatureID)
 		_, currentTime := utils.CurrentTime()
 		log.WithFields(f).Debugf("companySignature: %+v", companySignature)
 		majorVersion := 2
@@ -2626,12 +2106,31 @@ func (s *service) requestCorporateSignature(ctx context.Context, apiURL string,
 			SigtypeSignedApprovedID:       fmt.Sprintf("%s#%v#%v#%s", utils.SignatureTypeCCLA, signed, approved, signatureID),
 			SignatureReferenceNameLower:   strings.ToLower(comp.CompanyName),
 		}
+		if renewedSignature != nil {
+			log.WithFields(f).Debugf("renewing corporate signature: %s which expires on: %s", renewedSignature.SignatureID, renewedSignature.SignatureExpiresOn)
+			itemSignature.EmailApprovalList = renewedSignature.EmailApprovalList
+			itemSignature.EmailDomainApprovalList = renewedSignature.DomainApprovalList
+			itemSignature.GitHubUsernameApprovalList = renewedSignature.GithubUsernameApprovalList
+			itemSignature.GitHubOrgApprovalList = renewedSignature.GithubOrgApprovalList
+			itemSignature.GitlabUsernameApprovalList = renewedSignature.GitlabUsernameApprovalList
+			itemSignature.GitlabOrgApprovalList = renewedSignature.GitlabOrgApprovalList
+		}
 	}
 
 	if !input.SendAsEmail {
 		itemSignature.SignatureReturnURL = input.ReturnURL
 	}
 
+	// Track the request sent to the CLA signatory - used for the signatory reminders and the request expiry
+	if input.SendAsEmail {
+		_, requestedOn := utils.CurrentTime()
+		itemSignature.SignatureStatus = utils.SignatureStatusPending
+		itemSignature.SignatoryEmail = signatoryEmail
+		itemSignature.SignatureRequestedByName = claUser.Username
+		itemSignature.SignatureRequestedByEmail = currentUserEmail
+		itemSignature.SignatureRequestedOn = requestedOn
+	}
+
 	// 6. Set signature ACL
 	log.WithFields(f).Debugf("setting signature ACL...")
 	itemSignature.SignatureACL = []string{claUser.LfUsername}
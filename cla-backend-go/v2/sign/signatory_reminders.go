@@ -0,0 +1,281 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// SignatoryReminderSummary is the summary of a CCLA signatory reminder run
+type SignatoryReminderSummary struct {
+	Checked  int
+	Reminded int
+	Expired  int
+	Failed   int
+}
+
+// signatoryRequest holds the details used for the CCLA signatory reminder and expiry notifications
+type signatoryRequest struct {
+	signature   *signatures.ItemSignature
+	claGroup    *v1Models.ClaGroup
+	companyName string
+	requestedOn time.Time
+}
+
+// ProcessPendingCorporateSignatures sends the configured reminders to the CLA signatories of the pending CCLA
+// signature requests and voids the requests which have not been signed within the CLA group expiry period
+func (s *service) ProcessPendingCorporateSignatures(ctx context.Context) (*SignatoryReminderSummary, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.ProcessPendingCorporateSignatures",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	unsignedSignatures, err := s.signatureService.GetUnsignedSignaturesWithEnvelope(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query unsigned signatures with an envelope")
+		return nil, err
+	}
+
+	summary := &SignatoryReminderSummary{}
+	claGroups := make(map[string]*v1Models.ClaGroup)
+	now := time.Now().UTC()
+	for _, signature := range unsignedSignatures {
+		// Only requests emailed to a CLA signatory - multi-party CLAs signed by the CLA manager are also pending
+		if signature.SignatureType != utils.SignatureTypeCCLA || signature.SignatureStatus != utils.SignatureStatusPending || signature.SignatureRequestedOn == "" {
+			continue
+		}
+
+		requestedOn, parseErr := utils.ParseDateTime(signature.SignatureRequestedOn)
+		if parseErr != nil {
+			log.WithFields(f).WithError(parseErr).Warnf("unable to parse the request date of signature: %s - skipping", signature.SignatureID)
+			continue
+		}
+
+		claGroup, ok := claGroups[signature.SignatureProjectID]
+		if !ok {
+			claGroup, err = s.projectRepo.GetCLAGroupByID(ctx, signature.SignatureProjectID, DontLoadRepoDetails)
+			if err != nil {
+				log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", signature.SignatureProjectID)
+				summary.Failed++
+				continue
+			}
+			claGroups[signature.SignatureProjectID] = claGroup
+		}
+		if claGroup == nil || (claGroup.ProjectCCLASignatoryReminderDays <= 0 && claGroup.ProjectCCLASignatoryExpiryDays <= 0) {
+			continue
+		}
+
+		summary.Checked++
+		request := &signatoryRequest{
+			signature:   signature,
+			claGroup:    claGroup,
+			companyName: s.signatoryRequestCompanyName(ctx, signature),
+			requestedOn: requestedOn,
+		}
+
+		expiryDays := claGroup.ProjectCCLASignatoryExpiryDays
+		if expiryDays > 0 && now.Sub(requestedOn) >= days(expiryDays) {
+			if expireErr := s.expireSignatoryRequest(ctx, request); expireErr != nil {
+				log.WithFields(f).WithError(expireErr).Warnf("unable to expire signature: %s", signature.SignatureID)
+				summary.Failed++
+				continue
+			}
+			summary.Expired++
+			continue
+		}
+
+		reminderDays := claGroup.ProjectCCLASignatoryReminderDays
+		if reminderDays <= 0 {
+			continue
+		}
+		lastNotified := requestedOn
+		if lastReminderOn, lastErr := utils.ParseDateTime(signature.SignatureLastReminderOn); lastErr == nil {
+			lastNotified = lastReminderOn
+		}
+		if now.Sub(lastNotified) < days(reminderDays) {
+			continue
+		}
+
+		if remindErr := s.remindSignatory(ctx, request); remindErr != nil {
+			log.WithFields(f).WithError(remindErr).Warnf("unable to send reminder for signature: %s", signature.SignatureID)
+			summary.Failed++
+			continue
+		}
+		summary.Reminded++
+	}
+
+	log.WithFields(f).Infof("CCLA signatory reminders complete - checked: %d, reminded: %d, expired: %d, failed: %d",
+		summary.Checked, summary.Reminded, summary.Expired, summary.Failed)
+	return summary, nil
+}
+
+// remindSignatory emails a reminder to the CLA signatory, copying the CLA manager who requested the signature
+func (s *service) remindSignatory(ctx context.Context, request *signatoryRequest) error {
+	signature := request.signature
+	f := logrus.Fields{
+		"functionName":   "v2.sign.remindSignatory",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+		"signatoryEmail": signature.SignatoryEmail,
+	}
+
+	subject, body := signatoryReminderEmailContent(request)
+	log.WithFields(f).Debug("sending CCLA signatory reminder...")
+	if err := utils.SendEmail(subject, body, signatoryRequestRecipients(signature)); err != nil {
+		return err
+	}
+
+	reminderCount := signature.SignatureReminderCount + 1
+	_, currentTime := utils.CurrentTime()
+	err := s.signatureService.UpdateSignature(ctx, signature.SignatureID, map[string]interface{}{
+		"signature_reminder_count":   reminderCount,
+		"signature_last_reminder_on": currentTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   events.CCLASignatoryReminderSent,
+		LfUsername:  "easycla system",
+		UserID:      "easycla system",
+		CLAGroupID:  signature.SignatureProjectID,
+		ProjectID:   signature.SignatureProjectID,
+		ProjectName: request.claGroup.ProjectName,
+		CompanyID:   signature.SignatureReferenceID,
+		CompanyName: request.companyName,
+		EventData: &events.CCLASignatoryReminderSentEventData{
+			SignatureID:    signature.SignatureID,
+			SignatoryName:  signature.SignatoryName,
+			SignatoryEmail: signature.SignatoryEmail,
+			ReminderCount:  reminderCount,
+		},
+	})
+
+	return nil
+}
+
+// expireSignatoryRequest voids the envelope of the pending CCLA signature request, marks the signature as expired
+// and notifies the CLA signatory and the CLA manager - the CLA manager can then re-request the signature
+func (s *service) expireSignatoryRequest(ctx context.Context, request *signatoryRequest) error {
+	signature := request.signature
+	f := logrus.Fields{
+		"functionName":   "v2.sign.expireSignatoryRequest",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+		"envelopeID":     signature.SignatureEnvelopeID,
+	}
+
+	message := fmt.Sprintf("The corporate CLA signature request for %s expired after %d days.",
+		request.claGroup.ProjectName, request.claGroup.ProjectCCLASignatoryExpiryDays)
+	log.WithFields(f).Debug("voiding the expired CCLA signatory envelope...")
+	if err := s.VoidEnvelope(ctx, signature.SignatureEnvelopeID, message); err != nil {
+		return err
+	}
+
+	_, currentTime := utils.CurrentTime()
+	err := s.signatureService.UpdateSignature(ctx, signature.SignatureID, map[string]interface{}{
+		"signature_status": utils.SignatureStatusExpired,
+		"date_modified":    currentTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	subject, body := signatoryRequestExpiredEmailContent(request)
+	if emailErr := utils.SendEmail(subject, body, signatoryRequestRecipients(signature)); emailErr != nil {
+		// The request has expired regardless - the CLA manager will see the expired status in the corporate console
+		log.WithFields(f).WithError(emailErr).Warn("unable to send the CCLA signature request expired notification")
+	}
+
+	s.eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   events.CCLASignatoryRequestExpired,
+		LfUsername:  "easycla system",
+		UserID:      "easycla system",
+		CLAGroupID:  signature.SignatureProjectID,
+		ProjectID:   signature.SignatureProjectID,
+		ProjectName: request.claGroup.ProjectName,
+		CompanyID:   signature.SignatureReferenceID,
+		CompanyName: request.companyName,
+		EventData: &events.CCLASignatoryRequestExpiredEventData{
+			SignatureID:    signature.SignatureID,
+			SignatoryName:  signature.SignatoryName,
+			SignatoryEmail: signature.SignatoryEmail,
+			RequestedOn:    signature.SignatureRequestedOn,
+			ExpiryDays:     request.claGroup.ProjectCCLASignatoryExpiryDays,
+		},
+	})
+
+	return nil
+}
+
+// signatoryRequestCompanyName returns the company name for the corporate signature
+func (s *service) signatoryRequestCompanyName(ctx context.Context, signature *signatures.ItemSignature) string {
+	if signature.SignatureReferenceName != "" {
+		return signature.SignatureReferenceName
+	}
+	companyModel, err := s.companyRepo.GetCompany(ctx, signature.SignatureReferenceID)
+	if err != nil || companyModel == nil {
+		log.WithField("signatureID", signature.SignatureID).Debugf("unable to lookup company by ID: %s", signature.SignatureReferenceID)
+		return signature.SignatureReferenceID
+	}
+	return companyModel.CompanyName
+}
+
+// signatoryRequestRecipients returns the CLA signatory and the requesting CLA manager email addresses
+func signatoryRequestRecipients(signature *signatures.ItemSignature) []string {
+	var recipients []string
+	for _, email := range []string{signature.SignatoryEmail, signature.SignatureRequestedByEmail} {
+		if email != "" && !utils.StringInSlice(email, recipients) {
+			recipients = append(recipients, email)
+		}
+	}
+	return recipients
+}
+
+// signatoryReminderEmailContent returns the CCLA signatory reminder email subject and body
+func signatoryReminderEmailContent(request *signatoryRequest) (string, string) {
+	signature := request.signature
+	emailSubject := fmt.Sprintf("EasyCLA: Reminder - CLA Signature Request for %s", request.claGroup.ProjectName)
+	emailBody := fmt.Sprintf("<p>Hello %s,</p>", signature.SignatoryName)
+	emailBody += fmt.Sprintf("<p>This is a reminder from EasyCLA that %s requested on %s that you sign the Corporate Contributor License Agreement for the CLA Group %s on behalf of the organization %s. The signature request has not been completed yet.</p>",
+		signature.SignatureRequestedByName, request.requestedOn.Format("January 2, 2006"), request.claGroup.ProjectName, request.companyName)
+	emailBody += "<p>Please review and sign the document using the link in the DocuSign email you received with the original request.</p>"
+	if expiryDays := request.claGroup.ProjectCCLASignatoryExpiryDays; expiryDays > 0 {
+		emailBody += fmt.Sprintf("<p>The signature request will expire on %s.</p>",
+			request.requestedOn.Add(days(expiryDays)).Format("January 2, 2006"))
+	}
+	emailBody += fmt.Sprintf("<p>If you have questions, or if you are not an authorized signatory of this company, please contact the requester at %s.</p>", signature.SignatureRequestedByEmail)
+	emailBody += utils.GetEmailHelpContent(true)
+	emailBody += utils.GetEmailSignOffContent()
+	return emailSubject, emailBody
+}
+
+// signatoryRequestExpiredEmailContent returns the CCLA signature request expired email subject and body
+func signatoryRequestExpiredEmailContent(request *signatoryRequest) (string, string) {
+	signature := request.signature
+	emailSubject := fmt.Sprintf("EasyCLA: CLA Signature Request Expired for %s", request.claGroup.ProjectName)
+	emailBody := fmt.Sprintf("<p>Hello %s,</p>", signature.SignatoryName)
+	emailBody += fmt.Sprintf("<p>The request sent on %s to sign the Corporate Contributor License Agreement for the CLA Group %s on behalf of the organization %s was not signed within %d days and has expired.</p>",
+		request.requestedOn.Format("January 2, 2006"), request.claGroup.ProjectName, request.companyName, request.claGroup.ProjectCCLASignatoryExpiryDays)
+	emailBody += fmt.Sprintf("<p>%s can send a new signature request from the <a href=\"%s\" target=\"_blank\">EasyCLA Corporate Console</a>.</p>",
+		signature.SignatureRequestedByName, utils.GetCorporateURL(true))
+	emailBody += utils.GetEmailHelpContent(true)
+	emailBody += utils.GetEmailSignOffContent()
+	return emailSubject, emailBody
+}
+
+// days returns the duration of the number of days
+func days(count int64) time.Duration {
+	return time.Duration(count) * 24 * time.Hour
+}
@@ -0,0 +1,230 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_company "github.com/communitybridge/easycla/cla-backend-go/company/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	mock_events "github.com/communitybridge/easycla/cla-backend-go/events/mock"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	mock_project "github.com/communitybridge/easycla/cla-backend-go/project/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// voidingSignatureProvider records the voided envelopes, the voids fail with voidErr
+type voidingSignatureProvider struct {
+	fakeSignatureProvider
+	voided  []string
+	voidErr error
+}
+
+func (p *voidingSignatureProvider) VoidEnvelope(ctx context.Context, envelopeID, message string) error {
+	if p.voidErr != nil {
+		return p.voidErr
+	}
+	p.voided = append(p.voided, envelopeID)
+	return nil
+}
+
+// recipientsEmailSender records the recipients of the emails sent
+type recipientsEmailSender struct {
+	subjects   []string
+	recipients [][]string
+}
+
+func (e *recipientsEmailSender) SendEmail(subject string, body string, recipients []string) error {
+	e.subjects = append(e.subjects, subject)
+	e.recipients = append(e.recipients, recipients)
+	return nil
+}
+
+func TestProcessPendingCorporateSignatures(t *testing.T) {
+	now := time.Now().UTC()
+	daysAgo := func(count int) string {
+		return utils.TimeToString(now.Add(-time.Duration(count) * 24 * time.Hour))
+	}
+	pendingRequest := func(requestedOn, lastReminderOn string) *signatures.ItemSignature {
+		return &signatures.ItemSignature{
+			SignatureID:               "signature-1",
+			SignatureType:             utils.SignatureTypeCCLA,
+			SignatureStatus:           utils.SignatureStatusPending,
+			SignatureProjectID:        "cla-group-1",
+			SignatureReferenceID:      "company-1",
+			SignatureEnvelopeID:       "envelope-1",
+			SignatoryName:             "Jane Signatory",
+			SignatoryEmail:            "jane@example.org",
+			SignatureRequestedByName:  "John Manager",
+			SignatureRequestedByEmail: "john@example.org",
+			SignatureRequestedOn:      requestedOn,
+			SignatureLastReminderOn:   lastReminderOn,
+			SignatureReminderCount:    1,
+		}
+	}
+	claGroup := &v1Models.ClaGroup{ProjectID: "cla-group-1", ProjectName: "Project", ProjectCCLASignatoryReminderDays: 3, ProjectCCLASignatoryExpiryDays: 30}
+
+	testCases := []struct {
+		Name              string
+		Signature         *signatures.ItemSignature
+		ClaGroup          *v1Models.ClaGroup
+		VoidErr           error
+		ExpectedSummary   SignatoryReminderSummary
+		ExpectedUpdates   map[string]interface{}
+		ExpectedEvent     string
+		ExpectedSubject   string
+		ExpectedVoided    []string
+		ExpectedCompanyID bool
+	}{
+		{
+			Name:              "reminder is sent to the signatory and the requester once the reminder period passed",
+			Signature:         pendingRequest(daysAgo(4), ""),
+			ClaGroup:          claGroup,
+			ExpectedSummary:   SignatoryReminderSummary{Checked: 1, Reminded: 1},
+			ExpectedUpdates:   map[string]interface{}{"signature_reminder_count": 2},
+			ExpectedEvent:     events.CCLASignatoryReminderSent,
+			ExpectedSubject:   "EasyCLA: Reminder - CLA Signature Request for Project",
+			ExpectedCompanyID: true,
+		},
+		{
+			Name:              "no reminder before the reminder period passed",
+			Signature:         pendingRequest(daysAgo(2), ""),
+			ClaGroup:          claGroup,
+			ExpectedSummary:   SignatoryReminderSummary{Checked: 1},
+			ExpectedCompanyID: true,
+		},
+		{
+			Name:              "no reminder before the reminder period passed since the last reminder",
+			Signature:         pendingRequest(daysAgo(10), daysAgo(1)),
+			ClaGroup:          claGroup,
+			ExpectedSummary:   SignatoryReminderSummary{Checked: 1},
+			ExpectedCompanyID: true,
+		},
+		{
+			Name:              "request is voided and expired once the expiry period passed",
+			Signature:         pendingRequest(daysAgo(31), daysAgo(1)),
+			ClaGroup:          claGroup,
+			ExpectedSummary:   SignatoryReminderSummary{Checked: 1, Expired: 1},
+			ExpectedUpdates:   map[string]interface{}{"signature_status": utils.SignatureStatusExpired},
+			ExpectedEvent:     events.CCLASignatoryRequestExpired,
+			ExpectedSubject:   "EasyCLA: CLA Signature Request Expired for Project",
+			ExpectedVoided:    []string{"envelope-1"},
+			ExpectedCompanyID: true,
+		},
+		{
+			Name:              "request which could not be voided is not expired",
+			Signature:         pendingRequest(daysAgo(31), ""),
+			ClaGroup:          claGroup,
+			VoidErr:           errors.New("docusign unavailable"),
+			ExpectedSummary:   SignatoryReminderSummary{Checked: 1, Failed: 1},
+			ExpectedCompanyID: true,
+		},
+		{
+			Name:            "request of a CLA group without reminders or expiry is not checked",
+			Signature:       pendingRequest(daysAgo(31), ""),
+			ClaGroup:        &v1Models.ClaGroup{ProjectID: "cla-group-1", ProjectName: "Project"},
+			ExpectedSummary: SignatoryReminderSummary{},
+		},
+		{
+			Name: "signed request is not checked",
+			Signature: func() *signatures.ItemSignature {
+				signature := pendingRequest(daysAgo(31), "")
+				signature.SignatureStatus = utils.SignatureStatusSigned
+				return signature
+			}(),
+			ExpectedSummary: SignatoryReminderSummary{},
+		},
+		{
+			Name: "individual signature is not checked",
+			Signature: func() *signatures.ItemSignature {
+				signature := pendingRequest(daysAgo(31), "")
+				signature.SignatureType = utils.SignatureTypeCLA
+				return signature
+			}(),
+			ExpectedSummary: SignatoryReminderSummary{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			emailSender := &recipientsEmailSender{}
+			previousEmailSender := utils.GetEmailSender()
+			utils.SetEmailSender(emailSender)
+			tt.Cleanup(func() { utils.SetEmailSender(previousEmailSender) })
+
+			var updates map[string]interface{}
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			signatureService.EXPECT().GetUnsignedSignaturesWithEnvelope(gomock.Any()).Return([]*signatures.ItemSignature{tc.Signature}, nil)
+			signatureService.EXPECT().UpdateSignature(gomock.Any(), "signature-1", gomock.Any()).DoAndReturn(func(ctx context.Context, signatureID string, signatureUpdates map[string]interface{}) error {
+				updates = signatureUpdates
+				return nil
+			}).AnyTimes()
+
+			projectRepo := mock_project.NewMockProjectRepository(ctrl)
+			if tc.ClaGroup != nil {
+				projectRepo.EXPECT().GetCLAGroupByID(gomock.Any(), "cla-group-1", DontLoadRepoDetails).Return(tc.ClaGroup, nil)
+			}
+
+			companyRepo := mock_company.NewMockIRepository(ctrl)
+			if tc.ExpectedCompanyID {
+				companyRepo.EXPECT().GetCompany(gomock.Any(), "company-1").Return(&v1Models.Company{CompanyID: "company-1", CompanyName: "Acme"}, nil)
+			}
+
+			var loggedEvents []*events.LogEventArgs
+			eventsService := mock_events.NewMockService(ctrl)
+			eventsService.EXPECT().LogEventWithContext(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, args *events.LogEventArgs) {
+				loggedEvents = append(loggedEvents, args)
+			}).AnyTimes()
+
+			docusign := &voidingSignatureProvider{voidErr: tc.VoidErr}
+			s := &service{
+				docusign:         docusign,
+				signatureService: signatureService,
+				projectRepo:      projectRepo,
+				companyRepo:      companyRepo,
+				eventsService:    eventsService,
+			}
+
+			summary, err := s.ProcessPendingCorporateSignatures(ctx)
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.ExpectedSummary, *summary)
+			assert.Equal(tt, tc.ExpectedVoided, docusign.voided)
+
+			if tc.ExpectedUpdates == nil {
+				assert.Nil(tt, updates)
+				assert.Empty(tt, loggedEvents)
+				assert.Empty(tt, emailSender.subjects)
+				return
+			}
+
+			for key, value := range tc.ExpectedUpdates {
+				assert.Equal(tt, value, updates[key])
+			}
+			assert.Equal(tt, []string{tc.ExpectedSubject}, emailSender.subjects)
+			assert.Equal(tt, [][]string{{"jane@example.org", "john@example.org"}}, emailSender.recipients)
+			assert.Len(tt, loggedEvents, 1)
+			assert.Equal(tt, tc.ExpectedEvent, loggedEvents[0].EventType)
+			assert.Equal(tt, "Acme", loggedEvents[0].CompanyName)
+			assert.Equal(tt, "cla-group-1", loggedEvents[0].CLAGroupID)
+		})
+	}
+}
+
+func TestSignatoryRequestRecipients(t *testing.T) {
+	assert.Equal(t, []string{"jane@example.org", "john@example.org"}, signatoryRequestRecipients(&signatures.ItemSignature{SignatoryEmail: "jane@example.org", SignatureRequestedByEmail: "john@example.org"}))
+	// the CLA manager who requested their own signature gets a single email
+	assert.Equal(t, []string{"jane@example.org"}, signatoryRequestRecipients(&signatures.ItemSignature{SignatoryEmail: "jane@example.org", SignatureRequestedByEmail: "jane@example.org"}))
+	assert.Nil(t, signatoryRequestRecipients(&signatures.ItemSignature{}))
+}
@@ -0,0 +1,249 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/communitybridge/easycla/cla-backend-go/events"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/signatures"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// SignatureExpirySummary is the summary of a signature expiry reminder run
+type SignatureExpirySummary struct {
+	Checked  int
+	Notified int
+	Renewed  int
+	Failed   int
+}
+
+// expiringSignature holds the details used for the signature expiry notifications
+type expiringSignature struct {
+	signature   *signatures.ItemSignature
+	claGroup    *v1Models.ClaGroup
+	companyName string
+	expiresOn   time.Time
+}
+
+// ProcessExpiringSignatures asks the signers and the CLA managers to renew the signatures which expire within the CLA
+// group notice period - each signature is notified once
+func (s *service) ProcessExpiringSignatures(ctx context.Context) (*SignatureExpirySummary, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.ProcessExpiringSignatures",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	expiringSignatures, err := s.signatureService.GetSignaturesWithExpiry(ctx)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to query signatures with an expiry date")
+		return nil, err
+	}
+
+	summary := &SignatureExpirySummary{}
+	claGroups := make(map[string]*v1Models.ClaGroup)
+	now := time.Now().UTC()
+	for _, signature := range expiringSignatures {
+		// Skip the signatures already notified and the employee acknowledgements covered by the corporate signature
+		if signature.SignatureExpiryNotifiedOn != "" || signature.SignatureUserCompanyID != "" {
+			continue
+		}
+
+		expiresOn, parseErr := utils.ParseDateTime(signature.SignatureExpiresOn)
+		if parseErr != nil {
+			log.WithFields(f).WithError(parseErr).Warnf("unable to parse the expiry date of signature: %s - skipping", signature.SignatureID)
+			continue
+		}
+
+		claGroup, ok := claGroups[signature.SignatureProjectID]
+		if !ok {
+			claGroup, err = s.projectRepo.GetCLAGroupByID(ctx, signature.SignatureProjectID, DontLoadRepoDetails)
+			if err != nil {
+				log.WithFields(f).WithError(err).Warnf("unable to lookup CLA Group by ID: %s", signature.SignatureProjectID)
+				summary.Failed++
+				continue
+			}
+			claGroups[signature.SignatureProjectID] = claGroup
+		}
+		if claGroup == nil || claGroup.ProjectSignatureExpiryNoticeDays <= 0 {
+			continue
+		}
+		if expiresOn.Sub(now) > days(claGroup.ProjectSignatureExpiryNoticeDays) {
+			continue
+		}
+
+		summary.Checked++
+		if s.isSignatureRenewed(ctx, signature) {
+			summary.Renewed++
+			continue
+		}
+
+		request := &expiringSignature{
+			signature: signature,
+			claGroup:  claGroup,
+			expiresOn: expiresOn,
+		}
+		if signature.SignatureType == utils.SignatureTypeCCLA {
+			request.companyName = s.signatoryRequestCompanyName(ctx, signature)
+		}
+
+		if notifyErr := s.notifyExpiringSignature(ctx, request); notifyErr != nil {
+			log.WithFields(f).WithError(notifyErr).Warnf("unable to send the expiry reminder for signature: %s", signature.SignatureID)
+			summary.Failed++
+			continue
+		}
+		summary.Notified++
+	}
+
+	log.WithFields(f).Infof("signature expiry reminders complete - checked: %d, notified: %d, renewed: %d, failed: %d",
+		summary.Checked, summary.Notified, summary.Renewed, summary.Failed)
+	return summary, nil
+}
+
+// isSignatureRenewed returns true when a more recent signature replaced the expiring signature
+func (s *service) isSignatureRenewed(ctx context.Context, signature *signatures.ItemSignature) bool {
+	approved, signed := true, true
+	var latest *v1Models.Signature
+	var err error
+	if signature.SignatureType == utils.SignatureTypeCCLA {
+		latest, err = s.signatureService.GetCorporateSignature(ctx, signature.SignatureProjectID, signature.SignatureReferenceID, &approved, &signed)
+	} else {
+		latest, err = s.signatureService.GetIndividualSignature(ctx, signature.SignatureProjectID, signature.SignatureReferenceID, &approved, &signed)
+	}
+	if err != nil || latest == nil {
+		return false
+	}
+
+	return latest.SignatureID != signature.SignatureID
+}
+
+// notifyExpiringSignature emails the renewal reminder to the signer - and the CLA managers of a corporate signature -
+// and records the notification on the signature
+func (s *service) notifyExpiringSignature(ctx context.Context, request *expiringSignature) error {
+	signature := request.signature
+	f := logrus.Fields{
+		"functionName":   "v2.sign.notifyExpiringSignature",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+		"expiresOn":      signature.SignatureExpiresOn,
+	}
+
+	recipients := s.expiringSignatureRecipients(ctx, signature)
+	if len(recipients) == 0 {
+		return fmt.Errorf("no email recipients found for signature: %s", signature.SignatureID)
+	}
+
+	subject, body := s.signatureExpiryEmailContent(request)
+	log.WithFields(f).Debugf("sending signature expiry reminder to %d recipients...", len(recipients))
+	if err := utils.SendEmail(subject, body, recipients); err != nil {
+		return err
+	}
+
+	_, currentTime := utils.CurrentTime()
+	err := s.signatureService.UpdateSignature(ctx, signature.SignatureID, map[string]interface{}{
+		"signature_expiry_notified_on": currentTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	claType := utils.ClaTypeICLA
+	if signature.SignatureType == utils.SignatureTypeCCLA {
+		claType = utils.ClaTypeCCLA
+	}
+	s.eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+		EventType:   events.SignatureExpiryReminderSent,
+		LfUsername:  "easycla system",
+		UserID:      "easycla system",
+		CLAGroupID:  signature.SignatureProjectID,
+		ProjectID:   signature.SignatureProjectID,
+		ProjectName: request.claGroup.ProjectName,
+		CompanyName: request.companyName,
+		EventData: &events.SignatureExpiryReminderSentEventData{
+			SignatureID: signature.SignatureID,
+			ClaType:     claType,
+			ExpiresOn:   signature.SignatureExpiresOn,
+			Recipients:  recipients,
+		},
+	})
+
+	return nil
+}
+
+// expiringSignatureRecipients returns the signer email address - the individual contributor for an ICLA, the CLA
+// signatory and the CLA managers for a CCLA
+func (s *service) expiringSignatureRecipients(ctx context.Context, signature *signatures.ItemSignature) []string {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.expiringSignatureRecipients",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signature.SignatureID,
+	}
+
+	var recipients []string
+	addRecipient := func(email string) {
+		if email != "" && !utils.StringInSlice(email, recipients) {
+			recipients = append(recipients, email)
+		}
+	}
+
+	if signature.SignatureType != utils.SignatureTypeCCLA {
+		if signature.UserEmail != "" {
+			addRecipient(signature.UserEmail)
+			return recipients
+		}
+		userModel, err := s.userService.GetUser(signature.SignatureReferenceID)
+		if err != nil || userModel == nil {
+			log.WithFields(f).WithError(err).Warnf("unable to lookup user by ID: %s", signature.SignatureReferenceID)
+			return recipients
+		}
+		addRecipient(getUserEmail(userModel, ""))
+		return recipients
+	}
+
+	addRecipient(signature.SignatoryEmail)
+	for _, lfUsername := range signature.SignatureACL {
+		claManager, err := s.userService.GetUserByLFUserName(lfUsername)
+		if err != nil || claManager == nil {
+			log.WithFields(f).WithError(err).Debugf("unable to lookup CLA manager by LF username: %s", lfUsername)
+			continue
+		}
+		addRecipient(getUserEmail(claManager, ""))
+	}
+	return recipients
+}
+
+// signatureExpiryEmailContent returns the signature expiry reminder email subject and body
+func (s *service) signatureExpiryEmailContent(request *expiringSignature) (string, string) {
+	signature := request.signature
+	projectName := request.claGroup.ProjectName
+	expiresOn := request.expiresOn.Format("January 2, 2006")
+
+	if signature.SignatureType == utils.SignatureTypeCCLA {
+		emailSubject := fmt.Sprintf("EasyCLA: Corporate CLA for %s Expires on %s", projectName, expiresOn)
+		emailBody := "<p>Hello,</p>"
+		emailBody += fmt.Sprintf("<p>This is a notification email from EasyCLA regarding the CLA Group %s.</p>", projectName)
+		emailBody += fmt.Sprintf("<p>The Corporate Contributor License Agreement signed on behalf of the organization %s expires on %s. After this date the employees on the approval lists will no longer be authorized to contribute.</p>",
+			request.companyName, expiresOn)
+		emailBody += fmt.Sprintf("<p>A CLA manager can renew the Corporate CLA by signing it again - or by sending it to the CLA signatory - from the <a href=\"%s\" target=\"_blank\">EasyCLA Corporate Console</a>. The approval lists are carried over to the renewed CLA.</p>",
+			utils.GetCorporateURL(true))
+		emailBody += utils.GetEmailHelpContent(true)
+		emailBody += utils.GetEmailSignOffContent()
+		return emailSubject, emailBody
+	}
+
+	emailSubject := fmt.Sprintf("EasyCLA: Individual CLA for %s Expires on %s", projectName, expiresOn)
+	emailBody := fmt.Sprintf("<p>Hello %s,</p>", signature.SignatureReferenceName)
+	emailBody += fmt.Sprintf("<p>This is a notification email from EasyCLA regarding the CLA Group %s.</p>", projectName)
+	emailBody += fmt.Sprintf("<p>Your Individual Contributor License Agreement expires on %s. After this date your contributions will no longer be authorized until you renew it.</p>", expiresOn)
+	emailBody += fmt.Sprintf("<p>You can renew the CLA by following the EasyCLA link on your next pull request or merge request, or from the <a href=\"%s\" target=\"_blank\">EasyCLA</a> site.</p>",
+		s.claLandingPage)
+	emailBody += utils.GetEmailHelpContent(true)
+	emailBody += utils.GetEmailSignOffContent()
+	return emailSubject, emailBody
+}
@@ -0,0 +1,112 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// signature provider names - configured per CLA Group
+const (
+	SignatureProviderDocuSign     = "docusign"
+	SignatureProviderClickThrough = "click-through"
+)
+
+// SignatureProvider defines the e-signature backend operations used by the sign service. The envelope request
+// and response models are the DocuSign models - other providers map these to their own representation.
+type SignatureProvider interface {
+	Name() string
+	PrepareSignRequest(ctx context.Context, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error)
+	CreateEnvelope(ctx context.Context, payload *DocuSignEnvelopeRequest) (string, error)
+	AddDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error
+	GetEnvelopeRecipients(ctx context.Context, envelopeID string) ([]Signer, error)
+	GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error)
+	GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error)
+	GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error)
+	VoidEnvelope(ctx context.Context, envelopeID, message string) error
+}
+
+// signatureProviderForCLAGroup returns the signature provider configured for the CLA Group, defaults to DocuSign
+func (s *service) signatureProviderForCLAGroup(ctx context.Context, claGroup *v1Models.ClaGroup) (SignatureProvider, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.signatureProviderForCLAGroup",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+	}
+
+	if claGroup == nil {
+		return s.docusign, nil
+	}
+
+	switch strings.ToLower(claGroup.ProjectSignatureProvider) {
+	case "", SignatureProviderDocuSign:
+		return s.docusign, nil
+	case SignatureProviderClickThrough:
+		return s.clickThrough, nil
+	default:
+		msg := fmt.Sprintf("unsupported signature provider: %s configured for CLA Group: %s", claGroup.ProjectSignatureProvider, claGroup.ProjectID)
+		log.WithFields(f).Warn(msg)
+		return nil, errors.New(msg)
+	}
+}
+
+// signatureProviderForEnvelope returns the signature provider which owns the envelope
+func (s *service) signatureProviderForEnvelope(envelopeID string) SignatureProvider {
+	if isClickThroughEnvelope(envelopeID) {
+		return s.clickThrough
+	}
+	return s.docusign
+}
+
+// VoidEnvelope voids the envelope using the provider which owns the envelope
+func (s *service) VoidEnvelope(ctx context.Context, envelopeID, message string) error {
+	return s.signatureProviderForEnvelope(envelopeID).VoidEnvelope(ctx, envelopeID, message)
+}
+
+// PrepareSignRequest creates a new envelope using the provider configured for the CLA Group
+func (s *service) PrepareSignRequest(ctx context.Context, claGroup *v1Models.ClaGroup, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error) {
+	provider, err := s.signatureProviderForCLAGroup(ctx, claGroup)
+	if err != nil {
+		return nil, err
+	}
+	return provider.PrepareSignRequest(ctx, signRequest)
+}
+
+// GetSignURL returns the signing URL using the provider which owns the envelope
+func (s *service) GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error) {
+	return s.signatureProviderForEnvelope(envelopeID).GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL)
+}
+
+func (s *service) createEnvelope(ctx context.Context, claGroup *v1Models.ClaGroup, payload *DocuSignEnvelopeRequest) (string, error) {
+	provider, err := s.signatureProviderForCLAGroup(ctx, claGroup)
+	if err != nil {
+		return "", err
+	}
+	return provider.CreateEnvelope(ctx, payload)
+}
+
+func (s *service) addDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error {
+	return s.signatureProviderForEnvelope(envelopeID).AddDocumentToEnvelope(ctx, envelopeID, documentName, document)
+}
+
+func (s *service) getEnvelopeRecipients(ctx context.Context, envelopeID string) ([]Signer, error) {
+	return s.signatureProviderForEnvelope(envelopeID).GetEnvelopeRecipients(ctx, envelopeID)
+}
+
+// GetSignedDocument returns the signed document using the provider which owns the envelope
+func (s *service) GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error) {
+	return s.signatureProviderForEnvelope(envelopeID).GetSignedDocument(ctx, envelopeID, documentID)
+}
+
+// GetEnvelopeDocuments returns the envelope documents using the provider which owns the envelope
+func (s *service) GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error) {
+	return s.signatureProviderForEnvelope(envelopeID).GetEnvelopeDocuments(ctx, envelopeID)
+}
@@ -0,0 +1,121 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSignatureProvider records the envelopes created with the provider
+type fakeSignatureProvider struct {
+	envelopes int
+}
+
+func (p *fakeSignatureProvider) Name() string {
+	return SignatureProviderDocuSign
+}
+
+func (p *fakeSignatureProvider) PrepareSignRequest(ctx context.Context, signRequest *DocuSignEnvelopeRequest) (*DocusignEnvelopeResponse, error) {
+	p.envelopes++
+	return &DocusignEnvelopeResponse{EnvelopeId: "docusign-envelope"}, nil
+}
+
+func (p *fakeSignatureProvider) CreateEnvelope(ctx context.Context, payload *DocuSignEnvelopeRequest) (string, error) {
+	p.envelopes++
+	return "docusign-envelope", nil
+}
+
+func (p *fakeSignatureProvider) AddDocumentToEnvelope(ctx context.Context, envelopeID, documentName string, document []byte) error {
+	return nil
+}
+
+func (p *fakeSignatureProvider) GetEnvelopeRecipients(ctx context.Context, envelopeID string) ([]Signer, error) {
+	return nil, nil
+}
+
+func (p *fakeSignatureProvider) GetSignURL(email, recipientID, userName, clientUserId, envelopeID, returnURL string) (string, error) {
+	return "", nil
+}
+
+func (p *fakeSignatureProvider) GetSignedDocument(ctx context.Context, envelopeID, documentID string) ([]byte, error) {
+	return nil, nil
+}
+
+func (p *fakeSignatureProvider) GetEnvelopeDocuments(ctx context.Context, envelopeID string) ([]DocuSignDocument, error) {
+	return nil, nil
+}
+
+func (p *fakeSignatureProvider) VoidEnvelope(ctx context.Context, envelopeID, message string) error {
+	return errors.New("not a click-through envelope")
+}
+
+func TestSignatureProviderForCLAGroup(t *testing.T) {
+	docusign := &fakeSignatureProvider{}
+	clickThrough := newClickThroughProvider("https://api.test", newFakeStore())
+	s := &service{docusign: docusign, clickThrough: clickThrough}
+
+	testCases := []struct {
+		Name             string
+		ClaGroup         *v1Models.ClaGroup
+		ExpectedProvider SignatureProvider
+		ExpectedError    bool
+	}{
+		{Name: "no CLA group", ExpectedProvider: docusign},
+		{Name: "not configured", ClaGroup: &v1Models.ClaGroup{}, ExpectedProvider: docusign},
+		{Name: "docusign", ClaGroup: &v1Models.ClaGroup{ProjectSignatureProvider: "DocuSign"}, ExpectedProvider: docusign},
+		{Name: "click-through", ClaGroup: &v1Models.ClaGroup{ProjectSignatureProvider: "click-through"}, ExpectedProvider: clickThrough},
+		{Name: "click-through mixed case", ClaGroup: &v1Models.ClaGroup{ProjectSignatureProvider: "Click-Through"}, ExpectedProvider: clickThrough},
+		{Name: "unsupported", ClaGroup: &v1Models.ClaGroup{ProjectSignatureProvider: "adobe-sign"}, ExpectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(tt *testing.T) {
+			provider, err := s.signatureProviderForCLAGroup(context.Background(), tc.ClaGroup)
+			if tc.ExpectedError {
+				assert.Error(tt, err)
+				return
+			}
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.ExpectedProvider, provider)
+		})
+	}
+}
+
+func TestPrepareSignRequest_SignatureProviderOfCLAGroup(t *testing.T) {
+	ctx := context.Background()
+	docusign := &fakeSignatureProvider{}
+	clickThrough, _, _, callbackURL := newTestClickThroughProvider(t)
+	s := &service{docusign: docusign, clickThrough: clickThrough}
+	signer := DocuSignRecipient{RecipientId: "1", ClientUserId: "user-1", Name: "John Doe", Email: "john@example.com"}
+
+	// the click-through CLA groups never get a DocuSign envelope
+	clickThroughGroup := &v1Models.ClaGroup{ProjectSignatureProvider: SignatureProviderClickThrough}
+	response, err := s.PrepareSignRequest(ctx, clickThroughGroup, clickThroughSignRequest(callbackURL, signer))
+	assert.NoError(t, err)
+	assert.True(t, isClickThroughEnvelope(response.EnvelopeId))
+	envelopeID, err := s.createEnvelope(ctx, clickThroughGroup, clickThroughSignRequest(callbackURL, signer))
+	assert.NoError(t, err)
+	assert.True(t, isClickThroughEnvelope(envelopeID))
+	assert.Equal(t, 0, docusign.envelopes)
+
+	// the envelope operations are routed to the provider which owns the envelope
+	assert.NoError(t, s.VoidEnvelope(ctx, envelopeID, "voided"))
+	assert.Error(t, s.VoidEnvelope(ctx, "docusign-envelope", "voided"))
+
+	docusignGroup := &v1Models.ClaGroup{}
+	response, err = s.PrepareSignRequest(ctx, docusignGroup, clickThroughSignRequest(callbackURL, signer))
+	assert.NoError(t, err)
+	assert.Equal(t, "docusign-envelope", response.EnvelopeId)
+	_, err = s.createEnvelope(ctx, docusignGroup, clickThroughSignRequest(callbackURL, signer))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, docusign.envelopes)
+
+	_, err = s.PrepareSignRequest(ctx, &v1Models.ClaGroup{ProjectSignatureProvider: "adobe-sign"}, clickThroughSignRequest(callbackURL, signer))
+	assert.Error(t, err)
+}
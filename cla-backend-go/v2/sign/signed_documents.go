@@ -0,0 +1,39 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+
+	log "github.com/communitybridge/easycla/cla-backend-go/logging"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// storeSignedDocument uploads the signed document to S3 and records its SHA-256 digest, size and retrieval time on the
+// signature - the digest is used to verify that the stored document has not been altered since signing
+func (s *service) storeSignedDocument(ctx context.Context, signedDocument []byte, projectID, claType, identifier, signatureID string) error {
+	f := logrus.Fields{
+		"functionName":   "v2.sign.storeSignedDocument",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"projectID":      projectID,
+		"claType":        claType,
+		"signatureID":    signatureID,
+	}
+
+	_, storedOn := utils.CurrentTime()
+	digest := utils.SignedDocumentDigest(signedDocument)
+
+	err := utils.UploadToS3(signedDocument, projectID, claType, identifier, signatureID)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(f).Debugf("recording signed document digest: %s, size: %d", digest, len(signedDocument))
+	return s.signatureService.UpdateSignature(ctx, signatureID, map[string]interface{}{
+		"signature_document_sha256":    digest,
+		"signature_document_size":      int64(len(signedDocument)),
+		"signature_document_stored_on": storedOn,
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package sign
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreSignedDocument(t *testing.T) {
+	signedDocument := []byte("%PDF-1.4 signed individual CLA")
+
+	testCases := []struct {
+		Name             string
+		UploadErr        error
+		UpdateErr        error
+		ExpectedErr      error
+		ExpectedRecorded bool
+	}{
+		{
+			Name:             "digest is recorded once the document is stored",
+			ExpectedRecorded: true,
+		},
+		{
+			Name:        "no digest is recorded for a document which failed to upload",
+			UploadErr:   errors.New("s3 unavailable"),
+			ExpectedErr: errors.New("s3 unavailable"),
+		},
+		{
+			Name:             "failure to record the digest is returned",
+			UpdateErr:        errors.New("dynamodb unavailable"),
+			ExpectedErr:      errors.New("dynamodb unavailable"),
+			ExpectedRecorded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := &fakeS3Storage{uploadErr: tc.UploadErr}
+			utils.SetS3StorageClient(storage)
+
+			signatureService := mock_signatures.NewMockSignatureService(ctrl)
+			var updates map[string]interface{}
+			if tc.ExpectedRecorded {
+				signatureService.EXPECT().UpdateSignature(gomock.Any(), "signature-1", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, signatureID string, values map[string]interface{}) error {
+						// the digest is only recorded for a stored document
+						assert.Equal(t, 1, storage.uploads)
+						updates = values
+						return tc.UpdateErr
+					})
+			}
+
+			s := &service{signatureService: signatureService}
+			err := s.storeSignedDocument(context.Background(), signedDocument, "cla-group-1", utils.ClaTypeICLA, "user-1", "signature-1")
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tc.ExpectedRecorded {
+				assert.Equal(t, utils.SignedDocumentDigest(signedDocument), updates["signature_document_sha256"])
+				assert.Len(t, updates["signature_document_sha256"], 64)
+				assert.Equal(t, int64(len(signedDocument)), updates["signature_document_size"])
+				assert.NotEmpty(t, updates["signature_document_stored_on"])
+			}
+		})
+	}
+}
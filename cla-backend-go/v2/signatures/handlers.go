@@ -1008,6 +1008,64 @@ func Configure(api *operations.EasyclaAPI, claGroupService service.Service, proj
 		return signatures.NewGetSignatureSignedDocumentOK().WithXRequestID(reqID).WithPayload(doc)
 	})
 
+	api.SignaturesVerifySignedDocumentHandler = signatures.VerifySignedDocumentHandlerFunc(func(params signatures.VerifySignedDocumentParams, authUser *auth.User) middleware.Responder {
+		reqID := utils.GetRequestID(params.XREQUESTID)
+		ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID) // nolint
+		utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+		f := logrus.Fields{
+			"functionName":   "v2.signatures.handlers.SignaturesVerifySignedDocumentHandler",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			"signatureID":    params.SignatureID,
+		}
+
+		log.WithFields(f).Debug("loading signature by ID...")
+		signatureModel, err := v1SignatureService.GetSignature(ctx, params.SignatureID)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("problem loading signature")
+			return signatures.NewVerifySignedDocumentBadRequest().WithXRequestID(reqID).WithPayload(errorResponse(reqID, err))
+		}
+		if signatureModel == nil {
+			log.WithFields(f).Warn("problem loading signature - signature not found")
+			return signatures.NewVerifySignedDocumentNotFound().WithXRequestID(reqID).WithPayload(errorResponse(reqID, errors.New("signature not found")))
+		}
+
+		haveAccess, err := isUserHaveAccessOfSignedSignaturePDF(ctx, authUser, signatureModel, companyService, projectClaGroupsRepo, projectRepo)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("problem determining signature access")
+			return signatures.NewVerifySignedDocumentBadRequest().WithXRequestID(reqID).WithPayload(errorResponse(reqID, err))
+		}
+
+		if !haveAccess {
+			return signatures.NewVerifySignedDocumentForbidden().WithXRequestID(reqID).WithPayload(
+				utils.ErrorResponseForbidden(reqID, fmt.Sprintf("user %s does not have access to the specified signature", authUser.UserName)))
+		}
+
+		result, err := v2SignatureService.VerifySignedDocument(ctx, signatureModel.SignatureID)
+		if err != nil {
+			log.WithFields(f).WithError(err).Warn("problem verifying signed document")
+			if strings.Contains(err.Error(), "bad request") {
+				return signatures.NewVerifySignedDocumentBadRequest().WithXRequestID(reqID).WithPayload(errorResponse(reqID, err))
+			}
+			return signatures.NewVerifySignedDocumentInternalServerError().WithXRequestID(reqID).WithPayload(errorResponse(reqID, err))
+		}
+
+		eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+			EventType:  events.SignedDocumentVerified,
+			CLAGroupID: signatureModel.ProjectID,
+			ProjectID:  signatureModel.ProjectID,
+			LfUsername: authUser.UserName,
+			UserName:   authUser.UserName,
+			EventData: &events.SignedDocumentVerifiedEventData{
+				SignatureID: signatureModel.SignatureID,
+				Verified:    result.Verified,
+				Message:     result.Message,
+			},
+		})
+
+		log.WithFields(f).Debugf("signed document verified: %t", result.Verified)
+		return signatures.NewVerifySignedDocumentOK().WithXRequestID(reqID).WithPayload(result)
+	})
+
 	api.SignaturesDownloadProjectSignatureICLAsHandler = signatures.DownloadProjectSignatureICLAsHandlerFunc(func(params signatures.DownloadProjectSignatureICLAsParams, authUser *auth.User) middleware.Responder {
 		reqID := utils.GetRequestID(params.XREQUESTID)
 		ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID) // nolint
@@ -1394,6 +1452,90 @@ func Configure(api *operations.EasyclaAPI, claGroupService service.Service, proj
 		return signatures.NewEclaAutoCreateOK().WithXRequestID(reqID)
 	})
 
+	api.SignaturesUpdateSignatureExpiryHandler = signatures.UpdateSignatureExpiryHandlerFunc(func(params signatures.UpdateSignatureExpiryParams, authUser *auth.User) middleware.Responder {
+		reqID := utils.GetRequestID(params.XREQUESTID)
+		ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID) // nolint
+		utils.SetAuthUserProperties(authUser, params.XUSERNAME, params.XEMAIL)
+		f := logrus.Fields{
+			"functionName":   "v2.signatures.handlers.SignaturesUpdateSignatureExpiryHandler",
+			utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+			"signatureID":    params.SignatureID,
+		}
+
+		if params.Body == nil {
+			return signatures.NewUpdateSignatureExpiryBadRequest().WithXRequestID(reqID).WithPayload(
+				utils.ErrorResponseBadRequest(reqID, "missing request body"))
+		}
+		f["expiresOn"] = params.Body.ExpiresOn
+
+		log.WithFields(f).Debug("loading signature...")
+		signature, err := v1SignatureService.GetSignature(ctx, params.SignatureID)
+		if err != nil {
+			msg := "error retrieving signatures by signature ID"
+			log.WithFields(f).WithError(err).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryBadRequest().WithXRequestID(reqID).WithPayload(utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+		}
+		if signature == nil {
+			msg := "signature search by ID not found"
+			log.WithFields(f).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryNotFound().WithXRequestID(reqID).WithPayload(utils.ErrorResponseNotFound(reqID, msg))
+		}
+
+		// Employee acknowledgements are covered by the corporate signature
+		if signature.ClaType == utils.ClaTypeECLA {
+			msg := "the expiry date can only be set on individual and corporate signatures"
+			log.WithFields(f).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryBadRequest().WithXRequestID(reqID).WithPayload(utils.ErrorResponseBadRequest(reqID, msg))
+		}
+
+		log.WithFields(f).Debug("checking access control permissions for user...")
+		if !utils.IsUserAdmin(authUser) && !isUserHaveAccessToCLAGroupProjects(ctx, authUser, signature.ProjectID, projectClaGroupsRepo, projectRepo) {
+			msg := fmt.Sprintf("user %s is not authorized to update the signature expiry date", authUser.UserName)
+			log.WithFields(f).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryForbidden().WithXRequestID(reqID).WithPayload(utils.ErrorResponseForbidden(reqID, msg))
+		}
+
+		err = v2SignatureService.UpdateSignatureExpiry(ctx, signature.SignatureID, params.Body.ExpiresOn)
+		if err != nil {
+			msg := "unable to update the signature expiry date"
+			log.WithFields(f).WithError(err).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryBadRequest().WithXRequestID(reqID).WithPayload(
+				utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+		}
+
+		eventsService.LogEventWithContext(ctx, &events.LogEventArgs{
+			EventType:  events.SignatureExpiryUpdated,
+			CLAGroupID: signature.ProjectID,
+			ProjectID:  signature.ProjectID,
+			LfUsername: authUser.UserName,
+			UserName:   authUser.UserName,
+			EventData: &events.SignatureExpiryUpdatedEventData{
+				SignatureID: signature.SignatureID,
+				ClaType:     signature.ClaType,
+				OldExpiry:   signature.SignatureExpiresOn,
+				NewExpiry:   params.Body.ExpiresOn,
+			},
+		})
+
+		// Reload the signature with the new expiry date
+		signature, err = v1SignatureService.GetSignature(ctx, params.SignatureID)
+		if err != nil || signature == nil {
+			msg := "error retrieving the updated signature by signature ID"
+			log.WithFields(f).WithError(err).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryInternalServerError().WithXRequestID(reqID).WithPayload(utils.ErrorResponseInternalServerErrorWithError(reqID, msg, err))
+		}
+
+		resp, err := v2Signature(signature)
+		if err != nil {
+			msg := "problem converting v1 signature to v2"
+			log.WithFields(f).WithError(err).Warn(msg)
+			return signatures.NewUpdateSignatureExpiryBadRequest().WithXRequestID(reqID).WithPayload(
+				utils.ErrorResponseBadRequestWithError(reqID, msg, err))
+		}
+
+		return signatures.NewUpdateSignatureExpiryOK().WithXRequestID(reqID).WithPayload(resp)
+	})
+
 	api.SignaturesIsAuthorizedHandler = signatures.IsAuthorizedHandlerFunc(func(params signatures.IsAuthorizedParams) middleware.Responder {
 		reqID := utils.GetRequestID(params.XREQUESTID)
 		ctx := context.WithValue(context.Background(), utils.XREQUESTID, reqID) // nolint
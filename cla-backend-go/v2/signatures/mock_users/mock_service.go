@@ -142,6 +142,36 @@ func (mr *MockServiceMockRecorder) GetUserByGitLabUsername(gitlabUsername interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByGitLabUsername", reflect.TypeOf((*MockService)(nil).GetUserByGitLabUsername), gitlabUsername)
 }
 
+// GetUserByGiteaID mocks base method.
+func (m *MockService) GetUserByGiteaID(giteaID string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByGiteaID", giteaID)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByGiteaID indicates an expected call of GetUserByGiteaID.
+func (mr *MockServiceMockRecorder) GetUserByGiteaID(giteaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByGiteaID", reflect.TypeOf((*MockService)(nil).GetUserByGiteaID), giteaID)
+}
+
+// GetUserByGiteaUsername mocks base method.
+func (m *MockService) GetUserByGiteaUsername(giteaUsername string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByGiteaUsername", giteaUsername)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByGiteaUsername indicates an expected call of GetUserByGiteaUsername.
+func (mr *MockServiceMockRecorder) GetUserByGiteaUsername(giteaUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByGiteaUsername", reflect.TypeOf((*MockService)(nil).GetUserByGiteaUsername), giteaUsername)
+}
+
 // GetUserByGitlabID mocks base method.
 func (m *MockService) GetUserByGitlabID(gitHubID int) (*models.User, error) {
 	m.ctrl.T.Helper()
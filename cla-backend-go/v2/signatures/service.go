@@ -55,10 +55,12 @@ type ServiceInterface interface {
 	GetClaGroupCorporateContributorsCsv(ctx context.Context, claGroupID string, companyID string) ([]byte, error)
 	GetClaGroupCorporateContributors(ctx context.Context, params v2Sigs.ListClaGroupCorporateContributorsParams) (*models.CorporateContributorList, error)
 	GetSignedDocument(ctx context.Context, signatureID string) (*models.SignedDocument, error)
+	VerifySignedDocument(ctx context.Context, signatureID string) (*models.SignedDocumentVerification, error)
 	GetSignedIclaZipPdf(claGroupID string) (*models.URLObject, error)
 	GetSignedCclaZipPdf(claGroupID string) (*models.URLObject, error)
 	InvalidateICLA(ctx context.Context, claGroupID string, userID string, authUser *auth.User, eventsService events.Service, eventArgs *events.LogEventArgs) error
 	EclaAutoCreate(ctx context.Context, signatureID string, autoCreateECLA bool) error
+	UpdateSignatureExpiry(ctx context.Context, signatureID, expiresOn string) error
 	IsUserAuthorized(ctx context.Context, lfid, claGroupId string) (*models.LfidAuthorizedResponse, error)
 }
 
@@ -252,14 +254,7 @@ func (s *Service) GetSignedDocument(ctx context.Context, signatureID string) (*m
 	if sig.SignatureType == utils.SignatureTypeCLA && sig.CompanyName != "" {
 		return nil, errors.New("bad request. employee signature does not have signed document")
 	}
-	var url string
-	switch sig.SignatureType {
-	case utils.SignatureTypeCLA:
-		url = utils.SignedCLAFilename(sig.ProjectID, "icla", sig.SignatureReferenceID, sig.SignatureID)
-	case utils.SignatureTypeCCLA:
-		url = utils.SignedCLAFilename(sig.ProjectID, "ccla", sig.SignatureReferenceID, sig.SignatureID)
-	}
-	signedURL, err := utils.GetDownloadLink(url)
+	signedURL, err := utils.GetDownloadLink(signedDocumentKey(sig))
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +264,75 @@ func (s *Service) GetSignedDocument(ctx context.Context, signatureID string) (*m
 	}, nil
 }
 
+// VerifySignedDocument re-hashes the stored signed document of the specified signature ID and compares it with the
+// digest recorded when the document was stored
+func (s *Service) VerifySignedDocument(ctx context.Context, signatureID string) (*models.SignedDocumentVerification, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.signatures.service.VerifySignedDocument",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signatureID,
+	}
+
+	sig, err := s.v1SignatureService.GetSignature(ctx, signatureID)
+	if err != nil {
+		return nil, err
+	}
+	if sig.SignatureType == utils.SignatureTypeCLA && sig.CompanyName != "" {
+		return nil, errors.New("bad request. employee signature does not have signed document")
+	}
+
+	_, verifiedOn := utils.CurrentTime()
+	result := &models.SignedDocumentVerification{
+		SignatureID:    signatureID,
+		RecordedSha256: sig.SignatureDocumentSHA256,
+		RecordedSize:   sig.SignatureDocumentSize,
+		StoredOn:       sig.SignatureDocumentStoredOn,
+		VerifiedOn:     verifiedOn,
+	}
+
+	key := signedDocumentKey(sig)
+	exists, err := utils.DocumentExists(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		result.Message = "the signed document is not stored"
+		return result, nil
+	}
+
+	signedDocument, err := utils.DownloadFromS3(key)
+	if err != nil {
+		return nil, err
+	}
+	result.CurrentSha256 = utils.SignedDocumentDigest(signedDocument)
+	result.CurrentSize = int64(len(signedDocument))
+
+	switch {
+	case sig.SignatureDocumentSHA256 == "":
+		result.Message = "no digest was recorded when the signed document was stored"
+	case result.CurrentSha256 != sig.SignatureDocumentSHA256 || result.CurrentSize != sig.SignatureDocumentSize:
+		log.WithFields(f).Warnf("signed document digest mismatch - recorded: %s, current: %s", sig.SignatureDocumentSHA256, result.CurrentSha256)
+		result.Message = "the signed document does not match the recorded digest"
+	default:
+		result.Verified = true
+		result.Message = "the signed document matches the recorded digest"
+	}
+
+	return result, nil
+}
+
+// signedDocumentKey returns the S3 key of the signed document of the signature
+func signedDocumentKey(sig *v1Models.Signature) string {
+	var key string
+	switch sig.SignatureType {
+	case utils.SignatureTypeCLA:
+		key = utils.SignedCLAFilename(sig.ProjectID, utils.ClaTypeICLA, sig.SignatureReferenceID, sig.SignatureID)
+	case utils.SignatureTypeCCLA:
+		key = utils.SignedCLAFilename(sig.ProjectID, utils.ClaTypeCCLA, sig.SignatureReferenceID, sig.SignatureID)
+	}
+	return key
+}
+
 // GetSignedCclaZipPdf returns the signed CCLA Zip PDF reference
 func (s *Service) GetSignedCclaZipPdf(claGroupID string) (*models.URLObject, error) {
 	url := utils.SignedClaGroupZipFilename(claGroupID, utils.ClaTypeCCLA)
@@ -436,6 +500,32 @@ func (s *Service) EclaAutoCreate(ctx context.Context, signatureID string, autoCr
 	return nil
 }
 
+// UpdateSignatureExpiry sets the expiry date of the signature, an empty value removes the expiry date - the expiry
+// reminder is sent again for the new date
+func (s *Service) UpdateSignatureExpiry(ctx context.Context, signatureID, expiresOn string) error {
+	f := logrus.Fields{
+		"functionName":   "v2.signatures.service.UpdateSignatureExpiry",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"signatureID":    signatureID,
+		"expiresOn":      expiresOn,
+	}
+
+	if expiresOn != "" {
+		expiryDate, err := utils.ParseDateTime(expiresOn)
+		if err != nil {
+			log.WithFields(f).WithError(err).Debug("unable to parse the signature expiry date")
+			return err
+		}
+		expiresOn = utils.TimeToString(expiryDate)
+	}
+
+	log.WithFields(f).Debug("updating the signature expiry date...")
+	return s.v1SignatureRepo.UpdateSignature(ctx, signatureID, map[string]interface{}{
+		"signature_expires_on":         expiresOn,
+		"signature_expiry_notified_on": "",
+	})
+}
+
 func (s *Service) IsUserAuthorized(ctx context.Context, lfid, claGroupId string) (*models.LfidAuthorizedResponse, error) {
 	f := logrus.Fields{
 		"functionName":   "v2.signatures.service.IsUserAuthorized",
@@ -492,6 +582,16 @@ func (s *Service) IsUserAuthorized(ctx context.Context, lfid, claGroupId string)
 		log.WithFields(f).WithError(iclaErr).Debug("unable to get individual signature")
 	}
 
+	if icla != nil && signatures.IsResignRequired(icla) {
+		log.WithFields(f).Debugf("user ICLA signature: %s was superseded by a new CLA document version and must be re-signed", icla.SignatureID)
+		icla = nil
+	}
+
+	if icla != nil && signatures.IsSignatureExpired(icla) {
+		log.WithFields(f).Debugf("user ICLA signature: %s expired on %s and must be renewed", icla.SignatureID, icla.SignatureExpiresOn)
+		icla = nil
+	}
+
 	if icla != nil {
 		log.WithFields(f).Debug("user has signed ICLA")
 		response.ICLA = true
@@ -0,0 +1,202 @@
+// Copyright The Linux Foundation and each contributor to CommunityBridge.
+// SPDX-License-Identifier: MIT
+
+package signatures
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/LF-Engineering/lfx-kit/auth"
+	mock_company "github.com/communitybridge/easycla/cla-backend-go/company/mocks"
+	v1Models "github.com/communitybridge/easycla/cla-backend-go/gen/v1/models"
+	mock_project "github.com/communitybridge/easycla/cla-backend-go/project/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups"
+	mock_projects_cla_groups "github.com/communitybridge/easycla/cla-backend-go/projects_cla_groups/mocks"
+	mock_v1_signatures "github.com/communitybridge/easycla/cla-backend-go/signatures/mocks"
+	"github.com/communitybridge/easycla/cla-backend-go/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSignedDocumentStorage serves the stored signed documents by S3 key
+type fakeSignedDocumentStorage struct {
+	documents map[string][]byte
+}
+
+func (s *fakeSignedDocumentStorage) Upload(fileContent []byte, projectID string, claType string, identifier string, signatureID string) error {
+	return nil
+}
+
+func (s *fakeSignedDocumentStorage) UploadFile(file *os.File, projectID string, claType string, identifier string, signatureID string) error {
+	return nil
+}
+
+func (s *fakeSignedDocumentStorage) UploadKey(fileContent []byte, key string) error {
+	return nil
+}
+
+func (s *fakeSignedDocumentStorage) Download(filename string) ([]byte, error) {
+	return s.documents[filename], nil
+}
+
+func (s *fakeSignedDocumentStorage) Delete(filename string) error {
+	return nil
+}
+
+func (s *fakeSignedDocumentStorage) GetPresignedURL(filename string) (string, error) {
+	return "", nil
+}
+
+func (s *fakeSignedDocumentStorage) KeyExists(key string) (bool, error) {
+	_, ok := s.documents[key]
+	return ok, nil
+}
+
+func TestService_VerifySignedDocument(t *testing.T) {
+	signedDocument := []byte("%PDF-1.4 signed corporate CLA")
+	key := utils.SignedCLAFilename("cla-group-1", utils.ClaTypeCCLA, "company-1", "signature-1")
+
+	testCases := []struct {
+		Name             string
+		Signature        *v1Models.Signature
+		StoredDocument   []byte
+		ExpectedErr      string
+		ExpectedVerified bool
+		ExpectedMessage  string
+	}{
+		{
+			Name:             "stored document matches the recorded digest",
+			Signature:        signedCCLA(utils.SignedDocumentDigest(signedDocument), int64(len(signedDocument))),
+			StoredDocument:   signedDocument,
+			ExpectedVerified: true,
+			ExpectedMessage:  "the signed document matches the recorded digest",
+		},
+		{
+			Name:            "altered document does not match the recorded digest",
+			Signature:       signedCCLA(utils.SignedDocumentDigest(signedDocument), int64(len(signedDocument))),
+			StoredDocument:  []byte("%PDF-1.4 altered corporate CLA"),
+			ExpectedMessage: "the signed document does not match the recorded digest",
+		},
+		{
+			Name:            "document of another size does not match the recorded size",
+			Signature:       signedCCLA(utils.SignedDocumentDigest(signedDocument), int64(len(signedDocument))+1),
+			StoredDocument:  signedDocument,
+			ExpectedMessage: "the signed document does not match the recorded digest",
+		},
+		{
+			Name:            "document stored before the digests were recorded",
+			Signature:       signedCCLA("", 0),
+			StoredDocument:  signedDocument,
+			ExpectedMessage: "no digest was recorded when the signed document was stored",
+		},
+		{
+			Name:            "missing document",
+			Signature:       signedCCLA(utils.SignedDocumentDigest(signedDocument), int64(len(signedDocument))),
+			ExpectedMessage: "the signed document is not stored",
+		},
+		{
+			Name: "employee signature has no signed document",
+			Signature: &v1Models.Signature{
+				SignatureID:   "signature-1",
+				SignatureType: utils.SignatureTypeCLA,
+				CompanyName:   "Acme",
+			},
+			ExpectedErr: "bad request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := &fakeSignedDocumentStorage{documents: map[string][]byte{}}
+			if tc.StoredDocument != nil {
+				storage.documents[key] = tc.StoredDocument
+			}
+			utils.SetS3StorageClient(storage)
+
+			v1SignatureService := mock_v1_signatures.NewMockSignatureService(ctrl)
+			v1SignatureService.EXPECT().GetSignature(gomock.Any(), "signature-1").Return(tc.Signature, nil)
+
+			s := &Service{v1SignatureService: v1SignatureService}
+			result, err := s.VerifySignedDocument(context.Background(), "signature-1")
+			if tc.ExpectedErr != "" {
+				assert.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), tc.ExpectedErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedVerified, result.Verified)
+			assert.Equal(t, tc.ExpectedMessage, result.Message)
+			assert.Equal(t, tc.Signature.SignatureDocumentSHA256, result.RecordedSha256)
+			if tc.StoredDocument != nil {
+				assert.Equal(t, utils.SignedDocumentDigest(tc.StoredDocument), result.CurrentSha256)
+				assert.Equal(t, int64(len(tc.StoredDocument)), result.CurrentSize)
+			}
+		})
+	}
+}
+
+func TestIsUserHaveAccessOfSignedSignaturePDF(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		AuthUser       *auth.User
+		Signature      *v1Models.Signature
+		Company        *v1Models.Company
+		ExpectedAccess bool
+	}{
+		{
+			Name:           "admin has access",
+			AuthUser:       &auth.User{UserName: "admin", Admin: true},
+			Signature:      signedCCLA("", 0),
+			ExpectedAccess: true,
+		},
+		{
+			Name:      "user without a project scope has no access to the individual signature",
+			AuthUser:  &auth.User{UserName: "contributor"},
+			Signature: &v1Models.Signature{SignatureID: "signature-2", ProjectID: "cla-group-1", SignatureType: utils.SignatureTypeCLA, SignatureReferenceID: "user-1"},
+		},
+		{
+			Name:      "user without a project or project|org scope has no access to the corporate signature",
+			AuthUser:  &auth.User{UserName: "contributor"},
+			Signature: signedCCLA("", 0),
+			Company:   &v1Models.Company{CompanyID: "company-1", CompanyExternalID: "company-sfid-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectClaGroupsRepo := mock_projects_cla_groups.NewMockRepository(ctrl)
+			projectClaGroupsRepo.EXPECT().GetProjectsIdsForClaGroup(gomock.Any(), "cla-group-1").Return([]*projects_cla_groups.ProjectClaGroup{
+				{ProjectSFID: "project-sfid-1", FoundationSFID: "foundation-sfid-1", ClaGroupID: "cla-group-1"},
+			}, nil)
+			companyService := mock_company.NewMockIService(ctrl)
+			if tc.Company != nil {
+				companyService.EXPECT().GetCompany(gomock.Any(), tc.Signature.SignatureReferenceID).Return(tc.Company, nil)
+			}
+
+			haveAccess, err := isUserHaveAccessOfSignedSignaturePDF(context.Background(), tc.AuthUser, tc.Signature, companyService, projectClaGroupsRepo, mock_project.NewMockProjectRepository(ctrl))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedAccess, haveAccess)
+		})
+	}
+}
+
+func signedCCLA(digest string, size int64) *v1Models.Signature {
+	return &v1Models.Signature{
+		SignatureID:             "signature-1",
+		ProjectID:               "cla-group-1",
+		SignatureType:           utils.SignatureTypeCCLA,
+		SignatureReferenceID:    "company-1",
+		SignatureDocumentSHA256: digest,
+		SignatureDocumentSize:   size,
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
@@ -31,6 +33,11 @@ type Repository interface {
 	SetActiveSignatureMetaData(ctx context.Context, key string, expire int64, value string) error
 	GetActiveSignatureMetaData(ctx context.Context, UserId string) (map[string]interface{}, error)
 	DeleteActiveSignatureMetaData(ctx context.Context, key string) error
+	SetValue(ctx context.Context, key string, expire int64, value string) error
+	GetValue(ctx context.Context, key string) (string, error)
+	SetValueIfNotExists(ctx context.Context, key string, expire int64, value string) (bool, error)
+	SetValueIfEquals(ctx context.Context, key string, expire int64, value, expectedValue string) (bool, error)
+	DeleteValue(ctx context.Context, key string) error
 }
 
 type repo struct {
@@ -204,3 +211,173 @@ func (r repo) DeleteActiveSignatureMetaData(ctx context.Context, key string) err
 
 	return nil
 }
+
+// SetValue saves the value for the specified key, expire is the epoch time in seconds when the record is expired
+func (r repo) SetValue(ctx context.Context, key string, expire int64, value string) error {
+	f := logrus.Fields{
+		"functionName":   "v2.store.repository.SetValue",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"key":            key,
+		"expire":         expire,
+	}
+
+	v, err := dynamodbattribute.MarshalMap(DBStore{
+		Key:    key,
+		Value:  value,
+		Expire: float64(expire),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem marshalling store record")
+		return err
+	}
+
+	_, err = r.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		Item:      v,
+		TableName: &r.storeTableName,
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to save store record")
+		return err
+	}
+
+	return nil
+}
+
+// GetValue returns the value for the specified key, returns an empty value if the key is not found
+func (r repo) GetValue(ctx context.Context, key string) (string, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.store.repository.GetValue",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"key":            key,
+	}
+
+	result, err := r.dynamoDBClient.GetItem(&dynamodb.GetItemInput{
+		TableName: &r.storeTableName,
+		Key: map[string]*dynamodb.AttributeValue{
+			"key": {
+				S: &key,
+			},
+		},
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem querying store table")
+		return "", err
+	}
+
+	if result.Item == nil {
+		log.WithFields(f).Debug("no record found")
+		return "", nil
+	}
+
+	var record DBStore
+	err = dynamodbattribute.UnmarshalMap(result.Item, &record)
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem unmarshalling store record")
+		return "", err
+	}
+
+	return record.Value, nil
+}
+
+// SetValueIfNotExists saves the value for the specified key only if the key does not exist, returns false if the key
+// already exists
+func (r repo) SetValueIfNotExists(ctx context.Context, key string, expire int64, value string) (bool, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.store.repository.SetValueIfNotExists",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"key":            key,
+		"expire":         expire,
+	}
+
+	v, err := dynamodbattribute.MarshalMap(DBStore{
+		Key:    key,
+		Value:  value,
+		Expire: float64(expire),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem marshalling store record")
+		return false, err
+	}
+
+	_, err = r.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		Item:                     v,
+		TableName:                &r.storeTableName,
+		ConditionExpression:      aws.String("attribute_not_exists(#K)"),
+		ExpressionAttributeNames: map[string]*string{"#K": aws.String("key")},
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			log.WithFields(f).Debug("store record already exists")
+			return false, nil
+		}
+		log.WithFields(f).WithError(err).Warn("unable to save store record")
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SetValueIfEquals saves the value for the specified key only if the current value of the key is the expected value,
+// returns false if the key does not exist or the value was changed
+func (r repo) SetValueIfEquals(ctx context.Context, key string, expire int64, value, expectedValue string) (bool, error) {
+	f := logrus.Fields{
+		"functionName":   "v2.store.repository.SetValueIfEquals",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"key":            key,
+		"expire":         expire,
+	}
+
+	v, err := dynamodbattribute.MarshalMap(DBStore{
+		Key:    key,
+		Value:  value,
+		Expire: float64(expire),
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("problem marshalling store record")
+		return false, err
+	}
+
+	_, err = r.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		Item:                     v,
+		TableName:                &r.storeTableName,
+		ConditionExpression:      aws.String("#V = :expected"),
+		ExpressionAttributeNames: map[string]*string{"#V": aws.String("value")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":expected": {S: aws.String(expectedValue)},
+		},
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			log.WithFields(f).Debug("store record value was changed")
+			return false, nil
+		}
+		log.WithFields(f).WithError(err).Warn("unable to save store record")
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteValue deletes the value for the specified key, deleting a key which does not exist is not an error
+func (r repo) DeleteValue(ctx context.Context, key string) error {
+	f := logrus.Fields{
+		"functionName":   "v2.store.repository.DeleteValue",
+		utils.XREQUESTID: ctx.Value(utils.XREQUESTID),
+		"key":            key,
+	}
+
+	_, err := r.dynamoDBClient.DeleteItem(&dynamodb.DeleteItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"key": {
+				S: &key,
+			},
+		},
+		TableName: &r.storeTableName,
+	})
+	if err != nil {
+		log.WithFields(f).WithError(err).Warn("unable to delete store record")
+		return err
+	}
+
+	return nil
+}
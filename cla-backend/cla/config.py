@@ -101,6 +101,8 @@ GITHUB_PR_NOTIFICATION = 'status+comment'
 
 # GitHub Application Service.
 GITHUB_APP_WEBHOOK_SECRET = os.getenv("GITHUB_APP_WEBHOOK_SECRET", "")
+# Webhook secret of the apps registered on the GitHub Enterprise Server instances
+GITHUB_ENTERPRISE_APP_WEBHOOK_SECRET = os.getenv("GITHUB_ENTERPRISE_APP_WEBHOOK_SECRET", "")
 
 # GitHub Oauth token used for authenticated GitHub API calls and testing
 GITHUB_OAUTH_TOKEN = os.environ.get('GITHUB_OAUTH_TOKEN', '')
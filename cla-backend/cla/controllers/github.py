@@ -801,18 +801,26 @@ def validate_organization(body):
             return {"status": "error"}
 
 
-def webhook_secret_validation(webhook_signature: str, data: bytes) -> bool:
+def webhook_secret_validation(webhook_signature: str, data: bytes, enterprise_host: Optional[str] = None) -> bool:
     """
     webhook_secret_validation checks if webhook_signature is same as incoming data's
     :param webhook_signature:
     :param data:
+    :param enterprise_host: the GitHub Enterprise Server host of the webhook, validated with the secret of the
+    enterprise apps - None for github.com
     :return:
     """
     fn = 'webhook_secret_validation'
     cla.log.debug(f'{fn} for signature {webhook_signature}')
-    if cla.config.GITHUB_APP_WEBHOOK_SECRET == "":
-        cla.log.warning(f'{fn} - GITHUB_APP_WEBHOOK_SECRET is empty - unable to validate webhook secret')
-        raise RuntimeError("GITHUB_APP_WEBHOOK_SECRET is empty")
+    if enterprise_host:
+        secret_name = 'GITHUB_ENTERPRISE_APP_WEBHOOK_SECRET'
+        webhook_secret = cla.config.GITHUB_ENTERPRISE_APP_WEBHOOK_SECRET
+    else:
+        secret_name = 'GITHUB_APP_WEBHOOK_SECRET'
+        webhook_secret = cla.config.GITHUB_APP_WEBHOOK_SECRET
+    if webhook_secret == "":
+        cla.log.warning(f'{fn} - {secret_name} is empty - unable to validate webhook secret')
+        raise RuntimeError(f"{secret_name} is empty")
 
     if not webhook_signature:
         cla.log.warning(f'{fn} - webhook_signature not provided - unable to validate webhook callback')
@@ -824,7 +832,7 @@ def webhook_secret_validation(webhook_signature: str, data: bytes) -> bool:
         return False
 
     cla.log.debug(f'{fn} - calculating and comparing webhook secret...')
-    mac = hmac.new(cla.config.GITHUB_APP_WEBHOOK_SECRET.encode('utf-8'), msg=data, digestmod='sha1')
+    mac = hmac.new(webhook_secret.encode('utf-8'), msg=data, digestmod='sha1')
     hex_digest = mac.hexdigest()
     return True if hmac.compare_digest(hex_digest, signature.strip()) else False
 
@@ -8,6 +8,22 @@ import cla
 
 GITHUB_ACTIVITY_ENDPOINT = "/github/activity"
 
+# the installation actions handled by the v4 api - the installation created events are still handled in Python
+V4_INSTALLATION_ACTIONS = ("deleted", "suspend", "unsuspend", "new_permissions_accepted")
+
+
+def is_v4_installation_event(event_type: str, action: str) -> bool:
+    """
+    Returns True when the github installation event is handled by the v4 api
+
+    :param event_type: the X-GITHUB-EVENT header value
+    :type event_type: str
+    :param action: the action of the event, or None
+    :type action: str
+    :return: True if the installation event is forwarded to the v4 api
+    """
+    return event_type == "installation" and action in V4_INSTALLATION_ACTIONS
+
 
 def v4_easycla_github_activity(base_url: str, request: falcon.Request):
     """
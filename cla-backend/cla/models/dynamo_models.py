@@ -219,6 +219,36 @@ class GitLabUsernameIndex(GlobalSecondaryIndex):
     user_gitlab_username = UnicodeAttribute(hash_key=True)
 
 
+class GiteaIDIndex(GlobalSecondaryIndex):
+    """
+    This class represents a global secondary index for querying users by the instance qualified gitea ID.
+    """
+
+    class Meta:
+        index_name = "gitea-id-index"
+        write_capacity_units = int(cla.conf["DYNAMO_WRITE_UNITS"])
+        read_capacity_units = int(cla.conf["DYNAMO_READ_UNITS"])
+        projection = AllProjection()
+
+    # This attribute is the hash key for the index.
+    user_gitea_id = UnicodeAttribute(hash_key=True)
+
+
+class GiteaUsernameIndex(GlobalSecondaryIndex):
+    """
+    This class represents a global secondary index for querying users by the instance qualified gitea username.
+    """
+
+    class Meta:
+        index_name = "gitea-username-index"
+        write_capacity_units = int(cla.conf["DYNAMO_WRITE_UNITS"])
+        read_capacity_units = int(cla.conf["DYNAMO_READ_UNITS"])
+        projection = AllProjection()
+
+    # This attribute is the hash key for the index.
+    user_gitea_username = UnicodeAttribute(hash_key=True)
+
+
 class LFUsernameIndex(GlobalSecondaryIndex):
     """
     This class represents a global secondary index for querying users by LF Username.
@@ -1187,6 +1217,7 @@ class ProjectModel(BaseModel):
     project_ccla_enabled = BooleanAttribute(default=True)
     project_ccla_requires_icla_signature = BooleanAttribute(default=False)
     project_live = BooleanAttribute(default=False)
+    project_enforcement_mode = UnicodeAttribute(null=True)  # cla (default) or dco
     foundation_sfid = UnicodeAttribute(null=True)
     root_project_repositories_count = NumberAttribute(null=True)
     note = UnicodeAttribute(null=True)
@@ -1327,6 +1358,9 @@ class Project(model_interfaces.Project):  # pylint: disable=too-many-public-meth
     def get_project_live(self):
         return self.model.project_live
 
+    def get_project_enforcement_mode(self):
+        return self.model.project_enforcement_mode or "cla"
+
     def get_project_individual_documents(self):
         documents = []
         for doc in self.model.project_individual_documents:
@@ -1615,6 +1649,10 @@ class UserModel(BaseModel):
     user_gitlab_username = UnicodeAttribute(null=True)
     user_gitlab_id_index = GitLabIDIndex()
     user_gitlab_username_index = GitLabUsernameIndex()
+    user_gitea_id = UnicodeAttribute(null=True)
+    user_gitea_username = UnicodeAttribute(null=True)
+    user_gitea_id_index = GiteaIDIndex()
+    user_gitea_username_index = GiteaUsernameIndex()
     user_ldap_id = UnicodeAttribute(null=True)
     user_github_id_index = GitHubUserIndex()
     github_user_external_id_index = GithubUserExternalIndex()
@@ -2258,6 +2296,12 @@ class RepositoryModel(BaseModel):
     project_sfid = UnicodeAttribute(null=True)
     enabled = BooleanAttribute(default=False)
     note = UnicodeAttribute(null=True)
+    enforcement_mode = UnicodeAttribute(null=True)  # cla or dco, not set uses the CLA group enforcement mode
+    check_run_enabled = BooleanAttribute(null=True)  # GitHub check run instead of a commit status
+    trivial_change_rules = ListAttribute(of=MapAttribute, null=True)  # paths and max_changed_lines exempting trivial changes
+    include_branches = UnicodeSetAttribute(null=True)  # base branch globs the CLA check applies to, all when empty
+    exclude_branches = UnicodeSetAttribute(null=True)  # base branch globs the CLA check never applies to
+    cla_group_bindings = ListAttribute(of=MapAttribute, null=True)  # cla_group_id selected by paths and branches
     repository_external_index = ExternalRepositoryIndex()
     repository_project_index = ProjectRepositoryIndex()
     project_sfid_repository_index = ProjectSFIDRepositoryIndex()
@@ -2380,6 +2424,23 @@ class Repository(model_interfaces.Repository):
     def get_note(self):
         return self.model.note
 
+    def get_enforcement_mode(self):
+        return self.model.enforcement_mode
+
+    def get_check_run_enabled(self):
+        return bool(self.model.check_run_enabled)
+
+    def get_cla_group_ids(self):
+        """
+        Returns the CLA group of the repository followed by the CLA groups bound to paths or branches.
+        """
+        cla_group_ids = [self.model.repository_project_id]
+        for binding in self.model.cla_group_bindings or []:
+            cla_group_id = binding.as_dict().get("cla_group_id")
+            if cla_group_id and cla_group_id not in cla_group_ids:
+                cla_group_ids.append(cla_group_id)
+        return cla_group_ids
+
     def set_repository_id(self, repo_id):
         self.model.repository_id = str(repo_id)
 
@@ -115,6 +115,8 @@ class GitHub(repository_service_interface.RepositoryService):
         session["github_installation_id"] = installation_id
         session["github_repository_id"] = github_repository_id
         session["github_change_request_id"] = change_request_id
+        # the CLA group to sign when the changes are bound to several CLA groups
+        session["github_cla_group_id"] = request.get_param("cla_group_id")
 
         cla.log.debug(f"{fn} - Determining return URL from the inbound request...")
         origin_url = self.get_return_url(github_repository_id, change_request_id, installation_id)
@@ -249,8 +251,13 @@ class GitHub(repository_service_interface.RepositoryService):
             cla.log.warning(f"{fn} - Could not find repository with the following " f"repository_id: {repository_id}")
             return None
 
-        # Get project ID from this repository
+        # Get project ID from this repository, or the CLA group selected by the sign link when it is bound to the repository
         project_id = repository.get_repository_project_id()
+        session = self._get_request_session(request)
+        cla_group_id = session.get("github_cla_group_id")
+        if cla_group_id and cla_group_id in repository.get_cla_group_ids():
+            cla.log.debug(f"{fn} - using the CLA group: {cla_group_id} bound to the repository: {repository_id}")
+            project_id = cla_group_id
 
         try:
             project = get_project_instance()
@@ -804,6 +811,16 @@ class GitHub(repository_service_interface.RepositoryService):
         project = get_project_instance()
         project.load(str(project_id))
 
+        # Repositories in the DCO enforcement mode are checked for commit sign-offs by the Go backend, the repositories
+        # without an enforcement mode use the enforcement mode of their CLA group
+        enforcement_mode = repository.get_enforcement_mode() or project.get_project_enforcement_mode()
+        if enforcement_mode == "dco":
+            cla.log.debug(
+                f"{fn} - repository {repository.get_repository_url()} associated with "
+                f"PR: {pull_request.number} is in the DCO enforcement mode - ignoring PR request"
+            )
+            return
+
         try:
             # Save entry into the cla-{stage}-store table for active PRs
             set_active_pr_metadata(
@@ -26,7 +26,7 @@ import cla.controllers.user
 import cla.hug_types
 import cla.salesforce
 from cla.controllers.github import get_github_activity_action
-from cla.controllers.github_activity import v4_easycla_github_activity
+from cla.controllers.github_activity import is_v4_installation_event, v4_easycla_github_activity
 from cla.controllers.project_cla_group import get_project_cla_group
 from cla.models.dynamo_models import Repository, Gerrit
 from cla.project_service import ProjectService
@@ -1622,8 +1622,11 @@ def github_app_activity(body, request, response):
     cla.log.debug(f'{fn} - received github activity with event type: \'{event_type}\' with action: \'{action}\'')
 
     # if not any of the events above we handle it via python
+    # the GitHub Enterprise Server webhooks are signed with the secret of the enterprise apps
+    enterprise_host = request.headers.get('X-GITHUB-ENTERPRISE-HOST')
     valid_request = cla.controllers.github.webhook_secret_validation(request.headers.get('X-HUB-SIGNATURE'),
-                                                                     request.bounded_stream.read())
+                                                                     request.bounded_stream.read(),
+                                                                     enterprise_host)
     if not valid_request:
         cla.log.error(f'{fn} - webhook secret validation failed, sending email')
         maintainers = cla.config.PLATFORM_MAINTAINERS.split(',') if cla.config.PLATFORM_MAINTAINERS else []
@@ -1640,7 +1643,12 @@ def github_app_activity(body, request, response):
     if event_type == "installation_repositories" or \
             event_type == "integration_installation_repositories" or \
             event_type == "repository" or \
-            (event_type == "push" and action and action == "created"):
+            is_v4_installation_event(event_type, action) or \
+            (event_type == "push" and action and action == "created") or \
+            (event_type == "pull_request" and action in ("opened", "reopened", "synchronize", "enqueued")) or \
+            (event_type == "check_run" and action in ("rerequested", "requested_action")) or \
+            (event_type == "issue_comment" and action in ("created", "edited")) or \
+            (event_type == "merge_group" and action == "checks_requested"):
         try:
             cla.log.debug(f'{fn} - redirecting event type: \'{event_type}\' with action: \'{action}\' to v4 golang api')
             v4_easycla_github_activity(cla.config.PLATFORM_GATEWAY_URL, request)
@@ -1656,6 +1664,12 @@ def github_app_activity(body, request, response):
             return {"status": f'v4_easycla_github_activity failed {ex}'}
     cla.log.debug(f'{fn} - not forwarding event type: \'{event_type}\' with action: \'{action}\'.')
 
+    if enterprise_host:
+        # the python handlers only call github.com, the enterprise installations are registered with their organization
+        cla.log.debug(f'{fn} - ignoring event type: \'{event_type}\' from the github enterprise host: {enterprise_host}')
+        response.status = HTTP_OK
+        return {"status": "OK"}
+
     if event_type is None:
         cla.log.error(f"{fn} - unable to determine the event type from request headers: {request.headers}")
         response.status = HTTP_400
@@ -0,0 +1,27 @@
+# Copyright The Linux Foundation and each contributor to CommunityBridge.
+# SPDX-License-Identifier: MIT
+import unittest
+
+from cla.controllers.github_activity import is_v4_installation_event
+
+
+class TestGitHubActivityRouting(unittest.TestCase):
+
+    def test_installation_events_forwarded_to_v4(self) -> None:
+        for action in ("deleted", "suspend", "unsuspend", "new_permissions_accepted"):
+            self.assertTrue(is_v4_installation_event("installation", action),
+                            f'installation {action} event is handled by the v4 api')
+
+    def test_installation_created_handled_in_python(self) -> None:
+        self.assertFalse(is_v4_installation_event("installation", "created"),
+                         'installation created event is handled in python')
+        self.assertFalse(is_v4_installation_event("installation", None),
+                         'installation event without action is handled in python')
+
+    def test_other_events_not_installation(self) -> None:
+        self.assertFalse(is_v4_installation_event("installation_repositories", "added"))
+        self.assertFalse(is_v4_installation_event("integration_installation", "deleted"))
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -1,13 +1,15 @@
 # Copyright The Linux Foundation and each contributor to CommunityBridge.
 # SPDX-License-Identifier: MIT
+import hmac
 import logging
 import unittest
-from unittest.mock import Mock
+from unittest.mock import Mock, patch
 
 import cla
 from cla.controllers.github import (get_github_activity_action,
                                     get_org_name_from_installation_event,
-                                    notify_project_managers)
+                                    notify_project_managers,
+                                    webhook_secret_validation)
 from cla.controllers.repository import Repository
 from cla.models.ses_models import MockSES
 
@@ -706,6 +708,25 @@ class TestGitHubController(unittest.TestCase):
         self.assertEqual(msg2['To'], ['pm3@linuxfoundation.org'])
 
 
+    @patch('cla.config.GITHUB_ENTERPRISE_APP_WEBHOOK_SECRET', 'enterprise-secret')
+    @patch('cla.config.GITHUB_APP_WEBHOOK_SECRET', 'github-secret')
+    def test_webhook_secret_validation_enterprise_host(self):
+        data = b'{"action": "opened"}'
+        github_signature = 'sha1=' + hmac.new(b'github-secret', msg=data, digestmod='sha1').hexdigest()
+        enterprise_signature = 'sha1=' + hmac.new(b'enterprise-secret', msg=data, digestmod='sha1').hexdigest()
+
+        self.assertTrue(webhook_secret_validation(github_signature, data))
+        self.assertFalse(webhook_secret_validation(enterprise_signature, data))
+        self.assertTrue(webhook_secret_validation(enterprise_signature, data, 'github.example.com'))
+        self.assertFalse(webhook_secret_validation(github_signature, data, 'github.example.com'))
+
+    @patch('cla.config.GITHUB_ENTERPRISE_APP_WEBHOOK_SECRET', '')
+    @patch('cla.config.GITHUB_APP_WEBHOOK_SECRET', 'github-secret')
+    def test_webhook_secret_validation_enterprise_secret_missing(self):
+        with self.assertRaises(RuntimeError):
+            webhook_secret_validation('sha1=abc', b'{}', 'github.example.com')
+
+
 def mock_get_project_managers(username, project_id, enable_auth):
     if project_id == 'project_1':
         return [